@@ -22,10 +22,15 @@ type ChainClient interface {
 	GetBlock(ctx context.Context, in *Hash, opts ...grpc.CallOption) (*Block, error)
 	GetBlockHash(ctx context.Context, in *Number, opts ...grpc.CallOption) (*Hash, error)
 	GetAccountInfo(ctx context.Context, in *Account, opts ...grpc.CallOption) (*AccountInfo, error)
+	GetAccountProof(ctx context.Context, in *Account, opts ...grpc.CallOption) (*AccountProof, error)
+	GetContractStorage(ctx context.Context, in *ContractStorageKey, opts ...grpc.CallOption) (*ContractStorageValue, error)
+	GetAsset(ctx context.Context, in *AssetID, opts ...grpc.CallOption) (*AssetInfo, error)
+	GetBlockReceipts(ctx context.Context, in *Hash, opts ...grpc.CallOption) (*BlockReceipts, error)
+	GetContractReceipts(ctx context.Context, in *Hash, opts ...grpc.CallOption) (*ContractReceipts, error)
 	Sync(ctx context.Context, in *Hash, opts ...grpc.CallOption) (Chain_SyncClient, error)
 	SubscribeBlocks(ctx context.Context, in *Empty, opts ...grpc.CallOption) (Chain_SubscribeBlocksClient, error)
-	SubscribeTransactions(ctx context.Context, in *KeyPairs, opts ...grpc.CallOption) (Chain_SubscribeTransactionsClient, error)
-	SubscribeValidatorTransactions(ctx context.Context, in *KeyPairs, opts ...grpc.CallOption) (Chain_SubscribeValidatorTransactionsClient, error)
+	SubscribeTransactions(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (Chain_SubscribeTransactionsClient, error)
+	SubscribeValidatorTransactions(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (Chain_SubscribeValidatorTransactionsClient, error)
 }
 
 type chainClient struct {
@@ -81,6 +86,51 @@ func (c *chainClient) GetAccountInfo(ctx context.Context, in *Account, opts ...g
 	return out, nil
 }
 
+func (c *chainClient) GetAccountProof(ctx context.Context, in *Account, opts ...grpc.CallOption) (*AccountProof, error) {
+	out := new(AccountProof)
+	err := c.cc.Invoke(ctx, "/Chain/GetAccountProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chainClient) GetContractStorage(ctx context.Context, in *ContractStorageKey, opts ...grpc.CallOption) (*ContractStorageValue, error) {
+	out := new(ContractStorageValue)
+	err := c.cc.Invoke(ctx, "/Chain/GetContractStorage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chainClient) GetAsset(ctx context.Context, in *AssetID, opts ...grpc.CallOption) (*AssetInfo, error) {
+	out := new(AssetInfo)
+	err := c.cc.Invoke(ctx, "/Chain/GetAsset", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chainClient) GetBlockReceipts(ctx context.Context, in *Hash, opts ...grpc.CallOption) (*BlockReceipts, error) {
+	out := new(BlockReceipts)
+	err := c.cc.Invoke(ctx, "/Chain/GetBlockReceipts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chainClient) GetContractReceipts(ctx context.Context, in *Hash, opts ...grpc.CallOption) (*ContractReceipts, error) {
+	out := new(ContractReceipts)
+	err := c.cc.Invoke(ctx, "/Chain/GetContractReceipts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *chainClient) Sync(ctx context.Context, in *Hash, opts ...grpc.CallOption) (Chain_SyncClient, error) {
 	stream, err := c.cc.NewStream(ctx, &_Chain_serviceDesc.Streams[0], "/Chain/Sync", opts...)
 	if err != nil {
@@ -145,7 +195,7 @@ func (x *chainSubscribeBlocksClient) Recv() (*RawData, error) {
 	return m, nil
 }
 
-func (c *chainClient) SubscribeTransactions(ctx context.Context, in *KeyPairs, opts ...grpc.CallOption) (Chain_SubscribeTransactionsClient, error) {
+func (c *chainClient) SubscribeTransactions(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (Chain_SubscribeTransactionsClient, error) {
 	stream, err := c.cc.NewStream(ctx, &_Chain_serviceDesc.Streams[2], "/Chain/SubscribeTransactions", opts...)
 	if err != nil {
 		return nil, err
@@ -161,7 +211,7 @@ func (c *chainClient) SubscribeTransactions(ctx context.Context, in *KeyPairs, o
 }
 
 type Chain_SubscribeTransactionsClient interface {
-	Recv() (*RawData, error)
+	Recv() (*SubscriptionEvent, error)
 	grpc.ClientStream
 }
 
@@ -169,15 +219,15 @@ type chainSubscribeTransactionsClient struct {
 	grpc.ClientStream
 }
 
-func (x *chainSubscribeTransactionsClient) Recv() (*RawData, error) {
-	m := new(RawData)
+func (x *chainSubscribeTransactionsClient) Recv() (*SubscriptionEvent, error) {
+	m := new(SubscriptionEvent)
 	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
 	return m, nil
 }
 
-func (c *chainClient) SubscribeValidatorTransactions(ctx context.Context, in *KeyPairs, opts ...grpc.CallOption) (Chain_SubscribeValidatorTransactionsClient, error) {
+func (c *chainClient) SubscribeValidatorTransactions(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (Chain_SubscribeValidatorTransactionsClient, error) {
 	stream, err := c.cc.NewStream(ctx, &_Chain_serviceDesc.Streams[3], "/Chain/SubscribeValidatorTransactions", opts...)
 	if err != nil {
 		return nil, err
@@ -193,7 +243,7 @@ func (c *chainClient) SubscribeValidatorTransactions(ctx context.Context, in *Ke
 }
 
 type Chain_SubscribeValidatorTransactionsClient interface {
-	Recv() (*RawData, error)
+	Recv() (*SubscriptionEvent, error)
 	grpc.ClientStream
 }
 
@@ -201,8 +251,8 @@ type chainSubscribeValidatorTransactionsClient struct {
 	grpc.ClientStream
 }
 
-func (x *chainSubscribeValidatorTransactionsClient) Recv() (*RawData, error) {
-	m := new(RawData)
+func (x *chainSubscribeValidatorTransactionsClient) Recv() (*SubscriptionEvent, error) {
+	m := new(SubscriptionEvent)
 	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
@@ -218,10 +268,15 @@ type ChainServer interface {
 	GetBlock(context.Context, *Hash) (*Block, error)
 	GetBlockHash(context.Context, *Number) (*Hash, error)
 	GetAccountInfo(context.Context, *Account) (*AccountInfo, error)
+	GetAccountProof(context.Context, *Account) (*AccountProof, error)
+	GetContractStorage(context.Context, *ContractStorageKey) (*ContractStorageValue, error)
+	GetAsset(context.Context, *AssetID) (*AssetInfo, error)
+	GetBlockReceipts(context.Context, *Hash) (*BlockReceipts, error)
+	GetContractReceipts(context.Context, *Hash) (*ContractReceipts, error)
 	Sync(*Hash, Chain_SyncServer) error
 	SubscribeBlocks(*Empty, Chain_SubscribeBlocksServer) error
-	SubscribeTransactions(*KeyPairs, Chain_SubscribeTransactionsServer) error
-	SubscribeValidatorTransactions(*KeyPairs, Chain_SubscribeValidatorTransactionsServer) error
+	SubscribeTransactions(*SubscribeRequest, Chain_SubscribeTransactionsServer) error
+	SubscribeValidatorTransactions(*SubscribeRequest, Chain_SubscribeValidatorTransactionsServer) error
 	mustEmbedUnimplementedChainServer()
 }
 
@@ -244,16 +299,31 @@ func (UnimplementedChainServer) GetBlockHash(context.Context, *Number) (*Hash, e
 func (UnimplementedChainServer) GetAccountInfo(context.Context, *Account) (*AccountInfo, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAccountInfo not implemented")
 }
+func (UnimplementedChainServer) GetAccountProof(context.Context, *Account) (*AccountProof, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountProof not implemented")
+}
+func (UnimplementedChainServer) GetContractStorage(context.Context, *ContractStorageKey) (*ContractStorageValue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetContractStorage not implemented")
+}
+func (UnimplementedChainServer) GetAsset(context.Context, *AssetID) (*AssetInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAsset not implemented")
+}
+func (UnimplementedChainServer) GetBlockReceipts(context.Context, *Hash) (*BlockReceipts, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlockReceipts not implemented")
+}
+func (UnimplementedChainServer) GetContractReceipts(context.Context, *Hash) (*ContractReceipts, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetContractReceipts not implemented")
+}
 func (UnimplementedChainServer) Sync(*Hash, Chain_SyncServer) error {
 	return status.Errorf(codes.Unimplemented, "method Sync not implemented")
 }
 func (UnimplementedChainServer) SubscribeBlocks(*Empty, Chain_SubscribeBlocksServer) error {
 	return status.Errorf(codes.Unimplemented, "method SubscribeBlocks not implemented")
 }
-func (UnimplementedChainServer) SubscribeTransactions(*KeyPairs, Chain_SubscribeTransactionsServer) error {
+func (UnimplementedChainServer) SubscribeTransactions(*SubscribeRequest, Chain_SubscribeTransactionsServer) error {
 	return status.Errorf(codes.Unimplemented, "method SubscribeTransactions not implemented")
 }
-func (UnimplementedChainServer) SubscribeValidatorTransactions(*KeyPairs, Chain_SubscribeValidatorTransactionsServer) error {
+func (UnimplementedChainServer) SubscribeValidatorTransactions(*SubscribeRequest, Chain_SubscribeValidatorTransactionsServer) error {
 	return status.Errorf(codes.Unimplemented, "method SubscribeValidatorTransactions not implemented")
 }
 func (UnimplementedChainServer) mustEmbedUnimplementedChainServer() {}
@@ -359,6 +429,96 @@ func _Chain_GetAccountInfo_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Chain_GetAccountProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Account)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChainServer).GetAccountProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Chain/GetAccountProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChainServer).GetAccountProof(ctx, req.(*Account))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chain_GetContractStorage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContractStorageKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChainServer).GetContractStorage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Chain/GetContractStorage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChainServer).GetContractStorage(ctx, req.(*ContractStorageKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chain_GetAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssetID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChainServer).GetAsset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Chain/GetAsset",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChainServer).GetAsset(ctx, req.(*AssetID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chain_GetBlockReceipts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Hash)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChainServer).GetBlockReceipts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Chain/GetBlockReceipts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChainServer).GetBlockReceipts(ctx, req.(*Hash))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chain_GetContractReceipts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Hash)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChainServer).GetContractReceipts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Chain/GetContractReceipts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChainServer).GetContractReceipts(ctx, req.(*Hash))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Chain_Sync_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(Hash)
 	if err := stream.RecvMsg(m); err != nil {
@@ -402,7 +562,7 @@ func (x *chainSubscribeBlocksServer) Send(m *RawData) error {
 }
 
 func _Chain_SubscribeTransactions_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(KeyPairs)
+	m := new(SubscribeRequest)
 	if err := stream.RecvMsg(m); err != nil {
 		return err
 	}
@@ -410,7 +570,7 @@ func _Chain_SubscribeTransactions_Handler(srv interface{}, stream grpc.ServerStr
 }
 
 type Chain_SubscribeTransactionsServer interface {
-	Send(*RawData) error
+	Send(*SubscriptionEvent) error
 	grpc.ServerStream
 }
 
@@ -418,12 +578,12 @@ type chainSubscribeTransactionsServer struct {
 	grpc.ServerStream
 }
 
-func (x *chainSubscribeTransactionsServer) Send(m *RawData) error {
+func (x *chainSubscribeTransactionsServer) Send(m *SubscriptionEvent) error {
 	return x.ServerStream.SendMsg(m)
 }
 
 func _Chain_SubscribeValidatorTransactions_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(KeyPairs)
+	m := new(SubscribeRequest)
 	if err := stream.RecvMsg(m); err != nil {
 		return err
 	}
@@ -431,7 +591,7 @@ func _Chain_SubscribeValidatorTransactions_Handler(srv interface{}, stream grpc.
 }
 
 type Chain_SubscribeValidatorTransactionsServer interface {
-	Send(*RawData) error
+	Send(*SubscriptionEvent) error
 	grpc.ServerStream
 }
 
@@ -439,7 +599,7 @@ type chainSubscribeValidatorTransactionsServer struct {
 	grpc.ServerStream
 }
 
-func (x *chainSubscribeValidatorTransactionsServer) Send(m *RawData) error {
+func (x *chainSubscribeValidatorTransactionsServer) Send(m *SubscriptionEvent) error {
 	return x.ServerStream.SendMsg(m)
 }
 
@@ -467,6 +627,26 @@ var _Chain_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetAccountInfo",
 			Handler:    _Chain_GetAccountInfo_Handler,
 		},
+		{
+			MethodName: "GetAccountProof",
+			Handler:    _Chain_GetAccountProof_Handler,
+		},
+		{
+			MethodName: "GetContractStorage",
+			Handler:    _Chain_GetContractStorage_Handler,
+		},
+		{
+			MethodName: "GetAsset",
+			Handler:    _Chain_GetAsset_Handler,
+		},
+		{
+			MethodName: "GetBlockReceipts",
+			Handler:    _Chain_GetBlockReceipts_Handler,
+		},
+		{
+			MethodName: "GetContractReceipts",
+			Handler:    _Chain_GetContractReceipts_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -454,7 +454,9 @@ type Account struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Account   string  `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Slot      *uint64 `protobuf:"varint,2,opt,name=slot,proto3,oneof" json:"slot,omitempty"`
+	BlockHash string  `protobuf:"bytes,3,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
 }
 
 func (x *Account) Reset() {
@@ -496,6 +498,75 @@ func (x *Account) GetAccount() string {
 	return ""
 }
 
+func (x *Account) GetSlot() uint64 {
+	if x != nil && x.Slot != nil {
+		return *x.Slot
+	}
+	return 0
+}
+
+func (x *Account) GetBlockHash() string {
+	if x != nil {
+		return x.BlockHash
+	}
+	return ""
+}
+
+type StateQuery struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Slot      *uint64 `protobuf:"varint,1,opt,name=slot,proto3,oneof" json:"slot,omitempty"`
+	BlockHash string  `protobuf:"bytes,2,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+}
+
+func (x *StateQuery) Reset() {
+	*x = StateQuery{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_common_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StateQuery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StateQuery) ProtoMessage() {}
+
+func (x *StateQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StateQuery.ProtoReflect.Descriptor instead.
+func (*StateQuery) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *StateQuery) GetSlot() uint64 {
+	if x != nil && x.Slot != nil {
+		return *x.Slot
+	}
+	return 0
+}
+
+func (x *StateQuery) GetBlockHash() string {
+	if x != nil {
+		return x.BlockHash
+	}
+	return ""
+}
+
 type Tx struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -513,7 +584,7 @@ type Tx struct {
 func (x *Tx) Reset() {
 	*x = Tx{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_common_proto_msgTypes[9]
+		mi := &file_common_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -526,7 +597,7 @@ func (x *Tx) String() string {
 func (*Tx) ProtoMessage() {}
 
 func (x *Tx) ProtoReflect() protoreflect.Message {
-	mi := &file_common_proto_msgTypes[9]
+	mi := &file_common_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -539,7 +610,7 @@ func (x *Tx) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Tx.ProtoReflect.Descriptor instead.
 func (*Tx) Descriptor() ([]byte, []int) {
-	return file_common_proto_rawDescGZIP(), []int{9}
+	return file_common_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *Tx) GetHash() string {
@@ -615,7 +686,7 @@ type BlockHeader struct {
 func (x *BlockHeader) Reset() {
 	*x = BlockHeader{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_common_proto_msgTypes[10]
+		mi := &file_common_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -628,7 +699,7 @@ func (x *BlockHeader) String() string {
 func (*BlockHeader) ProtoMessage() {}
 
 func (x *BlockHeader) ProtoReflect() protoreflect.Message {
-	mi := &file_common_proto_msgTypes[10]
+	mi := &file_common_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -641,7 +712,7 @@ func (x *BlockHeader) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BlockHeader.ProtoReflect.Descriptor instead.
 func (*BlockHeader) Descriptor() ([]byte, []int) {
-	return file_common_proto_rawDescGZIP(), []int{10}
+	return file_common_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *BlockHeader) GetVersion() uint64 {
@@ -758,7 +829,7 @@ type Block struct {
 func (x *Block) Reset() {
 	*x = Block{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_common_proto_msgTypes[11]
+		mi := &file_common_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -771,7 +842,7 @@ func (x *Block) String() string {
 func (*Block) ProtoMessage() {}
 
 func (x *Block) ProtoReflect() protoreflect.Message {
-	mi := &file_common_proto_msgTypes[11]
+	mi := &file_common_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -784,7 +855,7 @@ func (x *Block) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Block.ProtoReflect.Descriptor instead.
 func (*Block) Descriptor() ([]byte, []int) {
-	return file_common_proto_rawDescGZIP(), []int{11}
+	return file_common_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *Block) GetHash() string {
@@ -841,7 +912,7 @@ type ValidatorsRegistry struct {
 func (x *ValidatorsRegistry) Reset() {
 	*x = ValidatorsRegistry{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_common_proto_msgTypes[12]
+		mi := &file_common_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -854,7 +925,7 @@ func (x *ValidatorsRegistry) String() string {
 func (*ValidatorsRegistry) ProtoMessage() {}
 
 func (x *ValidatorsRegistry) ProtoReflect() protoreflect.Message {
-	mi := &file_common_proto_msgTypes[12]
+	mi := &file_common_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -867,7 +938,7 @@ func (x *ValidatorsRegistry) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ValidatorsRegistry.ProtoReflect.Descriptor instead.
 func (*ValidatorsRegistry) Descriptor() ([]byte, []int) {
-	return file_common_proto_rawDescGZIP(), []int{12}
+	return file_common_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *ValidatorsRegistry) GetInfo() *ValidatorsInfo {
@@ -900,7 +971,7 @@ type ValidatorRegistry struct {
 func (x *ValidatorRegistry) Reset() {
 	*x = ValidatorRegistry{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_common_proto_msgTypes[13]
+		mi := &file_common_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -913,7 +984,7 @@ func (x *ValidatorRegistry) String() string {
 func (*ValidatorRegistry) ProtoMessage() {}
 
 func (x *ValidatorRegistry) ProtoReflect() protoreflect.Message {
-	mi := &file_common_proto_msgTypes[13]
+	mi := &file_common_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -926,7 +997,7 @@ func (x *ValidatorRegistry) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ValidatorRegistry.ProtoReflect.Descriptor instead.
 func (*ValidatorRegistry) Descriptor() ([]byte, []int) {
-	return file_common_proto_rawDescGZIP(), []int{13}
+	return file_common_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *ValidatorRegistry) GetBalance() string {
@@ -986,7 +1057,7 @@ type ValidatorsInfo struct {
 func (x *ValidatorsInfo) Reset() {
 	*x = ValidatorsInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_common_proto_msgTypes[14]
+		mi := &file_common_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -999,7 +1070,7 @@ func (x *ValidatorsInfo) String() string {
 func (*ValidatorsInfo) ProtoMessage() {}
 
 func (x *ValidatorsInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_common_proto_msgTypes[14]
+	mi := &file_common_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1012,7 +1083,7 @@ func (x *ValidatorsInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ValidatorsInfo.ProtoReflect.Descriptor instead.
 func (*ValidatorsInfo) Descriptor() ([]byte, []int) {
-	return file_common_proto_rawDescGZIP(), []int{14}
+	return file_common_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *ValidatorsInfo) GetActive() int64 {
@@ -1063,7 +1134,7 @@ type Head struct {
 func (x *Head) Reset() {
 	*x = Head{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_common_proto_msgTypes[15]
+		mi := &file_common_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1076,7 +1147,7 @@ func (x *Head) String() string {
 func (*Head) ProtoMessage() {}
 
 func (x *Head) ProtoReflect() protoreflect.Message {
-	mi := &file_common_proto_msgTypes[15]
+	mi := &file_common_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1089,7 +1160,7 @@ func (x *Head) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Head.ProtoReflect.Descriptor instead.
 func (*Head) Descriptor() ([]byte, []int) {
-	return file_common_proto_rawDescGZIP(), []int{15}
+	return file_common_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *Head) GetHeight() uint64 {
@@ -1145,10 +1216,19 @@ var file_common_proto_rawDesc = []byte{
 	0x09, 0x52, 0x07, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x22, 0x31, 0x0a, 0x07, 0x52, 0x61,
 	0x77, 0x44, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x23, 0x0a,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x64, 0x0a,
 	0x07, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f,
 	0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x22, 0xae, 0x01, 0x0a, 0x02, 0x54, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73,
+	0x6e, 0x74, 0x12, 0x17, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x48, 0x00, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x0a, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x73,
+	0x6c, 0x6f, 0x74, 0x22, 0x4d, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x65, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x12, 0x17, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x48,
+	0x00, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x73, 0x6c,
+	0x6f, 0x74, 0x22, 0xae, 0x01, 0x0a, 0x02, 0x54, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73,
 	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x0e, 0x0a,
 	0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x26, 0x0a,
 	0x0f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79,
@@ -1262,7 +1342,7 @@ func file_common_proto_rawDescGZIP() []byte {
 	return file_common_proto_rawDescData
 }
 
-var file_common_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_common_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
 var file_common_proto_goTypes = []interface{}{
 	(*Empty)(nil),              // 0: Empty
 	(*Hash)(nil),               // 1: Hash
@@ -1273,18 +1353,19 @@ var file_common_proto_goTypes = []interface{}{
 	(*KeyPair)(nil),            // 6: KeyPair
 	(*RawData)(nil),            // 7: RawData
 	(*Account)(nil),            // 8: Account
-	(*Tx)(nil),                 // 9: Tx
-	(*BlockHeader)(nil),        // 10: BlockHeader
-	(*Block)(nil),              // 11: Block
-	(*ValidatorsRegistry)(nil), // 12: ValidatorsRegistry
-	(*ValidatorRegistry)(nil),  // 13: ValidatorRegistry
-	(*ValidatorsInfo)(nil),     // 14: ValidatorsInfo
-	(*Head)(nil),               // 15: Head
+	(*StateQuery)(nil),         // 9: StateQuery
+	(*Tx)(nil),                 // 10: Tx
+	(*BlockHeader)(nil),        // 11: BlockHeader
+	(*Block)(nil),              // 12: Block
+	(*ValidatorsRegistry)(nil), // 13: ValidatorsRegistry
+	(*ValidatorRegistry)(nil),  // 14: ValidatorRegistry
+	(*ValidatorsInfo)(nil),     // 15: ValidatorsInfo
+	(*Head)(nil),               // 16: Head
 }
 var file_common_proto_depIdxs = []int32{
-	10, // 0: Block.header:type_name -> BlockHeader
-	14, // 1: ValidatorsRegistry.info:type_name -> ValidatorsInfo
-	13, // 2: ValidatorsRegistry.validators:type_name -> ValidatorRegistry
+	11, // 0: Block.header:type_name -> BlockHeader
+	15, // 1: ValidatorsRegistry.info:type_name -> ValidatorsInfo
+	14, // 2: ValidatorsRegistry.validators:type_name -> ValidatorRegistry
 	3,  // [3:3] is the sub-list for method output_type
 	3,  // [3:3] is the sub-list for method input_type
 	3,  // [3:3] is the sub-list for extension type_name
@@ -1407,7 +1488,7 @@ func file_common_proto_init() {
 			}
 		}
 		file_common_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Tx); i {
+			switch v := v.(*StateQuery); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1419,7 +1500,7 @@ func file_common_proto_init() {
 			}
 		}
 		file_common_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BlockHeader); i {
+			switch v := v.(*Tx); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1431,7 +1512,7 @@ func file_common_proto_init() {
 			}
 		}
 		file_common_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Block); i {
+			switch v := v.(*BlockHeader); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1443,7 +1524,7 @@ func file_common_proto_init() {
 			}
 		}
 		file_common_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ValidatorsRegistry); i {
+			switch v := v.(*Block); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1455,7 +1536,7 @@ func file_common_proto_init() {
 			}
 		}
 		file_common_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ValidatorRegistry); i {
+			switch v := v.(*ValidatorsRegistry); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1467,7 +1548,7 @@ func file_common_proto_init() {
 			}
 		}
 		file_common_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ValidatorsInfo); i {
+			switch v := v.(*ValidatorRegistry); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1479,6 +1560,18 @@ func file_common_proto_init() {
 			}
 		}
 		file_common_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorsInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_common_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Head); i {
 			case 0:
 				return &v.state
@@ -1491,13 +1584,15 @@ func file_common_proto_init() {
 			}
 		}
 	}
+	file_common_proto_msgTypes[8].OneofWrappers = []interface{}{}
+	file_common_proto_msgTypes[9].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_common_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   16,
+			NumMessages:   17,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
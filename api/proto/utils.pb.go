@@ -152,6 +152,108 @@ func (x *ParticipationInfo) GetVotingValidators() uint64 {
 	return 0
 }
 
+type LogLevel struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Module string `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	Level  string `protobuf:"bytes,2,opt,name=level,proto3" json:"level,omitempty"`
+}
+
+func (x *LogLevel) Reset() {
+	*x = LogLevel{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_utils_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LogLevel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogLevel) ProtoMessage() {}
+
+func (x *LogLevel) ProtoReflect() protoreflect.Message {
+	mi := &file_utils_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogLevel.ProtoReflect.Descriptor instead.
+func (*LogLevel) Descriptor() ([]byte, []int) {
+	return file_utils_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *LogLevel) GetModule() string {
+	if x != nil {
+		return x.Module
+	}
+	return ""
+}
+
+func (x *LogLevel) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+type LogLevels struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Levels []*LogLevel `protobuf:"bytes,1,rep,name=levels,proto3" json:"levels,omitempty"`
+}
+
+func (x *LogLevels) Reset() {
+	*x = LogLevels{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_utils_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LogLevels) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogLevels) ProtoMessage() {}
+
+func (x *LogLevels) ProtoReflect() protoreflect.Message {
+	mi := &file_utils_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogLevels.ProtoReflect.Descriptor instead.
+func (*LogLevels) Descriptor() ([]byte, []int) {
+	return file_utils_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *LogLevels) GetLevels() []*LogLevel {
+	if x != nil {
+		return x.Levels
+	}
+	return nil
+}
+
 var File_utils_proto protoreflect.FileDescriptor
 
 var file_utils_proto_rawDesc = []byte{
@@ -174,44 +276,59 @@ var file_utils_proto_rawDesc = []byte{
 	0x6f, 0x72, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x76, 0x61,
 	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10,
 	0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73,
-	0x32, 0xca, 0x04, 0x0a, 0x05, 0x55, 0x74, 0x69, 0x6c, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x47, 0x65,
-	0x6e, 0x4b, 0x65, 0x79, 0x50, 0x61, 0x69, 0x72, 0x12, 0x06, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x1a, 0x08, 0x2e, 0x4b, 0x65, 0x79, 0x50, 0x61, 0x69, 0x72, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x13, 0x12, 0x11, 0x2f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2f, 0x67, 0x65, 0x6e, 0x6b, 0x65,
-	0x79, 0x70, 0x61, 0x69, 0x72, 0x12, 0x52, 0x0a, 0x0f, 0x47, 0x65, 0x6e, 0x56, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x6f, 0x72, 0x4b, 0x65, 0x79, 0x12, 0x11, 0x2e, 0x47, 0x65, 0x6e, 0x56, 0x61,
-	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x4b, 0x65, 0x79, 0x73, 0x1a, 0x09, 0x2e, 0x4b, 0x65,
-	0x79, 0x50, 0x61, 0x69, 0x72, 0x73, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x22, 0x16,
-	0x2f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2f, 0x67, 0x65, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x6f, 0x72, 0x6b, 0x65, 0x79, 0x3a, 0x01, 0x2a, 0x12, 0x44, 0x0a, 0x0d, 0x53, 0x75, 0x62,
-	0x6d, 0x69, 0x74, 0x52, 0x61, 0x77, 0x44, 0x61, 0x74, 0x61, 0x12, 0x08, 0x2e, 0x52, 0x61, 0x77,
-	0x44, 0x61, 0x74, 0x61, 0x1a, 0x08, 0x2e, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x1f,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x22, 0x14, 0x2f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2f, 0x73,
-	0x75, 0x62, 0x6d, 0x69, 0x74, 0x72, 0x61, 0x77, 0x64, 0x61, 0x74, 0x61, 0x3a, 0x01, 0x2a, 0x12,
-	0x4d, 0x0a, 0x14, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x61, 0x77, 0x54, 0x72, 0x61, 0x6e,
-	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x08, 0x2e, 0x52, 0x61, 0x77, 0x44, 0x61, 0x74,
-	0x61, 0x1a, 0x03, 0x2e, 0x54, 0x78, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x22, 0x1b,
-	0x2f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2f, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x77,
-	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x01, 0x2a, 0x12, 0x44,
-	0x0a, 0x0e, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x61, 0x77, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0x12, 0x08, 0x2e, 0x52, 0x61, 0x77, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x06, 0x2e, 0x42, 0x6c, 0x6f,
-	0x63, 0x6b, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x22, 0x15, 0x2f, 0x75, 0x74, 0x69,
-	0x6c, 0x73, 0x2f, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x77, 0x62, 0x6c, 0x6f, 0x63,
-	0x6b, 0x3a, 0x01, 0x2a, 0x12, 0x59, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69,
-	0x63, 0x69, 0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06,
-	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x12, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69,
-	0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2f, 0x67, 0x65, 0x74, 0x70, 0x61,
-	0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x66, 0x6f, 0x12,
-	0x38, 0x0a, 0x0b, 0x53, 0x79, 0x6e, 0x63, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x12, 0x06,
-	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x03, 0x2e, 0x54, 0x78, 0x22, 0x1a, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x14, 0x12, 0x12, 0x2f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2f, 0x73, 0x79, 0x6e, 0x63,
-	0x6d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x30, 0x01, 0x12, 0x42, 0x0a, 0x10, 0x53, 0x75, 0x62,
-	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x12, 0x06, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x03, 0x2e, 0x54, 0x78, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x19, 0x12, 0x17, 0x2f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63,
-	0x72, 0x69, 0x62, 0x65, 0x6d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x30, 0x01, 0x42, 0x09, 0x5a,
-	0x07, 0x2e, 0x3b, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x22, 0x38, 0x0a, 0x08, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x16, 0x0a, 0x06,
+	0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x6f,
+	0x64, 0x75, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0x2e, 0x0a, 0x09, 0x4c, 0x6f,
+	0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x73, 0x12, 0x21, 0x0a, 0x06, 0x6c, 0x65, 0x76, 0x65, 0x6c,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76,
+	0x65, 0x6c, 0x52, 0x06, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x73, 0x32, 0xc8, 0x05, 0x0a, 0x05, 0x55,
+	0x74, 0x69, 0x6c, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x47, 0x65, 0x6e, 0x4b, 0x65, 0x79, 0x50, 0x61,
+	0x69, 0x72, 0x12, 0x06, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x08, 0x2e, 0x4b, 0x65, 0x79,
+	0x50, 0x61, 0x69, 0x72, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x12, 0x11, 0x2f, 0x75,
+	0x74, 0x69, 0x6c, 0x73, 0x2f, 0x67, 0x65, 0x6e, 0x6b, 0x65, 0x79, 0x70, 0x61, 0x69, 0x72, 0x12,
+	0x52, 0x0a, 0x0f, 0x47, 0x65, 0x6e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x4b,
+	0x65, 0x79, 0x12, 0x11, 0x2e, 0x47, 0x65, 0x6e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x4b, 0x65, 0x79, 0x73, 0x1a, 0x09, 0x2e, 0x4b, 0x65, 0x79, 0x50, 0x61, 0x69, 0x72, 0x73,
+	0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x22, 0x16, 0x2f, 0x75, 0x74, 0x69, 0x6c, 0x73,
+	0x2f, 0x67, 0x65, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x6b, 0x65, 0x79,
+	0x3a, 0x01, 0x2a, 0x12, 0x44, 0x0a, 0x0d, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x52, 0x61, 0x77,
+	0x44, 0x61, 0x74, 0x61, 0x12, 0x08, 0x2e, 0x52, 0x61, 0x77, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x08,
+	0x2e, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19,
+	0x22, 0x14, 0x2f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2f, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x72,
+	0x61, 0x77, 0x64, 0x61, 0x74, 0x61, 0x3a, 0x01, 0x2a, 0x12, 0x4d, 0x0a, 0x14, 0x44, 0x65, 0x63,
+	0x6f, 0x64, 0x65, 0x52, 0x61, 0x77, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x08, 0x2e, 0x52, 0x61, 0x77, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x03, 0x2e, 0x54, 0x78,
+	0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x22, 0x1b, 0x2f, 0x75, 0x74, 0x69, 0x6c, 0x73,
+	0x2f, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x77, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x01, 0x2a, 0x12, 0x44, 0x0a, 0x0e, 0x44, 0x65, 0x63, 0x6f,
+	0x64, 0x65, 0x52, 0x61, 0x77, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x08, 0x2e, 0x52, 0x61, 0x77,
+	0x44, 0x61, 0x74, 0x61, 0x1a, 0x06, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x20, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x1a, 0x22, 0x15, 0x2f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2f, 0x64, 0x65, 0x63,
+	0x6f, 0x64, 0x65, 0x72, 0x61, 0x77, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x3a, 0x01, 0x2a, 0x12, 0x59,
+	0x0a, 0x16, 0x47, 0x65, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x1a, 0x12, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x6e, 0x66, 0x6f, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x75,
+	0x74, 0x69, 0x6c, 0x73, 0x2f, 0x67, 0x65, 0x74, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x38, 0x0a, 0x0b, 0x53, 0x79, 0x6e,
+	0x63, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x12, 0x06, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x1a, 0x03, 0x2e, 0x54, 0x78, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12, 0x12, 0x2f,
+	0x75, 0x74, 0x69, 0x6c, 0x73, 0x2f, 0x73, 0x79, 0x6e, 0x63, 0x6d, 0x65, 0x6d, 0x70, 0x6f, 0x6f,
+	0x6c, 0x30, 0x01, 0x12, 0x42, 0x0a, 0x10, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x12, 0x06, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
+	0x03, 0x2e, 0x54, 0x78, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x12, 0x17, 0x2f, 0x75,
+	0x74, 0x69, 0x6c, 0x73, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x6d, 0x65,
+	0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x30, 0x01, 0x12, 0x3c, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x4c, 0x6f,
+	0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x73, 0x12, 0x06, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
+	0x0a, 0x2e, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x73, 0x22, 0x18, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x12, 0x12, 0x10, 0x2f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2f, 0x6c, 0x6f, 0x67, 0x6c,
+	0x65, 0x76, 0x65, 0x6c, 0x73, 0x12, 0x3e, 0x0a, 0x0b, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x4c,
+	0x65, 0x76, 0x65, 0x6c, 0x12, 0x09, 0x2e, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x1a,
+	0x08, 0x2e, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x14, 0x22, 0x0f, 0x2f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2f, 0x6c, 0x6f, 0x67, 0x6c, 0x65, 0x76,
+	0x65, 0x6c, 0x3a, 0x01, 0x2a, 0x42, 0x09, 0x5a, 0x07, 0x2e, 0x3b, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -226,40 +343,47 @@ func file_utils_proto_rawDescGZIP() []byte {
 	return file_utils_proto_rawDescData
 }
 
-var file_utils_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_utils_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_utils_proto_goTypes = []interface{}{
 	(*GenValidatorKeys)(nil),  // 0: GenValidatorKeys
 	(*ParticipationInfo)(nil), // 1: ParticipationInfo
-	(*Empty)(nil),             // 2: Empty
-	(*RawData)(nil),           // 3: RawData
-	(*KeyPair)(nil),           // 4: KeyPair
-	(*KeyPairs)(nil),          // 5: KeyPairs
-	(*Success)(nil),           // 6: Success
-	(*Tx)(nil),                // 7: Tx
-	(*Block)(nil),             // 8: Block
+	(*LogLevel)(nil),          // 2: LogLevel
+	(*LogLevels)(nil),         // 3: LogLevels
+	(*Empty)(nil),             // 4: Empty
+	(*RawData)(nil),           // 5: RawData
+	(*KeyPair)(nil),           // 6: KeyPair
+	(*KeyPairs)(nil),          // 7: KeyPairs
+	(*Success)(nil),           // 8: Success
+	(*Tx)(nil),                // 9: Tx
+	(*Block)(nil),             // 10: Block
 }
 var file_utils_proto_depIdxs = []int32{
-	2, // 0: Utils.GenKeyPair:input_type -> Empty
-	0, // 1: Utils.GenValidatorKey:input_type -> GenValidatorKeys
-	3, // 2: Utils.SubmitRawData:input_type -> RawData
-	3, // 3: Utils.DecodeRawTransaction:input_type -> RawData
-	3, // 4: Utils.DecodeRawBlock:input_type -> RawData
-	2, // 5: Utils.GetParticipationStatus:input_type -> Empty
-	2, // 6: Utils.SyncMempool:input_type -> Empty
-	2, // 7: Utils.SubscribeMempool:input_type -> Empty
-	4, // 8: Utils.GenKeyPair:output_type -> KeyPair
-	5, // 9: Utils.GenValidatorKey:output_type -> KeyPairs
-	6, // 10: Utils.SubmitRawData:output_type -> Success
-	7, // 11: Utils.DecodeRawTransaction:output_type -> Tx
-	8, // 12: Utils.DecodeRawBlock:output_type -> Block
-	1, // 13: Utils.GetParticipationStatus:output_type -> ParticipationInfo
-	7, // 14: Utils.SyncMempool:output_type -> Tx
-	7, // 15: Utils.SubscribeMempool:output_type -> Tx
-	8, // [8:16] is the sub-list for method output_type
-	0, // [0:8] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	2,  // 0: LogLevels.levels:type_name -> LogLevel
+	4,  // 1: Utils.GenKeyPair:input_type -> Empty
+	0,  // 2: Utils.GenValidatorKey:input_type -> GenValidatorKeys
+	5,  // 3: Utils.SubmitRawData:input_type -> RawData
+	5,  // 4: Utils.DecodeRawTransaction:input_type -> RawData
+	5,  // 5: Utils.DecodeRawBlock:input_type -> RawData
+	4,  // 6: Utils.GetParticipationStatus:input_type -> Empty
+	4,  // 7: Utils.SyncMempool:input_type -> Empty
+	4,  // 8: Utils.SubscribeMempool:input_type -> Empty
+	4,  // 9: Utils.GetLogLevels:input_type -> Empty
+	2,  // 10: Utils.SetLogLevel:input_type -> LogLevel
+	6,  // 11: Utils.GenKeyPair:output_type -> KeyPair
+	7,  // 12: Utils.GenValidatorKey:output_type -> KeyPairs
+	8,  // 13: Utils.SubmitRawData:output_type -> Success
+	9,  // 14: Utils.DecodeRawTransaction:output_type -> Tx
+	10, // 15: Utils.DecodeRawBlock:output_type -> Block
+	1,  // 16: Utils.GetParticipationStatus:output_type -> ParticipationInfo
+	9,  // 17: Utils.SyncMempool:output_type -> Tx
+	9,  // 18: Utils.SubscribeMempool:output_type -> Tx
+	3,  // 19: Utils.GetLogLevels:output_type -> LogLevels
+	8,  // 20: Utils.SetLogLevel:output_type -> Success
+	11, // [11:21] is the sub-list for method output_type
+	1,  // [1:11] is the sub-list for method input_type
+	1,  // [1:1] is the sub-list for extension type_name
+	1,  // [1:1] is the sub-list for extension extendee
+	0,  // [0:1] is the sub-list for field type_name
 }
 
 func init() { file_utils_proto_init() }
@@ -293,6 +417,30 @@ func file_utils_proto_init() {
 				return nil
 			}
 		}
+		file_utils_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LogLevel); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_utils_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LogLevels); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -300,7 +448,7 @@ func file_utils_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_utils_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -65,6 +65,18 @@ type UtilsClient interface {
 	//Response: Tx
 	//Description: Returns a stream of transactions. Relaying a transaction when arrives the mempool.
 	SubscribeMempool(ctx context.Context, in *Empty, opts ...grpc.CallOption) (Utils_SubscribeMempoolClient, error)
+	//*
+	//Method: GetLogLevels
+	//Input: Empty
+	//Response: LogLevels
+	//Description: Returns the default log level and the levels set for modules.
+	GetLogLevels(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*LogLevels, error)
+	//*
+	//Method: SetLogLevel
+	//Input: LogLevel
+	//Response: Success
+	//Description: Changes the log level of a module while the node is running, an empty module or "default" changes the default level.
+	SetLogLevel(ctx context.Context, in *LogLevel, opts ...grpc.CallOption) (*Success, error)
 }
 
 type utilsClient struct {
@@ -193,6 +205,24 @@ func (x *utilsSubscribeMempoolClient) Recv() (*Tx, error) {
 	return m, nil
 }
 
+func (c *utilsClient) GetLogLevels(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*LogLevels, error) {
+	out := new(LogLevels)
+	err := c.cc.Invoke(ctx, "/Utils/GetLogLevels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *utilsClient) SetLogLevel(ctx context.Context, in *LogLevel, opts ...grpc.CallOption) (*Success, error) {
+	out := new(Success)
+	err := c.cc.Invoke(ctx, "/Utils/SetLogLevel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UtilsServer is the server API for Utils service.
 // All implementations must embed UnimplementedUtilsServer
 // for forward compatibility
@@ -245,6 +275,18 @@ type UtilsServer interface {
 	//Response: Tx
 	//Description: Returns a stream of transactions. Relaying a transaction when arrives the mempool.
 	SubscribeMempool(*Empty, Utils_SubscribeMempoolServer) error
+	//*
+	//Method: GetLogLevels
+	//Input: Empty
+	//Response: LogLevels
+	//Description: Returns the default log level and the levels set for modules.
+	GetLogLevels(context.Context, *Empty) (*LogLevels, error)
+	//*
+	//Method: SetLogLevel
+	//Input: LogLevel
+	//Response: Success
+	//Description: Changes the log level of a module while the node is running, an empty module or "default" changes the default level.
+	SetLogLevel(context.Context, *LogLevel) (*Success, error)
 	mustEmbedUnimplementedUtilsServer()
 }
 
@@ -276,6 +318,12 @@ func (UnimplementedUtilsServer) SyncMempool(*Empty, Utils_SyncMempoolServer) err
 func (UnimplementedUtilsServer) SubscribeMempool(*Empty, Utils_SubscribeMempoolServer) error {
 	return status.Errorf(codes.Unimplemented, "method SubscribeMempool not implemented")
 }
+func (UnimplementedUtilsServer) GetLogLevels(context.Context, *Empty) (*LogLevels, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLogLevels not implemented")
+}
+func (UnimplementedUtilsServer) SetLogLevel(context.Context, *LogLevel) (*Success, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLogLevel not implemented")
+}
 func (UnimplementedUtilsServer) mustEmbedUnimplementedUtilsServer() {}
 
 // UnsafeUtilsServer may be embedded to opt out of forward compatibility for this service.
@@ -439,6 +487,42 @@ func (x *utilsSubscribeMempoolServer) Send(m *Tx) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _Utils_GetLogLevels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UtilsServer).GetLogLevels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Utils/GetLogLevels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UtilsServer).GetLogLevels(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Utils_SetLogLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogLevel)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UtilsServer).SetLogLevel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Utils/SetLogLevel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UtilsServer).SetLogLevel(ctx, req.(*LogLevel))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Utils_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "Utils",
 	HandlerType: (*UtilsServer)(nil),
@@ -467,6 +551,14 @@ var _Utils_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetParticipationStatus",
 			Handler:    _Utils_GetParticipationStatus_Handler,
 		},
+		{
+			MethodName: "GetLogLevels",
+			Handler:    _Utils_GetLogLevels_Handler,
+		},
+		{
+			MethodName: "SetLogLevel",
+			Handler:    _Utils_SetLogLevel_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
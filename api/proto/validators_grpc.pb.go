@@ -18,6 +18,7 @@ const _ = grpc.SupportPackageIsVersion7
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type ValidatorsClient interface {
 	GetValidatorsList(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ValidatorsRegistry, error)
+	GetValidatorsListAt(ctx context.Context, in *StateQuery, opts ...grpc.CallOption) (*ValidatorsRegistry, error)
 	GetAccountValidators(ctx context.Context, in *Account, opts ...grpc.CallOption) (*ValidatorsRegistry, error)
 }
 
@@ -38,6 +39,15 @@ func (c *validatorsClient) GetValidatorsList(ctx context.Context, in *Empty, opt
 	return out, nil
 }
 
+func (c *validatorsClient) GetValidatorsListAt(ctx context.Context, in *StateQuery, opts ...grpc.CallOption) (*ValidatorsRegistry, error) {
+	out := new(ValidatorsRegistry)
+	err := c.cc.Invoke(ctx, "/Validators/GetValidatorsListAt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *validatorsClient) GetAccountValidators(ctx context.Context, in *Account, opts ...grpc.CallOption) (*ValidatorsRegistry, error) {
 	out := new(ValidatorsRegistry)
 	err := c.cc.Invoke(ctx, "/Validators/GetAccountValidators", in, out, opts...)
@@ -52,6 +62,7 @@ func (c *validatorsClient) GetAccountValidators(ctx context.Context, in *Account
 // for forward compatibility
 type ValidatorsServer interface {
 	GetValidatorsList(context.Context, *Empty) (*ValidatorsRegistry, error)
+	GetValidatorsListAt(context.Context, *StateQuery) (*ValidatorsRegistry, error)
 	GetAccountValidators(context.Context, *Account) (*ValidatorsRegistry, error)
 	mustEmbedUnimplementedValidatorsServer()
 }
@@ -63,6 +74,9 @@ type UnimplementedValidatorsServer struct {
 func (UnimplementedValidatorsServer) GetValidatorsList(context.Context, *Empty) (*ValidatorsRegistry, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetValidatorsList not implemented")
 }
+func (UnimplementedValidatorsServer) GetValidatorsListAt(context.Context, *StateQuery) (*ValidatorsRegistry, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetValidatorsListAt not implemented")
+}
 func (UnimplementedValidatorsServer) GetAccountValidators(context.Context, *Account) (*ValidatorsRegistry, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAccountValidators not implemented")
 }
@@ -97,6 +111,24 @@ func _Validators_GetValidatorsList_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Validators_GetValidatorsListAt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StateQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ValidatorsServer).GetValidatorsListAt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Validators/GetValidatorsListAt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValidatorsServer).GetValidatorsListAt(ctx, req.(*StateQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Validators_GetAccountValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Account)
 	if err := dec(in); err != nil {
@@ -123,6 +155,10 @@ var _Validators_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetValidatorsList",
 			Handler:    _Validators_GetValidatorsList_Handler,
 		},
+		{
+			MethodName: "GetValidatorsListAt",
+			Handler:    _Validators_GetValidatorsListAt_Handler,
+		},
 		{
 			MethodName: "GetAccountValidators",
 			Handler:    _Validators_GetAccountValidators_Handler,
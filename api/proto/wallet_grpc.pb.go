@@ -28,6 +28,12 @@ type WalletClient interface {
 	GetValidators(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ValidatorsRegistry, error)
 	GetAccount(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*KeyPair, error)
 	SendTransaction(ctx context.Context, in *SendTransactionInfo, opts ...grpc.CallOption) (*Hash, error)
+	DeployContract(ctx context.Context, in *DeployContractInfo, opts ...grpc.CallOption) (*ContractTx, error)
+	CallContract(ctx context.Context, in *CallContractInfo, opts ...grpc.CallOption) (*ContractTx, error)
+	IssueAsset(ctx context.Context, in *AssetTxInfo, opts ...grpc.CallOption) (*AssetTx, error)
+	TransferAsset(ctx context.Context, in *AssetTxInfo, opts ...grpc.CallOption) (*AssetTx, error)
+	BurnAsset(ctx context.Context, in *AssetTxInfo, opts ...grpc.CallOption) (*AssetTx, error)
+	GetAssetBalances(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*AssetBalances, error)
 	StartValidator(ctx context.Context, in *KeyPair, opts ...grpc.CallOption) (*Success, error)
 	StartValidatorBulk(ctx context.Context, in *KeyPairs, opts ...grpc.CallOption) (*Success, error)
 	ExitValidator(ctx context.Context, in *KeyPair, opts ...grpc.CallOption) (*Success, error)
@@ -141,6 +147,60 @@ func (c *walletClient) SendTransaction(ctx context.Context, in *SendTransactionI
 	return out, nil
 }
 
+func (c *walletClient) DeployContract(ctx context.Context, in *DeployContractInfo, opts ...grpc.CallOption) (*ContractTx, error) {
+	out := new(ContractTx)
+	err := c.cc.Invoke(ctx, "/Wallet/DeployContract", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletClient) CallContract(ctx context.Context, in *CallContractInfo, opts ...grpc.CallOption) (*ContractTx, error) {
+	out := new(ContractTx)
+	err := c.cc.Invoke(ctx, "/Wallet/CallContract", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletClient) IssueAsset(ctx context.Context, in *AssetTxInfo, opts ...grpc.CallOption) (*AssetTx, error) {
+	out := new(AssetTx)
+	err := c.cc.Invoke(ctx, "/Wallet/IssueAsset", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletClient) TransferAsset(ctx context.Context, in *AssetTxInfo, opts ...grpc.CallOption) (*AssetTx, error) {
+	out := new(AssetTx)
+	err := c.cc.Invoke(ctx, "/Wallet/TransferAsset", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletClient) BurnAsset(ctx context.Context, in *AssetTxInfo, opts ...grpc.CallOption) (*AssetTx, error) {
+	out := new(AssetTx)
+	err := c.cc.Invoke(ctx, "/Wallet/BurnAsset", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletClient) GetAssetBalances(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*AssetBalances, error) {
+	out := new(AssetBalances)
+	err := c.cc.Invoke(ctx, "/Wallet/GetAssetBalances", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *walletClient) StartValidator(ctx context.Context, in *KeyPair, opts ...grpc.CallOption) (*Success, error) {
 	out := new(Success)
 	err := c.cc.Invoke(ctx, "/Wallet/StartValidator", in, out, opts...)
@@ -192,6 +252,12 @@ type WalletServer interface {
 	GetValidators(context.Context, *Empty) (*ValidatorsRegistry, error)
 	GetAccount(context.Context, *Empty) (*KeyPair, error)
 	SendTransaction(context.Context, *SendTransactionInfo) (*Hash, error)
+	DeployContract(context.Context, *DeployContractInfo) (*ContractTx, error)
+	CallContract(context.Context, *CallContractInfo) (*ContractTx, error)
+	IssueAsset(context.Context, *AssetTxInfo) (*AssetTx, error)
+	TransferAsset(context.Context, *AssetTxInfo) (*AssetTx, error)
+	BurnAsset(context.Context, *AssetTxInfo) (*AssetTx, error)
+	GetAssetBalances(context.Context, *Empty) (*AssetBalances, error)
 	StartValidator(context.Context, *KeyPair) (*Success, error)
 	StartValidatorBulk(context.Context, *KeyPairs) (*Success, error)
 	ExitValidator(context.Context, *KeyPair) (*Success, error)
@@ -236,6 +302,24 @@ func (UnimplementedWalletServer) GetAccount(context.Context, *Empty) (*KeyPair,
 func (UnimplementedWalletServer) SendTransaction(context.Context, *SendTransactionInfo) (*Hash, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendTransaction not implemented")
 }
+func (UnimplementedWalletServer) DeployContract(context.Context, *DeployContractInfo) (*ContractTx, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeployContract not implemented")
+}
+func (UnimplementedWalletServer) CallContract(context.Context, *CallContractInfo) (*ContractTx, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CallContract not implemented")
+}
+func (UnimplementedWalletServer) IssueAsset(context.Context, *AssetTxInfo) (*AssetTx, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IssueAsset not implemented")
+}
+func (UnimplementedWalletServer) TransferAsset(context.Context, *AssetTxInfo) (*AssetTx, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferAsset not implemented")
+}
+func (UnimplementedWalletServer) BurnAsset(context.Context, *AssetTxInfo) (*AssetTx, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BurnAsset not implemented")
+}
+func (UnimplementedWalletServer) GetAssetBalances(context.Context, *Empty) (*AssetBalances, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAssetBalances not implemented")
+}
 func (UnimplementedWalletServer) StartValidator(context.Context, *KeyPair) (*Success, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StartValidator not implemented")
 }
@@ -459,6 +543,114 @@ func _Wallet_SendTransaction_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Wallet_DeployContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeployContractInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServer).DeployContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Wallet/DeployContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServer).DeployContract(ctx, req.(*DeployContractInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Wallet_CallContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CallContractInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServer).CallContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Wallet/CallContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServer).CallContract(ctx, req.(*CallContractInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Wallet_IssueAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssetTxInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServer).IssueAsset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Wallet/IssueAsset",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServer).IssueAsset(ctx, req.(*AssetTxInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Wallet_TransferAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssetTxInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServer).TransferAsset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Wallet/TransferAsset",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServer).TransferAsset(ctx, req.(*AssetTxInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Wallet_BurnAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssetTxInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServer).BurnAsset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Wallet/BurnAsset",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServer).BurnAsset(ctx, req.(*AssetTxInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Wallet_GetAssetBalances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServer).GetAssetBalances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Wallet/GetAssetBalances",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServer).GetAssetBalances(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Wallet_StartValidator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(KeyPair)
 	if err := dec(in); err != nil {
@@ -579,6 +771,30 @@ var _Wallet_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SendTransaction",
 			Handler:    _Wallet_SendTransaction_Handler,
 		},
+		{
+			MethodName: "DeployContract",
+			Handler:    _Wallet_DeployContract_Handler,
+		},
+		{
+			MethodName: "CallContract",
+			Handler:    _Wallet_CallContract_Handler,
+		},
+		{
+			MethodName: "IssueAsset",
+			Handler:    _Wallet_IssueAsset_Handler,
+		},
+		{
+			MethodName: "TransferAsset",
+			Handler:    _Wallet_TransferAsset_Handler,
+		},
+		{
+			MethodName: "BurnAsset",
+			Handler:    _Wallet_BurnAsset_Handler,
+		},
+		{
+			MethodName: "GetAssetBalances",
+			Handler:    _Wallet_GetAssetBalances_Handler,
+		},
 		{
 			MethodName: "StartValidator",
 			Handler:    _Wallet_StartValidator_Handler,
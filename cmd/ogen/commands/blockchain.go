@@ -22,13 +22,35 @@ var (
 	Debug    bool
 	LogFile  bool
 
-	RPCPort       string
-	RPCWallet     bool
-	RPCProxy      bool
-	RPCProxyPort  string
-	RPCPRoxyAddr  string
-	Dashboard     bool
-	DashboardPort string
+	LogJSON       bool
+	LogLevel      string
+	LogMaxSize    int
+	LogMaxAge     time.Duration
+	LogMaxBackups int
+
+	RPCPort         string
+	RPCWallet       bool
+	RPCProxy        bool
+	RPCProxyPort    string
+	RPCPRoxyAddr    string
+	Dashboard       bool
+	DashboardPort   string
+	DashboardUser   string
+	DashboardPass   string
+	DBBackend       string
+	Archive         bool
+	ArchiveInterval uint64
+	Prune           bool
+	PruneDepth      uint64
+	GossipWorkers   int
+	GossipPeerQueue int
+	Slasher         bool
+
+	Webhooks          []string
+	WebhookSecret     string
+	WebhookAddresses  []string
+	WebhookValidators []string
+	WebhookAttempts   int
 )
 
 func init() {
@@ -46,9 +68,31 @@ func init() {
 
 	rootCmd.Flags().StringVar(&DashboardPort, "dashboard_port", "8080", "Port to expose node dashboard.")
 	rootCmd.Flags().BoolVar(&Dashboard, "dashboard", false, "Expose node dashboard.")
+	rootCmd.Flags().StringVar(&DashboardUser, "dashboard_user", "ogen", "User for the dashboard basic authentication.")
+	rootCmd.Flags().StringVar(&DashboardPass, "dashboard_password", "", "Password for the dashboard basic authentication (empty disables the authentication).")
+
+	rootCmd.Flags().StringVar(&DBBackend, "db_backend", blockdb.BackendLevelDB, "Storage backend for the chain data (leveldb, bolt or memory).")
+	rootCmd.Flags().BoolVar(&Archive, "archive", false, "Keep state checkpoints to serve historical state queries.")
+	rootCmd.Flags().Uint64Var(&ArchiveInterval, "archive_interval", 8, "Amount of epochs between state checkpoints on archive mode.")
+	rootCmd.Flags().BoolVar(&Prune, "prune", false, "Remove old blocks to reduce the disk usage.")
+	rootCmd.Flags().Uint64Var(&PruneDepth, "prune_depth", 64, "Amount of finalized epochs to keep block data on pruned mode.")
+	rootCmd.Flags().IntVar(&GossipWorkers, "gossip_workers", 0, "Amount of workers to validate gossip messages (0 uses one for each CPU).")
+	rootCmd.Flags().IntVar(&GossipPeerQueue, "gossip_peer_queue", 64, "Amount of gossip messages from a single peer that can wait to be validated before dropping them.")
+	rootCmd.Flags().BoolVar(&Slasher, "slasher", false, "Record the votes and blocks seen to report slashable validators.")
+
+	rootCmd.Flags().StringSliceVar(&Webhooks, "webhook_url", nil, "URLs to post the chain event notifications (can be used multiple times).")
+	rootCmd.Flags().StringVar(&WebhookSecret, "webhook_secret", "", "Secret to sign the webhook notifications with HMAC-SHA256.")
+	rootCmd.Flags().StringSliceVar(&WebhookAddresses, "webhook_address", nil, "Accounts to notify when they receive funds, once on the mempool and again when the block is finalized.")
+	rootCmd.Flags().StringSliceVar(&WebhookValidators, "webhook_validator", nil, "Validator public keys to notify when their status changes.")
+	rootCmd.Flags().IntVar(&WebhookAttempts, "webhook_attempts", 10, "Amount of attempts to deliver a notification before dropping it.")
 
 	rootCmd.PersistentFlags().BoolVar(&Debug, "debug", false, "Displays debug information.")
 	rootCmd.PersistentFlags().BoolVar(&LogFile, "logfile", false, "Display log information to file.")
+	rootCmd.PersistentFlags().BoolVar(&LogJSON, "log_json", false, "Write the log as JSON objects, one for each line.")
+	rootCmd.PersistentFlags().StringVar(&LogLevel, "log_level", "", "Log levels as a default level and module levels, e.g. info,chain=debug,mempool=warn (modules: hostnode, chain, mempool, proposer, rpc, wallet, slasher, notifier, dashboard, actionmanager).")
+	rootCmd.PersistentFlags().IntVar(&LogMaxSize, "log_max_size", 100, "Size in megabytes to rotate the log file (0 disables the size rotation).")
+	rootCmd.PersistentFlags().DurationVar(&LogMaxAge, "log_max_age", 24*time.Hour, "Interval to rotate the log file (0 disables the time rotation).")
+	rootCmd.PersistentFlags().IntVar(&LogMaxBackups, "log_max_backups", 7, "Amount of rotated log files to keep (0 keeps all).")
 
 	err := viper.BindPFlags(rootCmd.PersistentFlags())
 	if err != nil {
@@ -106,35 +150,70 @@ func initConfig() {
 	}
 
 	config.GlobalFlags = &config.Flags{
-		DataPath:      DataPath,
-		NetworkName:   NetName,
-		Port:          Port,
-		RPCProxy:      RPCProxy,
-		RPCProxyPort:  RPCProxyPort,
-		RPCProxyAddr:  RPCPRoxyAddr,
-		RPCPort:       RPCPort,
-		RPCWallet:     RPCWallet,
-		Debug:         Debug,
-		LogFile:       LogFile,
-		DashboardPort: DashboardPort,
-		Dashboard:     Dashboard,
+		DataPath:        DataPath,
+		NetworkName:     NetName,
+		Port:            Port,
+		RPCProxy:        RPCProxy,
+		RPCProxyPort:    RPCProxyPort,
+		RPCProxyAddr:    RPCPRoxyAddr,
+		RPCPort:         RPCPort,
+		RPCWallet:       RPCWallet,
+		Debug:           Debug,
+		LogFile:         LogFile,
+		LogJSON:         LogJSON,
+		LogLevel:        LogLevel,
+		LogMaxSize:      LogMaxSize,
+		LogMaxAge:       LogMaxAge,
+		LogMaxBackups:   LogMaxBackups,
+		DashboardPort:   DashboardPort,
+		Dashboard:       Dashboard,
+		DashboardUser:   DashboardUser,
+		DashboardPass:   DashboardPass,
+		DBBackend:       DBBackend,
+		Archive:         Archive,
+		ArchiveInterval: ArchiveInterval,
+		Prune:           Prune,
+		PruneDepth:      PruneDepth,
+		GossipWorkers:   GossipWorkers,
+		GossipPeerQueue: GossipPeerQueue,
+		Slasher:         Slasher,
+
+		Webhooks:          Webhooks,
+		WebhookSecret:     WebhookSecret,
+		WebhookAddresses:  WebhookAddresses,
+		WebhookValidators: WebhookValidators,
+		WebhookAttempts:   WebhookAttempts,
 	}
 
 	var log logger.Logger
 
 	if config.GlobalFlags.LogFile {
-		logFile, err := os.OpenFile(path.Join(DataPath, "logger.log"), os.O_CREATE|os.O_RDWR, 0755)
+		logFile, err := logger.OpenRotatingFile(path.Join(DataPath, "logger.log"), int64(LogMaxSize)*1024*1024, LogMaxAge, LogMaxBackups)
 		if err != nil {
 			panic(err)
 		}
 		log = logger.New(logFile)
 	} else {
-		log = logger.New(os.Stdin)
+		log = logger.New(os.Stdout)
 	}
 
+	if config.GlobalFlags.LogJSON {
+		log = log.WithJSON()
+	}
 	if config.GlobalFlags.Debug {
 		log = log.WithDebug()
 	}
+	if config.GlobalFlags.LogLevel != "" {
+		levels, err := logger.ParseLevels(config.GlobalFlags.LogLevel)
+		if err != nil {
+			panic(err)
+		}
+		for module, level := range levels {
+			if err := log.SetLevel(module, level); err != nil {
+				panic(err)
+			}
+		}
+	}
 	var netParams *params.ChainParams
 	switch config.GlobalFlags.NetworkName {
 	case "mainnet":
@@ -190,7 +269,15 @@ var rootCmd = &cobra.Command{
 
 		config.InterruptListener()
 
-		db, err := blockdb.NewLevelDB()
+		if config.GlobalFlags.Archive && config.GlobalFlags.Prune {
+			log.Fatal("archive and prune modes can't be enabled at the same time")
+		}
+
+		if config.GlobalFlags.Prune && config.GlobalFlags.PruneDepth == 0 {
+			log.Fatal("prune depth must keep at least one finalized epoch")
+		}
+
+		db, err := blockdb.NewDatabase(config.GlobalFlags.DBBackend, config.GlobalFlags.DataPath)
 		if err != nil {
 			log.Fatal(err)
 		}
@@ -1,12 +1,20 @@
 package commands
 
 import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+
 	"github.com/c-bata/go-prompt"
 	"github.com/fatih/color"
 	"github.com/olympus-protocol/ogen/pkg/rpcclient"
 	"github.com/spf13/cobra"
-	strings "strings"
 )
 
 var chainCmd = []prompt.Suggest{
@@ -14,11 +22,20 @@ var chainCmd = []prompt.Suggest{
 	{Text: "getrawblock", Description: "Get the serialized block data"},
 	{Text: "getblock", Description: "Get the block data"},
 	{Text: "getblockhash", Description: "Get the block hash of specified height"},
-	{Text: "getaccountinfo", Description: "Get the specified account information"},
+	{Text: "getaccountinfo", Description: "Get the specified account information, optionally at a slot or block hash"},
+	{Text: "getaccountproof", Description: "Get and verify the merkle proof of an account balance and nonce, optionally at a slot or block hash"},
+	{Text: "getcontractstorage", Description: "Get the value of a key on the storage of a contract"},
+	{Text: "getasset", Description: "Get the issuer, supply and holders of an asset"},
+	{Text: "getblockreceipts", Description: "Get the epoch receipts and validator balances processed before a block"},
+	{Text: "getcontractreceipts", Description: "Get the receipts of the contract txs included in a block"},
+	{Text: "sync", Description: "Stream the serialized blocks after a block hash up to the tip"},
+	{Text: "subscribeblocks", Description: "Stream the serialized blocks added to the chain"},
+	{Text: "subscribetransactions", Description: "Stream the transaction events of a comma separated list of accounts, optionally from a cursor"},
+	{Text: "subscribevalidatortransactions", Description: "Stream the deposit, exit and slashing events of a comma separated list of validators, optionally from a cursor"},
 }
 
 var validatorsCmd = []prompt.Suggest{
-	{Text: "getvalidatorslist", Description: "Get the network validators list"},
+	{Text: "getvalidatorslist", Description: "Get the network validators list, optionally at a slot or block hash"},
 	{Text: "getaccountvalidators", Description: "Get the validators with deposits from an account"},
 }
 
@@ -35,6 +52,11 @@ var utilsCmd = []prompt.Suggest{
 	{Text: "genvalidatorkey", Description: "Create a new validator key and store the private key on the keychain"},
 	{Text: "decoderawtransaction", Description: "Returns a serialized transaction on human readable format"},
 	{Text: "decoderawblock", Description: "Returns a serialized block on human readable format"},
+	{Text: "getparticipationstatus", Description: "Get the voting and proposing status of the node validators"},
+	{Text: "syncmempool", Description: "Stream the transactions currently on the mempool"},
+	{Text: "subscribemempool", Description: "Stream the transactions added to the mempool"},
+	{Text: "getloglevels", Description: "Get the log level of every module of the node"},
+	{Text: "setloglevel", Description: "Set the log level of a module of the node"},
 }
 
 var walletCmd = []prompt.Suggest{
@@ -49,8 +71,16 @@ var walletCmd = []prompt.Suggest{
 	{Text: "getvalidators", Description: "Get validator list for open wallet"},
 	{Text: "getaccount", Description: "Returns the public account of the open wallet"},
 	{Text: "sendtransaction", Description: "Sends a transaction using the current open wallet"},
+	{Text: "deploycontract", Description: "Deploys a contract using the current open wallet"},
+	{Text: "callcontract", Description: "Calls a contract using the current open wallet"},
+	{Text: "issueasset", Description: "Creates an asset or issues more units of an asset created by the current open wallet"},
+	{Text: "transferasset", Description: "Sends asset units using the current open wallet"},
+	{Text: "burnasset", Description: "Destroys asset units of the current open wallet"},
+	{Text: "getassetbalances", Description: "Get the asset balances of the current open wallet"},
 	{Text: "startvalidator", Description: "Starts a validator using the current open wallet as the deposit holder"},
 	{Text: "exitvalidator", Description: "Exits a validator from the current open wallet"},
+	{Text: "startvalidatorbulk", Description: "Starts multiple validators using the current open wallet as the deposit holder"},
+	{Text: "exitvalidatorbulk", Description: "Exits multiple validators from the current open wallet"},
 }
 
 func completer(d prompt.Document) []prompt.Suggest {
@@ -66,177 +96,449 @@ func completer(d prompt.Document) []prompt.Suggest {
 // Empty is the empty request.
 type Empty struct{}
 
+// errCommandsFailed is returned by the non interactive modes when a command failed.
+var errCommandsFailed = errors.New("some commands failed")
+
 // CLI is the module that allows operations across multiple services.
 type CLI struct {
 	rpcClient *rpcclient.Client
+
+	// json prints every result as a JSON object on a single line.
+	json bool
+	// continueOnError keeps running the commands of a file after a command fails.
+	continueOnError bool
+}
+
+// commandResult is a command result printed on JSON mode. Results that are JSON are embedded, any other result is
+// printed as a string.
+type commandResult struct {
+	Command string          `json:"command"`
+	Args    []string        `json:"args"`
+	Result  json.RawMessage `json:"result,omitempty"`
+	Error   string          `json:"error,omitempty"`
 }
 
-var rpcHost string
+var (
+	rpcHost         string
+	consoleJSON     bool
+	consoleFile     string
+	continueOnError bool
+)
 
 var cliCmd = &cobra.Command{
-	Use:   "console",
+	Use:   "console [command] [args...]",
 	Short: "Starts the integrated RPC command line.",
-	Long:  `Starts the integrated RPC command line.`,
+	Long: `Starts the integrated RPC command line.
+
+A command passed as arguments is executed and the console exits. With --file the commands are read one per line
+from a file, or from the standard input when the file is "-" or the standard input is not a terminal. Lines starting
+with # are ignored and arguments can be quoted with single or double quotes.`,
 	Run: func(cmd *cobra.Command, args []string) {
-		StartConsole(rpcHost, args)
+		err := StartConsole(rpcHost, args)
+		if err != nil {
+			os.Exit(1)
+		}
 	},
 }
 
 func init() {
 	cliCmd.Flags().StringVar(&rpcHost, "rpc_host", "127.0.0.1:24127", "IP and port of the RPC Server to connect")
+	cliCmd.Flags().BoolVar(&consoleJSON, "json", false, "Print every result as a JSON object on a single line")
+	cliCmd.Flags().StringVar(&consoleFile, "file", "", "Execute the commands of a file, use - to read from the standard input")
+	cliCmd.Flags().BoolVar(&continueOnError, "continue_on_error", false, "Keep executing the commands of a file after a command fails")
 
 	rootCmd.AddCommand(cliCmd)
 }
 
-// Run runs the CLI.
-func (c *CLI) Run(optArgs []string) {
+// Run starts the interactive console.
+func (c *CLI) Run() {
 	color.Green("Welcome to the Ogen console")
 	for {
-		var t string
-		if len(optArgs) == 0 {
-			t = prompt.Input("> ", completer, prompt.OptionCompletionWordSeparator(" "))
-		} else {
-			t = strings.Join(optArgs, " ")
-			optArgs[0] = "exit"
+		t := prompt.Input("> ", completer, prompt.OptionCompletionWordSeparator(" "))
+		if strings.HasPrefix(strings.TrimSpace(t), "#") {
+			continue
+		}
+		args, err := splitArgs(t)
+		if err != nil {
+			c.print("", nil, "", err)
+			continue
 		}
-
-		args := strings.Split(t, " ")
 		if len(args) == 0 {
 			continue
 		}
+		if args[0] == "exit" {
+			return
+		}
+		_ = c.Execute(args)
+	}
+}
 
-		if args[0] == "" {
-			continue
+// RunBatch executes the commands of a reader, one per line. It stops on the first failed command unless
+// continueOnError is set and returns errCommandsFailed when any command failed.
+func (c *CLI) RunBatch(r io.Reader) error {
+	reader := bufio.NewReader(r)
+	failed := false
+	for line := 1; ; line++ {
+		t, readErr := reader.ReadString('\n')
+		if readErr != nil && readErr != io.EOF {
+			c.print("", nil, "", readErr)
+			return errCommandsFailed
+		}
+		if !strings.HasPrefix(strings.TrimSpace(t), "#") {
+			args, err := splitArgs(t)
+			if err != nil {
+				err = fmt.Errorf("line %d: %s", line, err)
+				c.print("", nil, "", err)
+			} else if len(args) > 0 {
+				if args[0] == "exit" {
+					break
+				}
+				err = c.Execute(args)
+			}
+			if err != nil {
+				failed = true
+				if !c.continueOnError {
+					break
+				}
+			}
+		}
+		if readErr == io.EOF {
+			break
 		}
+	}
+	if failed {
+		return errCommandsFailed
+	}
+	return nil
+}
 
-		var out string
-		var err error
+// print prints the result of a command.
+func (c *CLI) print(command string, args []string, out string, err error) {
+	if !c.json {
+		if err != nil {
+			color.Red("%s", err.Error())
+		} else {
+			color.Green("%s", out)
+		}
+		return
+	}
+	res := commandResult{
+		Command: command,
+		Args:    args,
+	}
+	if res.Args == nil {
+		res.Args = []string{}
+	}
+	if err != nil {
+		res.Error = err.Error()
+	} else if json.Valid([]byte(out)) {
+		res.Result = json.RawMessage(out)
+	} else {
+		res.Result, _ = json.Marshal(out)
+	}
+	b, err := json.Marshal(res)
+	if err != nil {
+		color.Red("%s", err.Error())
+		return
+	}
+	fmt.Println(string(b))
+}
 
-		switch args[0] {
-		case "help":
-			out = "Ogen CLI command \n\n"
+// stream runs a streaming command printing every message received. The stream is closed on an interrupt signal.
+func (c *CLI) stream(command string, args []string, run func(ctx context.Context, handler rpcclient.StreamHandler) error) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt)
+	defer signal.Stop(interrupt)
+	go func() {
+		select {
+		case <-interrupt:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
 
-			out += "Chain \n\n"
-			for _, c := range chainCmd {
-				out += fmt.Sprintf("%-25s %s \n", c.Text, c.Description)
-			}
-			out += "\n"
+	return run(ctx, func(msg string) error {
+		c.print(command, args, msg, nil)
+		return nil
+	})
+}
 
-			out += "Validators\n\n"
-			for _, c := range validatorsCmd {
-				out += fmt.Sprintf("%-25s %s \n", c.Text, c.Description)
-			}
-			out += "\n"
+// help returns the list of commands.
+func help() string {
+	out := "Ogen CLI command \n\n"
+
+	groups := []struct {
+		name     string
+		commands []prompt.Suggest
+	}{
+		{"Chain", chainCmd},
+		{"Validators", validatorsCmd},
+		{"Network", netCmd},
+		{"Utils", utilsCmd},
+		{"Wallet", walletCmd},
+	}
+	for _, g := range groups {
+		out += g.name + "\n\n"
+		for _, c := range g.commands {
+			out += fmt.Sprintf("%-32s %s \n", c.Text, c.Description)
+		}
+		out += "\n"
+	}
+	return out
+}
 
-			out += "Network\n\n"
-			for _, c := range netCmd {
-				out += fmt.Sprintf("%-25s %s \n", c.Text, c.Description)
-			}
-			out += "\n"
+// Execute executes a single command and prints its result. The returned error is the error of the command, it is
+// already printed.
+func (c *CLI) Execute(args []string) error {
+	var out string
+	var err error
+	// Streaming commands print their messages as they are received.
+	streaming := false
+
+	switch args[0] {
+	case "help":
+		out = help()
+
+	// Chain methods
+	case "getchaininfo":
+		out, err = c.rpcClient.GetChainInfo()
+	case "getrawblock":
+		out, err = c.rpcClient.GetRawBlock(args[1:])
+	case "getblockhash":
+		out, err = c.rpcClient.GetBlockHash(args[1:])
+	case "getblock":
+		out, err = c.rpcClient.GetBlock(args[1:])
+	case "getaccountinfo":
+		out, err = c.rpcClient.GetAccountInfo(args[1:])
+	case "getaccountproof":
+		out, err = c.rpcClient.GetAccountProof(args[1:])
+	case "getcontractstorage":
+		out, err = c.rpcClient.GetContractStorage(args[1:])
+	case "getasset":
+		out, err = c.rpcClient.GetAsset(args[1:])
+	case "getblockreceipts":
+		out, err = c.rpcClient.GetBlockReceipts(args[1:])
+	case "getcontractreceipts":
+		out, err = c.rpcClient.GetContractReceipts(args[1:])
+	case "sync":
+		streaming = true
+		err = c.stream(args[0], args[1:], func(ctx context.Context, handler rpcclient.StreamHandler) error {
+			return c.rpcClient.Sync(ctx, args[1:], handler)
+		})
+	case "subscribeblocks":
+		streaming = true
+		err = c.stream(args[0], args[1:], c.rpcClient.SubscribeBlocks)
+	case "subscribetransactions":
+		streaming = true
+		err = c.stream(args[0], args[1:], func(ctx context.Context, handler rpcclient.StreamHandler) error {
+			return c.rpcClient.SubscribeTransactions(ctx, args[1:], handler)
+		})
+	case "subscribevalidatortransactions":
+		streaming = true
+		err = c.stream(args[0], args[1:], func(ctx context.Context, handler rpcclient.StreamHandler) error {
+			return c.rpcClient.SubscribeValidatorTransactions(ctx, args[1:], handler)
+		})
+
+	// Validator methods
+	case "getvalidatorslist":
+		out, err = c.rpcClient.GetValidatorsList(args[1:])
+	case "getaccountvalidators":
+		out, err = c.rpcClient.GetAccountValidators(args[1:])
+
+	// Network methods
+	case "getnetworkinfo":
+		out, err = c.rpcClient.GetNetworkInfo()
+	case "getpeersinfo":
+		out, err = c.rpcClient.GetPeersInfo()
+	case "addpeer":
+		out, err = c.rpcClient.AddPeer(args[1:])
+
+	// Utils methods
+	case "submitrawdata":
+		out, err = c.rpcClient.SubmitRawData(args[1:])
+	case "genkeypair":
+		out, err = c.rpcClient.GenKeyPair(args[1:], false)
+	case "genrawkeypair":
+		out, err = c.rpcClient.GenKeyPair(args[1:], true)
+	case "genvalidatorkey":
+		out, err = c.rpcClient.GenValidatorKey(args[1:])
+	case "decoderawtransaction":
+		out, err = c.rpcClient.DecodeRawTransaction(args[1:])
+	case "decoderawblock":
+		out, err = c.rpcClient.DecodeRawBlock(args[1:])
+	case "getparticipationstatus":
+		out, err = c.rpcClient.GetParticipationStatus()
+	case "syncmempool":
+		streaming = true
+		err = c.stream(args[0], args[1:], c.rpcClient.SyncMempool)
+	case "subscribemempool":
+		streaming = true
+		err = c.stream(args[0], args[1:], c.rpcClient.SubscribeMempool)
+	case "getloglevels":
+		out, err = c.rpcClient.GetLogLevels()
+	case "setloglevel":
+		out, err = c.rpcClient.SetLogLevel(args[1:])
+
+	// Wallet methods
+	case "listwallets":
+		out, err = c.rpcClient.ListWallets()
+	case "createwallet":
+		out, err = c.rpcClient.CreateWallet(args[1:])
+	case "openwallet":
+		out, err = c.rpcClient.OpenWallet(args[1:])
+	case "closewallet":
+		out, err = c.rpcClient.CloseWallet()
+	case "importwallet":
+		out, err = c.rpcClient.ImportWallet(args[1:])
+	case "dumpwallet":
+		out, err = c.rpcClient.DumpWallet()
+	case "dumphdinfo":
+		out, err = c.rpcClient.DumpHDInfo()
+	case "getbalance":
+		out, err = c.rpcClient.GetBalance()
+	case "getvalidators":
+		out, err = c.rpcClient.GetValidators()
+	case "getaccount":
+		out, err = c.rpcClient.GetAccount()
+	case "sendtransaction":
+		out, err = c.rpcClient.SendTransaction(args[1:])
+	case "deploycontract":
+		out, err = c.rpcClient.DeployContract(args[1:])
+	case "callcontract":
+		out, err = c.rpcClient.CallContract(args[1:])
+	case "issueasset":
+		out, err = c.rpcClient.IssueAsset(args[1:])
+	case "transferasset":
+		out, err = c.rpcClient.TransferAsset(args[1:])
+	case "burnasset":
+		out, err = c.rpcClient.BurnAsset(args[1:])
+	case "getassetbalances":
+		out, err = c.rpcClient.GetAssetBalances()
+	case "startvalidator":
+		out, err = c.rpcClient.StartValidator(args[1:])
+	case "exitvalidator":
+		out, err = c.rpcClient.ExitValidator(args[1:])
+	case "startvalidatorbulk":
+		out, err = c.rpcClient.StartValidatorBulk(args[1:])
+	case "exitvalidatorbulk":
+		out, err = c.rpcClient.ExitValidatorBulk(args[1:])
+
+	default:
+		err = fmt.Errorf("Unknown command: %s", args[0])
+	}
 
-			out += "Utils\n\n"
-			for _, c := range utilsCmd {
-				out += fmt.Sprintf("%-25s %s \n", c.Text, c.Description)
-			}
-			out += "\n"
+	if err != nil {
+		c.print(args[0], args[1:], "", err)
+		return err
+	}
+	if !streaming {
+		c.print(args[0], args[1:], out, nil)
+	}
+	return nil
+}
 
-			out += "Wallet\n\n"
-			for _, c := range walletCmd {
-				out += fmt.Sprintf("%-25s %s \n", c.Text, c.Description)
+// splitArgs splits a command line into arguments. Arguments are separated by spaces or tabs, single quotes keep their
+// content as is, double quotes allow \" and \\ escapes and a backslash outside quotes escapes the next character.
+func splitArgs(line string) ([]string, error) {
+	var args []string
+	var arg strings.Builder
+	inArg := false
+	var quote rune
+	escaped := false
+
+	for _, r := range strings.TrimRight(line, "\r\n") {
+		switch {
+		case escaped:
+			if quote == '"' && r != '"' && r != '\\' {
+				arg.WriteRune('\\')
+			}
+			arg.WriteRune(r)
+			escaped = false
+		case quote == '\'':
+			if r == '\'' {
+				quote = 0
+			} else {
+				arg.WriteRune(r)
+			}
+		case r == '\\':
+			escaped = true
+			inArg = true
+		case quote == '"':
+			if r == '"' {
+				quote = 0
+			} else {
+				arg.WriteRune(r)
+			}
+		case r == '"' || r == '\'':
+			quote = r
+			inArg = true
+		case r == ' ' || r == '\t':
+			if inArg {
+				args = append(args, arg.String())
+				arg.Reset()
+				inArg = false
 			}
-			out += "\n"
-		// Chain methods
-		case "getchaininfo":
-			out, err = c.rpcClient.GetChainInfo()
-		case "getrawblock":
-			out, err = c.rpcClient.GetRawBlock(args[1:])
-		case "getblockhash":
-			out, err = c.rpcClient.GetBlockHash(args[1:])
-		case "getblock":
-			out, err = c.rpcClient.GetBlock(args[1:])
-		case "getaccountinfo":
-			out, err = c.rpcClient.GetAccountInfo(args[1:])
-
-		// Validator methods
-		case "getvalidatorslist":
-			out, err = c.rpcClient.GetValidatorsList()
-		case "getaccountvalidators":
-			out, err = c.rpcClient.GetAccountValidators(args[1:])
-
-		// Network methods
-		case "getnetworkinfo":
-			out, err = c.rpcClient.GetNetworkInfo()
-		case "getpeersinfo":
-			out, err = c.rpcClient.GetPeersInfo()
-		case "addpeer":
-			out, err = c.rpcClient.AddPeer(args[1:])
-
-		// Utils methods
-		case "submitrawdata":
-			out, err = c.rpcClient.SubmitRawData(args[1:])
-		case "genkeypair":
-			out, err = c.rpcClient.GenKeyPair(args[1:], false)
-		case "genrawkeypair":
-			out, err = c.rpcClient.GenKeyPair(args[1:], true)
-		case "genvalidatorkey":
-			out, err = c.rpcClient.GenValidatorKey(args[1:])
-		case "decoderawtransaction":
-			out, err = c.rpcClient.DecodeRawTransaction(args[1:])
-		case "decoderawblock":
-			out, err = c.rpcClient.DecodeRawBlock(args[1:])
-
-		// Wallet methods
-		case "listwallets":
-			out, err = c.rpcClient.ListWallets()
-		case "createwallet":
-			out, err = c.rpcClient.CreateWallet(args[1:])
-		case "openwallet":
-			out, err = c.rpcClient.OpenWallet(args[1:])
-		case "closewallet":
-			out, err = c.rpcClient.CloseWallet()
-		case "importwallet":
-			out, err = c.rpcClient.ImportWallet(args[1:])
-		case "dumpwallet":
-			out, err = c.rpcClient.DumpWallet()
-		case "dumphdinfo":
-			out, err = c.rpcClient.DumpHDInfo()
-		case "getbalance":
-			out, err = c.rpcClient.GetBalance()
-		case "getvalidators":
-			out, err = c.rpcClient.GetValidators()
-		case "getaccount":
-			out, err = c.rpcClient.GetAccount()
-		case "sendtransaction":
-			out, err = c.rpcClient.SendTransaction(args[1:])
-		case "startvalidator":
-			out, err = c.rpcClient.StartValidator(args[1:])
-		case "exitvalidator":
-			out, err = c.rpcClient.ExitValidator(args[1:])
-
-		// Misc methods
-		case "exit":
-			return
-
 		default:
-			err = fmt.Errorf("Unknown command: %s", args[0])
-		}
-
-		if err != nil {
-			color.Red("%s", err.Error())
-		} else {
-			color.Green("%s", out)
+			arg.WriteRune(r)
+			inArg = true
 		}
 	}
+	if escaped {
+		return nil, errors.New("unfinished escape at the end of the line")
+	}
+	if quote != 0 {
+		return nil, fmt.Errorf("missing closing quote %c", quote)
+	}
+	if inArg {
+		args = append(args, arg.String())
+	}
+	return args, nil
 }
 
-func newCli(rpcClient *rpcclient.Client) *CLI {
+func newCli(rpcClient *rpcclient.Client, jsonOutput bool, continueOnError bool) *CLI {
 	return &CLI{
-		rpcClient: rpcClient,
+		rpcClient:       rpcClient,
+		json:            jsonOutput,
+		continueOnError: continueOnError,
 	}
 }
 
-func StartConsole(host string, args []string) {
+// StartConsole connects to the RPC server and executes the command of the arguments, the commands of the file flag or
+// the standard input when it is not a terminal, or starts the interactive console.
+func StartConsole(host string, args []string) error {
 	rpcClient := rpcclient.NewRPCClient(host, false)
-	cli := newCli(rpcClient)
-	cli.Run(args)
+	if rpcClient == nil {
+		color.Red("unable to load the RPC certificates")
+		return errCommandsFailed
+	}
+	cli := newCli(rpcClient, consoleJSON, continueOnError)
+
+	if len(args) > 0 {
+		return cli.Execute(args)
+	}
+
+	file := consoleFile
+	if file == "" {
+		if info, err := os.Stdin.Stat(); err == nil && info.Mode()&os.ModeCharDevice == 0 {
+			file = "-"
+		}
+	}
+	switch file {
+	case "":
+		cli.Run()
+		return nil
+	case "-":
+		return cli.RunBatch(os.Stdin)
+	default:
+		f, err := os.Open(file)
+		if err != nil {
+			cli.print("", nil, "", err)
+			return err
+		}
+		defer f.Close()
+		return cli.RunBatch(f)
+	}
 }
@@ -0,0 +1,37 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitArgs(t *testing.T) {
+	tests := []struct {
+		line string
+		args []string
+		err  string
+	}{
+		{line: "", args: nil},
+		{line: " \t\n", args: nil},
+		{line: "getbalance  w1\t x\r\n", args: []string{"getbalance", "w1", "x"}},
+		{line: `importwallet w "a b c" 'p w'`, args: []string{"importwallet", "w", "a b c", "p w"}},
+		{line: `a\ b "x\"y\\z\n" ''`, args: []string{"a b", `x"y\z\n`, ""}},
+		{line: `'a\b "c"' "it's"`, args: []string{`a\b "c"`, "it's"}},
+		{line: `pre"quoted"post x\'y`, args: []string{"prequotedpost", "x'y"}},
+		{line: `"open`, err: `missing closing quote "`},
+		{line: `a 'open`, err: "missing closing quote '"},
+		{line: `x\`, err: "unfinished escape at the end of the line"},
+		{line: `"x\`, err: "unfinished escape at the end of the line"},
+	}
+
+	for _, tt := range tests {
+		args, err := splitArgs(tt.line)
+		if tt.err != "" {
+			assert.EqualError(t, err, tt.err, tt.line)
+			continue
+		}
+		assert.NoError(t, err, tt.line)
+		assert.Equal(t, tt.args, args, tt.line)
+	}
+}
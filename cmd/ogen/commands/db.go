@@ -0,0 +1,80 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/olympus-protocol/ogen/internal/blockdb"
+	"github.com/spf13/cobra"
+)
+
+var (
+	migrateFrom       string
+	migrateTo         string
+	migrateTargetPath string
+)
+
+func init() {
+	dbMigrateCmd.Flags().StringVar(&migrateFrom, "from", blockdb.BackendLevelDB, "Storage backend of the source datadir.")
+	dbMigrateCmd.Flags().StringVar(&migrateTo, "to", blockdb.BackendBolt, "Storage backend to migrate the chain data to.")
+	dbMigrateCmd.Flags().StringVar(&migrateTargetPath, "target", "", "Directory to store the migrated chain data (defaults to the datadir).")
+
+	dbCmd.AddCommand(dbMigrateCmd)
+	rootCmd.AddCommand(dbCmd)
+}
+
+var dbCmd = &cobra.Command{
+	Use:   "db",
+	Short: "Manage the chain database",
+	Long:  `Manage the chain database`,
+}
+
+var dbMigrateCmd = &cobra.Command{
+	Use:   "migrate",
+	Short: "Copies the chain data from one storage backend to another",
+	Long:  `Copies the chain data from one storage backend to another`,
+	Run: func(cmd *cobra.Command, args []string) {
+		target := migrateTargetPath
+		if target == "" {
+			target = DataPath
+		}
+
+		if migrateFrom == blockdb.BackendMemory || migrateTo == blockdb.BackendMemory {
+			fmt.Println("the memory backend can't be migrated")
+			os.Exit(1)
+		}
+
+		if blockdb.StorePath(migrateFrom, DataPath) == blockdb.StorePath(migrateTo, target) {
+			fmt.Println("source and target chain data are the same")
+			os.Exit(1)
+		}
+
+		copied, err := migrateChain(target)
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+
+		fmt.Printf("migrated %d keys from %s to %s\n", copied, blockdb.StorePath(migrateFrom, DataPath), blockdb.StorePath(migrateTo, target))
+	},
+}
+
+func migrateChain(target string) (uint64, error) {
+	if _, err := os.Stat(blockdb.StorePath(migrateFrom, DataPath)); err != nil {
+		return 0, err
+	}
+
+	from, err := blockdb.OpenStore(migrateFrom, DataPath)
+	if err != nil {
+		return 0, err
+	}
+	defer from.Close()
+
+	to, err := blockdb.OpenStore(migrateTo, target)
+	if err != nil {
+		return 0, err
+	}
+	defer to.Close()
+
+	return blockdb.Migrate(from, to)
+}
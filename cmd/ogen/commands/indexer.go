@@ -3,58 +3,110 @@ package commands
 import (
 	"fmt"
 	"github.com/olympus-protocol/ogen/cmd/ogen/indexer"
+	"github.com/olympus-protocol/ogen/internal/blockdb"
 	"github.com/olympus-protocol/ogen/pkg/params"
 	"github.com/spf13/cobra"
 	"os"
+	"runtime"
 )
 
 var (
 	rpcEndpoint  string
 	dbConnString string
 	dbDriver     string
+	graphqlAddr  string
+
+	importBackend string
+	importWorkers int
+	importFollow  bool
 )
 
 func init() {
-	indexerCmd.Flags().StringVar(&rpcEndpoint, "rpc_host", "127.0.0.1:24127", "IP and port of the RPC Server to connect")
-	indexerCmd.Flags().StringVar(&dbConnString, "dbconn", "", "Database connection string")
-	indexerCmd.Flags().StringVar(&dbDriver, "driver", "mysql", "Database driver to connect the database")
+	indexerCmd.PersistentFlags().StringVar(&rpcEndpoint, "rpc_host", "127.0.0.1:24127", "IP and port of the RPC Server to connect")
+	indexerCmd.PersistentFlags().StringVar(&dbConnString, "dbconn", "", "Database connection string (a file path for sqlite3)")
+	indexerCmd.PersistentFlags().StringVar(&dbDriver, "driver", "mysql", "Database driver to connect the database (mysql, postgres or sqlite3)")
+	indexerCmd.PersistentFlags().StringVar(&graphqlAddr, "graphql", "", "IP and port to serve the GraphQL API, the API is disabled if empty")
+
+	indexerImportCmd.Flags().StringVar(&importBackend, "db_backend", blockdb.BackendLevelDB, "Storage backend of the datadir chain data (leveldb or bolt).")
+	indexerImportCmd.Flags().IntVar(&importWorkers, "workers", runtime.NumCPU(), "Amount of workers reading blocks from the datadir.")
+	indexerImportCmd.Flags().BoolVar(&importFollow, "follow", true, "Keep indexing new blocks through RPC after the import.")
 
+	indexerCmd.AddCommand(indexerImportCmd)
 	rootCmd.AddCommand(indexerCmd)
 }
 
+func newIndexer(args []string) *indexer.Indexer {
+	if len(args) < 1 {
+		fmt.Println("indexer <network> [flags]")
+		os.Exit(0)
+	}
+
+	network := args[0]
+
+	var netParams *params.ChainParams
+	switch network {
+	case "testnet":
+		netParams = &params.TestNet
+	case "mainnet":
+		netParams = &params.Mainnet
+	default:
+		fmt.Println("unknown network parameters")
+		os.Exit(0)
+	}
+
+	if dbConnString == "" || dbDriver == "" {
+		fmt.Println("Missing database connection string or driver")
+		os.Exit(0)
+	}
+
+	idx, err := indexer.NewIndexer(dbConnString, rpcEndpoint, dbDriver, netParams)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(0)
+	}
+
+	if graphqlAddr != "" {
+		err = idx.ServeGraphQL(graphqlAddr)
+		if err != nil {
+			fmt.Println(err)
+			idx.Close()
+			os.Exit(1)
+		}
+	}
+
+	return idx
+}
+
 var indexerCmd = &cobra.Command{
 	Use:   "indexer <network>",
 	Short: "Execute the and indexer to organize the blockchain information through RPC",
 	Long:  `Execute the and indexer to organize the blockchain information through RPC`,
 	Run: func(cmd *cobra.Command, args []string) {
+		idx := newIndexer(args)
 
-		if len(args) < 1 {
-			fmt.Println("indexer <network> [flags]")
-			os.Exit(0)
-		}
-
-		network := args[0]
-
-		var netParams *params.ChainParams
-		switch network {
-		case "testnet":
-			netParams = &params.TestNet
-		case "mainnet":
-			netParams = &params.Mainnet
-		default:
-			fmt.Println("unknown network parameters")
-			os.Exit(0)
-		}
+		idx.Start()
+		<-idx.Context().Done()
+		idx.Close()
+	},
+}
 
-		if dbConnString == "" || dbDriver == "" {
-			fmt.Println("Missing database connection string or driver")
-			os.Exit(0)
-		}
+var indexerImportCmd = &cobra.Command{
+	Use:   "import <network>",
+	Short: "Index the blocks stored on a node datadir without a running node",
+	Long:  `Index the blocks stored on a node datadir without a running node. The node must be stopped while the blocks are imported, after the import the indexer follows the chain through RPC unless --follow=false is used. The import starts after the last indexed block and fails if the datadir is pruned past it. The epoch receipts are calculated by the node and are not stored on the datadir, the imported blocks are indexed without epoch receipts.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		idx := newIndexer(args)
 
-		idx, err := indexer.NewIndexer(dbConnString, rpcEndpoint, dbDriver, netParams)
+		err := idx.Import(importBackend, DataPath, importWorkers)
 		if err != nil {
 			fmt.Println(err)
-			os.Exit(0)
+			idx.Close()
+			os.Exit(1)
+		}
+
+		if !importFollow {
+			idx.Close()
+			return
 		}
 
 		idx.Start()
@@ -19,19 +19,40 @@ var (
 )
 
 type Flags struct {
-	DataPath      string
-	NetworkName   string
-	Port          string
-	RPCProxy      bool
-	RPCProxyPort  string
-	RPCProxyAddr  string
-	RPCPort       string
-	RPCWallet     bool
-	RPCAuthToken  string
-	Debug         bool
-	LogFile       bool
-	Dashboard     bool
-	DashboardPort string
+	DataPath        string
+	NetworkName     string
+	Port            string
+	RPCProxy        bool
+	RPCProxyPort    string
+	RPCProxyAddr    string
+	RPCPort         string
+	RPCWallet       bool
+	RPCAuthToken    string
+	Debug           bool
+	LogFile         bool
+	LogJSON         bool
+	LogLevel        string
+	LogMaxSize      int
+	LogMaxAge       time.Duration
+	LogMaxBackups   int
+	Dashboard       bool
+	DashboardPort   string
+	DashboardUser   string
+	DashboardPass   string
+	DBBackend       string
+	Archive         bool
+	ArchiveInterval uint64
+	Prune           bool
+	PruneDepth      uint64
+	GossipWorkers   int
+	GossipPeerQueue int
+	Slasher         bool
+
+	Webhooks          []string
+	WebhookSecret     string
+	WebhookAddresses  []string
+	WebhookValidators []string
+	WebhookAttempts   int
 }
 
 type Params struct {
@@ -100,6 +121,7 @@ func SetTestFlags() {
 		RPCAuthToken: "",
 		Debug:        false,
 		LogFile:      false,
+		DBBackend:    "memory",
 	}
 }
 
@@ -0,0 +1,92 @@
+package db
+
+import (
+	"github.com/doug-martin/goqu/v9"
+	"github.com/olympus-protocol/ogen/pkg/primitives"
+)
+
+// bulkInsertRows is the maximum amount of rows inserted with a single statement.
+const bulkInsertRows = 500
+
+// bulkTables are the tables buffered during a bulk load, in the order they are written to satisfy the foreign keys.
+// Deposits are not buffered since the validators table references them.
+var bulkTables = []string{"blocks", "block_headers", "votes", "tx_single", "tx_multi", "tx_asset", "tx_contract_deploy",
+	"tx_contract_call", "exits", "vote_slashing", "randao_slashing", "proposer_slashing", "governance_votes",
+	"epoch_receipts", "manager_payouts", "validator_balances", "undo_log"}
+
+// bulkLoad buffers the rows that only depend on a single block, they are written with multi-row inserts when the
+// load is flushed. Rows read or referenced while indexing, like accounts, deposits and validators, are always written
+// directly.
+type bulkLoad struct {
+	rows map[string][]interface{}
+
+	// tip and height are the last buffered block, blocks are not visible on the database until the load is flushed.
+	tip    string
+	height int
+}
+
+// insertRecord inserts a row into a table, or buffers it if a bulk load is in progress.
+func (d *Database) insertRecord(table string, record goqu.Record) error {
+	if d.bulk != nil {
+		if _, ok := d.bulk.rows[table]; ok {
+			d.bulk.rows[table] = append(d.bulk.rows[table], record)
+			return nil
+		}
+	}
+
+	ds := goqu.Dialect(d.driver).Insert(table).Rows(record)
+	query, _, err := ds.ToSQL()
+	if err != nil {
+		return err
+	}
+	_, err = d.q.Exec(query)
+	return err
+}
+
+// flushBulk writes the buffered rows with multi-row inserts.
+func (d *Database) flushBulk() error {
+	dw := goqu.Dialect(d.driver)
+	for _, table := range bulkTables {
+		rows := d.bulk.rows[table]
+		for len(rows) > 0 {
+			n := len(rows)
+			if n > bulkInsertRows {
+				n = bulkInsertRows
+			}
+			query, _, err := dw.Insert(table).Rows(rows[:n]...).ToSQL()
+			if err != nil {
+				return err
+			}
+			if _, err := d.q.Exec(query); err != nil {
+				return err
+			}
+			rows = rows[n:]
+		}
+	}
+	d.bulk = newBulkLoad()
+	return nil
+}
+
+func newBulkLoad() *bulkLoad {
+	rows := make(map[string][]interface{}, len(bulkTables))
+	for _, table := range bulkTables {
+		rows[table] = nil
+	}
+	return &bulkLoad{rows: rows}
+}
+
+// ImportBlocks indexes a batch of consecutive blocks in a single database transaction. The rows of each block are
+// buffered and written with multi-row inserts, the blocks must extend the indexed tip in chain order. Epoch receipts
+// are only known by a running node, so they are not indexed for imported blocks. Blocks with contract transactions can't
+// be imported, the gas they paid is also only known by a running node.
+func (d *Database) ImportBlocks(blocks []*primitives.Block) error {
+	return d.update(func(tx *Database) error {
+		tx.bulk = newBulkLoad()
+		for _, b := range blocks {
+			if err := tx.insertBlock(b, nil, nil); err != nil {
+				return err
+			}
+		}
+		return tx.flushBulk()
+	})
+}
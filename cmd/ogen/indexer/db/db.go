@@ -4,16 +4,20 @@ import (
 	"database/sql"
 	"encoding/hex"
 	"errors"
+	"fmt"
 	"github.com/doug-martin/goqu/v9"
 	_ "github.com/doug-martin/goqu/v9/dialect/mysql"
 	_ "github.com/doug-martin/goqu/v9/dialect/postgres"
+	_ "github.com/doug-martin/goqu/v9/dialect/sqlite3"
 	_ "github.com/go-sql-driver/mysql"
 	"github.com/golang-migrate/migrate/v4"
 	"github.com/golang-migrate/migrate/v4/database"
 	"github.com/golang-migrate/migrate/v4/database/mysql"
 	"github.com/golang-migrate/migrate/v4/database/postgres"
+	"github.com/golang-migrate/migrate/v4/database/sqlite3"
 	_ "github.com/golang-migrate/migrate/v4/source/file"
 	_ "github.com/lib/pq"
+	_ "github.com/mattn/go-sqlite3"
 	"github.com/olympus-protocol/ogen/api/proto"
 	"github.com/olympus-protocol/ogen/cmd/ogen/initialization"
 	"github.com/olympus-protocol/ogen/internal/state"
@@ -24,6 +28,7 @@ import (
 	"github.com/olympus-protocol/ogen/pkg/logger"
 	"github.com/olympus-protocol/ogen/pkg/primitives"
 	"strconv"
+	"strings"
 	"sync"
 )
 
@@ -39,9 +44,16 @@ type State struct {
 type Database struct {
 	log       logger.Logger
 	db        *sql.DB
+	q         queryer
 	canClose  *sync.WaitGroup
 	driver    string
 	netParams *params.ChainParams
+
+	// undoBlock is the hash of the block being inserted, the changes to the database are journaled with it.
+	undoBlock string
+
+	// bulk buffers the inserted rows while importing blocks.
+	bulk *bulkLoad
 }
 
 func (d *Database) GetCurrentState() (State, error) {
@@ -59,9 +71,59 @@ func (d *Database) GetCurrentState() (State, error) {
 	}
 }
 
+// InsertBlock indexes a block in a single database transaction. If the block extends an indexed block that is not the
+// tip, the blocks after it are orphaned and rolled back first. Returns ErrorPrevBlockHash if the previous block is not
+// indexed.
 func (d *Database) InsertBlock(block *primitives.Block) error {
+	return d.InsertBlockWithReceipts(block, nil, nil)
+}
+
+// InsertBlockWithReceipts indexes a block together with the epoch receipts, manager payouts and validator balances of
+// the epoch transitions processed before it. The contract receipts are required if the block has contract
+// transactions, the gas paid is taken from them.
+func (d *Database) InsertBlockWithReceipts(block *primitives.Block, receipts *proto.BlockReceipts, contracts *proto.ContractReceipts) error {
+	return d.update(func(tx *Database) error {
+		return tx.insertBlock(block, receipts, contracts)
+	})
+}
+
+func (d *Database) insertBlock(block *primitives.Block, receipts *proto.BlockReceipts, contracts *proto.ContractReceipts) error {
 	// TODO fix, initialize epoch/slot tables for non produced block tables.
 
+	nextHeight, prevHash, err := d.getNextHeight()
+	if err != nil {
+		d.log.Error(err)
+		return err
+	}
+
+	parentHash := hex.EncodeToString(block.Header.PrevBlockHash[:])
+	if nextHeight > 0 && parentHash != prevHash {
+		known, err := d.hasBlock(parentHash)
+		if err != nil {
+			return err
+		}
+		if !known {
+			d.log.Error(ErrorPrevBlockHash)
+			return ErrorPrevBlockHash
+		}
+
+		// The block extends a fork, rollback the blocks up to the common ancestor.
+		for prevHash != parentHash {
+			prevHash, err = d.rollbackTip()
+			if err != nil {
+				return err
+			}
+		}
+
+		nextHeight, _, err = d.getNextHeight()
+		if err != nil {
+			return err
+		}
+	}
+
+	hash := block.Hash()
+	d.undoBlock = hash.String()
+
 	blockSlot := int64(block.Header.Slot) - 1
 
 	currentEpoch := blockSlot / int64(d.netParams.EpochLength)
@@ -75,32 +137,24 @@ func (d *Database) InsertBlock(block *primitives.Block) error {
 		}
 	}
 
-	// Check if this block finishes an epoch and creates a new one
-
-	nextHeight, prevHash, err := d.getNextHeight()
-	if err != nil {
-		d.log.Error(err)
-		return err
-	}
-
-	if nextHeight > 0 && hex.EncodeToString(block.Header.PrevBlockHash[:]) != prevHash {
-		d.log.Error(ErrorPrevBlockHash)
-		return ErrorPrevBlockHash
-	}
-
 	// Insert into blocks table
 	var queryVars []interface{}
-	hash := block.Hash()
 	queryVars = append(queryVars, hash.String(), hex.EncodeToString(block.Signature[:]), hex.EncodeToString(block.RandaoSignature[:]), nextHeight)
 	err = d.insertRow("blocks", queryVars)
 	if err != nil {
-		d.log.Error(err)
+		return err
+	}
+	if d.bulk != nil {
+		d.bulk.tip, d.bulk.height = hash.String(), nextHeight
 	}
 
 	fee := 0
 	for _, tx := range block.Txs {
 		fee += int(tx.Fee)
 	}
+	for _, tx := range block.AssetTxs {
+		fee += int(tx.Fee)
+	}
 	var feeReceiver = &AccountInfo{
 		Account:       hex.EncodeToString(block.Header.FeeAddress[:]),
 		Confirmed:     fee,
@@ -109,7 +163,7 @@ func (d *Database) InsertBlock(block *primitives.Block) error {
 
 	err = d.modifyAccountRow(feeReceiver)
 	if err != nil {
-		d.log.Error(err)
+		return err
 	}
 
 	// Block Headers
@@ -123,7 +177,7 @@ func (d *Database) InsertBlock(block *primitives.Block) error {
 		hex.EncodeToString(block.Header.FeeAddress[:]))
 	err = d.insertRow("block_headers", queryVars)
 	if err != nil {
-		d.log.Error(err)
+		return err
 	}
 
 	// Votes
@@ -134,8 +188,7 @@ func (d *Database) InsertBlock(block *primitives.Block) error {
 			int(vote.Data.Nonce), vote.Data.Hash().String())
 		err = d.insertRow("votes", queryVars)
 		if err != nil {
-			d.log.Error(err)
-			continue
+			return err
 		}
 	}
 
@@ -144,8 +197,7 @@ func (d *Database) InsertBlock(block *primitives.Block) error {
 		queryVars = nil
 		pkh, err := tx.FromPubkeyHash()
 		if err != nil {
-			d.log.Error(err)
-			continue
+			return err
 		}
 
 		var receiverAccInfo = &AccountInfo{
@@ -162,24 +214,176 @@ func (d *Database) InsertBlock(block *primitives.Block) error {
 
 		err = d.modifyAccountRow(receiverAccInfo)
 		if err != nil {
-			d.log.Error(err)
-			continue
+			return err
 		}
 
 		err = d.modifyAccountRow(senderAccInfo)
 		if err != nil {
-			d.log.Error(err)
-			continue
+			return err
 		}
 
 		queryVars = append(queryVars, tx.Hash().String(), hash.String(), 0, hex.EncodeToString(tx.To[:]), hex.EncodeToString(tx.FromPublicKey[:]), hex.EncodeToString(pkh[:]),
 			int(tx.Amount), int(tx.Nonce), int(tx.Fee), hex.EncodeToString(tx.Signature[:]))
 		err = d.insertRow("tx_single", queryVars)
 		if err != nil {
-			d.log.Error(err)
-			continue
+			return err
+		}
+
+	}
+
+	// Transactions Multi
+	// The state doesn't apply multisig transactions, they are stored without modifying the accounts.
+	for _, tx := range block.TxsMulti {
+		queryVars = nil
+		pkh, err := tx.FromPubkeyHash()
+		if err != nil {
+			return err
+		}
+
+		sig, err := tx.Signature.Marshal()
+		if err != nil {
+			return err
+		}
+
+		queryVars = append(queryVars, tx.Hash().String(), hash.String(), hex.EncodeToString(tx.To[:]), hex.EncodeToString(pkh[:]),
+			int(tx.Signature.PublicKey.NumNeeded), len(tx.Signature.PublicKey.PublicKeys), int(tx.Amount), int(tx.Nonce), int(tx.Fee),
+			hex.EncodeToString(sig))
+		err = d.insertRow("tx_multi", queryVars)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Asset Transactions
+	for _, tx := range block.AssetTxs {
+		queryVars = nil
+		pkh, err := tx.FromPubkeyHash()
+		if err != nil {
+			return err
+		}
+
+		var senderAccInfo = &AccountInfo{
+			Account:   hex.EncodeToString(pkh[:]),
+			Confirmed: -1 * int(tx.Fee),
+			TotalSent: int(tx.Fee),
+		}
+
+		err = d.modifyAccountRow(senderAccInfo)
+		if err != nil {
+			return err
+		}
+
+		assetID := hex.EncodeToString(tx.Asset[:])
+		switch tx.Type {
+		case primitives.AssetIssue:
+			err = d.modifyAssetRow(assetID, hex.EncodeToString(pkh[:]), int(tx.Amount))
+			if err == nil {
+				err = d.modifyAssetBalanceRow(assetID, hex.EncodeToString(tx.To[:]), int(tx.Amount))
+			}
+		case primitives.AssetTransfer:
+			err = d.modifyAssetBalanceRow(assetID, hex.EncodeToString(pkh[:]), -1*int(tx.Amount))
+			if err == nil {
+				err = d.modifyAssetBalanceRow(assetID, hex.EncodeToString(tx.To[:]), int(tx.Amount))
+			}
+		case primitives.AssetBurn:
+			err = d.modifyAssetRow(assetID, hex.EncodeToString(pkh[:]), -1*int(tx.Amount))
+			if err == nil {
+				err = d.modifyAssetBalanceRow(assetID, hex.EncodeToString(pkh[:]), -1*int(tx.Amount))
+			}
+		}
+		if err != nil {
+			return err
 		}
 
+		queryVars = append(queryVars, tx.Hash().String(), hash.String(), int(tx.Type), assetID, hex.EncodeToString(tx.To[:]), hex.EncodeToString(tx.FromPublicKey[:]),
+			hex.EncodeToString(pkh[:]), int(tx.Amount), int(tx.Nonce), int(tx.Fee), hex.EncodeToString(tx.Signature[:]))
+		err = d.insertRow("tx_asset", queryVars)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Contract Transactions
+	contractReceipts := make(map[string]*proto.ContractReceipt)
+	if contracts != nil {
+		for _, r := range contracts.Receipts {
+			contractReceipts[r.TxHash] = r
+		}
+	}
+
+	for _, tx := range block.ContractDeploys {
+		pkh, err := tx.FromPubkeyHash()
+		if err != nil {
+			return err
+		}
+		contract, err := tx.ContractAddress()
+		if err != nil {
+			return err
+		}
+		txHash := tx.Hash()
+		r, ok := contractReceipts[txHash.String()]
+		if !ok {
+			return fmt.Errorf("missing receipt of contract deploy %s", txHash)
+		}
+
+		err = d.payContractTx(pkh, contract, block.Header.FeeAddress, tx.Amount, r.GasUsed*tx.GasPrice, r.Success)
+		if err != nil {
+			return err
+		}
+
+		err = d.insertRecord("tx_contract_deploy", goqu.Record{
+			"hash":                 txHash.String(),
+			"block_hash":           hash.String(),
+			"contract":             hex.EncodeToString(contract[:]),
+			"from_public_key":      hex.EncodeToString(tx.FromPublicKey[:]),
+			"from_public_key_hash": hex.EncodeToString(pkh[:]),
+			"amount":               int(tx.Amount),
+			"nonce":                int(tx.Nonce),
+			"gas_limit":            int(tx.GasLimit),
+			"gas_price":            int(tx.GasPrice),
+			"gas_used":             int(r.GasUsed),
+			"success":              r.Success,
+			"signature":            hex.EncodeToString(tx.Signature[:]),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, tx := range block.ContractCalls {
+		pkh, err := tx.FromPubkeyHash()
+		if err != nil {
+			return err
+		}
+		txHash := tx.Hash()
+		r, ok := contractReceipts[txHash.String()]
+		if !ok {
+			return fmt.Errorf("missing receipt of contract call %s", txHash)
+		}
+
+		err = d.payContractTx(pkh, tx.To, block.Header.FeeAddress, tx.Amount, r.GasUsed*tx.GasPrice, r.Success)
+		if err != nil {
+			return err
+		}
+
+		err = d.insertRecord("tx_contract_call", goqu.Record{
+			"hash":                 txHash.String(),
+			"block_hash":           hash.String(),
+			"to_addr":              hex.EncodeToString(tx.To[:]),
+			"from_public_key":      hex.EncodeToString(tx.FromPublicKey[:]),
+			"from_public_key_hash": hex.EncodeToString(pkh[:]),
+			"input":                hex.EncodeToString(tx.Input),
+			"amount":               int(tx.Amount),
+			"nonce":                int(tx.Nonce),
+			"gas_limit":            int(tx.GasLimit),
+			"gas_price":            int(tx.GasPrice),
+			"gas_used":             int(r.GasUsed),
+			"success":              r.Success,
+			"signature":            hex.EncodeToString(tx.Signature[:]),
+		})
+		if err != nil {
+			return err
+		}
 	}
 
 	for _, deposit := range block.Deposits {
@@ -192,8 +396,7 @@ func (d *Database) InsertBlock(block *primitives.Block) error {
 
 		err = d.modifyAccountRow(lockedAccountInfo)
 		if err != nil {
-			d.log.Error(err)
-			continue
+			return err
 		}
 
 		queryVars = nil
@@ -201,8 +404,7 @@ func (d *Database) InsertBlock(block *primitives.Block) error {
 			hex.EncodeToString(deposit.Data.PublicKey[:]), hex.EncodeToString(deposit.Data.ProofOfPossession[:]), hex.EncodeToString(deposit.Data.WithdrawalAddress[:]))
 		err = d.insertRow("deposits", queryVars)
 		if err != nil {
-			d.log.Error(err)
-			continue
+			return err
 		}
 	}
 
@@ -211,8 +413,7 @@ func (d *Database) InsertBlock(block *primitives.Block) error {
 
 		balance, err := d.GetValidatorBalance(hex.EncodeToString(exits.ValidatorPubkey[:]))
 		if err != nil {
-			d.log.Error(err)
-			continue
+			return err
 		}
 		var pkh [20]byte
 		pkhHash := chainhash.HashB(exits.WithdrawPubkey[:])
@@ -226,8 +427,7 @@ func (d *Database) InsertBlock(block *primitives.Block) error {
 
 		err = d.modifyAccountRow(unlockedAccountInfo)
 		if err != nil {
-			d.log.Error(err)
-			continue
+			return err
 		}
 
 		queryVars = nil
@@ -235,8 +435,7 @@ func (d *Database) InsertBlock(block *primitives.Block) error {
 			hex.EncodeToString(exits.Signature[:]), currentEpoch)
 		err = d.insertRow("exits", queryVars)
 		if err != nil {
-			d.log.Error(err)
-			continue
+			return err
 		}
 	}
 
@@ -246,8 +445,7 @@ func (d *Database) InsertBlock(block *primitives.Block) error {
 		queryVars = append(queryVars, hash.String(), vs.Vote1.Data.Hash().String(), vs.Vote2.Data.Hash().String())
 		err = d.insertRow("vote_slashings", queryVars)
 		if err != nil {
-			d.log.Error(err)
-			continue
+			return err
 		}
 	}
 
@@ -257,8 +455,7 @@ func (d *Database) InsertBlock(block *primitives.Block) error {
 		queryVars = append(queryVars, hash.String(), hex.EncodeToString(rs.RandaoReveal[:]), int(rs.Slot), hex.EncodeToString(rs.ValidatorPubkey[:]))
 		err = d.insertRow("randao_slashings", queryVars)
 		if err != nil {
-			d.log.Error(err)
-			continue
+			return err
 		}
 	}
 
@@ -269,8 +466,33 @@ func (d *Database) InsertBlock(block *primitives.Block) error {
 			hex.EncodeToString(ps.Signature2[:]), hex.EncodeToString(ps.ValidatorPublicKey[:]))
 		err = d.insertRow("proposer_slashings", queryVars)
 		if err != nil {
-			d.log.Error(err)
-			continue
+			return err
+		}
+	}
+
+	// Governance Votes
+	for _, gv := range block.GovernanceVotes {
+		queryVars = nil
+		pub, err := gv.Multisig.GetPublicKey()
+		if err != nil {
+			return err
+		}
+		pkh, err := pub.Hash()
+		if err != nil {
+			return err
+		}
+		queryVars = append(queryVars, gv.Hash().String(), hash.String(), int(gv.Type), hex.EncodeToString(gv.Data[:]), int(gv.VoteEpoch),
+			hex.EncodeToString(pkh[:]))
+		err = d.insertRow("governance_votes", queryVars)
+		if err != nil {
+			return err
+		}
+	}
+
+	if receipts != nil {
+		err = d.insertReceipts(hash.String(), receipts)
+		if err != nil {
+			return err
 		}
 	}
 
@@ -287,6 +509,41 @@ func (d *Database) InsertBlock(block *primitives.Block) error {
 	return nil
 }
 
+// payContractTx applies a contract transaction to the accounts. The sender pays the gas to the fee address even if the
+// execution fails, the amount is only sent to the contract by a successful execution. The transfers done by the
+// contract code are not indexed.
+func (d *Database) payContractTx(from, contract, feeAddress [20]byte, amount uint64, gas uint64, success bool) error {
+	if !success {
+		amount = 0
+	}
+
+	err := d.modifyAccountRow(&AccountInfo{
+		Account:   hex.EncodeToString(from[:]),
+		Confirmed: -1 * int(amount+gas),
+		TotalSent: int(amount + gas),
+	})
+	if err != nil {
+		return err
+	}
+
+	if amount > 0 {
+		err = d.modifyAccountRow(&AccountInfo{
+			Account:       hex.EncodeToString(contract[:]),
+			Confirmed:     int(amount),
+			TotalReceived: int(amount),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return d.modifyAccountRow(&AccountInfo{
+		Account:       hex.EncodeToString(feeAddress[:]),
+		Confirmed:     int(gas),
+		TotalReceived: int(gas),
+	})
+}
+
 func (d *Database) initializeEpoch(epoch int64) error {
 
 	lastSlotFromEpoch := int(epoch)*int(d.netParams.EpochLength) + 1
@@ -321,11 +578,15 @@ func (d *Database) initializeEpoch(epoch int64) error {
 		if err != nil {
 			return err
 		}
-		_, err = d.db.Exec(query)
+		_, err = d.q.Exec(query)
 		if err != nil {
 			return err
 		}
 
+		err = d.journal(dw.Delete("slots").Where(goqu.Ex{"slot": slot}))
+		if err != nil {
+			return err
+		}
 	}
 
 	hash := chainhash.Hash{}
@@ -352,12 +613,12 @@ func (d *Database) initializeEpoch(epoch int64) error {
 		return err
 	}
 
-	_, err = d.db.Exec(query)
+	_, err = d.q.Exec(query)
 	if err != nil {
 		return err
 	}
 
-	return nil
+	return d.journal(dw.Delete("epochs").Where(goqu.Ex{"epoch": int(epoch)}))
 }
 
 func (d *Database) getEpochProposers(epoch int64) ([]uint64, error) {
@@ -386,7 +647,7 @@ func (d *Database) getEpochRandao(epoch int64) (chainhash.Hash, error) {
 		return chainhash.Hash{}, err
 	}
 	var hashB []byte
-	err = d.db.QueryRow(query).Scan(&hashB)
+	err = d.q.QueryRow(query).Scan(&hashB)
 	if err != nil {
 		return chainhash.Hash{}, err
 	}
@@ -396,6 +657,10 @@ func (d *Database) getEpochRandao(epoch int64) (chainhash.Hash, error) {
 }
 
 func (d *Database) getNextHeight() (int, string, error) {
+	if d.bulk != nil && d.bulk.tip != "" {
+		return d.bulk.height + 1, d.bulk.tip, nil
+	}
+
 	dw := goqu.Dialect(d.driver)
 	ds := dw.From("blocks").Select(goqu.MAX("height"))
 	query, _, err := ds.ToSQL()
@@ -405,7 +670,7 @@ func (d *Database) getNextHeight() (int, string, error) {
 
 	// This will fail when the db is empty return 0 to load genesis
 	var height string
-	err = d.db.QueryRow(query).Scan(&height)
+	err = d.q.QueryRow(query).Scan(&height)
 	if err != nil {
 		return 0, "", nil
 	}
@@ -421,7 +686,7 @@ func (d *Database) getNextHeight() (int, string, error) {
 		return heightNum + 1, "", err
 	}
 	var blockhash string
-	err = d.db.QueryRow(query).Scan(&blockhash)
+	err = d.q.QueryRow(query).Scan(&blockhash)
 	if err != nil {
 		return heightNum + 1, "", err
 	}
@@ -442,6 +707,10 @@ func (d *Database) insertRow(tableName string, queryVars []interface{}) error {
 		return d.insertVote(queryVars)
 	case "tx_single":
 		return d.insertTxSingle(queryVars)
+	case "tx_multi":
+		return d.insertTxMulti(queryVars)
+	case "tx_asset":
+		return d.insertTxAsset(queryVars)
 	case "deposits":
 		return d.insertDeposit(queryVars)
 	case "exits":
@@ -452,25 +721,19 @@ func (d *Database) insertRow(tableName string, queryVars []interface{}) error {
 		return d.insertRandaoSlashing(queryVars)
 	case "proposer_slashings":
 		return d.insertProposerSlashing(queryVars)
+	case "governance_votes":
+		return d.insertGovernanceVote(queryVars)
 	}
 	return nil
 }
 
 func (d *Database) insertBlockRow(queryVars []interface{}) error {
-	dw := goqu.Dialect(d.driver)
-	ds := dw.Insert("blocks").Rows(
-		goqu.Record{
-			"block_hash":             queryVars[0],
-			"block_signature":        queryVars[1],
-			"block_randao_signature": queryVars[2],
-			"height":                 queryVars[3],
-		},
-	)
-	query, _, err := ds.ToSQL()
-	if err != nil {
-		return err
-	}
-	_, err = d.db.Exec(query)
+	err := d.insertRecord("blocks", goqu.Record{
+		"block_hash":             queryVars[0],
+		"block_signature":        queryVars[1],
+		"block_randao_signature": queryVars[2],
+		"height":                 queryVars[3],
+	})
 	if err != nil {
 		return err
 	}
@@ -478,33 +741,25 @@ func (d *Database) insertBlockRow(queryVars []interface{}) error {
 }
 
 func (d *Database) insertBlockHeadersRow(queryVars []interface{}) error {
-	dw := goqu.Dialect(d.driver)
-	ds := dw.Insert("block_headers").Rows(
-		goqu.Record{
-			"block_hash":                    queryVars[0],
-			"version":                       queryVars[1],
-			"nonce":                         queryVars[2],
-			"tx_merkle_root":                queryVars[3],
-			"tx_multi_merkle_root":          queryVars[4],
-			"vote_merkle_root":              queryVars[5],
-			"deposit_merkle_root":           queryVars[6],
-			"exit_merkle_root":              queryVars[7],
-			"vote_slashing_merkle_root":     queryVars[8],
-			"randao_slashing_merkle_root":   queryVars[9],
-			"proposer_slashing_merkle_root": queryVars[10],
-			"governance_votes_merkle_root":  queryVars[11],
-			"previous_block_hash":           queryVars[12],
-			"timestamp":                     queryVars[13],
-			"slot":                          queryVars[14],
-			"state_root":                    queryVars[15],
-			"fee_address":                   queryVars[16],
-		},
-	)
-	query, _, err := ds.ToSQL()
-	if err != nil {
-		return err
-	}
-	_, err = d.db.Exec(query)
+	err := d.insertRecord("block_headers", goqu.Record{
+		"block_hash":                    queryVars[0],
+		"version":                       queryVars[1],
+		"nonce":                         queryVars[2],
+		"tx_merkle_root":                queryVars[3],
+		"tx_multi_merkle_root":          queryVars[4],
+		"vote_merkle_root":              queryVars[5],
+		"deposit_merkle_root":           queryVars[6],
+		"exit_merkle_root":              queryVars[7],
+		"vote_slashing_merkle_root":     queryVars[8],
+		"randao_slashing_merkle_root":   queryVars[9],
+		"proposer_slashing_merkle_root": queryVars[10],
+		"governance_votes_merkle_root":  queryVars[11],
+		"previous_block_hash":           queryVars[12],
+		"timestamp":                     queryVars[13],
+		"slot":                          queryVars[14],
+		"state_root":                    queryVars[15],
+		"fee_address":                   queryVars[16],
+	})
 	if err != nil {
 		return err
 	}
@@ -512,27 +767,19 @@ func (d *Database) insertBlockHeadersRow(queryVars []interface{}) error {
 }
 
 func (d *Database) insertVote(queryVars []interface{}) error {
-	dw := goqu.Dialect(d.driver)
-	ds := dw.Insert("votes").Rows(
-		goqu.Record{
-			"block_hash":             queryVars[0],
-			"signature":              queryVars[1],
-			"participation_bitfield": queryVars[2],
-			"data_slot":              queryVars[3],
-			"data_from_epoch":        queryVars[4],
-			"data_from_hash":         queryVars[5],
-			"data_to_epoch":          queryVars[6],
-			"data_to_hash":           queryVars[7],
-			"data_beacon_block_hash": queryVars[8],
-			"data_nonce":             queryVars[9],
-			"vote_hash":              queryVars[10],
-		},
-	)
-	query, _, err := ds.ToSQL()
-	if err != nil {
-		return err
-	}
-	_, err = d.db.Exec(query)
+	err := d.insertRecord("votes", goqu.Record{
+		"block_hash":             queryVars[0],
+		"signature":              queryVars[1],
+		"participation_bitfield": queryVars[2],
+		"data_slot":              queryVars[3],
+		"data_from_epoch":        queryVars[4],
+		"data_from_hash":         queryVars[5],
+		"data_to_epoch":          queryVars[6],
+		"data_to_hash":           queryVars[7],
+		"data_beacon_block_hash": queryVars[8],
+		"data_nonce":             queryVars[9],
+		"vote_hash":              queryVars[10],
+	})
 	if err != nil {
 		return err
 	}
@@ -540,49 +787,72 @@ func (d *Database) insertVote(queryVars []interface{}) error {
 }
 
 func (d *Database) insertTxSingle(queryVars []interface{}) error {
-	dw := goqu.Dialect(d.driver)
-	ds := dw.Insert("tx_single").Rows(
-		goqu.Record{
-			"hash":                 queryVars[0],
-			"block_hash":           queryVars[1],
-			"tx_type":              queryVars[2],
-			"to_addr":              queryVars[3],
-			"from_public_key":      queryVars[4],
-			"from_public_key_hash": queryVars[5],
-			"amount":               queryVars[6],
-			"nonce":                queryVars[7],
-			"fee":                  queryVars[8],
-			"signature":            queryVars[9],
-		},
-	)
-	query, _, err := ds.ToSQL()
+	err := d.insertRecord("tx_single", goqu.Record{
+		"hash":                 queryVars[0],
+		"block_hash":           queryVars[1],
+		"tx_type":              queryVars[2],
+		"to_addr":              queryVars[3],
+		"from_public_key":      queryVars[4],
+		"from_public_key_hash": queryVars[5],
+		"amount":               queryVars[6],
+		"nonce":                queryVars[7],
+		"fee":                  queryVars[8],
+		"signature":            queryVars[9],
+	})
 	if err != nil {
 		return err
 	}
-	_, err = d.db.Exec(query)
+	return nil
+}
+
+func (d *Database) insertTxMulti(queryVars []interface{}) error {
+	err := d.insertRecord("tx_multi", goqu.Record{
+		"hash":                 queryVars[0],
+		"block_hash":           queryVars[1],
+		"to_addr":              queryVars[2],
+		"from_public_key_hash": queryVars[3],
+		"signatures_needed":    queryVars[4],
+		"public_keys":          queryVars[5],
+		"amount":               queryVars[6],
+		"nonce":                queryVars[7],
+		"fee":                  queryVars[8],
+		"signature":            queryVars[9],
+	})
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-func (d *Database) insertDeposit(queryVars []interface{}) error {
-	dw := goqu.Dialect(d.driver)
-	ds := dw.Insert("deposits").Rows(
-		goqu.Record{
-			"block_hash":               queryVars[0],
-			"public_key":               queryVars[1],
-			"signature":                queryVars[2],
-			"data_public_key":          queryVars[3],
-			"data_proof_of_possession": queryVars[4],
-			"data_withdrawal_address":  queryVars[5],
-		},
-	)
-	query, _, err := ds.ToSQL()
+func (d *Database) insertTxAsset(queryVars []interface{}) error {
+	err := d.insertRecord("tx_asset", goqu.Record{
+		"hash":                 queryVars[0],
+		"block_hash":           queryVars[1],
+		"tx_type":              queryVars[2],
+		"asset_id":             queryVars[3],
+		"to_addr":              queryVars[4],
+		"from_public_key":      queryVars[5],
+		"from_public_key_hash": queryVars[6],
+		"amount":               queryVars[7],
+		"nonce":                queryVars[8],
+		"fee":                  queryVars[9],
+		"signature":            queryVars[10],
+	})
 	if err != nil {
 		return err
 	}
-	_, err = d.db.Exec(query)
+	return nil
+}
+
+func (d *Database) insertDeposit(queryVars []interface{}) error {
+	err := d.insertRecord("deposits", goqu.Record{
+		"block_hash":               queryVars[0],
+		"public_key":               queryVars[1],
+		"signature":                queryVars[2],
+		"data_public_key":          queryVars[3],
+		"data_proof_of_possession": queryVars[4],
+		"data_withdrawal_address":  queryVars[5],
+	})
 	if err != nil {
 		return err
 	}
@@ -609,7 +879,7 @@ func (d *Database) insertMockDeposit(genesisHash string, valPubKey string, premi
 	if err != nil {
 		return err
 	}
-	_, err = d.db.Exec(query)
+	_, err = d.q.Exec(query)
 	if err != nil {
 		return err
 	}
@@ -618,20 +888,12 @@ func (d *Database) insertMockDeposit(genesisHash string, valPubKey string, premi
 }
 
 func (d *Database) insertExit(queryVars []interface{}) error {
-	dw := goqu.Dialect(d.driver)
-	ds := dw.Insert("exits").Rows(
-		goqu.Record{
-			"block_hash":            queryVars[0],
-			"validator_public_key":  queryVars[1],
-			"withdrawal_public_key": queryVars[2],
-			"signature":             queryVars[3],
-		},
-	)
-	query, _, err := ds.ToSQL()
-	if err != nil {
-		return err
-	}
-	_, err = d.db.Exec(query)
+	err := d.insertRecord("exits", goqu.Record{
+		"block_hash":            queryVars[0],
+		"validator_public_key":  queryVars[1],
+		"withdrawal_public_key": queryVars[2],
+		"signature":             queryVars[3],
+	})
 	if err != nil {
 		return err
 	}
@@ -639,67 +901,156 @@ func (d *Database) insertExit(queryVars []interface{}) error {
 }
 
 func (d *Database) insertVoteSlashing(queryVars []interface{}) error {
-	dw := goqu.Dialect(d.driver)
-	ds := dw.Insert("vote_slashings").Rows(
-		goqu.Record{
-			"block_hash": queryVars[0],
-			"vote_1":     queryVars[1],
-			"vote_2":     queryVars[2],
-		},
-	)
-	query, _, err := ds.ToSQL()
+	err := d.insertRecord("vote_slashing", goqu.Record{
+		"block_hash": queryVars[0],
+		"vote_1":     queryVars[1],
+		"vote_2":     queryVars[2],
+	})
 	if err != nil {
 		return err
 	}
-	_, err = d.db.Exec(query)
+	return nil
+}
+
+func (d *Database) insertRandaoSlashing(queryVars []interface{}) error {
+	err := d.insertRecord("randao_slashing", goqu.Record{
+		"block_hash":           queryVars[0],
+		"randao_reveal":        queryVars[1],
+		"slot":                 queryVars[2],
+		"validator_public_key": queryVars[3],
+	})
 	if err != nil {
 		return err
 	}
-	return nil
+	return d.exitPenalizeValidator(queryVars[3])
 }
 
-func (d *Database) insertRandaoSlashing(queryVars []interface{}) error {
-	dw := goqu.Dialect(d.driver)
-	ds := dw.Insert("randao_slashing").Rows(
-		goqu.Record{
-			"block_hash":           queryVars[0],
-			"randao_reveal":        queryVars[1],
-			"slot":                 queryVars[2],
-			"validator_public_key": queryVars[3],
-		},
-	)
-	query, _, err := ds.ToSQL()
+func (d *Database) insertProposerSlashing(queryVars []interface{}) error {
+	err := d.insertRecord("proposer_slashing", goqu.Record{
+		"block_hash":           queryVars[0],
+		"blockheader_1":        queryVars[1],
+		"blockheader_2":        queryVars[2],
+		"signature_1":          queryVars[3],
+		"signature_2":          queryVars[4],
+		"validator_public_key": queryVars[5],
+	})
 	if err != nil {
 		return err
 	}
-	_, err = d.db.Exec(query)
+	return d.exitPenalizeValidator(queryVars[5])
+}
+
+func (d *Database) insertGovernanceVote(queryVars []interface{}) error {
+	err := d.insertRecord("governance_votes", goqu.Record{
+		"hash":                     queryVars[0],
+		"block_hash":               queryVars[1],
+		"vote_type":                queryVars[2],
+		"data":                     queryVars[3],
+		"vote_epoch":               queryVars[4],
+		"multisig_public_key_hash": queryVars[5],
+	})
 	if err != nil {
 		return err
 	}
-	return d.exitPenalizeValidator(queryVars[3])
+	return nil
 }
 
-func (d *Database) insertProposerSlashing(queryVars []interface{}) error {
+// insertReceipts stores the epoch receipts, manager payouts and validator balances of a block. The payouts are added
+// to the manager accounts and the validators balance is updated to the last balance of the block.
+func (d *Database) insertReceipts(blockHash string, receipts *proto.BlockReceipts) error {
+	for _, r := range receipts.Receipts {
+		err := d.insertRecord("epoch_receipts", goqu.Record{
+			"block_hash":           blockHash,
+			"epoch":                int(r.Epoch),
+			"receipt_type":         int(r.Type),
+			"amount":               int(r.Amount),
+			"validator_public_key": r.ValidatorPublicKey,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, p := range receipts.Payouts {
+		_, account, err := bech32.Decode(p.Account)
+		if err != nil {
+			return err
+		}
+		err = d.modifyAccountRow(&AccountInfo{
+			Account:       hex.EncodeToString(account),
+			Confirmed:     int(p.Amount),
+			TotalReceived: int(p.Amount),
+		})
+		if err != nil {
+			return err
+		}
+		err = d.insertRecord("manager_payouts", goqu.Record{
+			"block_hash": blockHash,
+			"epoch":      int(p.Epoch),
+			"account":    hex.EncodeToString(account),
+			"amount":     int(p.Amount),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	balances := make(map[string]uint64)
+	for _, b := range receipts.Balances {
+		err := d.insertRecord("validator_balances", goqu.Record{
+			"block_hash": blockHash,
+			"epoch":      int(b.Epoch),
+			"public_key": b.PublicKey,
+			"balance":    int(b.Balance),
+		})
+		if err != nil {
+			return err
+		}
+		balances[b.PublicKey] = b.Balance
+	}
+
+	for pubkey, balance := range balances {
+		err := d.setValidatorBalance(pubkey, int(balance))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// setValidatorBalance replaces the balance of a validator.
+func (d *Database) setValidatorBalance(pubkey string, balance int) error {
+	current, err := d.GetValidatorBalance(pubkey)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil
+		}
+		return err
+	}
+	if current == balance {
+		return nil
+	}
+
 	dw := goqu.Dialect(d.driver)
-	ds := dw.Insert("proposer_slashing").Rows(
+	ds := dw.Update("validators").Set(
 		goqu.Record{
-			"block_hash":           queryVars[0],
-			"blockheader_1":        queryVars[1],
-			"blockheader_2":        queryVars[2],
-			"signature_1":          queryVars[3],
-			"signature_2":          queryVars[4],
-			"validator_public_key": queryVars[5],
+			"balance": balance,
+		}).Where(
+		goqu.Ex{
+			"public_key": pubkey,
 		},
 	)
 	query, _, err := ds.ToSQL()
 	if err != nil {
 		return err
 	}
-	_, err = d.db.Exec(query)
+	_, err = d.q.Exec(query)
 	if err != nil {
 		return err
 	}
-	return d.exitPenalizeValidator(queryVars[5])
+
+	return d.journal(dw.Update("validators").Set(goqu.Record{"balance": current}).Where(goqu.Ex{"public_key": pubkey}))
 }
 
 func (d *Database) addValidator(valPubKey interface{}, payee interface{}, status interface{}) error {
@@ -731,14 +1082,18 @@ func (d *Database) addValidator(valPubKey interface{}, payee interface{}, status
 	if err != nil {
 		return err
 	}
-	_, err = d.db.Exec(query)
+	_, err = d.q.Exec(query)
 	if err != nil {
 		return err
 	}
-	return nil
+	return d.journal(dw.Delete("validators").Where(goqu.Ex{"public_key": valPubKey}))
 }
 
 func (d *Database) exitValidator(valPubKey interface{}, epoch interface{}) error {
+	if err := d.journalValidator(valPubKey); err != nil {
+		return err
+	}
+
 	dw := goqu.Dialect(d.driver)
 	ds := dw.Update("validators").Set(
 		goqu.Record{
@@ -754,7 +1109,7 @@ func (d *Database) exitValidator(valPubKey interface{}, epoch interface{}) error
 	if err != nil {
 		return err
 	}
-	_, err = d.db.Exec(query)
+	_, err = d.q.Exec(query)
 	if err != nil {
 		return err
 	}
@@ -762,6 +1117,10 @@ func (d *Database) exitValidator(valPubKey interface{}, epoch interface{}) error
 }
 
 func (d *Database) exitPenalizeValidator(valPubKey interface{}) error {
+	if err := d.journalValidator(valPubKey); err != nil {
+		return err
+	}
+
 	dw := goqu.Dialect(d.driver)
 	ds := dw.Update("validators").Set(
 		goqu.Record{
@@ -776,7 +1135,7 @@ func (d *Database) exitPenalizeValidator(valPubKey interface{}) error {
 	if err != nil {
 		return err
 	}
-	_, err = d.db.Exec(query)
+	_, err = d.q.Exec(query)
 	if err != nil {
 		return err
 	}
@@ -795,7 +1154,7 @@ func (d *Database) modifyAccountRowForMempoolTxs(accInfo *AccountInfo) error {
 		return err
 	}
 
-	row := d.db.QueryRow(query)
+	row := d.q.QueryRow(query)
 
 	var accountResult AccountInfo
 
@@ -818,7 +1177,7 @@ func (d *Database) modifyAccountRowForMempoolTxs(accInfo *AccountInfo) error {
 				return err
 			}
 
-			_, err = d.db.Exec(query)
+			_, err = d.q.Exec(query)
 			if err != nil {
 				return err
 			}
@@ -846,7 +1205,7 @@ func (d *Database) modifyAccountRowForMempoolTxs(accInfo *AccountInfo) error {
 		return err
 	}
 
-	_, err = d.db.Exec(nquery)
+	_, err = d.q.Exec(nquery)
 	if err != nil {
 		return err
 	}
@@ -867,7 +1226,7 @@ func (d *Database) modifyAccountRow(accInfo *AccountInfo) error {
 		return err
 	}
 
-	row := d.db.QueryRow(query)
+	row := d.q.QueryRow(query)
 
 	var accountResult AccountInfo
 
@@ -888,11 +1247,11 @@ func (d *Database) modifyAccountRow(accInfo *AccountInfo) error {
 				return err
 			}
 
-			_, err = d.db.Exec(query)
+			_, err = d.q.Exec(query)
 			if err != nil {
 				return err
 			}
-			return nil
+			return d.journal(dw.Delete("accounts").Where(goqu.Ex{"account": accInfo.Account}))
 		}
 		return err
 	}
@@ -925,12 +1284,141 @@ func (d *Database) modifyAccountRow(accInfo *AccountInfo) error {
 	if err != nil {
 		return err
 	}
-	_, err = d.db.Exec(nquery)
+	_, err = d.q.Exec(nquery)
 	if err != nil {
 		return err
 	}
 
-	return nil
+	return d.journal(dw.Update("accounts").Set(
+		goqu.Record{
+			"confirmed":      accountResult.Confirmed,
+			"unconfirmed":    accountResult.Unconfirmed,
+			"locked":         accountResult.Locked,
+			"total_sent":     accountResult.TotalSent,
+			"total_received": accountResult.TotalReceived,
+		}).Where(
+		goqu.Ex{
+			"account": accountResult.Account,
+		},
+	))
+}
+
+// modifyAssetRow adds the supply difference to an asset, creating it with the issuer if it doesn't exist.
+func (d *Database) modifyAssetRow(assetID string, issuer string, supply int) error {
+	dw := goqu.Dialect(d.driver)
+
+	ds := dw.From("assets").Select("supply").Where(goqu.Ex{
+		"asset_id": assetID,
+	})
+
+	query, _, err := ds.ToSQL()
+	if err != nil {
+		return err
+	}
+
+	var current int
+	err = d.q.QueryRow(query).Scan(&current)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ds := dw.Insert("assets").Rows(
+				goqu.Record{
+					"asset_id": assetID,
+					"issuer":   issuer,
+					"supply":   supply,
+				},
+			)
+
+			query, _, err := ds.ToSQL()
+			if err != nil {
+				return err
+			}
+
+			_, err = d.q.Exec(query)
+			if err != nil {
+				return err
+			}
+			return d.journal(dw.Delete("assets").Where(goqu.Ex{"asset_id": assetID}))
+		}
+		return err
+	}
+
+	nds := dw.Update("assets").Set(
+		goqu.Record{
+			"supply": current + supply,
+		}).Where(
+		goqu.Ex{
+			"asset_id": assetID,
+		},
+	)
+	nquery, _, err := nds.ToSQL()
+	if err != nil {
+		return err
+	}
+	_, err = d.q.Exec(nquery)
+	if err != nil {
+		return err
+	}
+	return d.journal(dw.Update("assets").Set(goqu.Record{"supply": current}).Where(goqu.Ex{"asset_id": assetID}))
+}
+
+// modifyAssetBalanceRow adds the balance difference to the asset balance of an account.
+func (d *Database) modifyAssetBalanceRow(assetID string, account string, balance int) error {
+	dw := goqu.Dialect(d.driver)
+
+	ds := dw.From("asset_balances").Select("balance").Where(goqu.Ex{
+		"asset_id": assetID,
+		"account":  account,
+	})
+
+	query, _, err := ds.ToSQL()
+	if err != nil {
+		return err
+	}
+
+	var current int
+	err = d.q.QueryRow(query).Scan(&current)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ds := dw.Insert("asset_balances").Rows(
+				goqu.Record{
+					"asset_id": assetID,
+					"account":  account,
+					"balance":  balance,
+				},
+			)
+
+			query, _, err := ds.ToSQL()
+			if err != nil {
+				return err
+			}
+
+			_, err = d.q.Exec(query)
+			if err != nil {
+				return err
+			}
+			return d.journal(dw.Delete("asset_balances").Where(goqu.Ex{"asset_id": assetID, "account": account}))
+		}
+		return err
+	}
+
+	nds := dw.Update("asset_balances").Set(
+		goqu.Record{
+			"balance": current + balance,
+		}).Where(
+		goqu.Ex{
+			"asset_id": assetID,
+			"account":  account,
+		},
+	)
+	nquery, _, err := nds.ToSQL()
+	if err != nil {
+		return err
+	}
+	_, err = d.q.Exec(nquery)
+	if err != nil {
+		return err
+	}
+	return d.journal(dw.Update("asset_balances").Set(goqu.Record{"balance": current}).Where(goqu.Ex{"asset_id": assetID, "account": account}))
 }
 
 func (d *Database) Close() {
@@ -956,6 +1444,12 @@ func (d *Database) Migrate() error {
 		if err != nil {
 			return err
 		}
+	case "sqlite3":
+		migrationsString = "file://cmd/ogen/indexer/db/migrations/sqlite3"
+		dbdriver, err = sqlite3.WithInstance(d.db, &sqlite3.Config{})
+		if err != nil {
+			return err
+		}
 	}
 
 	m, err := migrate.NewWithDatabaseInstance(
@@ -1004,7 +1498,7 @@ func (d *Database) ProcessEpoch(b *primitives.Block, epoch int64) error {
 	//if err != nil {
 	//	return err
 	//}
-	//_, err = d.db.Exec(query)
+	//_, err = d.q.Exec(query)
 	//if err != nil {
 	//	return err
 	//}
@@ -1026,7 +1520,7 @@ func (d *Database) GetValidatorBalance(pubkey string) (int, error) {
 		return 0, err
 	}
 
-	row := d.db.QueryRow(query)
+	row := d.q.QueryRow(query)
 
 	var balance int
 	err = row.Scan(&balance)
@@ -1050,7 +1544,7 @@ func (d *Database) GetActiveValidatorIndices() ([]uint64, error) {
 		return nil, err
 	}
 
-	row, err := d.db.Query(query)
+	row, err := d.q.Query(query)
 	if err != nil {
 		return nil, err
 	}
@@ -1093,7 +1587,7 @@ func (d *Database) ModifyValidatorBalance(pubkey string, balance int) error {
 		return err
 	}
 
-	_, err = d.db.Exec(query)
+	_, err = d.q.Exec(query)
 	if err != nil {
 		return err
 	}
@@ -1184,8 +1678,24 @@ func (d *Database) Initialize() (string, error) {
 	return genesisHash.String(), nil
 }
 
+// sqliteConnString enables the foreign keys on the sqlite3 connection string. The driver sets the option on every
+// new connection, a PRAGMA statement only applies to the connection it runs on.
+func sqliteConnString(dsn string) string {
+	if strings.Contains(dsn, "_foreign_keys=") || strings.Contains(dsn, "_fk=") {
+		return dsn
+	}
+	if strings.Contains(dsn, "?") {
+		return dsn + "&_foreign_keys=1"
+	}
+	return dsn + "?_foreign_keys=1"
+}
+
 // NewDB creates a db client
 func NewDB(dbConnString string, log logger.Logger, wg *sync.WaitGroup, driver string, netParams *params.ChainParams) *Database {
+	if driver == "sqlite3" {
+		dbConnString = sqliteConnString(dbConnString)
+	}
+
 	db, err := sql.Open(driver, dbConnString)
 	if err != nil {
 		log.Fatal(err)
@@ -1196,9 +1706,15 @@ func NewDB(dbConnString string, log logger.Logger, wg *sync.WaitGroup, driver st
 		log.Fatal(err)
 	}
 
+	if driver == "sqlite3" {
+		// SQLite only allows a single writer, all the queries share one connection to avoid locking errors.
+		db.SetMaxOpenConns(1)
+	}
+
 	dbclient := &Database{
 		log:       log,
 		db:        db,
+		q:         db,
 		canClose:  wg,
 		driver:    driver,
 		netParams: netParams,
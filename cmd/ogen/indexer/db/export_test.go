@@ -0,0 +1,14 @@
+package db
+
+import "github.com/doug-martin/goqu/v9"
+
+// BlockRows returns the amount of rows of a block in a table.
+func (d *Database) BlockRows(table string, hash string) (int, error) {
+	query, _, err := goqu.Dialect(d.driver).From(table).Select(goqu.COUNT("*")).Where(goqu.Ex{"block_hash": hash}).ToSQL()
+	if err != nil {
+		return 0, err
+	}
+	var rows int
+	err = d.q.QueryRow(query).Scan(&rows)
+	return rows, err
+}
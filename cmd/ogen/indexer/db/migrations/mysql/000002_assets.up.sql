@@ -0,0 +1,37 @@
+CREATE TABLE `assets` (
+    `asset_id` varchar(255) PRIMARY KEY NOT NULL,
+    `issuer` varchar(255) NOT NULL,
+    `supply` bigint DEFAULT 0
+);
+
+CREATE TABLE `asset_balances` (
+    `asset_id` varchar(255) NOT NULL,
+    `account` varchar(255) NOT NULL,
+    `balance` bigint DEFAULT 0
+);
+
+CREATE TABLE `tx_asset` (
+    `hash` varchar(255) NOT NULL,
+    `block_hash` varchar(255) NOT NULL,
+    `tx_type` int NOT NULL,
+    `asset_id` varchar(255) NOT NULL,
+    `to_addr` varchar(255) NOT NULL,
+    `from_public_key` varchar(255) NOT NULL,
+    `from_public_key_hash` varchar(255) NOT NULL,
+    `amount` bigint NOT NULL,
+    `nonce` bigint NOT NULL,
+    `fee` bigint NOT NULL,
+    `signature` varchar(255) NOT NULL
+);
+
+ALTER TABLE `asset_balances` ADD FOREIGN KEY (`asset_id`) REFERENCES `assets` (`asset_id`);
+
+ALTER TABLE `tx_asset` ADD FOREIGN KEY (`block_hash`) REFERENCES `block_headers` (`block_hash`);
+
+ALTER TABLE `tx_asset` ADD FOREIGN KEY (`from_public_key_hash`) REFERENCES `accounts` (`account`);
+
+CREATE UNIQUE INDEX `assets_index_0` ON `assets` (`asset_id`);
+
+CREATE UNIQUE INDEX `asset_balances_index_1` ON `asset_balances` (`asset_id`, `account`);
+
+CREATE UNIQUE INDEX `tx_asset_index_2` ON `tx_asset` (`hash`);
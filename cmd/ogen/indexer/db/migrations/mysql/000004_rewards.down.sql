@@ -0,0 +1,9 @@
+DROP TABLE IF EXISTS validator_balances;
+
+DROP TABLE IF EXISTS manager_payouts;
+
+DROP TABLE IF EXISTS epoch_receipts;
+
+DROP TABLE IF EXISTS governance_votes;
+
+DROP TABLE IF EXISTS tx_multi;
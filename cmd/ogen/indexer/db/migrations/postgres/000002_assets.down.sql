@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS tx_asset;
+
+DROP TABLE IF EXISTS asset_balances;
+
+DROP TABLE IF EXISTS assets;
@@ -0,0 +1,37 @@
+CREATE TABLE "assets" (
+    "asset_id" varchar PRIMARY KEY NOT NULL,
+    "issuer" varchar NOT NULL,
+    "supply" bigint DEFAULT 0
+);
+
+CREATE TABLE "asset_balances" (
+    "asset_id" varchar NOT NULL,
+    "account" varchar NOT NULL,
+    "balance" bigint DEFAULT 0
+);
+
+CREATE TABLE "tx_asset" (
+    "hash" varchar NOT NULL,
+    "block_hash" varchar NOT NULL,
+    "tx_type" int NOT NULL,
+    "asset_id" varchar NOT NULL,
+    "to_addr" varchar NOT NULL,
+    "from_public_key" varchar NOT NULL,
+    "from_public_key_hash" varchar NOT NULL,
+    "amount" bigint NOT NULL,
+    "nonce" bigint NOT NULL,
+    "fee" bigint NOT NULL,
+    "signature" varchar NOT NULL
+);
+
+ALTER TABLE "asset_balances" ADD FOREIGN KEY ("asset_id") REFERENCES "assets" ("asset_id");
+
+ALTER TABLE "tx_asset" ADD FOREIGN KEY ("block_hash") REFERENCES "block_headers" ("block_hash");
+
+ALTER TABLE "tx_asset" ADD FOREIGN KEY ("from_public_key_hash") REFERENCES "accounts" ("account");
+
+CREATE UNIQUE INDEX ON "assets" ("asset_id");
+
+CREATE UNIQUE INDEX ON "asset_balances" ("asset_id", "account");
+
+CREATE UNIQUE INDEX ON "tx_asset" ("hash");
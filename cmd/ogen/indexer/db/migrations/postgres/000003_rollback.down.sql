@@ -0,0 +1,3 @@
+ALTER TABLE "blocks" DROP COLUMN IF EXISTS "finalized";
+
+DROP TABLE IF EXISTS undo_log;
@@ -0,0 +1,9 @@
+CREATE TABLE "undo_log" (
+    "id" SERIAL PRIMARY KEY,
+    "block_hash" varchar NOT NULL,
+    "statement" text NOT NULL
+);
+
+CREATE INDEX ON "undo_log" ("block_hash");
+
+ALTER TABLE "blocks" ADD COLUMN "finalized" boolean DEFAULT false;
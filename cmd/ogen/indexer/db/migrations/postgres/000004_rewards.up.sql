@@ -0,0 +1,65 @@
+CREATE TABLE "tx_multi" (
+    "hash" varchar NOT NULL,
+    "block_hash" varchar NOT NULL,
+    "to_addr" varchar NOT NULL,
+    "from_public_key_hash" varchar NOT NULL,
+    "signatures_needed" int NOT NULL,
+    "public_keys" int NOT NULL,
+    "amount" bigint NOT NULL,
+    "nonce" bigint NOT NULL,
+    "fee" bigint NOT NULL,
+    "signature" text NOT NULL
+);
+
+CREATE TABLE "governance_votes" (
+    "hash" varchar NOT NULL,
+    "block_hash" varchar NOT NULL,
+    "vote_type" int NOT NULL,
+    "data" varchar NOT NULL,
+    "vote_epoch" bigint NOT NULL,
+    "multisig_public_key_hash" varchar NOT NULL
+);
+
+CREATE TABLE "epoch_receipts" (
+    "block_hash" varchar NOT NULL,
+    "epoch" bigint NOT NULL,
+    "receipt_type" int NOT NULL,
+    "amount" bigint NOT NULL,
+    "validator_public_key" varchar NOT NULL
+);
+
+CREATE TABLE "manager_payouts" (
+    "block_hash" varchar NOT NULL,
+    "epoch" bigint NOT NULL,
+    "account" varchar NOT NULL,
+    "amount" bigint NOT NULL
+);
+
+CREATE TABLE "validator_balances" (
+    "block_hash" varchar NOT NULL,
+    "epoch" bigint NOT NULL,
+    "public_key" varchar NOT NULL,
+    "balance" bigint NOT NULL
+);
+
+ALTER TABLE "tx_multi" ADD FOREIGN KEY ("block_hash") REFERENCES "block_headers" ("block_hash");
+
+ALTER TABLE "tx_multi" ADD FOREIGN KEY ("from_public_key_hash") REFERENCES "accounts" ("account");
+
+ALTER TABLE "governance_votes" ADD FOREIGN KEY ("block_hash") REFERENCES "block_headers" ("block_hash");
+
+ALTER TABLE "epoch_receipts" ADD FOREIGN KEY ("block_hash") REFERENCES "block_headers" ("block_hash");
+
+ALTER TABLE "manager_payouts" ADD FOREIGN KEY ("block_hash") REFERENCES "block_headers" ("block_hash");
+
+ALTER TABLE "validator_balances" ADD FOREIGN KEY ("block_hash") REFERENCES "block_headers" ("block_hash");
+
+CREATE UNIQUE INDEX ON "tx_multi" ("hash");
+
+CREATE UNIQUE INDEX ON "governance_votes" ("hash");
+
+CREATE INDEX ON "epoch_receipts" ("validator_public_key", "epoch");
+
+CREATE INDEX ON "manager_payouts" ("account");
+
+CREATE INDEX ON "validator_balances" ("public_key", "epoch");
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS tx_contract_call;
+
+DROP TABLE IF EXISTS tx_contract_deploy;
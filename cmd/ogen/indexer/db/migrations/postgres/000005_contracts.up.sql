@@ -0,0 +1,42 @@
+CREATE TABLE "tx_contract_deploy" (
+    "hash" varchar NOT NULL,
+    "block_hash" varchar NOT NULL,
+    "contract" varchar NOT NULL,
+    "from_public_key" varchar NOT NULL,
+    "from_public_key_hash" varchar NOT NULL,
+    "amount" bigint NOT NULL,
+    "nonce" bigint NOT NULL,
+    "gas_limit" bigint NOT NULL,
+    "gas_price" bigint NOT NULL,
+    "gas_used" bigint NOT NULL,
+    "success" boolean NOT NULL,
+    "signature" varchar NOT NULL
+);
+
+CREATE TABLE "tx_contract_call" (
+    "hash" varchar NOT NULL,
+    "block_hash" varchar NOT NULL,
+    "to_addr" varchar NOT NULL,
+    "from_public_key" varchar NOT NULL,
+    "from_public_key_hash" varchar NOT NULL,
+    "input" text NOT NULL,
+    "amount" bigint NOT NULL,
+    "nonce" bigint NOT NULL,
+    "gas_limit" bigint NOT NULL,
+    "gas_price" bigint NOT NULL,
+    "gas_used" bigint NOT NULL,
+    "success" boolean NOT NULL,
+    "signature" varchar NOT NULL
+);
+
+ALTER TABLE "tx_contract_deploy" ADD FOREIGN KEY ("block_hash") REFERENCES "block_headers" ("block_hash");
+
+ALTER TABLE "tx_contract_deploy" ADD FOREIGN KEY ("from_public_key_hash") REFERENCES "accounts" ("account");
+
+ALTER TABLE "tx_contract_call" ADD FOREIGN KEY ("block_hash") REFERENCES "block_headers" ("block_hash");
+
+ALTER TABLE "tx_contract_call" ADD FOREIGN KEY ("from_public_key_hash") REFERENCES "accounts" ("account");
+
+CREATE UNIQUE INDEX ON "tx_contract_deploy" ("hash");
+
+CREATE UNIQUE INDEX ON "tx_contract_call" ("hash");
@@ -0,0 +1,25 @@
+DROP TABLE IF EXISTS epochs;
+
+DROP TABLE IF EXISTS slots;
+
+DROP TABLE IF EXISTS proposer_slashing;
+
+DROP TABLE IF EXISTS randao_slashing;
+
+DROP TABLE IF EXISTS vote_slashing;
+
+DROP TABLE IF EXISTS tx_single;
+
+DROP TABLE IF EXISTS exits;
+
+DROP TABLE IF EXISTS validators;
+
+DROP TABLE IF EXISTS accounts;
+
+DROP TABLE IF EXISTS deposits;
+
+DROP TABLE IF EXISTS votes;
+
+DROP TABLE IF EXISTS block_headers;
+
+DROP TABLE IF EXISTS blocks;
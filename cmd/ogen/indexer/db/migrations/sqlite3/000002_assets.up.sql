@@ -0,0 +1,31 @@
+CREATE TABLE "assets" (
+    "asset_id" varchar PRIMARY KEY NOT NULL,
+    "issuer" varchar NOT NULL,
+    "supply" bigint DEFAULT 0
+);
+
+CREATE TABLE "asset_balances" (
+    "asset_id" varchar NOT NULL REFERENCES "assets" ("asset_id"),
+    "account" varchar NOT NULL,
+    "balance" bigint DEFAULT 0
+);
+
+CREATE TABLE "tx_asset" (
+    "hash" varchar NOT NULL,
+    "block_hash" varchar NOT NULL REFERENCES "block_headers" ("block_hash"),
+    "tx_type" int NOT NULL,
+    "asset_id" varchar NOT NULL,
+    "to_addr" varchar NOT NULL,
+    "from_public_key" varchar NOT NULL,
+    "from_public_key_hash" varchar NOT NULL REFERENCES "accounts" ("account"),
+    "amount" bigint NOT NULL,
+    "nonce" bigint NOT NULL,
+    "fee" bigint NOT NULL,
+    "signature" varchar NOT NULL
+);
+
+CREATE UNIQUE INDEX "assets_index_0" ON "assets" ("asset_id");
+
+CREATE UNIQUE INDEX "asset_balances_index_1" ON "asset_balances" ("asset_id", "account");
+
+CREATE UNIQUE INDEX "tx_asset_index_2" ON "tx_asset" ("hash");
@@ -0,0 +1,3 @@
+ALTER TABLE "blocks" DROP COLUMN "finalized";
+
+DROP TABLE IF EXISTS undo_log;
@@ -0,0 +1,9 @@
+CREATE TABLE "undo_log" (
+    "id" INTEGER PRIMARY KEY AUTOINCREMENT,
+    "block_hash" varchar NOT NULL,
+    "statement" text NOT NULL
+);
+
+CREATE INDEX "undo_log_index_0" ON "undo_log" ("block_hash");
+
+ALTER TABLE "blocks" ADD COLUMN "finalized" boolean DEFAULT false;
@@ -0,0 +1,53 @@
+CREATE TABLE "tx_multi" (
+    "hash" varchar NOT NULL,
+    "block_hash" varchar NOT NULL REFERENCES "block_headers" ("block_hash"),
+    "to_addr" varchar NOT NULL,
+    "from_public_key_hash" varchar NOT NULL REFERENCES "accounts" ("account"),
+    "signatures_needed" int NOT NULL,
+    "public_keys" int NOT NULL,
+    "amount" bigint NOT NULL,
+    "nonce" bigint NOT NULL,
+    "fee" bigint NOT NULL,
+    "signature" text NOT NULL
+);
+
+CREATE TABLE "governance_votes" (
+    "hash" varchar NOT NULL,
+    "block_hash" varchar NOT NULL REFERENCES "block_headers" ("block_hash"),
+    "vote_type" int NOT NULL,
+    "data" varchar NOT NULL,
+    "vote_epoch" bigint NOT NULL,
+    "multisig_public_key_hash" varchar NOT NULL
+);
+
+CREATE TABLE "epoch_receipts" (
+    "block_hash" varchar NOT NULL REFERENCES "block_headers" ("block_hash"),
+    "epoch" bigint NOT NULL,
+    "receipt_type" int NOT NULL,
+    "amount" bigint NOT NULL,
+    "validator_public_key" varchar NOT NULL
+);
+
+CREATE TABLE "manager_payouts" (
+    "block_hash" varchar NOT NULL REFERENCES "block_headers" ("block_hash"),
+    "epoch" bigint NOT NULL,
+    "account" varchar NOT NULL,
+    "amount" bigint NOT NULL
+);
+
+CREATE TABLE "validator_balances" (
+    "block_hash" varchar NOT NULL REFERENCES "block_headers" ("block_hash"),
+    "epoch" bigint NOT NULL,
+    "public_key" varchar NOT NULL,
+    "balance" bigint NOT NULL
+);
+
+CREATE UNIQUE INDEX "tx_multi_index_0" ON "tx_multi" ("hash");
+
+CREATE UNIQUE INDEX "governance_votes_index_1" ON "governance_votes" ("hash");
+
+CREATE INDEX "epoch_receipts_index_2" ON "epoch_receipts" ("validator_public_key", "epoch");
+
+CREATE INDEX "manager_payouts_index_3" ON "manager_payouts" ("account");
+
+CREATE INDEX "validator_balances_index_4" ON "validator_balances" ("public_key", "epoch");
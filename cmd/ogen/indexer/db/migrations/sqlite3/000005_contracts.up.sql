@@ -0,0 +1,34 @@
+CREATE TABLE "tx_contract_deploy" (
+    "hash" varchar NOT NULL,
+    "block_hash" varchar NOT NULL REFERENCES "block_headers" ("block_hash"),
+    "contract" varchar NOT NULL,
+    "from_public_key" varchar NOT NULL,
+    "from_public_key_hash" varchar NOT NULL REFERENCES "accounts" ("account"),
+    "amount" bigint NOT NULL,
+    "nonce" bigint NOT NULL,
+    "gas_limit" bigint NOT NULL,
+    "gas_price" bigint NOT NULL,
+    "gas_used" bigint NOT NULL,
+    "success" boolean NOT NULL,
+    "signature" varchar NOT NULL
+);
+
+CREATE TABLE "tx_contract_call" (
+    "hash" varchar NOT NULL,
+    "block_hash" varchar NOT NULL REFERENCES "block_headers" ("block_hash"),
+    "to_addr" varchar NOT NULL,
+    "from_public_key" varchar NOT NULL,
+    "from_public_key_hash" varchar NOT NULL REFERENCES "accounts" ("account"),
+    "input" text NOT NULL,
+    "amount" bigint NOT NULL,
+    "nonce" bigint NOT NULL,
+    "gas_limit" bigint NOT NULL,
+    "gas_price" bigint NOT NULL,
+    "gas_used" bigint NOT NULL,
+    "success" boolean NOT NULL,
+    "signature" varchar NOT NULL
+);
+
+CREATE UNIQUE INDEX "tx_contract_deploy_index_0" ON "tx_contract_deploy" ("hash");
+
+CREATE UNIQUE INDEX "tx_contract_call_index_1" ON "tx_contract_call" ("hash");
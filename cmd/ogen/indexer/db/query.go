@@ -0,0 +1,442 @@
+package db
+
+import (
+	"database/sql"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/exp"
+)
+
+const (
+	// DefaultPageSize is the amount of rows returned when a page has no limit.
+	DefaultPageSize = 20
+
+	// MaxPageSize is the maximum amount of rows returned on a single page.
+	MaxPageSize = 100
+)
+
+// Cursor is the position of a row on a paginated list. Rows are sorted by the position and rows with the same position
+// are sorted by the key.
+type Cursor struct {
+	Position int64
+	Key      string
+}
+
+// Page selects the rows after a cursor. A page without cursor starts from the first row.
+type Page struct {
+	After *Cursor
+	Limit int
+}
+
+// Size returns the amount of rows selected by the page.
+func (p Page) Size() int {
+	if p.Limit <= 0 {
+		return DefaultPageSize
+	}
+	if p.Limit > MaxPageSize {
+		return MaxPageSize
+	}
+	return p.Limit
+}
+
+// apply filters and sorts a dataset to select the page. The key is only used for positions that are not unique. One
+// row more than the limit is selected to know if there is a next page.
+func (p Page) apply(ds *goqu.SelectDataset, position exp.IdentifierExpression, key exp.IdentifierExpression, desc bool) *goqu.SelectDataset {
+	if p.After != nil {
+		switch {
+		case key == nil && desc:
+			ds = ds.Where(position.Lt(p.After.Position))
+		case key == nil:
+			ds = ds.Where(position.Gt(p.After.Position))
+		case desc:
+			ds = ds.Where(goqu.Or(
+				position.Lt(p.After.Position),
+				goqu.And(position.Eq(p.After.Position), key.Lt(p.After.Key)),
+			))
+		default:
+			ds = ds.Where(goqu.Or(
+				position.Gt(p.After.Position),
+				goqu.And(position.Eq(p.After.Position), key.Gt(p.After.Key)),
+			))
+		}
+	}
+	switch {
+	case key == nil && desc:
+		ds = ds.Order(position.Desc())
+	case key == nil:
+		ds = ds.Order(position.Asc())
+	case desc:
+		ds = ds.Order(position.Desc(), key.Desc())
+	default:
+		ds = ds.Order(position.Asc(), key.Asc())
+	}
+	return ds.Limit(uint(p.Size() + 1))
+}
+
+// Block is an indexed block with its header.
+type Block struct {
+	Hash              string
+	Height            int64
+	Signature         string
+	RandaoSignature   string
+	Finalized         bool
+	Version           int64
+	Nonce             int64
+	PreviousBlockHash string
+	Timestamp         int64
+	Slot              int64
+	StateRoot         string
+	FeeAddress        string
+}
+
+// Cursor returns the position of the block on the block list.
+func (b *Block) Cursor() Cursor {
+	return Cursor{Position: b.Height}
+}
+
+// Transaction is an indexed coin, multisig or asset transaction. The sender is the hash of the sender public key.
+type Transaction struct {
+	Kind      string
+	Hash      string
+	BlockHash string
+	Height    int64
+	To        string
+	From      string
+	AssetID   string
+	Amount    int64
+	Nonce     int64
+	Fee       int64
+}
+
+// Cursor returns the position of the transaction on a transaction list.
+func (t *Transaction) Cursor() Cursor {
+	return Cursor{Position: t.Height, Key: t.Hash}
+}
+
+// Validator is an indexed validator.
+type Validator struct {
+	Index            int64
+	PublicKey        string
+	Status           int64
+	Exit             bool
+	Penalized        bool
+	Balance          int64
+	PayeeAddress     string
+	FirstActiveEpoch int64
+	LastActiveEpoch  int64
+}
+
+// Cursor returns the position of the validator on the validator list.
+func (v *Validator) Cursor() Cursor {
+	return Cursor{Position: v.Index}
+}
+
+// Epoch is an indexed epoch.
+type Epoch struct {
+	Epoch                   int64
+	Slots                   []int64
+	ParticipationPercentage int64
+	Finalized               bool
+	Justified               bool
+	Randao                  string
+}
+
+// Cursor returns the position of the epoch on the epoch list.
+func (e *Epoch) Cursor() Cursor {
+	return Cursor{Position: e.Epoch}
+}
+
+// Slashing is an indexed vote, RANDAO or proposer slashing. The evidence are the hashes of the slashable votes or
+// block headers, or the RANDAO reveal. Vote slashings don't have the validator public key.
+type Slashing struct {
+	Kind               string
+	BlockHash          string
+	Height             int64
+	Key                string
+	ValidatorPublicKey string
+	Evidence1          string
+	Evidence2          string
+	Slot               int64
+}
+
+// Cursor returns the position of the slashing on the slashing list.
+func (s *Slashing) Cursor() Cursor {
+	return Cursor{Position: s.Height, Key: s.Key}
+}
+
+// query runs a select and calls scan for every row.
+func (d *Database) query(ds sqlBuilder, scan func(rows *sql.Rows) error) error {
+	query, _, err := ds.ToSQL()
+	if err != nil {
+		return err
+	}
+	rows, err := d.q.Query(query)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		if err := scan(rows); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+func (d *Database) blocksQuery() *goqu.SelectDataset {
+	return goqu.Dialect(d.driver).From(goqu.T("blocks").As("b")).
+		Join(goqu.T("block_headers").As("h"), goqu.On(goqu.I("h.block_hash").Eq(goqu.I("b.block_hash")))).
+		Select("b.block_hash", "b.height", "b.block_signature", "b.block_randao_signature", "b.finalized", "h.version",
+			"h.nonce", "h.previous_block_hash", "h.timestamp", "h.slot", "h.state_root", "h.fee_address")
+}
+
+func (d *Database) getBlocks(ds *goqu.SelectDataset) ([]*Block, error) {
+	var blocks []*Block
+	err := d.query(ds, func(rows *sql.Rows) error {
+		b := new(Block)
+		var finalized sql.NullBool
+		err := rows.Scan(&b.Hash, &b.Height, &b.Signature, &b.RandaoSignature, &finalized, &b.Version, &b.Nonce,
+			&b.PreviousBlockHash, &b.Timestamp, &b.Slot, &b.StateRoot, &b.FeeAddress)
+		if err != nil {
+			return err
+		}
+		b.Finalized = finalized.Bool
+		blocks = append(blocks, b)
+		return nil
+	})
+	return blocks, err
+}
+
+func (d *Database) getBlock(ds *goqu.SelectDataset) (*Block, error) {
+	blocks, err := d.getBlocks(ds.Limit(1))
+	if err != nil || len(blocks) == 0 {
+		return nil, err
+	}
+	return blocks[0], nil
+}
+
+// GetBlock returns an indexed block or nil if the block is not indexed.
+func (d *Database) GetBlock(hash string) (*Block, error) {
+	return d.getBlock(d.blocksQuery().Where(goqu.I("b.block_hash").Eq(hash)))
+}
+
+// GetBlockByHeight returns the block indexed at a height or nil if there is no block at the height.
+func (d *Database) GetBlockByHeight(height int64) (*Block, error) {
+	return d.getBlock(d.blocksQuery().Where(goqu.I("b.height").Eq(height)))
+}
+
+// GetTip returns the last indexed block or nil if the database is empty.
+func (d *Database) GetTip() (*Block, error) {
+	return d.getBlock(d.blocksQuery().Order(goqu.I("b.height").Desc()))
+}
+
+// GetBlocks returns a page of blocks, from the last indexed block backwards.
+func (d *Database) GetBlocks(page Page) ([]*Block, error) {
+	return d.getBlocks(page.apply(d.blocksQuery(), goqu.I("b.height"), nil, true))
+}
+
+// GetBlocksBySlot returns the blocks between two slots, both included.
+func (d *Database) GetBlocksBySlot(from int64, to int64) ([]*Block, error) {
+	return d.getBlocks(d.blocksQuery().Where(goqu.I("h.slot").Between(goqu.Range(from, to))).Order(goqu.I("h.slot").Asc()))
+}
+
+// transactionsQuery returns the union of the coin, multisig and asset transactions as a table named t.
+func (d *Database) transactionsQuery() *goqu.SelectDataset {
+	dw := goqu.Dialect(d.driver)
+	table := func(name string, kind string, assetID interface{}) *goqu.SelectDataset {
+		return dw.From(goqu.T(name).As("x")).
+			Join(goqu.T("blocks").As("b"), goqu.On(goqu.I("b.block_hash").Eq(goqu.I("x.block_hash")))).
+			Select(goqu.V(kind).As("kind"), goqu.I("x.hash").As("hash"), goqu.I("x.block_hash").As("block_hash"),
+				goqu.I("b.height").As("height"), goqu.I("x.to_addr").As("to_addr"),
+				goqu.I("x.from_public_key_hash").As("from_addr"), goqu.L("?", assetID).As("asset_id"),
+				goqu.I("x.amount").As("amount"), goqu.I("x.nonce").As("nonce"), goqu.I("x.fee").As("fee"))
+	}
+	union := table("tx_single", "single", "").
+		UnionAll(table("tx_multi", "multi", "")).
+		UnionAll(table("tx_asset", "asset", goqu.I("x.asset_id")))
+	return dw.From(union.As("t")).Select("kind", "hash", "block_hash", "height", "to_addr", "from_addr",
+		"asset_id", "amount", "nonce", "fee")
+}
+
+func (d *Database) getTransactions(ds *goqu.SelectDataset) ([]*Transaction, error) {
+	var txs []*Transaction
+	err := d.query(ds, func(rows *sql.Rows) error {
+		tx := new(Transaction)
+		err := rows.Scan(&tx.Kind, &tx.Hash, &tx.BlockHash, &tx.Height, &tx.To, &tx.From, &tx.AssetID, &tx.Amount,
+			&tx.Nonce, &tx.Fee)
+		if err != nil {
+			return err
+		}
+		txs = append(txs, tx)
+		return nil
+	})
+	return txs, err
+}
+
+// GetTransaction returns an indexed transaction or nil if the transaction is not indexed.
+func (d *Database) GetTransaction(hash string) (*Transaction, error) {
+	txs, err := d.getTransactions(d.transactionsQuery().Where(goqu.C("hash").Eq(hash)).Limit(1))
+	if err != nil || len(txs) == 0 {
+		return nil, err
+	}
+	return txs[0], nil
+}
+
+// GetBlockTransactions returns the transactions included on a block.
+func (d *Database) GetBlockTransactions(blockHash string) ([]*Transaction, error) {
+	return d.getTransactions(d.transactionsQuery().Where(goqu.C("block_hash").Eq(blockHash)).Order(goqu.C("hash").Asc()))
+}
+
+// GetAccountTransactions returns a page of the transactions sent or received by an account, from the last
+// transaction backwards.
+func (d *Database) GetAccountTransactions(account string, page Page) ([]*Transaction, error) {
+	ds := d.transactionsQuery().Where(goqu.Or(goqu.C("to_addr").Eq(account), goqu.C("from_addr").Eq(account)))
+	return d.getTransactions(page.apply(ds, goqu.C("height"), goqu.C("hash"), true))
+}
+
+// GetAccount returns the balances of an account or nil if the account is not indexed.
+func (d *Database) GetAccount(account string) (*AccountInfo, error) {
+	ds := goqu.Dialect(d.driver).From("accounts").Select("account", "confirmed", "unconfirmed", "locked",
+		"total_sent", "total_received").Where(goqu.Ex{"account": account})
+	var acc *AccountInfo
+	err := d.query(ds, func(rows *sql.Rows) error {
+		acc = new(AccountInfo)
+		return rows.Scan(&acc.Account, &acc.Confirmed, &acc.Unconfirmed, &acc.Locked, &acc.TotalSent, &acc.TotalReceived)
+	})
+	return acc, err
+}
+
+func (d *Database) validatorsQuery() *goqu.SelectDataset {
+	return goqu.Dialect(d.driver).From("validators").Select("id", "public_key", "status", "exit", "penalized",
+		"balance", "payee_address", "first_active_epoch", "last_active_epoch")
+}
+
+func (d *Database) getValidators(ds *goqu.SelectDataset) ([]*Validator, error) {
+	var validators []*Validator
+	err := d.query(ds, func(rows *sql.Rows) error {
+		v := new(Validator)
+		err := rows.Scan(&v.Index, &v.PublicKey, &v.Status, &v.Exit, &v.Penalized, &v.Balance, &v.PayeeAddress,
+			&v.FirstActiveEpoch, &v.LastActiveEpoch)
+		if err != nil {
+			return err
+		}
+		validators = append(validators, v)
+		return nil
+	})
+	return validators, err
+}
+
+// GetValidator returns an indexed validator or nil if the validator is not indexed.
+func (d *Database) GetValidator(pubkey string) (*Validator, error) {
+	validators, err := d.getValidators(d.validatorsQuery().Where(goqu.Ex{"public_key": pubkey}).Limit(1))
+	if err != nil || len(validators) == 0 {
+		return nil, err
+	}
+	return validators[0], nil
+}
+
+// GetValidatorByIndex returns the validator with an index or nil if there is no validator with the index.
+func (d *Database) GetValidatorByIndex(index int64) (*Validator, error) {
+	validators, err := d.getValidators(d.validatorsQuery().Where(goqu.Ex{"id": index}).Limit(1))
+	if err != nil || len(validators) == 0 {
+		return nil, err
+	}
+	return validators[0], nil
+}
+
+// GetValidators returns a page of validators sorted by index.
+func (d *Database) GetValidators(page Page) ([]*Validator, error) {
+	return d.getValidators(page.apply(d.validatorsQuery(), goqu.C("id"), nil, false))
+}
+
+// GetPayeeValidators returns the validators that pay their rewards to an account.
+func (d *Database) GetPayeeValidators(account string) ([]*Validator, error) {
+	return d.getValidators(d.validatorsQuery().Where(goqu.Ex{"payee_address": account}).Order(goqu.C("id").Asc()))
+}
+
+func (d *Database) epochsQuery() *goqu.SelectDataset {
+	return goqu.Dialect(d.driver).From("epochs").Select("epoch", "slot_1", "slot_2", "slot_3", "slot_4", "slot_5",
+		"participation_percentage", "finalized", "justified", "randao")
+}
+
+func (d *Database) getEpochs(ds *goqu.SelectDataset) ([]*Epoch, error) {
+	var epochs []*Epoch
+	err := d.query(ds, func(rows *sql.Rows) error {
+		e := &Epoch{Slots: make([]int64, 5)}
+		err := rows.Scan(&e.Epoch, &e.Slots[0], &e.Slots[1], &e.Slots[2], &e.Slots[3], &e.Slots[4],
+			&e.ParticipationPercentage, &e.Finalized, &e.Justified, &e.Randao)
+		if err != nil {
+			return err
+		}
+		epochs = append(epochs, e)
+		return nil
+	})
+	return epochs, err
+}
+
+// GetEpoch returns an indexed epoch or nil if the epoch is not indexed.
+func (d *Database) GetEpoch(epoch int64) (*Epoch, error) {
+	epochs, err := d.getEpochs(d.epochsQuery().Where(goqu.Ex{"epoch": epoch}).Limit(1))
+	if err != nil || len(epochs) == 0 {
+		return nil, err
+	}
+	return epochs[0], nil
+}
+
+// GetEpochs returns a page of epochs, from the last epoch backwards.
+func (d *Database) GetEpochs(page Page) ([]*Epoch, error) {
+	return d.getEpochs(page.apply(d.epochsQuery(), goqu.C("epoch"), nil, true))
+}
+
+// slashingsQuery returns the union of the vote, RANDAO and proposer slashings as a table named s. Blocks include at
+// most one RANDAO and one proposer slashing, vote slashings are told apart by the hash of the first vote.
+func (d *Database) slashingsQuery() *goqu.SelectDataset {
+	dw := goqu.Dialect(d.driver)
+	table := func(name string, kind string, key interface{}, pubkey interface{}, evidence1 interface{},
+		evidence2 interface{}, slot interface{}) *goqu.SelectDataset {
+		return dw.From(goqu.T(name).As("x")).
+			Join(goqu.T("blocks").As("b"), goqu.On(goqu.I("b.block_hash").Eq(goqu.I("x.block_hash")))).
+			Select(goqu.V(kind).As("kind"), goqu.I("x.block_hash").As("block_hash"), goqu.I("b.height").As("height"),
+				goqu.L("?", key).As("slashing_key"), goqu.L("?", pubkey).As("validator_public_key"),
+				goqu.L("?", evidence1).As("evidence_1"), goqu.L("?", evidence2).As("evidence_2"),
+				goqu.L("?", slot).As("slot"))
+	}
+	union := table("vote_slashing", "vote", goqu.I("x.vote_1"), "", goqu.I("x.vote_1"), goqu.I("x.vote_2"), 0).
+		UnionAll(table("randao_slashing", "randao", "randao", goqu.I("x.validator_public_key"),
+			goqu.I("x.randao_reveal"), "", goqu.I("x.slot"))).
+		UnionAll(table("proposer_slashing", "proposer", "proposer", goqu.I("x.validator_public_key"),
+			goqu.I("x.blockheader_1"), goqu.I("x.blockheader_2"), 0))
+	return dw.From(union.As("s")).Select("kind", "block_hash", "height", "slashing_key", "validator_public_key",
+		"evidence_1", "evidence_2", "slot")
+}
+
+func (d *Database) getSlashings(ds *goqu.SelectDataset) ([]*Slashing, error) {
+	var slashings []*Slashing
+	err := d.query(ds, func(rows *sql.Rows) error {
+		s := new(Slashing)
+		err := rows.Scan(&s.Kind, &s.BlockHash, &s.Height, &s.Key, &s.ValidatorPublicKey, &s.Evidence1, &s.Evidence2,
+			&s.Slot)
+		if err != nil {
+			return err
+		}
+		slashings = append(slashings, s)
+		return nil
+	})
+	return slashings, err
+}
+
+// GetSlashings returns a page of slashings, from the last slashing backwards.
+func (d *Database) GetSlashings(page Page) ([]*Slashing, error) {
+	return d.getSlashings(page.apply(d.slashingsQuery(), goqu.C("height"), goqu.C("slashing_key"), true))
+}
+
+// GetBlockSlashings returns the slashings included on a block.
+func (d *Database) GetBlockSlashings(blockHash string) ([]*Slashing, error) {
+	return d.getSlashings(d.slashingsQuery().Where(goqu.C("block_hash").Eq(blockHash)).Order(goqu.C("slashing_key").Asc()))
+}
+
+// GetValidatorSlashings returns the RANDAO and proposer slashings of a validator.
+func (d *Database) GetValidatorSlashings(pubkey string) ([]*Slashing, error) {
+	ds := d.slashingsQuery().Where(goqu.C("validator_public_key").Eq(pubkey)).Order(goqu.C("height").Asc())
+	return d.getSlashings(ds)
+}
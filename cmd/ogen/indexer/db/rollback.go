@@ -0,0 +1,315 @@
+package db
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/olympus-protocol/ogen/api/proto"
+)
+
+var ErrorFinalizedRollback = errors.New("unable to rollback a finalized block")
+
+// blockTables are the tables with rows that belong to a single block. They are removed when the block is orphaned,
+// the order matters to remove the rows before the rows they reference.
+var blockTables = []string{"tx_contract_deploy", "tx_contract_call", "tx_asset", "tx_single", "tx_multi", "votes",
+	"exits", "vote_slashing", "randao_slashing", "proposer_slashing", "governance_votes", "epoch_receipts",
+	"manager_payouts", "validator_balances"}
+
+// queryer is implemented by both a database connection and a database transaction.
+type queryer interface {
+	Exec(query string, args ...interface{}) (sql.Result, error)
+	Query(query string, args ...interface{}) (*sql.Rows, error)
+	QueryRow(query string, args ...interface{}) *sql.Row
+}
+
+// sqlBuilder is a goqu dataset that can be converted into a query.
+type sqlBuilder interface {
+	ToSQL() (string, []interface{}, error)
+}
+
+// update runs fn inside a database transaction. The transaction is committed if fn succeeds and rolled back otherwise.
+func (d *Database) update(fn func(tx *Database) error) error {
+	d.canClose.Add(1)
+	defer d.canClose.Done()
+
+	sqlTx, err := d.db.Begin()
+	if err != nil {
+		return err
+	}
+
+	tx := *d
+	tx.q = sqlTx
+
+	if err := fn(&tx); err != nil {
+		_ = sqlTx.Rollback()
+		return err
+	}
+
+	return sqlTx.Commit()
+}
+
+// journal stores a statement that undoes a change done by the block being inserted. Changes done outside a block
+// insert are not journaled.
+func (d *Database) journal(undo sqlBuilder) error {
+	if d.undoBlock == "" {
+		return nil
+	}
+
+	undoQuery, _, err := undo.ToSQL()
+	if err != nil {
+		return err
+	}
+
+	return d.insertRecord("undo_log", goqu.Record{
+		"block_hash": d.undoBlock,
+		"statement":  undoQuery,
+	})
+}
+
+// journalValidator stores the current exit flags of a validator before they are modified.
+func (d *Database) journalValidator(valPubKey interface{}) error {
+	if d.undoBlock == "" {
+		return nil
+	}
+
+	dw := goqu.Dialect(d.driver)
+	ds := dw.From("validators").Select("exit", "penalized", "last_active_epoch").Where(goqu.Ex{
+		"public_key": valPubKey,
+	})
+	query, _, err := ds.ToSQL()
+	if err != nil {
+		return err
+	}
+
+	var exit, penalized bool
+	var lastActiveEpoch int
+	err = d.q.QueryRow(query).Scan(&exit, &penalized, &lastActiveEpoch)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil
+		}
+		return err
+	}
+
+	return d.journal(dw.Update("validators").Set(
+		goqu.Record{
+			"exit":              exit,
+			"penalized":         penalized,
+			"last_active_epoch": lastActiveEpoch,
+		}).Where(
+		goqu.Ex{
+			"public_key": valPubKey,
+		},
+	))
+}
+
+// hasBlock returns true if the block is indexed.
+func (d *Database) hasBlock(hash string) (bool, error) {
+	ds := goqu.Dialect(d.driver).From("blocks").Select("block_hash").Where(goqu.Ex{
+		"block_hash": hash,
+	})
+	query, _, err := ds.ToSQL()
+	if err != nil {
+		return false, err
+	}
+	var found string
+	err = d.q.QueryRow(query).Scan(&found)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// RollbackTip removes the last indexed block and undoes all its changes.
+func (d *Database) RollbackTip() error {
+	return d.update(func(tx *Database) error {
+		_, err := tx.rollbackTip()
+		return err
+	})
+}
+
+// RollbackTo removes every indexed block after a block and undoes their changes in a single transaction. If any of
+// the blocks can't be rolled back, none of them is.
+func (d *Database) RollbackTo(hash string) error {
+	return d.update(func(tx *Database) error {
+		_, tip, err := tx.getNextHeight()
+		if err != nil {
+			return err
+		}
+		for tip != hash {
+			if tip == "" {
+				return fmt.Errorf("block %s is not indexed", hash)
+			}
+			tip, err = tx.rollbackTip()
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// rollbackTip removes the last indexed block, undoes all its changes and returns the hash of the new tip.
+func (d *Database) rollbackTip() (string, error) {
+	_, hash, err := d.getNextHeight()
+	if err != nil {
+		return "", err
+	}
+	if hash == "" {
+		return "", errors.New("there are no blocks to rollback")
+	}
+
+	dw := goqu.Dialect(d.driver)
+
+	ds := dw.From("blocks").Select("finalized").Where(goqu.Ex{"block_hash": hash})
+	query, _, err := ds.ToSQL()
+	if err != nil {
+		return "", err
+	}
+	var finalized sql.NullBool
+	if err := d.q.QueryRow(query).Scan(&finalized); err != nil {
+		return "", err
+	}
+	if finalized.Bool {
+		return "", ErrorFinalizedRollback
+	}
+
+	ds = dw.From("block_headers").Select("previous_block_hash").Where(goqu.Ex{"block_hash": hash})
+	query, _, err = ds.ToSQL()
+	if err != nil {
+		return "", err
+	}
+	var prevHash string
+	if err := d.q.QueryRow(query).Scan(&prevHash); err != nil {
+		return "", err
+	}
+
+	for _, table := range blockTables {
+		if err := d.deleteBlockRows(table, hash); err != nil {
+			return "", err
+		}
+	}
+
+	// The journal is read before running it, a transaction can't run statements while reading rows.
+	ds = dw.From("undo_log").Select("statement").Where(goqu.Ex{"block_hash": hash}).Order(goqu.C("id").Desc())
+	query, _, err = ds.ToSQL()
+	if err != nil {
+		return "", err
+	}
+	rows, err := d.q.Query(query)
+	if err != nil {
+		return "", err
+	}
+	var statements []string
+	for rows.Next() {
+		var s string
+		if err := rows.Scan(&s); err != nil {
+			_ = rows.Close()
+			return "", err
+		}
+		statements = append(statements, s)
+	}
+	if err := rows.Close(); err != nil {
+		return "", err
+	}
+
+	for _, s := range statements {
+		if _, err := d.q.Exec(s); err != nil {
+			return "", err
+		}
+	}
+
+	for _, table := range []string{"undo_log", "deposits", "block_headers", "blocks"} {
+		if err := d.deleteBlockRows(table, hash); err != nil {
+			return "", err
+		}
+	}
+
+	d.log.Warnf("rolled back orphaned block %s", hash)
+
+	return prevHash, nil
+}
+
+func (d *Database) deleteBlockRows(table string, hash string) error {
+	ds := goqu.Dialect(d.driver).Delete(table).Where(goqu.Ex{"block_hash": hash})
+	query, _, err := ds.ToSQL()
+	if err != nil {
+		return err
+	}
+	_, err = d.q.Exec(query)
+	return err
+}
+
+// MarkFinalized marks the blocks and epochs finalized and justified by the chain. Blocks are only marked if the
+// finalized head is part of the indexed chain, the journal of the finalized blocks is removed since they can't be
+// orphaned anymore.
+func (d *Database) MarkFinalized(info *proto.ChainInfo) error {
+	if info.FinalizedHead == nil || info.JustifiedHead == nil {
+		return nil
+	}
+
+	return d.update(func(tx *Database) error {
+		dw := goqu.Dialect(tx.driver)
+
+		ds := dw.From("blocks").Select("block_hash").Where(goqu.Ex{"height": int(info.FinalizedHead.Height)})
+		query, _, err := ds.ToSQL()
+		if err != nil {
+			return err
+		}
+		var hash string
+		err = tx.q.QueryRow(query).Scan(&hash)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return nil
+			}
+			return err
+		}
+		if hash != info.FinalizedHead.Hash {
+			return nil
+		}
+
+		finalizedBlocks := dw.From("blocks").Select("block_hash").Where(
+			goqu.C("height").Lte(int(info.FinalizedHead.Height)),
+			goqu.C("finalized").Eq(false),
+		)
+
+		for _, ds := range []sqlBuilder{
+			dw.Delete("undo_log").Where(goqu.C("block_hash").In(finalizedBlocks)),
+			dw.Update("blocks").Set(goqu.Record{"finalized": true}).Where(
+				goqu.C("height").Lte(int(info.FinalizedHead.Height)),
+				goqu.C("finalized").Eq(false),
+			),
+			dw.Update("epochs").Set(goqu.Record{"finalized": true}).Where(
+				goqu.C("epoch").Lte(tx.slotEpoch(info.FinalizedHead.Slot)),
+				goqu.C("finalized").Eq(false),
+			),
+			dw.Update("epochs").Set(goqu.Record{"justified": true}).Where(
+				goqu.C("epoch").Lte(tx.slotEpoch(info.JustifiedHead.Slot)),
+				goqu.C("justified").Eq(false),
+			),
+		} {
+			query, _, err := ds.ToSQL()
+			if err != nil {
+				return err
+			}
+			if _, err := tx.q.Exec(query); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// slotEpoch returns the epoch of a slot.
+func (d *Database) slotEpoch(slot uint64) int {
+	if slot == 0 {
+		return 0
+	}
+	return int((slot - 1) / d.netParams.EpochLength)
+}
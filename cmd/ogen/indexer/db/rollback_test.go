@@ -0,0 +1,264 @@
+package db_test
+
+import (
+	"encoding/hex"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/olympus-protocol/ogen/api/proto"
+	"github.com/olympus-protocol/ogen/cmd/ogen/indexer/db"
+	"github.com/olympus-protocol/ogen/pkg/bls"
+	"github.com/olympus-protocol/ogen/pkg/chainhash"
+	"github.com/olympus-protocol/ogen/pkg/logger"
+	"github.com/olympus-protocol/ogen/pkg/primitives"
+	testdata "github.com/olympus-protocol/ogen/test"
+	"github.com/stretchr/testify/assert"
+)
+
+// newTestDB returns an initialized sqlite3 database and the hash of its genesis block.
+func newTestDB(t *testing.T) (*db.Database, chainhash.Hash) {
+	bls.Initialize(&testdata.TestParams)
+
+	dir, err := ioutil.TempDir("", "indexer")
+	assert.NoError(t, err)
+
+	// The migrations are loaded relative to the repository root.
+	wd, err := os.Getwd()
+	assert.NoError(t, err)
+	assert.NoError(t, os.Chdir("../../../.."))
+
+	d := db.NewDB(filepath.Join(dir, "index.db"), logger.New(os.Stdout).Quiet(), new(sync.WaitGroup), "sqlite3", &testdata.TestParams)
+	t.Cleanup(func() {
+		d.Close()
+		_ = os.Chdir(wd)
+		_ = os.RemoveAll(dir)
+	})
+	assert.NoError(t, d.Migrate())
+
+	genesis, err := d.Initialize()
+	assert.NoError(t, err)
+	hash, err := chainhash.NewHashFromStr(genesis)
+	assert.NoError(t, err)
+	return d, hash
+}
+
+func testBlock(parent chainhash.Hash, slot uint64, txs ...*primitives.Tx) *primitives.Block {
+	return &primitives.Block{
+		Header: &primitives.BlockHeader{PrevBlockHash: parent, Slot: slot, Timestamp: 1600000000 + slot, FeeAddress: [20]byte{1}},
+		Txs:    txs,
+	}
+}
+
+func testTx(t *testing.T, to [20]byte, amount uint64) *primitives.Tx {
+	key, err := bls.RandKey()
+	assert.NoError(t, err)
+	tx := &primitives.Tx{To: to, Amount: amount, Nonce: 1, Fee: 1}
+	copy(tx.FromPublicKey[:], key.PublicKey().Marshal())
+	msg := tx.SignatureMessage()
+	copy(tx.Signature[:], key.Sign(msg[:]).Marshal())
+	return tx
+}
+
+func TestDatabase_RollbackTip(t *testing.T) {
+	d, genesis := newTestDB(t)
+
+	b1 := testBlock(genesis, 1)
+	assert.NoError(t, d.InsertBlock(b1))
+
+	to := [20]byte{9}
+	account := hex.EncodeToString(to[:])
+	b2 := testBlock(b1.Hash(), 2, testTx(t, to, 100))
+	assert.NoError(t, d.InsertBlock(b2))
+
+	acc, err := d.GetAccount(account)
+	assert.NoError(t, err)
+	assert.Equal(t, 100, acc.Confirmed)
+
+	assert.NoError(t, d.RollbackTip())
+
+	tip, err := d.GetTip()
+	assert.NoError(t, err)
+	assert.Equal(t, b1.Hash().String(), tip.Hash)
+
+	block, err := d.GetBlock(b2.Hash().String())
+	assert.NoError(t, err)
+	assert.Nil(t, block)
+
+	txs, err := d.GetBlockTransactions(b2.Hash().String())
+	assert.NoError(t, err)
+	assert.Empty(t, txs)
+
+	// the account was created by the block.
+	acc, err = d.GetAccount(account)
+	assert.NoError(t, err)
+	assert.Nil(t, acc)
+
+	// the rolled back block can be indexed again.
+	assert.NoError(t, d.InsertBlock(b2))
+	acc, err = d.GetAccount(account)
+	assert.NoError(t, err)
+	assert.Equal(t, 100, acc.Confirmed)
+}
+
+func TestDatabase_RollbackTo(t *testing.T) {
+	d, genesis := newTestDB(t)
+
+	b1 := testBlock(genesis, 1)
+	b2 := testBlock(b1.Hash(), 2)
+	b3 := testBlock(b2.Hash(), 3)
+	for _, b := range []*primitives.Block{b1, b2, b3} {
+		assert.NoError(t, d.InsertBlock(b))
+	}
+
+	// a block that is not indexed fails without rolling back any block.
+	assert.Error(t, d.RollbackTo(chainhash.Hash{1}.String()))
+	tip, err := d.GetTip()
+	assert.NoError(t, err)
+	assert.Equal(t, b3.Hash().String(), tip.Hash)
+
+	assert.NoError(t, d.RollbackTo(b1.Hash().String()))
+	tip, err = d.GetTip()
+	assert.NoError(t, err)
+	assert.Equal(t, b1.Hash().String(), tip.Hash)
+}
+
+func TestDatabase_MarkFinalized(t *testing.T) {
+	d, genesis := newTestDB(t)
+
+	b1 := testBlock(genesis, 1)
+	b2 := testBlock(b1.Hash(), 2)
+	assert.NoError(t, d.InsertBlock(b1))
+	assert.NoError(t, d.InsertBlock(b2))
+
+	// a finalized head that is not part of the indexed chain is ignored.
+	other := &proto.Head{Height: 1, Slot: 1, Hash: chainhash.Hash{1}.String()}
+	assert.NoError(t, d.MarkFinalized(&proto.ChainInfo{FinalizedHead: other, JustifiedHead: other}))
+	block, err := d.GetBlock(b1.Hash().String())
+	assert.NoError(t, err)
+	assert.False(t, block.Finalized)
+
+	head := &proto.Head{Height: 1, Slot: 1, Hash: b1.Hash().String()}
+	assert.NoError(t, d.MarkFinalized(&proto.ChainInfo{FinalizedHead: head, JustifiedHead: head}))
+
+	for _, b := range []*primitives.Block{b1, b2} {
+		block, err := d.GetBlock(b.Hash().String())
+		assert.NoError(t, err)
+		assert.Equal(t, b == b1, block.Finalized)
+	}
+
+	// blocks after the finalized head can still be rolled back, the finalized blocks can't.
+	assert.NoError(t, d.RollbackTip())
+	assert.Equal(t, db.ErrorFinalizedRollback, d.RollbackTip())
+	assert.Equal(t, db.ErrorFinalizedRollback, d.RollbackTo(genesis.String()))
+}
+
+func TestDatabase_InsertBlockFailure(t *testing.T) {
+	d, genesis := newTestDB(t)
+
+	to := [20]byte{9}
+	account := hex.EncodeToString(to[:])
+	tx := testTx(t, to, 100)
+	b1 := testBlock(genesis, 1, tx)
+	assert.NoError(t, d.InsertBlock(b1))
+
+	undo, err := d.BlockRows("undo_log", b1.Hash().String())
+	assert.NoError(t, err)
+	assert.NotZero(t, undo)
+
+	// the transaction hash is already indexed, the accounts are modified before the transaction insert fails.
+	b2 := testBlock(b1.Hash(), 2, testTx(t, to, 50), tx)
+	assert.Error(t, d.InsertBlock(b2))
+
+	tip, err := d.GetTip()
+	assert.NoError(t, err)
+	assert.Equal(t, b1.Hash().String(), tip.Hash)
+
+	block, err := d.GetBlock(b2.Hash().String())
+	assert.NoError(t, err)
+	assert.Nil(t, block)
+
+	undo, err = d.BlockRows("undo_log", b2.Hash().String())
+	assert.NoError(t, err)
+	assert.Zero(t, undo)
+
+	acc, err := d.GetAccount(account)
+	assert.NoError(t, err)
+	assert.Equal(t, 100, acc.Confirmed)
+
+	// the journal of the indexed block still undoes it completely.
+	assert.NoError(t, d.RollbackTip())
+	acc, err = d.GetAccount(account)
+	assert.NoError(t, err)
+	assert.Nil(t, acc)
+}
+
+func TestDatabase_ContractTransactions(t *testing.T) {
+	d, genesis := newTestDB(t)
+
+	b1 := testBlock(genesis, 1)
+	assert.NoError(t, d.InsertBlock(b1))
+
+	key, err := bls.RandKey()
+	assert.NoError(t, err)
+	var pub [48]byte
+	copy(pub[:], key.PublicKey().Marshal())
+	from, err := key.PublicKey().Hash()
+	assert.NoError(t, err)
+
+	deploy := &primitives.ContractDeploy{FromPublicKey: pub, Code: []byte{0x00}, Amount: 10, Nonce: 1, GasLimit: 1000, GasPrice: 2}
+	contract, err := deploy.ContractAddress()
+	assert.NoError(t, err)
+	call := &primitives.ContractCall{To: contract, FromPublicKey: pub, Amount: 5, Nonce: 2, GasLimit: 1000, GasPrice: 1}
+
+	b2 := testBlock(b1.Hash(), 2)
+	feeAccount := hex.EncodeToString(b2.Header.FeeAddress[:])
+	b2.ContractDeploys = []*primitives.ContractDeploy{deploy}
+	b2.ContractCalls = []*primitives.ContractCall{call}
+
+	// the gas used is unknown without the receipts.
+	assert.Error(t, d.InsertBlock(b2))
+	deployReceipt := &proto.ContractReceipt{TxHash: deploy.Hash().String(), GasUsed: 50, Success: true}
+	assert.Error(t, d.InsertBlockWithReceipts(b2, nil, &proto.ContractReceipts{Receipts: []*proto.ContractReceipt{deployReceipt}}))
+
+	// the failed call pays the gas without sending the amount.
+	receipts := &proto.ContractReceipts{Receipts: []*proto.ContractReceipt{
+		deployReceipt,
+		{TxHash: call.Hash().String(), GasUsed: 30, Error: "reverted"},
+	}}
+	assert.NoError(t, d.InsertBlockWithReceipts(b2, nil, receipts))
+
+	balances := map[string]int{
+		hex.EncodeToString(from[:]):     -10 - 100 - 30,
+		hex.EncodeToString(contract[:]): 10,
+		feeAccount:                      100 + 30,
+	}
+	for account, balance := range balances {
+		acc, err := d.GetAccount(account)
+		assert.NoError(t, err)
+		assert.Equal(t, balance, acc.Confirmed, account)
+	}
+	for _, table := range []string{"tx_contract_deploy", "tx_contract_call"} {
+		rows, err := d.BlockRows(table, b2.Hash().String())
+		assert.NoError(t, err)
+		assert.Equal(t, 1, rows, table)
+	}
+
+	assert.NoError(t, d.RollbackTip())
+
+	for _, table := range []string{"tx_contract_deploy", "tx_contract_call"} {
+		rows, err := d.BlockRows(table, b2.Hash().String())
+		assert.NoError(t, err)
+		assert.Zero(t, rows, table)
+	}
+	for _, account := range []string{hex.EncodeToString(from[:]), hex.EncodeToString(contract[:])} {
+		acc, err := d.GetAccount(account)
+		assert.NoError(t, err)
+		assert.Nil(t, acc, account)
+	}
+	fee, err := d.GetAccount(feeAccount)
+	assert.NoError(t, err)
+	assert.Zero(t, fee.Confirmed)
+}
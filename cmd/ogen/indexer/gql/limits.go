@@ -0,0 +1,55 @@
+package gql
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+
+	"github.com/graph-gophers/graphql-go/errors"
+	"github.com/graph-gophers/graphql-go/introspection"
+	"github.com/graph-gophers/graphql-go/trace"
+)
+
+const (
+	// maxQueryDepth is the maximum nesting of the fields selected by a query.
+	maxQueryDepth = 10
+
+	// maxParallelism is the maximum amount of resolvers that run at the same time for a single query.
+	maxParallelism = 10
+
+	// maxQueryComplexity is the maximum amount of fields resolved for a single query. The fields of every item of a list
+	// are counted, so large pages of nested lists reach the limit.
+	maxQueryComplexity = 5000
+)
+
+var errQueryComplexity = fmt.Errorf("query complexity exceeds the limit of %d fields", maxQueryComplexity)
+
+type complexityKey struct{}
+
+// complexityTracer counts the fields resolved for each query and stops resolving fields once the query resolves more
+// than maxQueryComplexity fields. Subscriptions are not traced as a query and are not limited.
+type complexityTracer struct {
+	trace.NoopTracer
+}
+
+func (complexityTracer) TraceQuery(ctx context.Context, queryString string, operationName string, variables map[string]interface{}, varTypes map[string]*introspection.Type) (context.Context, trace.TraceQueryFinishFunc) {
+	return context.WithValue(ctx, complexityKey{}, new(int64)), func([]*errors.QueryError) {}
+}
+
+func (complexityTracer) TraceField(ctx context.Context, label, typeName, fieldName string, trivial bool, args map[string]interface{}) (context.Context, trace.TraceFieldFinishFunc) {
+	fields, ok := ctx.Value(complexityKey{}).(*int64)
+	if ok && atomic.AddInt64(fields, 1) > maxQueryComplexity {
+		ctx = exceededContext{ctx}
+	}
+	return ctx, func(*errors.QueryError) {}
+}
+
+// exceededContext is the context of the fields resolved after reaching the complexity limit. The executor doesn't
+// resolve fields with a context error.
+type exceededContext struct {
+	context.Context
+}
+
+func (exceededContext) Err() error {
+	return errQueryComplexity
+}
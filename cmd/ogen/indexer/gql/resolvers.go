@@ -0,0 +1,576 @@
+package gql
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/olympus-protocol/ogen/cmd/ogen/indexer/db"
+	"github.com/olympus-protocol/ogen/pkg/params"
+	"github.com/olympus-protocol/ogen/pkg/primitives"
+)
+
+var errInvalidCursor = errors.New("invalid cursor")
+
+// Long is the GraphQL scalar of 64 bits integers.
+type Long int64
+
+// ImplementsGraphQLType maps the type to the Long scalar.
+func (Long) ImplementsGraphQLType(name string) bool {
+	return name == "Long"
+}
+
+// UnmarshalGraphQL parses the value of a Long argument.
+func (l *Long) UnmarshalGraphQL(input interface{}) error {
+	switch v := input.(type) {
+	case int32:
+		*l = Long(v)
+	case int64:
+		*l = Long(v)
+	case float64:
+		*l = Long(v)
+	case string:
+		n, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return err
+		}
+		*l = Long(n)
+	default:
+		return fmt.Errorf("wrong type for Long: %T", input)
+	}
+	return nil
+}
+
+// MarshalJSON encodes the value as a JSON number.
+func (l Long) MarshalJSON() ([]byte, error) {
+	return strconv.AppendInt(nil, int64(l), 10), nil
+}
+
+// encodeCursor returns the opaque cursor of a position.
+func encodeCursor(c db.Cursor) *string {
+	s := base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatInt(c.Position, 10) + ":" + c.Key))
+	return &s
+}
+
+func decodeCursor(s string) (*db.Cursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, errInvalidCursor
+	}
+	parts := strings.SplitN(string(b), ":", 2)
+	if len(parts) != 2 {
+		return nil, errInvalidCursor
+	}
+	position, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return nil, errInvalidCursor
+	}
+	return &db.Cursor{Position: position, Key: parts[1]}, nil
+}
+
+type pageArgs struct {
+	First *int32
+	After *string
+}
+
+func (a pageArgs) page() (db.Page, error) {
+	var page db.Page
+	if a.First != nil {
+		if *a.First < 0 {
+			return page, errors.New("first must be positive")
+		}
+		page.Limit = int(*a.First)
+	}
+	if a.After != nil {
+		after, err := decodeCursor(*a.After)
+		if err != nil {
+			return page, err
+		}
+		page.After = after
+	}
+	return page, nil
+}
+
+type pageInfo struct {
+	EndCursor   *string
+	HasNextPage bool
+}
+
+// newPageInfo returns the page info of the n rows returned for a page. The database returns one row more than the page
+// size when there is a next page.
+func newPageInfo(page db.Page, n int, last func(i int) db.Cursor) (int, *pageInfo) {
+	size := page.Size()
+	info := &pageInfo{HasNextPage: n > size}
+	if n > size {
+		n = size
+	}
+	if n > 0 {
+		info.EndCursor = encodeCursor(last(n - 1))
+	}
+	return n, info
+}
+
+// Resolver is the root resolver of the indexer schema.
+type Resolver struct {
+	db        *db.Database
+	netParams *params.ChainParams
+	blocks    *broadcaster
+}
+
+func (r *Resolver) block(b *db.Block, err error) (*blockResolver, error) {
+	if err != nil || b == nil {
+		return nil, err
+	}
+	return &blockResolver{r: r, b: b}, nil
+}
+
+func (r *Resolver) blockList(blocks []*db.Block, err error) ([]*blockResolver, error) {
+	if err != nil {
+		return nil, err
+	}
+	res := make([]*blockResolver, len(blocks))
+	for i, b := range blocks {
+		res[i] = &blockResolver{r: r, b: b}
+	}
+	return res, nil
+}
+
+func (r *Resolver) transactionList(txs []*db.Transaction, err error) ([]*transactionResolver, error) {
+	if err != nil {
+		return nil, err
+	}
+	res := make([]*transactionResolver, len(txs))
+	for i, tx := range txs {
+		res[i] = &transactionResolver{r: r, tx: tx}
+	}
+	return res, nil
+}
+
+// account returns the resolver of an account. Accounts that are not indexed have no balance.
+func (r *Resolver) account(address string) (*accountResolver, error) {
+	info, err := r.db.GetAccount(address)
+	if err != nil {
+		return nil, err
+	}
+	if info == nil {
+		info = &db.AccountInfo{Account: address}
+	}
+	return &accountResolver{r: r, info: info}, nil
+}
+
+func (r *Resolver) validator(v *db.Validator, err error) (*validatorResolver, error) {
+	if err != nil || v == nil {
+		return nil, err
+	}
+	return &validatorResolver{r: r, v: v}, nil
+}
+
+func (r *Resolver) epoch(e *db.Epoch, err error) (*epochResolver, error) {
+	if err != nil || e == nil {
+		return nil, err
+	}
+	return &epochResolver{r: r, e: e}, nil
+}
+
+func (r *Resolver) slashingList(slashings []*db.Slashing, err error) ([]*slashingResolver, error) {
+	if err != nil {
+		return nil, err
+	}
+	res := make([]*slashingResolver, len(slashings))
+	for i, s := range slashings {
+		res[i] = &slashingResolver{r: r, s: s}
+	}
+	return res, nil
+}
+
+func (r *Resolver) Tip() (*blockResolver, error) {
+	return r.block(r.db.GetTip())
+}
+
+func (r *Resolver) Block(args struct {
+	Hash   *string
+	Height *Long
+}) (*blockResolver, error) {
+	switch {
+	case args.Hash != nil:
+		return r.block(r.db.GetBlock(*args.Hash))
+	case args.Height != nil:
+		return r.block(r.db.GetBlockByHeight(int64(*args.Height)))
+	default:
+		return nil, errors.New("missing block hash or height")
+	}
+}
+
+type blockEdge struct {
+	Cursor string
+	Node   *blockResolver
+}
+
+type blockConnection struct {
+	Edges    []*blockEdge
+	PageInfo *pageInfo
+}
+
+func (r *Resolver) Blocks(args pageArgs) (*blockConnection, error) {
+	page, err := args.page()
+	if err != nil {
+		return nil, err
+	}
+	blocks, err := r.db.GetBlocks(page)
+	if err != nil {
+		return nil, err
+	}
+	n, info := newPageInfo(page, len(blocks), func(i int) db.Cursor { return blocks[i].Cursor() })
+	c := &blockConnection{Edges: make([]*blockEdge, n), PageInfo: info}
+	for i := range c.Edges {
+		c.Edges[i] = &blockEdge{Cursor: *encodeCursor(blocks[i].Cursor()), Node: &blockResolver{r: r, b: blocks[i]}}
+	}
+	return c, nil
+}
+
+func (r *Resolver) Transaction(args struct{ Hash string }) (*transactionResolver, error) {
+	tx, err := r.db.GetTransaction(args.Hash)
+	if err != nil || tx == nil {
+		return nil, err
+	}
+	return &transactionResolver{r: r, tx: tx}, nil
+}
+
+func (r *Resolver) Account(args struct{ Address string }) (*accountResolver, error) {
+	info, err := r.db.GetAccount(args.Address)
+	if err != nil || info == nil {
+		return nil, err
+	}
+	return &accountResolver{r: r, info: info}, nil
+}
+
+func (r *Resolver) Validator(args struct {
+	PublicKey *string
+	Index     *Long
+}) (*validatorResolver, error) {
+	switch {
+	case args.PublicKey != nil:
+		return r.validator(r.db.GetValidator(*args.PublicKey))
+	case args.Index != nil:
+		return r.validator(r.db.GetValidatorByIndex(int64(*args.Index)))
+	default:
+		return nil, errors.New("missing validator public key or index")
+	}
+}
+
+type validatorEdge struct {
+	Cursor string
+	Node   *validatorResolver
+}
+
+type validatorConnection struct {
+	Edges    []*validatorEdge
+	PageInfo *pageInfo
+}
+
+func (r *Resolver) Validators(args pageArgs) (*validatorConnection, error) {
+	page, err := args.page()
+	if err != nil {
+		return nil, err
+	}
+	validators, err := r.db.GetValidators(page)
+	if err != nil {
+		return nil, err
+	}
+	n, info := newPageInfo(page, len(validators), func(i int) db.Cursor { return validators[i].Cursor() })
+	c := &validatorConnection{Edges: make([]*validatorEdge, n), PageInfo: info}
+	for i := range c.Edges {
+		c.Edges[i] = &validatorEdge{Cursor: *encodeCursor(validators[i].Cursor()), Node: &validatorResolver{r: r, v: validators[i]}}
+	}
+	return c, nil
+}
+
+func (r *Resolver) Epoch(args struct{ Number Long }) (*epochResolver, error) {
+	return r.epoch(r.db.GetEpoch(int64(args.Number)))
+}
+
+type epochEdge struct {
+	Cursor string
+	Node   *epochResolver
+}
+
+type epochConnection struct {
+	Edges    []*epochEdge
+	PageInfo *pageInfo
+}
+
+func (r *Resolver) Epochs(args pageArgs) (*epochConnection, error) {
+	page, err := args.page()
+	if err != nil {
+		return nil, err
+	}
+	epochs, err := r.db.GetEpochs(page)
+	if err != nil {
+		return nil, err
+	}
+	n, info := newPageInfo(page, len(epochs), func(i int) db.Cursor { return epochs[i].Cursor() })
+	c := &epochConnection{Edges: make([]*epochEdge, n), PageInfo: info}
+	for i := range c.Edges {
+		c.Edges[i] = &epochEdge{Cursor: *encodeCursor(epochs[i].Cursor()), Node: &epochResolver{r: r, e: epochs[i]}}
+	}
+	return c, nil
+}
+
+type slashingEdge struct {
+	Cursor string
+	Node   *slashingResolver
+}
+
+type slashingConnection struct {
+	Edges    []*slashingEdge
+	PageInfo *pageInfo
+}
+
+func (r *Resolver) Slashings(args pageArgs) (*slashingConnection, error) {
+	page, err := args.page()
+	if err != nil {
+		return nil, err
+	}
+	slashings, err := r.db.GetSlashings(page)
+	if err != nil {
+		return nil, err
+	}
+	n, info := newPageInfo(page, len(slashings), func(i int) db.Cursor { return slashings[i].Cursor() })
+	c := &slashingConnection{Edges: make([]*slashingEdge, n), PageInfo: info}
+	for i := range c.Edges {
+		c.Edges[i] = &slashingEdge{Cursor: *encodeCursor(slashings[i].Cursor()), Node: &slashingResolver{r: r, s: slashings[i]}}
+	}
+	return c, nil
+}
+
+// NewBlocks streams the blocks indexed until the subscription is closed.
+func (r *Resolver) NewBlocks(ctx context.Context) <-chan *blockResolver {
+	blocks := r.blocks.subscribe(ctx)
+	res := make(chan *blockResolver)
+	go func() {
+		defer close(res)
+		for b := range blocks {
+			select {
+			case res <- &blockResolver{r: r, b: b}:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return res
+}
+
+type blockResolver struct {
+	r *Resolver
+	b *db.Block
+}
+
+func (b *blockResolver) Hash() string              { return b.b.Hash }
+func (b *blockResolver) Height() Long              { return Long(b.b.Height) }
+func (b *blockResolver) Slot() Long                { return Long(b.b.Slot) }
+func (b *blockResolver) Timestamp() Long           { return Long(b.b.Timestamp) }
+func (b *blockResolver) Version() Long             { return Long(b.b.Version) }
+func (b *blockResolver) Nonce() Long               { return Long(b.b.Nonce) }
+func (b *blockResolver) Signature() string         { return b.b.Signature }
+func (b *blockResolver) RandaoSignature() string   { return b.b.RandaoSignature }
+func (b *blockResolver) StateRoot() string         { return b.b.StateRoot }
+func (b *blockResolver) Finalized() bool           { return b.b.Finalized }
+func (b *blockResolver) PreviousBlockHash() string { return b.b.PreviousBlockHash }
+
+func (b *blockResolver) Previous() (*blockResolver, error) {
+	if b.b.Height == 0 {
+		return nil, nil
+	}
+	return b.r.block(b.r.db.GetBlock(b.b.PreviousBlockHash))
+}
+
+func (b *blockResolver) Next() (*blockResolver, error) {
+	return b.r.block(b.r.db.GetBlockByHeight(b.b.Height + 1))
+}
+
+func (b *blockResolver) FeeAccount() (*accountResolver, error) {
+	return b.r.account(b.b.FeeAddress)
+}
+
+func (b *blockResolver) Epoch() (*epochResolver, error) {
+	var epoch int64
+	if b.b.Slot > 0 {
+		epoch = (b.b.Slot - 1) / int64(b.r.netParams.EpochLength)
+	}
+	return b.r.epoch(b.r.db.GetEpoch(epoch))
+}
+
+func (b *blockResolver) Transactions() ([]*transactionResolver, error) {
+	return b.r.transactionList(b.r.db.GetBlockTransactions(b.b.Hash))
+}
+
+func (b *blockResolver) Slashings() ([]*slashingResolver, error) {
+	return b.r.slashingList(b.r.db.GetBlockSlashings(b.b.Hash))
+}
+
+type transactionResolver struct {
+	r  *Resolver
+	tx *db.Transaction
+}
+
+func (t *transactionResolver) Hash() string { return t.tx.Hash }
+func (t *transactionResolver) Kind() string { return strings.ToUpper(t.tx.Kind) }
+func (t *transactionResolver) Amount() Long { return Long(t.tx.Amount) }
+func (t *transactionResolver) Nonce() Long  { return Long(t.tx.Nonce) }
+func (t *transactionResolver) Fee() Long    { return Long(t.tx.Fee) }
+
+func (t *transactionResolver) Block() (*blockResolver, error) {
+	return t.r.block(t.r.db.GetBlock(t.tx.BlockHash))
+}
+
+func (t *transactionResolver) From() (*accountResolver, error) {
+	return t.r.account(t.tx.From)
+}
+
+func (t *transactionResolver) To() (*accountResolver, error) {
+	return t.r.account(t.tx.To)
+}
+
+func (t *transactionResolver) AssetId() *string {
+	if t.tx.Kind != "asset" {
+		return nil
+	}
+	return &t.tx.AssetID
+}
+
+type transactionEdge struct {
+	Cursor string
+	Node   *transactionResolver
+}
+
+type transactionConnection struct {
+	Edges    []*transactionEdge
+	PageInfo *pageInfo
+}
+
+type accountResolver struct {
+	r    *Resolver
+	info *db.AccountInfo
+}
+
+func (a *accountResolver) Address() string     { return a.info.Account }
+func (a *accountResolver) Confirmed() Long     { return Long(a.info.Confirmed) }
+func (a *accountResolver) Unconfirmed() Long   { return Long(a.info.Unconfirmed) }
+func (a *accountResolver) Locked() Long        { return Long(a.info.Locked) }
+func (a *accountResolver) TotalSent() Long     { return Long(a.info.TotalSent) }
+func (a *accountResolver) TotalReceived() Long { return Long(a.info.TotalReceived) }
+
+func (a *accountResolver) Transactions(args pageArgs) (*transactionConnection, error) {
+	page, err := args.page()
+	if err != nil {
+		return nil, err
+	}
+	txs, err := a.r.db.GetAccountTransactions(a.info.Account, page)
+	if err != nil {
+		return nil, err
+	}
+	n, info := newPageInfo(page, len(txs), func(i int) db.Cursor { return txs[i].Cursor() })
+	c := &transactionConnection{Edges: make([]*transactionEdge, n), PageInfo: info}
+	for i := range c.Edges {
+		c.Edges[i] = &transactionEdge{Cursor: *encodeCursor(txs[i].Cursor()), Node: &transactionResolver{r: a.r, tx: txs[i]}}
+	}
+	return c, nil
+}
+
+func (a *accountResolver) Validators() ([]*validatorResolver, error) {
+	validators, err := a.r.db.GetPayeeValidators(a.info.Account)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]*validatorResolver, len(validators))
+	for i, v := range validators {
+		res[i] = &validatorResolver{r: a.r, v: v}
+	}
+	return res, nil
+}
+
+type validatorResolver struct {
+	r *Resolver
+	v *db.Validator
+}
+
+func (v *validatorResolver) Index() Long            { return Long(v.v.Index) }
+func (v *validatorResolver) PublicKey() string      { return v.v.PublicKey }
+func (v *validatorResolver) Exit() bool             { return v.v.Exit }
+func (v *validatorResolver) Penalized() bool        { return v.v.Penalized }
+func (v *validatorResolver) Balance() Long          { return Long(v.v.Balance) }
+func (v *validatorResolver) FirstActiveEpoch() Long { return Long(v.v.FirstActiveEpoch) }
+func (v *validatorResolver) LastActiveEpoch() Long  { return Long(v.v.LastActiveEpoch) }
+
+func (v *validatorResolver) Status() string {
+	val := &primitives.Validator{Status: uint64(v.v.Status)}
+	return val.StatusString()
+}
+
+func (v *validatorResolver) Payee() (*accountResolver, error) {
+	return v.r.account(v.v.PayeeAddress)
+}
+
+func (v *validatorResolver) Slashings() ([]*slashingResolver, error) {
+	return v.r.slashingList(v.r.db.GetValidatorSlashings(v.v.PublicKey))
+}
+
+type epochResolver struct {
+	r *Resolver
+	e *db.Epoch
+}
+
+func (e *epochResolver) Number() Long                  { return Long(e.e.Epoch) }
+func (e *epochResolver) ParticipationPercentage() Long { return Long(e.e.ParticipationPercentage) }
+func (e *epochResolver) Finalized() bool               { return e.e.Finalized }
+func (e *epochResolver) Justified() bool               { return e.e.Justified }
+func (e *epochResolver) Randao() string                { return e.e.Randao }
+
+func (e *epochResolver) Slots() []Long {
+	slots := make([]Long, len(e.e.Slots))
+	for i, s := range e.e.Slots {
+		slots[i] = Long(s)
+	}
+	return slots
+}
+
+func (e *epochResolver) Blocks() ([]*blockResolver, error) {
+	first, last := e.e.Slots[0], e.e.Slots[len(e.e.Slots)-1]
+	return e.r.blockList(e.r.db.GetBlocksBySlot(first, last))
+}
+
+type slashingResolver struct {
+	r *Resolver
+	s *db.Slashing
+}
+
+func (s *slashingResolver) Kind() string { return strings.ToUpper(s.s.Kind) }
+
+func (s *slashingResolver) Block() (*blockResolver, error) {
+	return s.r.block(s.r.db.GetBlock(s.s.BlockHash))
+}
+
+func (s *slashingResolver) Validator() (*validatorResolver, error) {
+	if s.s.ValidatorPublicKey == "" {
+		return nil, nil
+	}
+	return s.r.validator(s.r.db.GetValidator(s.s.ValidatorPublicKey))
+}
+
+func (s *slashingResolver) Evidence() []string {
+	if s.s.Evidence2 == "" {
+		return []string{s.s.Evidence1}
+	}
+	return []string{s.s.Evidence1, s.s.Evidence2}
+}
+
+func (s *slashingResolver) Slot() *Long {
+	if s.s.Kind != "randao" {
+		return nil
+	}
+	slot := Long(s.s.Slot)
+	return &slot
+}
@@ -0,0 +1,186 @@
+package gql
+
+// schema is the GraphQL schema of the indexer. Hashes, public keys and accounts are hex encoded, accounts are the hash
+// of the account public key. Lists that grow with the chain are paginated with opaque cursors, the first argument is
+// limited to 100 items.
+const schema = `
+schema {
+	query: Query
+	subscription: Subscription
+}
+
+# A 64 bits integer.
+scalar Long
+
+type Query {
+	# The last indexed block.
+	tip: Block
+	# A block by hash or by height.
+	block(hash: String, height: Long): Block
+	# The indexed blocks, from the tip backwards.
+	blocks(first: Int, after: String): BlockConnection!
+	transaction(hash: String!): Transaction
+	account(address: String!): Account
+	# A validator by public key or by index.
+	validator(publicKey: String, index: Long): Validator
+	# The indexed validators sorted by index.
+	validators(first: Int, after: String): ValidatorConnection!
+	epoch(number: Long!): Epoch
+	# The indexed epochs, from the last epoch backwards.
+	epochs(first: Int, after: String): EpochConnection!
+	# The indexed slashings, from the last slashing backwards.
+	slashings(first: Int, after: String): SlashingConnection!
+}
+
+type Subscription {
+	# The blocks indexed from now on.
+	newBlocks: Block!
+}
+
+type PageInfo {
+	endCursor: String
+	hasNextPage: Boolean!
+}
+
+type Block {
+	hash: String!
+	height: Long!
+	slot: Long!
+	timestamp: Long!
+	version: Long!
+	nonce: Long!
+	signature: String!
+	randaoSignature: String!
+	stateRoot: String!
+	finalized: Boolean!
+	previousBlockHash: String!
+	previous: Block
+	next: Block
+	feeAccount: Account!
+	epoch: Epoch
+	transactions: [Transaction!]!
+	slashings: [Slashing!]!
+}
+
+type BlockEdge {
+	cursor: String!
+	node: Block!
+}
+
+type BlockConnection {
+	edges: [BlockEdge!]!
+	pageInfo: PageInfo!
+}
+
+enum TransactionKind {
+	SINGLE
+	MULTI
+	ASSET
+}
+
+type Transaction {
+	hash: String!
+	kind: TransactionKind!
+	block: Block!
+	from: Account!
+	to: Account!
+	# The asset transferred by asset transactions.
+	assetId: String
+	amount: Long!
+	nonce: Long!
+	fee: Long!
+}
+
+type TransactionEdge {
+	cursor: String!
+	node: Transaction!
+}
+
+type TransactionConnection {
+	edges: [TransactionEdge!]!
+	pageInfo: PageInfo!
+}
+
+type Account {
+	address: String!
+	confirmed: Long!
+	unconfirmed: Long!
+	locked: Long!
+	totalSent: Long!
+	totalReceived: Long!
+	# The transactions sent or received by the account, from the last transaction backwards.
+	transactions(first: Int, after: String): TransactionConnection!
+	# The validators that pay their rewards to the account.
+	validators: [Validator!]!
+}
+
+type Validator {
+	index: Long!
+	publicKey: String!
+	status: String!
+	exit: Boolean!
+	penalized: Boolean!
+	balance: Long!
+	payee: Account!
+	firstActiveEpoch: Long!
+	lastActiveEpoch: Long!
+	slashings: [Slashing!]!
+}
+
+type ValidatorEdge {
+	cursor: String!
+	node: Validator!
+}
+
+type ValidatorConnection {
+	edges: [ValidatorEdge!]!
+	pageInfo: PageInfo!
+}
+
+type Epoch {
+	number: Long!
+	slots: [Long!]!
+	participationPercentage: Long!
+	finalized: Boolean!
+	justified: Boolean!
+	randao: String!
+	blocks: [Block!]!
+}
+
+type EpochEdge {
+	cursor: String!
+	node: Epoch!
+}
+
+type EpochConnection {
+	edges: [EpochEdge!]!
+	pageInfo: PageInfo!
+}
+
+enum SlashingKind {
+	VOTE
+	RANDAO
+	PROPOSER
+}
+
+type Slashing {
+	kind: SlashingKind!
+	block: Block!
+	# The slashed validator, vote slashings don't include it.
+	validator: Validator
+	# The hashes of the slashable votes or block headers, or the RANDAO reveal.
+	evidence: [String!]!
+	# The slot of the revealed RANDAO of RANDAO slashings.
+	slot: Long
+}
+
+type SlashingEdge {
+	cursor: String!
+	node: Slashing!
+}
+
+type SlashingConnection {
+	edges: [SlashingEdge!]!
+	pageInfo: PageInfo!
+}
+`
@@ -0,0 +1,155 @@
+package gql
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"sync"
+
+	"github.com/graph-gophers/graphql-go"
+	"github.com/graph-gophers/graphql-go/relay"
+	"github.com/olympus-protocol/ogen/cmd/ogen/indexer/db"
+	"github.com/olympus-protocol/ogen/pkg/logger"
+	"github.com/olympus-protocol/ogen/pkg/params"
+)
+
+// subscriberQueueSize is the amount of blocks that can wait to be sent to a subscriber before new blocks are dropped.
+const subscriberQueueSize = 16
+
+// broadcaster sends the indexed blocks to the block subscriptions.
+type broadcaster struct {
+	log logger.Logger
+
+	lock        sync.Mutex
+	subscribers map[chan *db.Block]struct{}
+}
+
+// subscribe returns a channel that receives the published blocks until the context is done.
+func (b *broadcaster) subscribe(ctx context.Context) <-chan *db.Block {
+	ch := make(chan *db.Block, subscriberQueueSize)
+
+	b.lock.Lock()
+	b.subscribers[ch] = struct{}{}
+	b.lock.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		b.lock.Lock()
+		delete(b.subscribers, ch)
+		b.lock.Unlock()
+		close(ch)
+	}()
+
+	return ch
+}
+
+func (b *broadcaster) publish(block *db.Block) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	for ch := range b.subscribers {
+		select {
+		case ch <- block:
+		default:
+			b.log.Warnf("graphql subscriber queue is full, dropping block %s", block.Hash)
+		}
+	}
+}
+
+// Server serves the GraphQL API of the indexer. Queries are served over HTTP POST requests and subscriptions over
+// websockets with the graphql-ws protocol, both on the /graphql path. Queries are rejected when they are nested more
+// than maxQueryDepth levels and stop resolving fields after maxQueryComplexity fields.
+type Server struct {
+	log      logger.Logger
+	db       *db.Database
+	schema   *graphql.Schema
+	blocks   *broadcaster
+	listener net.Listener
+	server   *http.Server
+
+	// ctx is canceled when the server is closed to close the websocket connections.
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+// NewBlock sends an indexed block to the block subscriptions.
+func (s *Server) NewBlock(hash string) {
+	block, err := s.db.GetBlock(hash)
+	if err != nil {
+		s.log.Errorf("unable to load block %s for graphql subscriptions: %s", hash, err)
+		return
+	}
+	if block == nil {
+		return
+	}
+	s.blocks.publish(block)
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("Upgrade") == "websocket" {
+		s.serveWebsocket(w, r)
+		return
+	}
+	if r.Method != http.MethodPost {
+		http.Error(w, "queries must be sent with POST requests", http.StatusMethodNotAllowed)
+		return
+	}
+	h := &relay.Handler{Schema: s.schema}
+	h.ServeHTTP(w, r)
+}
+
+// Start starts serving the API.
+func (s *Server) Start() {
+	go func() {
+		err := s.server.Serve(s.listener)
+		if err != nil && err != http.ErrServerClosed {
+			s.log.Errorf("graphql server stopped: %s", err)
+		}
+	}()
+}
+
+// Close stops the server and closes the open subscriptions.
+func (s *Server) Close() error {
+	s.cancel()
+	return s.server.Close()
+}
+
+// NewServer creates a GraphQL server listening on an address.
+func NewServer(addr string, database *db.Database, log logger.Logger, netParams *params.ChainParams) (*Server, error) {
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+
+	blocks := &broadcaster{
+		log:         log,
+		subscribers: make(map[chan *db.Block]struct{}),
+	}
+
+	resolver := &Resolver{
+		db:        database,
+		netParams: netParams,
+		blocks:    blocks,
+	}
+
+	opts := []graphql.SchemaOpt{
+		graphql.UseFieldResolvers(),
+		graphql.MaxDepth(maxQueryDepth),
+		graphql.MaxParallelism(maxParallelism),
+		graphql.Tracer(complexityTracer{}),
+	}
+
+	s := &Server{
+		log:      log,
+		db:       database,
+		schema:   graphql.MustParseSchema(schema, resolver, opts...),
+		blocks:   blocks,
+		listener: listener,
+	}
+	s.ctx, s.cancel = context.WithCancel(context.Background())
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/graphql", s.serveHTTP)
+	s.server = &http.Server{Handler: mux}
+
+	return s, nil
+}
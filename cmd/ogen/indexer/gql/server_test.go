@@ -0,0 +1,268 @@
+package gql
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/olympus-protocol/ogen/cmd/ogen/indexer/db"
+	"github.com/olympus-protocol/ogen/pkg/bls"
+	"github.com/olympus-protocol/ogen/pkg/chainhash"
+	"github.com/olympus-protocol/ogen/pkg/logger"
+	"github.com/olympus-protocol/ogen/pkg/primitives"
+	testdata "github.com/olympus-protocol/ogen/test"
+	"github.com/stretchr/testify/assert"
+)
+
+// account is the receiver of the transactions of the test chain.
+var account = [20]byte{9}
+
+// newTestServer returns a server with an indexed chain of the genesis block and three blocks. Each block sends a
+// transaction to account.
+func newTestServer(t *testing.T) (*Server, []*primitives.Block) {
+	bls.Initialize(&testdata.TestParams)
+
+	dir, err := ioutil.TempDir("", "gql")
+	assert.NoError(t, err)
+
+	// The migrations are loaded relative to the repository root.
+	wd, err := os.Getwd()
+	assert.NoError(t, err)
+	assert.NoError(t, os.Chdir("../../../.."))
+
+	log := logger.New(os.Stdout).Quiet()
+	d := db.NewDB(filepath.Join(dir, "index.db"), log, new(sync.WaitGroup), "sqlite3", &testdata.TestParams)
+	s, err := NewServer("127.0.0.1:0", d, log, &testdata.TestParams)
+	assert.NoError(t, err)
+	t.Cleanup(func() {
+		_ = s.Close()
+		d.Close()
+		_ = os.Chdir(wd)
+		_ = os.RemoveAll(dir)
+	})
+	assert.NoError(t, d.Migrate())
+
+	genesis, err := d.Initialize()
+	assert.NoError(t, err)
+	parent, err := chainhash.NewHashFromStr(genesis)
+	assert.NoError(t, err)
+
+	var blocks []*primitives.Block
+	for slot := uint64(1); slot <= 3; slot++ {
+		b := &primitives.Block{
+			Header: &primitives.BlockHeader{PrevBlockHash: parent, Slot: slot, Timestamp: 1600000000 + slot, FeeAddress: [20]byte{1}},
+			Txs:    []*primitives.Tx{testTx(t, slot)},
+		}
+		assert.NoError(t, d.InsertBlock(b))
+		blocks = append(blocks, b)
+		parent = b.Hash()
+	}
+
+	return s, blocks
+}
+
+func testTx(t *testing.T, amount uint64) *primitives.Tx {
+	key, err := bls.RandKey()
+	assert.NoError(t, err)
+	tx := &primitives.Tx{To: account, Amount: amount, Nonce: 1, Fee: 1}
+	copy(tx.FromPublicKey[:], key.PublicKey().Marshal())
+	msg := tx.SignatureMessage()
+	copy(tx.Signature[:], key.Sign(msg[:]).Marshal())
+	return tx
+}
+
+// exec runs a query and decodes the data into out. It returns the messages of the query errors.
+func exec(t *testing.T, s *Server, query string, variables map[string]interface{}, out interface{}) []string {
+	res := s.schema.Exec(context.Background(), query, "", variables)
+	var errs []string
+	for _, err := range res.Errors {
+		errs = append(errs, err.Message)
+	}
+	if out != nil && len(res.Data) > 0 {
+		assert.NoError(t, json.Unmarshal(res.Data, out))
+	}
+	return errs
+}
+
+func TestResolver_Block(t *testing.T) {
+	s, blocks := newTestServer(t)
+
+	var res struct {
+		Tip struct {
+			Hash     string
+			Height   int64
+			Previous struct {
+				Hash string
+			}
+			Next         *struct{}
+			Transactions []struct {
+				Hash   string
+				Kind   string
+				Amount int64
+				To     struct {
+					Address string
+				}
+			}
+		}
+		Block struct {
+			Hash string
+			Slot int64
+		}
+	}
+	errs := exec(t, s, `{
+		tip { hash height previous { hash } next { hash } transactions { hash kind amount to { address } } }
+		block(height: 1) { hash slot }
+	}`, nil, &res)
+	assert.Empty(t, errs)
+
+	tip := blocks[2]
+	assert.Equal(t, tip.Hash().String(), res.Tip.Hash)
+	assert.Equal(t, int64(3), res.Tip.Height)
+	assert.Equal(t, blocks[1].Hash().String(), res.Tip.Previous.Hash)
+	assert.Nil(t, res.Tip.Next)
+	if assert.Len(t, res.Tip.Transactions, 1) {
+		assert.Equal(t, tip.Txs[0].Hash().String(), res.Tip.Transactions[0].Hash)
+		assert.Equal(t, "SINGLE", res.Tip.Transactions[0].Kind)
+		assert.Equal(t, int64(3), res.Tip.Transactions[0].Amount)
+		assert.Equal(t, hex.EncodeToString(account[:]), res.Tip.Transactions[0].To.Address)
+	}
+	assert.Equal(t, blocks[0].Hash().String(), res.Block.Hash)
+	assert.Equal(t, int64(1), res.Block.Slot)
+
+	errs = exec(t, s, `{ block { hash } }`, nil, nil)
+	assert.Equal(t, []string{"missing block hash or height"}, errs)
+}
+
+type connection struct {
+	Edges []struct {
+		Cursor string
+		Node   struct {
+			Hash   string
+			Height int64
+			Amount int64
+		}
+	}
+	PageInfo struct {
+		EndCursor   *string
+		HasNextPage bool
+	}
+}
+
+func TestResolver_BlocksPagination(t *testing.T) {
+	s, blocks := newTestServer(t)
+
+	query := `query ($first: Int, $after: String) {
+		blocks(first: $first, after: $after) { edges { cursor node { hash height } } pageInfo { endCursor hasNextPage } }
+	}`
+
+	var res struct{ Blocks connection }
+	errs := exec(t, s, query, map[string]interface{}{"first": 2}, &res)
+	assert.Empty(t, errs)
+	if assert.Len(t, res.Blocks.Edges, 2) {
+		assert.Equal(t, blocks[2].Hash().String(), res.Blocks.Edges[0].Node.Hash)
+		assert.Equal(t, blocks[1].Hash().String(), res.Blocks.Edges[1].Node.Hash)
+		assert.Equal(t, res.Blocks.Edges[1].Cursor, *res.Blocks.PageInfo.EndCursor)
+	}
+	assert.True(t, res.Blocks.PageInfo.HasNextPage)
+
+	// the next page continues after the end cursor and includes the genesis block.
+	var next struct{ Blocks connection }
+	errs = exec(t, s, query, map[string]interface{}{"first": 2, "after": *res.Blocks.PageInfo.EndCursor}, &next)
+	assert.Empty(t, errs)
+	if assert.Len(t, next.Blocks.Edges, 2) {
+		assert.Equal(t, blocks[0].Hash().String(), next.Blocks.Edges[0].Node.Hash)
+		assert.Equal(t, int64(0), next.Blocks.Edges[1].Node.Height)
+	}
+	assert.False(t, next.Blocks.PageInfo.HasNextPage)
+
+	// a page after the last block is empty.
+	var empty struct{ Blocks connection }
+	errs = exec(t, s, query, map[string]interface{}{"after": *next.Blocks.PageInfo.EndCursor}, &empty)
+	assert.Empty(t, errs)
+	assert.Empty(t, empty.Blocks.Edges)
+	assert.Nil(t, empty.Blocks.PageInfo.EndCursor)
+	assert.False(t, empty.Blocks.PageInfo.HasNextPage)
+
+	errs = exec(t, s, query, map[string]interface{}{"first": -1}, nil)
+	assert.Equal(t, []string{"first must be positive"}, errs)
+
+	errs = exec(t, s, query, map[string]interface{}{"after": "not a cursor"}, nil)
+	assert.Equal(t, []string{errInvalidCursor.Error()}, errs)
+}
+
+func TestResolver_AccountTransactionsPagination(t *testing.T) {
+	s, blocks := newTestServer(t)
+
+	query := `query ($address: String!, $after: String) {
+		account(address: $address) {
+			confirmed
+			transactions(first: 2, after: $after) { edges { cursor node { hash amount } } pageInfo { endCursor hasNextPage } }
+		}
+	}`
+	address := hex.EncodeToString(account[:])
+
+	var res struct {
+		Account struct {
+			Confirmed    int64
+			Transactions connection
+		}
+	}
+	errs := exec(t, s, query, map[string]interface{}{"address": address}, &res)
+	assert.Empty(t, errs)
+	assert.Equal(t, int64(6), res.Account.Confirmed)
+	if assert.Len(t, res.Account.Transactions.Edges, 2) {
+		assert.Equal(t, blocks[2].Txs[0].Hash().String(), res.Account.Transactions.Edges[0].Node.Hash)
+		assert.Equal(t, blocks[1].Txs[0].Hash().String(), res.Account.Transactions.Edges[1].Node.Hash)
+	}
+	assert.True(t, res.Account.Transactions.PageInfo.HasNextPage)
+
+	var next struct {
+		Account struct {
+			Transactions connection
+		}
+	}
+	errs = exec(t, s, query, map[string]interface{}{"address": address, "after": *res.Account.Transactions.PageInfo.EndCursor}, &next)
+	assert.Empty(t, errs)
+	if assert.Len(t, next.Account.Transactions.Edges, 1) {
+		assert.Equal(t, blocks[0].Txs[0].Hash().String(), next.Account.Transactions.Edges[0].Node.Hash)
+		assert.Equal(t, int64(1), next.Account.Transactions.Edges[0].Node.Amount)
+	}
+	assert.False(t, next.Account.Transactions.PageInfo.HasNextPage)
+}
+
+func TestServer_QueryLimits(t *testing.T) {
+	s, _ := newTestServer(t)
+
+	// every previous field adds a level to the query.
+	deep := "{ tip { " + strings.Repeat("previous { ", maxQueryDepth) + "hash" + strings.Repeat(" }", maxQueryDepth) + " } }"
+	errs := exec(t, s, deep, nil, nil)
+	if assert.Len(t, errs, 1) {
+		assert.Contains(t, errs[0], "exceeds max depth")
+	}
+
+	// 20 tips with 20 previous blocks with 20 fields each resolve more than 8000 fields.
+	aliases := func(prefix string, field string) string {
+		var b strings.Builder
+		for i := 0; i < 20; i++ {
+			b.WriteString(fmt.Sprintf(" %s%d: %s", prefix, i, field))
+		}
+		return b.String()
+	}
+	complex := "{" + aliases("t", "tip {"+aliases("p", "previous {"+aliases("f", "__typename")+" }")+" }") + " }"
+	errs = exec(t, s, complex, nil, nil)
+	if assert.NotEmpty(t, errs) {
+		assert.Equal(t, errQueryComplexity.Error(), errs[0])
+	}
+
+	var res struct{ Tip struct{ Hash string } }
+	errs = exec(t, s, "{ tip { hash } }", nil, &res)
+	assert.Empty(t, errs)
+	assert.NotEmpty(t, res.Tip.Hash)
+}
@@ -0,0 +1,193 @@
+package gql
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// Message types of the graphql-ws protocol.
+const (
+	gqlConnectionInit      = "connection_init"
+	gqlConnectionAck       = "connection_ack"
+	gqlConnectionError     = "connection_error"
+	gqlConnectionKeepAlive = "ka"
+	gqlConnectionTerminate = "connection_terminate"
+	gqlStart               = "start"
+	gqlStop                = "stop"
+	gqlData                = "data"
+	gqlError               = "error"
+	gqlComplete            = "complete"
+)
+
+// keepAliveInterval is the time between keep alive messages sent to the websocket clients.
+const keepAliveInterval = 30 * time.Second
+
+var upgrader = websocket.Upgrader{
+	Subprotocols: []string{"graphql-ws"},
+	CheckOrigin: func(r *http.Request) bool {
+		return true
+	},
+}
+
+type wsMessage struct {
+	ID      string          `json:"id,omitempty"`
+	Type    string          `json:"type"`
+	Payload json.RawMessage `json:"payload,omitempty"`
+}
+
+type wsStartPayload struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+// wsConn is a websocket connection of a client. Every operation started by the client is run until the client stops
+// it or the connection is closed.
+type wsConn struct {
+	s    *Server
+	conn *websocket.Conn
+	ctx  context.Context
+
+	writeLock sync.Mutex
+
+	opsLock sync.Mutex
+	ops     map[string]context.CancelFunc
+}
+
+func (c *wsConn) write(msg *wsMessage) error {
+	c.writeLock.Lock()
+	defer c.writeLock.Unlock()
+	return c.conn.WriteJSON(msg)
+}
+
+func (c *wsConn) writePayload(id string, msgType string, payload interface{}) error {
+	b, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	return c.write(&wsMessage{ID: id, Type: msgType, Payload: b})
+}
+
+func (c *wsConn) start(id string, payload *wsStartPayload) {
+	c.opsLock.Lock()
+	if _, ok := c.ops[id]; ok {
+		c.opsLock.Unlock()
+		_ = c.writePayload(id, gqlError, map[string]string{"message": "operation id already in use"})
+		return
+	}
+	ctx, cancel := context.WithCancel(c.ctx)
+	c.ops[id] = cancel
+	c.opsLock.Unlock()
+
+	responses, err := c.s.schema.Subscribe(ctx, payload.Query, payload.OperationName, payload.Variables)
+	if err != nil {
+		c.stop(id)
+		_ = c.writePayload(id, gqlError, map[string]string{"message": err.Error()})
+		return
+	}
+
+	go func() {
+		defer c.stop(id)
+		for {
+			select {
+			case <-ctx.Done():
+				// The responses are drained to let the schema finish the subscription.
+				go func() {
+					for range responses {
+					}
+				}()
+				return
+			case res, ok := <-responses:
+				if !ok {
+					_ = c.write(&wsMessage{ID: id, Type: gqlComplete})
+					return
+				}
+				if err := c.writePayload(id, gqlData, res); err != nil {
+					c.stop(id)
+				}
+			}
+		}
+	}()
+}
+
+func (c *wsConn) stop(id string) {
+	c.opsLock.Lock()
+	defer c.opsLock.Unlock()
+	if cancel, ok := c.ops[id]; ok {
+		cancel()
+		delete(c.ops, id)
+	}
+}
+
+func (c *wsConn) keepAlive() {
+	ticker := time.NewTicker(keepAliveInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-c.ctx.Done():
+			return
+		case <-ticker.C:
+			if err := c.write(&wsMessage{Type: gqlConnectionKeepAlive}); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// serveWebsocket runs the graphql-ws protocol on a websocket connection. Queries sent on the connection are answered
+// with a single data message.
+func (s *Server) serveWebsocket(w http.ResponseWriter, r *http.Request) {
+	conn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(s.ctx)
+	defer cancel()
+
+	c := &wsConn{
+		s:    s,
+		conn: conn,
+		ctx:  ctx,
+		ops:  make(map[string]context.CancelFunc),
+	}
+
+	// The connection is closed when the server is closed to unblock the reads.
+	go func() {
+		<-ctx.Done()
+		_ = conn.Close()
+	}()
+
+	for {
+		msg := new(wsMessage)
+		if err := conn.ReadJSON(msg); err != nil {
+			return
+		}
+
+		switch msg.Type {
+		case gqlConnectionInit:
+			if err := c.write(&wsMessage{Type: gqlConnectionAck}); err != nil {
+				return
+			}
+			go c.keepAlive()
+		case gqlStart:
+			payload := new(wsStartPayload)
+			if err := json.Unmarshal(msg.Payload, payload); err != nil {
+				_ = c.writePayload(msg.ID, gqlError, map[string]string{"message": "invalid payload"})
+				continue
+			}
+			c.start(msg.ID, payload)
+		case gqlStop:
+			c.stop(msg.ID)
+		case gqlConnectionTerminate:
+			return
+		default:
+			_ = c.writePayload(msg.ID, gqlConnectionError, map[string]string{"message": "unknown message type"})
+		}
+	}
+}
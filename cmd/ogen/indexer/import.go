@@ -0,0 +1,190 @@
+package indexer
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/olympus-protocol/ogen/internal/blockdb"
+	"github.com/olympus-protocol/ogen/pkg/chainhash"
+	"github.com/olympus-protocol/ogen/pkg/primitives"
+)
+
+// importBatchSize is the amount of blocks read from the datadir and indexed on a single database transaction.
+const importBatchSize = 256
+
+type importBatch struct {
+	blocks []*primitives.Block
+	err    error
+}
+
+// Import indexes the blocks stored on a node datadir in chain order without a running node. The blocks are read from
+// the block database by several workers and written in batches using multi-row inserts.
+func (i *Indexer) Import(backend string, datadir string, workers int) error {
+	if workers < 1 {
+		workers = 1
+	}
+
+	store, err := blockdb.OpenStore(backend, datadir)
+	if err != nil {
+		return err
+	}
+	bdb := blockdb.NewDatabaseWithStore(store)
+	defer bdb.Close()
+
+	chain, err := chainHashes(bdb)
+	if err != nil {
+		return err
+	}
+
+	firstAvailable, err := firstAvailableBlock(bdb, chain)
+	if err != nil {
+		return err
+	}
+
+	indexState, err := i.db.GetCurrentState()
+	if err != nil {
+		return err
+	}
+
+	var latestBHash string
+	if indexState.Blocks == 0 && indexState.LastBlockHash == "" {
+		if firstAvailable > 1 {
+			return fmt.Errorf("the datadir is pruned up to block %s, the blocks before it are not available to import", chain[firstAvailable])
+		}
+		latestBHash, err = i.db.Initialize()
+		if err != nil {
+			return err
+		}
+	} else {
+		latestBHash = indexState.LastBlockHash
+	}
+
+	start := -1
+	for n, h := range chain {
+		if h.String() == latestBHash {
+			start = n + 1
+			break
+		}
+	}
+	if start < 0 {
+		return errors.New("the last indexed block is not part of the datadir chain")
+	}
+	if start < firstAvailable {
+		return fmt.Errorf("the datadir is pruned up to block %s, the blocks after the last indexed block are not available to import", chain[firstAvailable])
+	}
+	chain = chain[start:]
+
+	i.log.Infof("Importing %d blocks from %s", len(chain), datadir)
+
+	done := make(chan struct{})
+	defer close(done)
+
+	batches := make(chan *importBatch, 1)
+	go func() {
+		defer close(batches)
+		for n := 0; n < len(chain); n += importBatchSize {
+			end := n + importBatchSize
+			if end > len(chain) {
+				end = len(chain)
+			}
+			select {
+			case batches <- readBlocks(bdb, chain[n:end], workers):
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	imported := 0
+	for batch := range batches {
+		if batch.err != nil {
+			return batch.err
+		}
+		select {
+		case <-i.ctx.Done():
+			return i.ctx.Err()
+		default:
+		}
+		err := i.db.ImportBlocks(batch.blocks)
+		if err != nil {
+			return err
+		}
+		imported += len(batch.blocks)
+		i.log.Infof("Imported %d/%d blocks", imported, len(chain))
+	}
+
+	i.log.Infof("Import finished, parsed %d blocks", imported)
+	return nil
+}
+
+// chainHashes returns the hashes of the datadir main chain from the genesis block to the tip.
+func chainHashes(bdb blockdb.Database) ([]chainhash.Hash, error) {
+	h, err := bdb.GetTip()
+	if err != nil {
+		return nil, err
+	}
+
+	var hashes []chainhash.Hash
+	for {
+		row, err := bdb.GetBlockRow(h)
+		if err != nil {
+			return nil, err
+		}
+		hashes = append(hashes, h)
+		if row.Height == 0 {
+			break
+		}
+		h = row.Parent
+	}
+
+	for a, b := 0, len(hashes)-1; a < b; a, b = a+1, b-1 {
+		hashes[a], hashes[b] = hashes[b], hashes[a]
+	}
+	return hashes, nil
+}
+
+// firstAvailableBlock returns the position on the chain of the first block with a stored body. Block bodies before
+// the pruned head are removed when the datadir is pruned.
+func firstAvailableBlock(bdb blockdb.Database, chain []chainhash.Hash) (int, error) {
+	prunedHead, err := bdb.GetPrunedHead()
+	if err != nil {
+		if errors.Is(err, blockdb.ErrNotFound) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	for n, h := range chain {
+		if h == prunedHead {
+			return n, nil
+		}
+	}
+	return 0, fmt.Errorf("the pruned head %s is not part of the datadir chain", prunedHead)
+}
+
+// readBlocks reads the blocks with several workers keeping the order of the hashes.
+func readBlocks(bdb blockdb.Database, hashes []chainhash.Hash, workers int) *importBatch {
+	batch := &importBatch{blocks: make([]*primitives.Block, len(hashes))}
+
+	var wg sync.WaitGroup
+	var errLock sync.Mutex
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for n := w; n < len(hashes); n += workers {
+				b, err := bdb.GetBlock(hashes[n])
+				if err != nil {
+					errLock.Lock()
+					batch.err = err
+					errLock.Unlock()
+					return
+				}
+				batch.blocks[n] = b
+			}
+		}(w)
+	}
+	wg.Wait()
+
+	return batch
+}
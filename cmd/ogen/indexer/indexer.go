@@ -3,15 +3,32 @@ package indexer
 import (
 	"context"
 	"encoding/hex"
+	"errors"
+	"fmt"
 	"github.com/olympus-protocol/ogen/api/proto"
 	"github.com/olympus-protocol/ogen/cmd/ogen/indexer/db"
+	"github.com/olympus-protocol/ogen/cmd/ogen/indexer/gql"
 	"github.com/olympus-protocol/ogen/pkg/logger"
 	"github.com/olympus-protocol/ogen/pkg/params"
 	"github.com/olympus-protocol/ogen/pkg/primitives"
 	"github.com/olympus-protocol/ogen/pkg/rpcclient"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"io"
 	"os"
 	"sync"
+	"time"
+)
+
+// errUnknownStart is returned by sync when the node doesn't know the last indexed block.
+var errUnknownStart = errors.New("the last indexed block is not part of the node chain")
+
+const (
+	// receiptsAttempts is the amount of times the receipts of a block are requested before the block insert fails.
+	receiptsAttempts = 5
+
+	// receiptsRetryDelay is the time to wait between receipt requests.
+	receiptsRetryDelay = time.Second
 )
 
 // Indexer is the module that allows operations across multiple services.
@@ -23,6 +40,9 @@ type Indexer struct {
 	db        *db.Database
 	canClose  *sync.WaitGroup
 	netParams *params.ChainParams
+
+	// graphql is the GraphQL API server, nil if the API is disabled.
+	graphql *gql.Server
 }
 
 func (i *Indexer) Start() {
@@ -62,7 +82,7 @@ func (i *Indexer) subscribeBlocks() {
 				i.log.Errorf("unable to parse error %s", err.Error())
 				continue
 			}
-			err = i.db.InsertBlock(block)
+			err = i.insertBlock(block)
 			if err != nil {
 				if err == db.ErrorPrevBlockHash {
 					i.log.Error(db.ErrorPrevBlockHash)
@@ -71,9 +91,15 @@ func (i *Indexer) subscribeBlocks() {
 					continue
 				}
 				i.log.Errorf("unable to insert error %s", err.Error())
+				i.log.Info("Restarting sync...")
+				i.initialSync()
 				continue
 			}
 			i.log.Infof("Received new block %s", block.Hash().String())
+			i.markFinalized()
+			if i.graphql != nil {
+				i.graphql.NewBlock(block.Hash().String())
+			}
 		}
 	}
 }
@@ -119,8 +145,77 @@ func (i *Indexer) mempoolSync() {
 	}
 }
 
+// initialSync indexes the blocks the node has after the last indexed block. If the last indexed block was orphaned, the
+// indexed blocks after the last block that is part of the node chain are rolled back before the sync continues.
 func (i *Indexer) initialSync() {
+	i.log.Infof("Starting initial sync...")
+
+	blockCount := 0
+	for {
+		n, err := i.sync()
+		blockCount += n
+		if err == nil {
+			break
+		}
+		if err != errUnknownStart && err != db.ErrorPrevBlockHash {
+			i.log.Error(err)
+			break
+		}
+
+		fork, err := i.forkPoint()
+		if err != nil {
+			i.log.Errorf("unable to find the fork point: %s", err)
+			break
+		}
+
+		i.log.Warnf("Indexed chain is not part of the node chain, rolling back to block %s...", fork)
+		err = i.db.RollbackTo(fork)
+		if err != nil {
+			i.log.Errorf("unable to rollback blocks: %s", err)
+			break
+		}
+	}
+
+	i.markFinalized()
+	i.log.Infof("Initial sync finished, parsed %d blocks", blockCount)
+}
+
+// forkPoint returns the hash of the last indexed block that is part of the node chain.
+func (i *Indexer) forkPoint() (string, error) {
+	tip, err := i.db.GetTip()
+	if err != nil {
+		return "", err
+	}
+	if tip == nil {
+		return "", errors.New("there are no indexed blocks")
+	}
 
+	for height := tip.Height; height >= 0; height-- {
+		block, err := i.db.GetBlockByHeight(height)
+		if err != nil {
+			return "", err
+		}
+		if block == nil {
+			return "", fmt.Errorf("there is no indexed block at height %d", height)
+		}
+
+		res, err := i.client.Chain().GetBlockHash(i.ctx, &proto.Number{Number: uint64(height)})
+		if err != nil && status.Code(err) != codes.NotFound {
+			return "", err
+		}
+		if err == nil && res.Hash == block.Hash {
+			return block.Hash, nil
+		}
+		if block.Finalized {
+			return "", db.ErrorFinalizedRollback
+		}
+	}
+
+	return "", errors.New("no indexed block is part of the node chain")
+}
+
+// sync requests the blocks after the last indexed block and returns the amount of blocks indexed.
+func (i *Indexer) sync() (int, error) {
 	// get the saved state
 	indexState, err := i.db.GetCurrentState()
 	if err != nil {
@@ -133,17 +228,16 @@ func (i *Indexer) initialSync() {
 		latestBHash, err = i.db.Initialize()
 		if err != nil {
 			i.log.Fatal("unable to initialize database")
-			return
+			return 0, err
 		}
 	} else {
 		latestBHash = indexState.LastBlockHash
 	}
 
-	i.log.Infof("Starting initial sync...")
 	syncClient, err := i.client.Chain().Sync(context.Background(), &proto.Hash{Hash: latestBHash})
 	if err != nil {
 		i.log.Fatal("unable to initialize initial sync")
-		return
+		return 0, err
 	}
 
 	blockCount := 0
@@ -152,34 +246,113 @@ func (i *Indexer) initialSync() {
 		if err != nil {
 			if err == io.EOF {
 				_ = syncClient.CloseSend()
-				break
+				return blockCount, nil
 			}
-			i.log.Error(err)
-			break
+			if blockCount == 0 && status.Code(err) == codes.NotFound {
+				return blockCount, errUnknownStart
+			}
+			return blockCount, err
 		}
 		blockBytes, err := hex.DecodeString(res.Data)
 		if err != nil {
-			i.log.Error("unable to parse block")
-			break
+			return blockCount, errors.New("unable to parse block")
 		}
 		block := new(primitives.Block)
 		err = block.Unmarshal(blockBytes)
 		if err != nil {
-			i.log.Error("unable to parse block")
-			break
+			return blockCount, errors.New("unable to parse block")
 		}
-		err = i.db.InsertBlock(block)
+		err = i.insertBlock(block)
 		if err != nil {
-			i.log.Error("unable to insert block")
+			if err == db.ErrorPrevBlockHash && blockCount == 0 {
+				return blockCount, err
+			}
+			return blockCount, fmt.Errorf("unable to insert block: %s", err)
+		}
+		blockCount++
+	}
+}
+
+// insertBlock indexes a block with the receipts of the epoch transitions processed before it and the receipts of its
+// contract transactions. The receipts requests are retried and the block is not indexed if the node is unable to
+// calculate them.
+func (i *Indexer) insertBlock(block *primitives.Block) error {
+	hash := block.Hash().String()
+
+	var receipts *proto.BlockReceipts
+	err := i.requestReceipts(hash, func() (err error) {
+		receipts, err = i.client.Chain().GetBlockReceipts(i.ctx, &proto.Hash{Hash: hash})
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	var contracts *proto.ContractReceipts
+	if len(block.ContractDeploys)+len(block.ContractCalls) > 0 {
+		err = i.requestReceipts(hash, func() (err error) {
+			contracts, err = i.client.Chain().GetContractReceipts(i.ctx, &proto.Hash{Hash: hash})
+			return err
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return i.db.InsertBlockWithReceipts(block, receipts, contracts)
+}
+
+// requestReceipts runs a receipts request of a block up to receiptsAttempts times.
+func (i *Indexer) requestReceipts(hash string, request func() error) error {
+	var err error
+	for attempt := 1; ; attempt++ {
+		err = request()
+		if err == nil {
+			return nil
+		}
+		if attempt == receiptsAttempts {
 			break
-		} else {
-			blockCount++
+		}
+		i.log.Warnf("unable to get receipts for block %s, retrying: %s", hash, err)
+		select {
+		case <-i.ctx.Done():
+			return i.ctx.Err()
+		case <-time.After(receiptsRetryDelay):
 		}
 	}
-	i.log.Infof("Initial sync finished, parsed %d blocks", blockCount)
+	return fmt.Errorf("unable to get receipts for block %s: %s", hash, err)
+}
+
+// markFinalized marks the indexed blocks finalized by the node.
+func (i *Indexer) markFinalized() {
+	info, err := i.client.Chain().GetChainInfo(i.ctx, &proto.Empty{})
+	if err != nil {
+		i.log.Errorf("unable to get chain info: %s", err)
+		return
+	}
+	err = i.db.MarkFinalized(info)
+	if err != nil {
+		i.log.Errorf("unable to mark finalized blocks: %s", err)
+	}
+}
+
+// ServeGraphQL starts serving the GraphQL API on an address. The new blocks received from the node are sent to the
+// GraphQL subscriptions.
+func (i *Indexer) ServeGraphQL(addr string) error {
+	server, err := gql.NewServer(addr, i.db, i.log, i.netParams)
+	if err != nil {
+		return err
+	}
+	server.Start()
+	i.graphql = server
+	i.log.Infof("Serving GraphQL API on %s/graphql", addr)
+	return nil
 }
 
 func (i *Indexer) Close() {
+	if i.graphql != nil {
+		_ = i.graphql.Close()
+	}
 	i.db.Close()
 }
 
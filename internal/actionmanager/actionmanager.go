@@ -71,7 +71,7 @@ func (l *lastActionManager) ProposerSlashingConditionViolated(*primitives.Propos
 // NewLastActionManager creates a new last action manager.
 func NewLastActionManager(node hostnode.HostNode, ch chain.Blockchain) (LastActionManager, error) {
 	ctx := config.GlobalParams.Context
-	log := config.GlobalParams.Logger
+	log := config.GlobalParams.Logger.Module("actionmanager")
 	netParams := config.GlobalParams.NetParams
 
 	l := &lastActionManager{
@@ -0,0 +1,89 @@
+package blockdb
+
+type batchOp struct {
+	key    []byte
+	value  []byte
+	delete bool
+}
+
+// Batch is a set of writes applied atomically to a store.
+type Batch struct {
+	ops     []batchOp
+	pending map[string]*batchOp
+}
+
+// NewBatch creates an empty batch.
+func NewBatch() *Batch {
+	return &Batch{
+		pending: make(map[string]*batchOp),
+	}
+}
+
+// Put adds a key write to the batch.
+func (b *Batch) Put(key []byte, value []byte) {
+	op := batchOp{key: copyBytes(key), value: copyBytes(value)}
+	b.ops = append(b.ops, op)
+	b.pending[string(key)] = &op
+}
+
+// Delete adds a key removal to the batch.
+func (b *Batch) Delete(key []byte) {
+	op := batchOp{key: copyBytes(key), delete: true}
+	b.ops = append(b.ops, op)
+	b.pending[string(key)] = &op
+}
+
+// Len returns the amount of writes in the batch.
+func (b *Batch) Len() int {
+	return len(b.ops)
+}
+
+func copyBytes(b []byte) []byte {
+	out := make([]byte, len(b))
+	copy(out, b)
+	return out
+}
+
+// batchStore is a store that reads from the underlying store and keeps the writes in a batch until they are written.
+type batchStore struct {
+	store Store
+	batch *Batch
+}
+
+// Get returns the value for a key, including the writes pending on the batch.
+func (s *batchStore) Get(key []byte) ([]byte, error) {
+	if op, ok := s.batch.pending[string(key)]; ok {
+		if op.delete {
+			return nil, ErrNotFound
+		}
+		return copyBytes(op.value), nil
+	}
+	return s.store.Get(key)
+}
+
+// Put stores a value for a key on the batch.
+func (s *batchStore) Put(key []byte, value []byte) error {
+	s.batch.Put(key, value)
+	return nil
+}
+
+// Delete removes a key on the batch.
+func (s *batchStore) Delete(key []byte) error {
+	s.batch.Delete(key)
+	return nil
+}
+
+// Iterate calls fn for the keys of the underlying store. Pending writes are not included.
+func (s *batchStore) Iterate(prefix []byte, fn func(key []byte, value []byte) error) error {
+	return s.store.Iterate(prefix, fn)
+}
+
+// Write writes a batch on the underlying store.
+func (s *batchStore) Write(b *Batch) error {
+	return s.store.Write(b)
+}
+
+// Close does nothing, the underlying store is closed by its owner.
+func (s *batchStore) Close() error {
+	return nil
+}
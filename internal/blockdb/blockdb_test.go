@@ -0,0 +1,216 @@
+package blockdb_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/olympus-protocol/ogen/internal/blockdb"
+	"github.com/olympus-protocol/ogen/internal/execution"
+	"github.com/olympus-protocol/ogen/pkg/bitfield"
+	"github.com/olympus-protocol/ogen/pkg/chainhash"
+	"github.com/olympus-protocol/ogen/pkg/primitives"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDatabase_Memory(t *testing.T) {
+	db := blockdb.NewMemoryDatabase()
+	defer db.Close()
+
+	_, err := db.GetTip()
+	assert.Equal(t, blockdb.ErrNotFound, err)
+
+	tip := chainhash.Hash{1, 2, 3}
+	assert.NoError(t, db.SetTip(tip))
+
+	got, err := db.GetTip()
+	assert.NoError(t, err)
+	assert.Equal(t, tip, got)
+
+	row := &primitives.BlockNodeDisk{
+		StateRoot: chainhash.Hash{4},
+		Height:    10,
+		Slot:      12,
+		Children:  [][32]byte{{5}, {6}},
+		Hash:      chainhash.Hash{7},
+		Parent:    chainhash.Hash{8},
+	}
+	assert.NoError(t, db.SetBlockRow(row))
+
+	gotRow, err := db.GetBlockRow(row.Hash)
+	assert.NoError(t, err)
+	assert.Equal(t, row, gotRow)
+}
+
+func TestMigrate(t *testing.T) {
+	dir, err := ioutil.TempDir("", "blockdb")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	for _, backend := range []string{blockdb.BackendLevelDB, blockdb.BackendBolt} {
+		from := blockdb.NewMemoryStore()
+		for i := byte(0); i < 100; i++ {
+			assert.NoError(t, from.Put([]byte{i}, []byte{i, i}))
+		}
+
+		to, err := blockdb.OpenStore(backend, dir)
+		assert.NoError(t, err)
+
+		copied, err := blockdb.Migrate(from, to)
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(100), copied)
+
+		for i := byte(0); i < 100; i++ {
+			v, err := to.Get([]byte{i})
+			assert.NoError(t, err)
+			assert.Equal(t, []byte{i, i}, v)
+		}
+
+		_, err = to.Get([]byte{100})
+		assert.Equal(t, blockdb.ErrNotFound, err)
+
+		assert.NoError(t, to.Close())
+	}
+}
+
+func TestDatabase_Update(t *testing.T) {
+	db := blockdb.NewMemoryDatabase()
+	defer db.Close()
+
+	tip := chainhash.Hash{1}
+	head := chainhash.Hash{2}
+
+	err := db.Update(func(tx blockdb.Database) error {
+		assert.NoError(t, tx.SetTip(tip))
+
+		got, err := tx.GetTip()
+		assert.NoError(t, err)
+		assert.Equal(t, tip, got)
+
+		_, err = db.GetTip()
+		assert.Equal(t, blockdb.ErrNotFound, err)
+
+		return errors.New("abort")
+	})
+	assert.Error(t, err)
+
+	_, err = db.GetTip()
+	assert.Equal(t, blockdb.ErrNotFound, err)
+
+	err = db.Update(func(tx blockdb.Database) error {
+		if err := tx.SetTip(tip); err != nil {
+			return err
+		}
+		return tx.SetJustifiedHead(head)
+	})
+	assert.NoError(t, err)
+
+	got, err := db.GetTip()
+	assert.NoError(t, err)
+	assert.Equal(t, tip, got)
+
+	got, err = db.GetJustifiedHead()
+	assert.NoError(t, err)
+	assert.Equal(t, head, got)
+}
+
+func testVote(slot uint64) *primitives.MultiValidatorVote {
+	return &primitives.MultiValidatorVote{
+		Data: &primitives.VoteData{
+			Slot:            slot,
+			FromEpoch:       1,
+			FromHash:        [32]byte{1},
+			ToEpoch:         2,
+			ToHash:          [32]byte{2},
+			BeaconBlockHash: [32]byte{byte(slot)},
+		},
+		Sig:                   [96]byte{byte(slot)},
+		ParticipationBitfield: bitfield.NewBitlist(8),
+	}
+}
+
+func TestDatabase_LatestVotesRestart(t *testing.T) {
+	for _, backend := range []string{blockdb.BackendLevelDB, blockdb.BackendBolt} {
+		dir, err := ioutil.TempDir("", "blockdb")
+		assert.NoError(t, err)
+
+		db, err := blockdb.NewDatabase(backend, dir)
+		assert.NoError(t, err)
+
+		first, second := testVote(1), testVote(2)
+		assert.NoError(t, db.Update(func(tx blockdb.Database) error {
+			if err := tx.SetLatestVotes([]uint64{0, 1, 2}, first); err != nil {
+				return err
+			}
+			return tx.SetLatestVotes([]uint64{2, 3}, second)
+		}))
+		db.Close()
+
+		db, err = blockdb.NewDatabase(backend, dir)
+		assert.NoError(t, err)
+
+		votes, err := db.GetLatestVotes()
+		assert.NoError(t, err)
+		assert.Equal(t, map[uint64]*primitives.MultiValidatorVote{
+			0: first,
+			1: first,
+			2: second,
+			3: second,
+		}, votes)
+		assert.Same(t, votes[0], votes[1])
+
+		// once no validator points at the first vote it is removed.
+		assert.NoError(t, db.SetLatestVotes([]uint64{0, 1}, second))
+		assert.NoError(t, db.DeleteUnusedVotes())
+
+		votes, err = db.GetLatestVotes()
+		assert.NoError(t, err)
+		assert.Len(t, votes, 4)
+		for _, vote := range votes {
+			assert.Equal(t, second, vote)
+		}
+		db.Close()
+
+		os.RemoveAll(dir)
+	}
+}
+
+func TestDatabase_LatestVotesLegacy(t *testing.T) {
+	store := blockdb.NewMemoryStore()
+	db := blockdb.NewDatabaseWithStore(store)
+	defer db.Close()
+
+	legacy, current := testVote(1), testVote(2)
+	buf, err := legacy.Marshal()
+	assert.NoError(t, err)
+	assert.NoError(t, store.Put(append([]byte("latest-vote-"), 0, 0, 0, 0, 0, 0, 0, 5), buf))
+	assert.NoError(t, db.SetLatestVotes([]uint64{6}, current))
+
+	votes, err := db.GetLatestVotes()
+	assert.NoError(t, err)
+	assert.Equal(t, map[uint64]*primitives.MultiValidatorVote{5: legacy, 6: current}, votes)
+}
+
+func TestDatabase_ContractReceipts(t *testing.T) {
+	db := blockdb.NewMemoryDatabase()
+	defer db.Close()
+
+	block := chainhash.Hash{1}
+	_, err := db.GetContractReceipts(block)
+	assert.Equal(t, blockdb.ErrNotFound, err)
+
+	receipts := []*execution.Receipt{
+		{TxHash: chainhash.Hash{2}, Contract: [20]byte{3}, GasUsed: 21000, Return: chainhash.Hash{4}},
+		{TxHash: chainhash.Hash{5}, Contract: [20]byte{6}, GasUsed: 100, Err: errors.New("out of gas")},
+	}
+	assert.NoError(t, db.SetContractReceipts(block, receipts))
+
+	got, err := db.GetContractReceipts(block)
+	assert.NoError(t, err)
+	assert.Equal(t, receipts, got)
+
+	assert.NoError(t, db.DeleteContractReceipts(block))
+	_, err = db.GetContractReceipts(block)
+	assert.Equal(t, blockdb.ErrNotFound, err)
+}
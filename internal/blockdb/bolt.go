@@ -0,0 +1,101 @@
+package blockdb
+
+import (
+	"bytes"
+
+	"go.etcd.io/bbolt"
+)
+
+// chainBucket is the bucket that holds all the chain keys.
+var chainBucket = []byte("chain")
+
+type boltStore struct {
+	db *bbolt.DB
+}
+
+// NewBoltStore opens a bbolt store on the selected file path.
+func NewBoltStore(path string) (Store, error) {
+	db, err := bbolt.Open(path, 0600, nil)
+	if err != nil {
+		return nil, err
+	}
+	err = db.Update(func(tx *bbolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(chainBucket)
+		return err
+	})
+	if err != nil {
+		_ = db.Close()
+		return nil, err
+	}
+	return &boltStore{db: db}, nil
+}
+
+// Get returns the value stored for a key.
+func (b *boltStore) Get(key []byte) ([]byte, error) {
+	var out []byte
+	err := b.db.View(func(tx *bbolt.Tx) error {
+		v := tx.Bucket(chainBucket).Get(key)
+		if v == nil {
+			return ErrNotFound
+		}
+		// bbolt values are only valid during the transaction.
+		out = make([]byte, len(v))
+		copy(out, v)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Put stores a value for a key.
+func (b *boltStore) Put(key []byte, value []byte) error {
+	return b.db.Update(func(tx *bbolt.Tx) error {
+		return tx.Bucket(chainBucket).Put(key, value)
+	})
+}
+
+// Delete removes a key from the store.
+func (b *boltStore) Delete(key []byte) error {
+	return b.db.Update(func(tx *bbolt.Tx) error {
+		return tx.Bucket(chainBucket).Delete(key)
+	})
+}
+
+// Write applies all the batch writes atomically.
+func (b *boltStore) Write(batch *Batch) error {
+	return b.db.Update(func(tx *bbolt.Tx) error {
+		bkt := tx.Bucket(chainBucket)
+		for _, op := range batch.ops {
+			if op.delete {
+				if err := bkt.Delete(op.key); err != nil {
+					return err
+				}
+				continue
+			}
+			if err := bkt.Put(op.key, op.value); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// Iterate calls fn for every key-value pair starting with prefix in key order.
+func (b *boltStore) Iterate(prefix []byte, fn func(key []byte, value []byte) error) error {
+	return b.db.View(func(tx *bbolt.Tx) error {
+		c := tx.Bucket(chainBucket).Cursor()
+		for k, v := c.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); k, v = c.Next() {
+			if err := fn(k, v); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// Close closes the store.
+func (b *boltStore) Close() error {
+	return b.db.Close()
+}
@@ -0,0 +1,506 @@
+package blockdb
+
+import (
+	"encoding/binary"
+	"errors"
+	"github.com/olympus-protocol/ogen/internal/execution"
+	"github.com/olympus-protocol/ogen/internal/state"
+	"github.com/olympus-protocol/ogen/pkg/chainhash"
+	"github.com/olympus-protocol/ogen/pkg/primitives"
+	"sync"
+	"time"
+)
+
+type blockDB struct {
+	store    Store
+	canClose sync.WaitGroup
+}
+
+// NewDatabase returns a database instance using the selected backend stored on the datapath.
+func NewDatabase(backend string, datapath string) (Database, error) {
+	store, err := OpenStore(backend, datapath)
+	if err != nil {
+		return nil, err
+	}
+	return NewDatabaseWithStore(store), nil
+}
+
+// NewDatabaseWithStore returns a database instance on top of an already opened store.
+func NewDatabaseWithStore(store Store) Database {
+	return &blockDB{
+		store: store,
+	}
+}
+
+// NewMemoryDatabase returns a database instance that keeps all the information in memory.
+func NewMemoryDatabase() Database {
+	return NewDatabaseWithStore(NewMemoryStore())
+}
+
+// Update runs fn with a database that keeps every write in a batch. The batch is written atomically when fn returns
+// without errors, otherwise all the writes are discarded.
+func (db *blockDB) Update(fn func(tx Database) error) error {
+	batch := NewBatch()
+	tx := &blockDB{
+		store: &batchStore{store: db.store, batch: batch},
+	}
+	if err := fn(tx); err != nil {
+		return err
+	}
+	if batch.Len() == 0 {
+		return nil
+	}
+	db.canClose.Add(1)
+	defer db.canClose.Done()
+	return db.store.Write(batch)
+}
+
+// Close closes the database.
+func (db *blockDB) Close() {
+	db.canClose.Wait()
+	_ = db.store.Close()
+}
+
+// GetBlock gets a block from the database.
+func (db *blockDB) GetBlock(hash chainhash.Hash) (*primitives.Block, error) {
+	blockBytes, err := db.getKey(hash[:])
+	if err != nil {
+		return nil, err
+	}
+
+	block := new(primitives.Block)
+	err = block.Unmarshal(blockBytes)
+	return block, err
+}
+
+// GetRawBlock gets a block serialized from the database.
+func (db *blockDB) GetRawBlock(hash chainhash.Hash) ([]byte, error) {
+	blockBytes, err := db.getKey(hash[:])
+	if err != nil {
+		return nil, err
+	}
+	return blockBytes, err
+}
+
+// AddRawBlock adds a raw block to the database.
+func (db *blockDB) AddRawBlock(block *primitives.Block) error {
+	blockHash := block.Hash()
+	blockBytes, err := block.Marshal()
+	if err != nil {
+		return err
+	}
+	return db.setKey(blockHash[:], blockBytes)
+}
+
+// DeleteBlock removes a block from the database.
+func (db *blockDB) DeleteBlock(hash chainhash.Hash) error {
+	return db.deleteKey(hash[:])
+}
+
+// SetTip sets the current best tip of the blockchain.
+func (db *blockDB) SetTip(c chainhash.Hash) error {
+	return db.setKeyHash(tipKey, c)
+}
+
+// GetTip gets the current best tip of the blockchain.
+func (db *blockDB) GetTip() (chainhash.Hash, error) {
+	return db.getKeyHash(tipKey)
+}
+
+// SetFinalizedState sets the finalized state of the blockchain.
+func (db *blockDB) SetFinalizedState(s state.State) error {
+	buf, err := s.Marshal()
+	if err != nil {
+		return err
+	}
+
+	return db.setKey(finStateKey, buf)
+}
+
+// GetFinalizedState gets the finalized state of the blockchain.
+func (db *blockDB) GetFinalizedState() (state.State, error) {
+	stateBytes, err := db.getKey(finStateKey)
+	if err != nil {
+		return nil, err
+	}
+	s := state.NewEmptyState()
+	err = s.Unmarshal(stateBytes)
+	return s, err
+}
+
+// SetJustifiedState sets the justified state of the blockchain.
+func (db *blockDB) SetJustifiedState(s state.State) error {
+	buf, err := s.Marshal()
+	if err != nil {
+		return err
+	}
+
+	return db.setKey(jusStateKey, buf)
+}
+
+// GetJustifiedState gets the justified state of the blockchain.
+func (db *blockDB) GetJustifiedState() (state.State, error) {
+	stateBytes, err := db.getKey(jusStateKey)
+	if err != nil {
+		return nil, err
+	}
+	s := state.NewEmptyState()
+	err = s.Unmarshal(stateBytes)
+	return s, err
+}
+
+// SetBlockRow sets a block row on disk to store the block index.
+func (db *blockDB) SetBlockRow(disk *primitives.BlockNodeDisk) error {
+	key := append(blockRowPrefix, disk.Hash[:]...)
+	diskSer, err := disk.Marshal()
+	if err != nil {
+		return err
+	}
+	return db.setKey(key, diskSer)
+}
+
+// GetBlockRow gets the block row on disk.
+func (db *blockDB) GetBlockRow(c chainhash.Hash) (*primitives.BlockNodeDisk, error) {
+	key := append(blockRowPrefix, c[:]...)
+	diskSer, err := db.getKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	d := new(primitives.BlockNodeDisk)
+	err = d.Unmarshal(diskSer)
+	return d, err
+}
+
+// DeleteBlockRow removes a block row from the database.
+func (db *blockDB) DeleteBlockRow(c chainhash.Hash) error {
+	key := append(blockRowPrefix, c[:]...)
+	return db.deleteKey(key)
+}
+
+// SetJustifiedHead sets the latest justified head.
+func (db *blockDB) SetJustifiedHead(c chainhash.Hash) error {
+	return db.setKeyHash(jusHeadKey, c)
+}
+
+// GetJustifiedHead gets the latest justified head.
+func (db *blockDB) GetJustifiedHead() (chainhash.Hash, error) {
+	return db.getKeyHash(jusHeadKey)
+}
+
+// SetFinalizedHead sets the finalized head of the blockchain.
+func (db *blockDB) SetFinalizedHead(c chainhash.Hash) error {
+	return db.setKeyHash(finHeadKey, c)
+}
+
+// GetFinalizedHead gets the finalized head of the blockchain.
+func (db *blockDB) GetFinalizedHead() (chainhash.Hash, error) {
+	return db.getKeyHash(finHeadKey)
+}
+
+// SetPrunedHead sets the last block row kept after pruning the blockchain.
+func (db *blockDB) SetPrunedHead(c chainhash.Hash) error {
+	return db.setKeyHash(prunedKey, c)
+}
+
+// GetPrunedHead gets the last block row kept after pruning the blockchain.
+func (db *blockDB) GetPrunedHead() (chainhash.Hash, error) {
+	return db.getKeyHash(prunedKey)
+}
+
+// SetState stores the state after processing the block with the provided hash.
+func (db *blockDB) SetState(c chainhash.Hash, s state.State) error {
+	buf, err := s.Marshal()
+	if err != nil {
+		return err
+	}
+	key := append(statePrefix, c[:]...)
+	return db.setKey(key, buf)
+}
+
+// GetState gets the state stored for the block with the provided hash.
+func (db *blockDB) GetState(c chainhash.Hash) (state.State, error) {
+	key := append(statePrefix, c[:]...)
+	stateBytes, err := db.getKey(key)
+	if err != nil {
+		return nil, err
+	}
+	s := state.NewEmptyState()
+	err = s.Unmarshal(stateBytes)
+	return s, err
+}
+
+// SetTreeState stores the state of a block that is not finalized yet.
+func (db *blockDB) SetTreeState(c chainhash.Hash, s state.State) error {
+	buf, err := s.Marshal()
+	if err != nil {
+		return err
+	}
+	key := append(treeStatePrefix, c[:]...)
+	return db.setKey(key, buf)
+}
+
+// GetTreeState gets the state of a block that is not finalized yet.
+func (db *blockDB) GetTreeState(c chainhash.Hash) (state.State, error) {
+	key := append(treeStatePrefix, c[:]...)
+	stateBytes, err := db.getKey(key)
+	if err != nil {
+		return nil, err
+	}
+	s := state.NewEmptyState()
+	err = s.Unmarshal(stateBytes)
+	return s, err
+}
+
+// DeleteTreeState removes the state of a block from the tree states.
+func (db *blockDB) DeleteTreeState(c chainhash.Hash) error {
+	key := append(treeStatePrefix, c[:]...)
+	return db.deleteKey(key)
+}
+
+// SetLatestVotes sets the vote as the latest vote for the validators. The vote is stored once by hash and every
+// validator keeps the hash of its latest vote.
+func (db *blockDB) SetLatestVotes(validators []uint64, vote *primitives.MultiValidatorVote) error {
+	if len(validators) == 0 {
+		return nil
+	}
+	buf, err := vote.Marshal()
+	if err != nil {
+		return err
+	}
+	hash := chainhash.HashH(buf)
+	if err := db.setKey(voteKey(hash), buf); err != nil {
+		return err
+	}
+	for _, v := range validators {
+		if err := db.setKeyHash(latestVoteKey(v), hash); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetLatestVotes gets the latest vote of every validator.
+func (db *blockDB) GetLatestVotes() (map[uint64]*primitives.MultiValidatorVote, error) {
+	index, legacy, err := db.latestVoteIndex()
+	if err != nil {
+		return nil, err
+	}
+
+	votes := make(map[uint64]*primitives.MultiValidatorVote, len(index)+len(legacy))
+	for v, vote := range legacy {
+		votes[v] = vote
+	}
+	loaded := make(map[chainhash.Hash]*primitives.MultiValidatorVote)
+	for v, hash := range index {
+		vote, ok := loaded[hash]
+		if !ok {
+			buf, err := db.getKey(voteKey(hash))
+			if err != nil {
+				return nil, err
+			}
+			vote = new(primitives.MultiValidatorVote)
+			if err := vote.Unmarshal(buf); err != nil {
+				return nil, err
+			}
+			loaded[hash] = vote
+		}
+		votes[v] = vote
+	}
+	return votes, nil
+}
+
+// DeleteUnusedVotes removes the stored votes that are no longer the latest vote of any validator.
+func (db *blockDB) DeleteUnusedVotes() error {
+	index, _, err := db.latestVoteIndex()
+	if err != nil {
+		return err
+	}
+	used := make(map[chainhash.Hash]struct{}, len(index))
+	for _, hash := range index {
+		used[hash] = struct{}{}
+	}
+
+	var unused [][]byte
+	db.canClose.Add(1)
+	err = db.store.Iterate(votePrefix, func(key []byte, _ []byte) error {
+		var hash chainhash.Hash
+		copy(hash[:], key[len(votePrefix):])
+		if _, ok := used[hash]; !ok {
+			unused = append(unused, append([]byte(nil), key...))
+		}
+		return nil
+	})
+	db.canClose.Done()
+	if err != nil {
+		return err
+	}
+	for _, key := range unused {
+		if err := db.deleteKey(key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// latestVoteIndex reads the hash of the latest vote of every validator. Databases written before the votes were
+// stored by hash keep the whole vote on the index, those are returned decoded.
+func (db *blockDB) latestVoteIndex() (map[uint64]chainhash.Hash, map[uint64]*primitives.MultiValidatorVote, error) {
+	db.canClose.Add(1)
+	defer db.canClose.Done()
+
+	index := make(map[uint64]chainhash.Hash)
+	legacy := make(map[uint64]*primitives.MultiValidatorVote)
+	err := db.store.Iterate(latestVotePrefix, func(key []byte, value []byte) error {
+		validator := binary.BigEndian.Uint64(key[len(latestVotePrefix):])
+		if len(value) == chainhash.HashSize {
+			var hash chainhash.Hash
+			copy(hash[:], value)
+			index[validator] = hash
+			return nil
+		}
+		vote := new(primitives.MultiValidatorVote)
+		if err := vote.Unmarshal(value); err != nil {
+			return err
+		}
+		legacy[validator] = vote
+		return nil
+	})
+	return index, legacy, err
+}
+
+func latestVoteKey(validator uint64) []byte {
+	key := make([]byte, len(latestVotePrefix)+8)
+	copy(key, latestVotePrefix)
+	binary.BigEndian.PutUint64(key[len(latestVotePrefix):], validator)
+	return key
+}
+
+func voteKey(hash chainhash.Hash) []byte {
+	return append(append([]byte(nil), votePrefix...), hash[:]...)
+}
+
+// SetContractReceipts stores the receipts of the contract txs of a block.
+func (db *blockDB) SetContractReceipts(block chainhash.Hash, receipts []*execution.Receipt) error {
+	return db.setKey(receiptsKey(block), encodeReceipts(receipts))
+}
+
+// GetContractReceipts gets the receipts of the contract txs of a block. Blocks without contract txs have no receipts
+// stored and return ErrNotFound.
+func (db *blockDB) GetContractReceipts(block chainhash.Hash) ([]*execution.Receipt, error) {
+	buf, err := db.getKey(receiptsKey(block))
+	if err != nil {
+		return nil, err
+	}
+	return decodeReceipts(buf)
+}
+
+// DeleteContractReceipts removes the receipts of a block.
+func (db *blockDB) DeleteContractReceipts(block chainhash.Hash) error {
+	return db.deleteKey(receiptsKey(block))
+}
+
+func receiptsKey(block chainhash.Hash) []byte {
+	return append(append([]byte(nil), receiptsPrefix...), block[:]...)
+}
+
+// encodeReceipts serializes the receipts as the tx hash, contract, gas used, return value and error message of each
+// receipt. The error message is prefixed by its length.
+func encodeReceipts(receipts []*execution.Receipt) []byte {
+	var buf []byte
+	for _, r := range receipts {
+		var msg string
+		if r.Err != nil {
+			msg = r.Err.Error()
+		}
+		b := make([]byte, 96, 96+len(msg))
+		copy(b[:32], r.TxHash[:])
+		copy(b[32:52], r.Contract[:])
+		binary.BigEndian.PutUint64(b[52:60], r.GasUsed)
+		copy(b[60:92], r.Return[:])
+		binary.BigEndian.PutUint32(b[92:96], uint32(len(msg)))
+		buf = append(buf, append(b, msg...)...)
+	}
+	return buf
+}
+
+func decodeReceipts(buf []byte) ([]*execution.Receipt, error) {
+	const fixedSize = 32 + 20 + 8 + 32 + 4
+	var receipts []*execution.Receipt
+	for len(buf) > 0 {
+		if len(buf) < fixedSize {
+			return nil, errors.New("invalid contract receipts")
+		}
+		r := new(execution.Receipt)
+		copy(r.TxHash[:], buf[:32])
+		copy(r.Contract[:], buf[32:52])
+		r.GasUsed = binary.BigEndian.Uint64(buf[52:60])
+		copy(r.Return[:], buf[60:92])
+		size := binary.BigEndian.Uint32(buf[92:96])
+		buf = buf[fixedSize:]
+		if uint32(len(buf)) < size {
+			return nil, errors.New("invalid contract receipts")
+		}
+		if size > 0 {
+			r.Err = errors.New(string(buf[:size]))
+		}
+		buf = buf[size:]
+		receipts = append(receipts, r)
+	}
+	return receipts, nil
+}
+
+// SetGenesisTime sets the genesis time of the blockchain.
+func (db *blockDB) SetGenesisTime(t time.Time) error {
+	bs, err := t.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	return db.setKey(genTimeKey, bs)
+}
+
+// GetGenesisTime gets the genesis time of the blockchain.
+func (db *blockDB) GetGenesisTime() (time.Time, error) {
+	bs, err := db.getKey(genTimeKey)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	var t time.Time
+	err = t.UnmarshalBinary(bs)
+	return t, err
+}
+
+func (db *blockDB) getKeyHash(key []byte) (chainhash.Hash, error) {
+	db.canClose.Add(1)
+	defer db.canClose.Done()
+	var out chainhash.Hash
+	h, err := db.store.Get(key)
+	if err != nil {
+		return chainhash.Hash{}, err
+	}
+	copy(out[:], h)
+	return out, nil
+}
+
+func (db *blockDB) getKey(key []byte) ([]byte, error) {
+	db.canClose.Add(1)
+	defer db.canClose.Done()
+	return db.store.Get(key)
+}
+
+func (db *blockDB) setKeyHash(key []byte, to chainhash.Hash) error {
+	return db.setKey(key, to[:])
+}
+
+func (db *blockDB) setKey(key []byte, to []byte) error {
+	db.canClose.Add(1)
+	defer db.canClose.Done()
+	return db.store.Put(key, to)
+}
+
+func (db *blockDB) deleteKey(key []byte) error {
+	db.canClose.Add(1)
+	defer db.canClose.Done()
+	return db.store.Delete(key)
+}
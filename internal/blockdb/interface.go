@@ -1,6 +1,8 @@
 package blockdb
 
 import (
+	"errors"
+	"github.com/olympus-protocol/ogen/internal/execution"
 	"github.com/olympus-protocol/ogen/internal/state"
 	"github.com/olympus-protocol/ogen/pkg/chainhash"
 	"github.com/olympus-protocol/ogen/pkg/primitives"
@@ -14,15 +16,55 @@ var (
 	finStateKey = []byte("finalized_state")
 	jusStateKey = []byte("justified_state")
 	genTimeKey  = []byte("genesis_key")
+	prunedKey   = []byte("pruned_head")
 
-	blockRowPrefix = []byte("block-row-")
+	blockRowPrefix   = []byte("block-row-")
+	statePrefix      = []byte("state-")
+	treeStatePrefix  = []byte("tree-state-")
+	latestVotePrefix = []byte("latest-vote-")
+	votePrefix       = []byte("vote-")
+	receiptsPrefix   = []byte("contract-receipts-")
 )
 
+var (
+	// ErrNotFound is returned by a Store when the requested key does not exist.
+	ErrNotFound = errors.New("key not found")
+
+	// ErrUnknownBackend is returned when trying to open a store with an unsupported backend name.
+	ErrUnknownBackend = errors.New("unknown database backend")
+)
+
+const (
+	// BackendLevelDB stores the chain on a LevelDB database.
+	BackendLevelDB = "leveldb"
+
+	// BackendBolt stores the chain on a single bbolt file.
+	BackendBolt = "bolt"
+
+	// BackendMemory keeps the chain in memory. Data is lost when the store is closed.
+	BackendMemory = "memory"
+)
+
+// Backends are the names of the supported storage backends.
+var Backends = []string{BackendLevelDB, BackendBolt, BackendMemory}
+
+// Store is a key-value storage backend used by the block database.
+type Store interface {
+	Get(key []byte) ([]byte, error)
+	Put(key []byte, value []byte) error
+	Delete(key []byte) error
+	Iterate(prefix []byte, fn func(key []byte, value []byte) error) error
+	Write(b *Batch) error
+	Close() error
+}
+
 type Database interface {
 	Close()
+	Update(fn func(tx Database) error) error
 	GetBlock(hash chainhash.Hash) (*primitives.Block, error)
 	GetRawBlock(hash chainhash.Hash) ([]byte, error)
 	AddRawBlock(block *primitives.Block) error
+	DeleteBlock(hash chainhash.Hash) error
 	SetTip(c chainhash.Hash) error
 	GetTip() (chainhash.Hash, error)
 	SetFinalizedState(s state.State) error
@@ -31,12 +73,31 @@ type Database interface {
 	GetJustifiedState() (state.State, error)
 	SetBlockRow(disk *primitives.BlockNodeDisk) error
 	GetBlockRow(c chainhash.Hash) (*primitives.BlockNodeDisk, error)
+	DeleteBlockRow(c chainhash.Hash) error
 	SetJustifiedHead(c chainhash.Hash) error
 	GetJustifiedHead() (chainhash.Hash, error)
 	SetFinalizedHead(c chainhash.Hash) error
 	GetFinalizedHead() (chainhash.Hash, error)
+	SetPrunedHead(c chainhash.Hash) error
+	GetPrunedHead() (chainhash.Hash, error)
+	SetState(c chainhash.Hash, s state.State) error
+	GetState(c chainhash.Hash) (state.State, error)
+	SetTreeState(c chainhash.Hash, s state.State) error
+	GetTreeState(c chainhash.Hash) (state.State, error)
+	DeleteTreeState(c chainhash.Hash) error
+	SetLatestVotes(validators []uint64, vote *primitives.MultiValidatorVote) error
+	GetLatestVotes() (map[uint64]*primitives.MultiValidatorVote, error)
+	DeleteUnusedVotes() error
+	SetContractReceipts(block chainhash.Hash, receipts []*execution.Receipt) error
+	GetContractReceipts(block chainhash.Hash) ([]*execution.Receipt, error)
+	DeleteContractReceipts(block chainhash.Hash) error
 	SetGenesisTime(t time.Time) error
 	GetGenesisTime() (time.Time, error)
 }
 
-var _ Database = &levelDB{}
+var _ Database = &blockDB{}
+
+var _ Store = &levelDBStore{}
+var _ Store = &boltStore{}
+var _ Store = &memoryStore{}
+var _ Store = &batchStore{}
@@ -0,0 +1,96 @@
+package blockdb
+
+import (
+	"bytes"
+	"sort"
+	"sync"
+)
+
+type memoryStore struct {
+	lock sync.RWMutex
+	data map[string][]byte
+}
+
+// NewMemoryStore creates a store that keeps all the keys in memory.
+func NewMemoryStore() Store {
+	return &memoryStore{
+		data: make(map[string][]byte),
+	}
+}
+
+// Get returns the value stored for a key.
+func (m *memoryStore) Get(key []byte) ([]byte, error) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	v, ok := m.data[string(key)]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	out := make([]byte, len(v))
+	copy(out, v)
+	return out, nil
+}
+
+// Put stores a value for a key.
+func (m *memoryStore) Put(key []byte, value []byte) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	v := make([]byte, len(value))
+	copy(v, value)
+	m.data[string(key)] = v
+	return nil
+}
+
+// Delete removes a key from the store.
+func (m *memoryStore) Delete(key []byte) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	delete(m.data, string(key))
+	return nil
+}
+
+// Write applies all the batch writes atomically.
+func (m *memoryStore) Write(b *Batch) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	for _, op := range b.ops {
+		if op.delete {
+			delete(m.data, string(op.key))
+			continue
+		}
+		m.data[string(op.key)] = copyBytes(op.value)
+	}
+	return nil
+}
+
+// Iterate calls fn for every key-value pair starting with prefix in key order.
+func (m *memoryStore) Iterate(prefix []byte, fn func(key []byte, value []byte) error) error {
+	m.lock.RLock()
+	keys := make([][]byte, 0, len(m.data))
+	for k := range m.data {
+		if bytes.HasPrefix([]byte(k), prefix) {
+			keys = append(keys, []byte(k))
+		}
+	}
+	m.lock.RUnlock()
+
+	sort.Slice(keys, func(i, j int) bool {
+		return bytes.Compare(keys[i], keys[j]) < 0
+	})
+
+	for _, k := range keys {
+		v, err := m.Get(k)
+		if err != nil {
+			continue
+		}
+		if err := fn(k, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Close closes the store.
+func (m *memoryStore) Close() error {
+	return nil
+}
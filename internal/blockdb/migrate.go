@@ -0,0 +1,26 @@
+package blockdb
+
+// migrateBatchSize is the amount of keys written on each batch during a migration.
+const migrateBatchSize = 10000
+
+// Migrate copies every key of the source store into the destination store. It returns the amount of keys copied.
+func Migrate(from Store, to Store) (uint64, error) {
+	var copied uint64
+	batch := NewBatch()
+	err := from.Iterate(nil, func(key []byte, value []byte) error {
+		batch.Put(key, value)
+		copied++
+		if batch.Len() < migrateBatchSize {
+			return nil
+		}
+		if err := to.Write(batch); err != nil {
+			return err
+		}
+		batch = NewBatch()
+		return nil
+	})
+	if err != nil {
+		return copied, err
+	}
+	return copied, to.Write(batch)
+}
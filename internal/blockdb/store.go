@@ -0,0 +1,36 @@
+package blockdb
+
+import (
+	"fmt"
+	"os"
+	"path"
+)
+
+// OpenStore opens the store for the selected backend on the datapath.
+func OpenStore(backend string, datapath string) (Store, error) {
+	switch backend {
+	case BackendLevelDB:
+		return NewLevelDBStore(StorePath(backend, datapath))
+	case BackendBolt:
+		if err := os.MkdirAll(datapath, 0744); err != nil {
+			return nil, err
+		}
+		return NewBoltStore(StorePath(backend, datapath))
+	case BackendMemory:
+		return NewMemoryStore(), nil
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownBackend, backend)
+	}
+}
+
+// StorePath returns the location of the store for the selected backend inside the datapath.
+func StorePath(backend string, datapath string) string {
+	switch backend {
+	case BackendBolt:
+		return path.Join(datapath, "chain.db")
+	case BackendMemory:
+		return ""
+	default:
+		return path.Join(datapath, "chain")
+	}
+}
@@ -0,0 +1,134 @@
+package chain
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/olympus-protocol/ogen/internal/blockdb"
+	"github.com/olympus-protocol/ogen/internal/chainindex"
+	"github.com/olympus-protocol/ogen/internal/state"
+	"github.com/olympus-protocol/ogen/pkg/chainhash"
+)
+
+// ErrHistoricalStateUnavailable is returned when a state is requested before the states kept in memory and the node
+// is not running on archive mode.
+var ErrHistoricalStateUnavailable = errors.New("historical state not available, run the node with --archive")
+
+// isCheckpoint returns true when a block on the slot crosses a checkpoint interval from its parent slot.
+func (s *stateService) isCheckpoint(parentSlot uint64, slot uint64) bool {
+	interval := s.archiveInterval * s.netParams.EpochLength
+	if interval == 0 {
+		return false
+	}
+	return parentSlot/interval != slot/interval
+}
+
+// checkpointIfNeeded stores the block state to disk if the node is on archive mode and the block is the first one of
+// a checkpoint interval.
+func (s *stateService) checkpointIfNeeded(tx blockdb.Database, parentSlot uint64, hash chainhash.Hash, st state.State) error {
+	if !s.archive || !s.isCheckpoint(parentSlot, st.GetSlot()) {
+		return nil
+	}
+	s.log.With("block", hash, "slot", st.GetSlot()).Debug("storing state checkpoint")
+	return tx.SetState(hash, st)
+}
+
+// baseStateForRow returns the closest state available before the row, and the rows that must be replayed on top of it.
+func (s *stateService) baseStateForRow(row *chainindex.BlockRow) (state.State, []*chainindex.BlockRow, error) {
+	var replay []*chainindex.BlockRow
+
+	for current := row; current != nil; current = current.Parent {
+		if st, found := s.GetStateForHash(current.Hash); found {
+			return st, reverseRows(replay), nil
+		}
+
+		if s.archive {
+			if st, err := s.db.GetState(current.Hash); err == nil {
+				return st, reverseRows(replay), nil
+			}
+			if current.Parent == nil {
+				return s.genesisState.Copy(), reverseRows(replay), nil
+			}
+		}
+
+		replay = append(replay, current)
+	}
+
+	return nil, nil, ErrHistoricalStateUnavailable
+}
+
+func reverseRows(rows []*chainindex.BlockRow) []*chainindex.BlockRow {
+	for i, j := 0, len(rows)-1; i < j; i, j = i+1, j-1 {
+		rows[i], rows[j] = rows[j], rows[i]
+	}
+	return rows
+}
+
+// GetStateForBlock returns the state after processing the block with the provided hash. States not kept in memory are
+// regenerated from the closest checkpoint by replaying the blocks.
+func (s *stateService) GetStateForBlock(hash chainhash.Hash) (state.State, error) {
+	row, found := s.index.Get(hash)
+	if !found {
+		return nil, fmt.Errorf("could not find block with hash %s", hash)
+	}
+
+	base, replay, err := s.baseStateForRow(row)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(replay) == 0 {
+		return base, nil
+	}
+
+	s.log.With("block", hash, "replay", len(replay)).Debug("regenerating state")
+
+	st := base.Copy()
+	for _, r := range replay {
+		block, err := s.db.GetBlock(r.Hash)
+		if err != nil {
+			return nil, err
+		}
+
+		view := NewChainView(r.Parent)
+		if _, err := st.ProcessSlots(block.Header.Slot, &view); err != nil {
+			return nil, err
+		}
+
+		if err := st.ProcessBlock(block); err != nil {
+			return nil, err
+		}
+	}
+
+	return st, nil
+}
+
+// GetStateAtSlot returns the state of the main chain at the provided slot.
+func (s *stateService) GetStateAtSlot(slot uint64) (state.State, error) {
+	tip := s.Tip()
+	if slot > tip.Slot {
+		return nil, fmt.Errorf("slot %d is after the chain tip at slot %d", slot, tip.Slot)
+	}
+
+	row := tip.GetAncestorAtSlot(slot)
+	if row == nil {
+		return nil, fmt.Errorf("could not find block at slot %d", slot)
+	}
+
+	st, err := s.GetStateForBlock(row.Hash)
+	if err != nil {
+		return nil, err
+	}
+
+	if row.Slot == slot {
+		return st, nil
+	}
+
+	st = st.Copy()
+	view := NewChainView(row)
+	if _, err := st.ProcessSlots(slot, &view); err != nil {
+		return nil, err
+	}
+
+	return st, nil
+}
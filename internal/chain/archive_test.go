@@ -0,0 +1,76 @@
+package chain_test
+
+import (
+	"testing"
+
+	"github.com/olympus-protocol/ogen/cmd/ogen/config"
+	"github.com/olympus-protocol/ogen/internal/chain"
+	"github.com/olympus-protocol/ogen/pkg/chainhash"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetStateForBlock_Archive(t *testing.T) {
+	c := newTestChain(t, func(f *config.Flags) {
+		f.Archive = true
+		f.ArchiveInterval = 2
+	})
+	c.extendTo(60)
+
+	// the states of the first blocks were removed from memory after finalization and are regenerated from the
+	// checkpoints.
+	finalized, _ := c.ch.State().GetFinalizedHead()
+	assert.Greater(t, finalized.Slot, uint64(30))
+	_, inMemory := c.ch.State().GetStateForHash(c.blocks[3].Hash())
+	assert.False(t, inMemory)
+
+	for _, slot := range []uint64{1, 3, 10, 11, 24, 59} {
+		hash := c.blocks[slot].Hash()
+		st, err := c.ch.State().GetStateForBlock(hash)
+		assert.NoError(t, err)
+		assert.Equal(t, slot, st.GetSlot())
+		assert.Equal(t, stateRoot(t, c.states[hash]), stateRoot(t, st))
+		assert.Equal(t, chainhash.Hash(c.blocks[slot].Header.StateRoot), stateRoot(t, st))
+	}
+}
+
+func TestGetStateAtSlot(t *testing.T) {
+	c := newTestChain(t, func(f *config.Flags) {
+		f.Archive = true
+		f.ArchiveInterval = 1
+	})
+	c.extend(1, 2, 3, 5, 6)
+	c.extendTo(50)
+
+	genesis, err := c.keys.GenesisState()
+	assert.NoError(t, err)
+
+	st, err := c.ch.State().GetStateAtSlot(0)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(0), st.GetSlot())
+	assert.Equal(t, stateRoot(t, genesis), stateRoot(t, st))
+
+	st, err = c.ch.State().GetStateAtSlot(5)
+	assert.NoError(t, err)
+	assert.Equal(t, stateRoot(t, c.states[c.blocks[5].Hash()]), stateRoot(t, st))
+
+	// an empty slot returns the state of the previous block updated to the slot.
+	st, err = c.ch.State().GetStateAtSlot(4)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(4), st.GetSlot())
+	assert.Equal(t, stateRoot(t, c.states[c.blocks[3].Hash()]), stateRoot(t, st))
+
+	_, err = c.ch.State().GetStateAtSlot(51)
+	assert.Error(t, err)
+}
+
+func TestGetStateForBlock_NotArchive(t *testing.T) {
+	c := newTestChain(t, nil)
+	c.extendTo(60)
+
+	st, err := c.ch.State().GetStateForBlock(c.blocks[58].Hash())
+	assert.NoError(t, err)
+	assert.Equal(t, stateRoot(t, c.states[c.blocks[58].Hash()]), stateRoot(t, st))
+
+	_, err = c.ch.State().GetStateForBlock(c.blocks[3].Hash())
+	assert.Equal(t, chain.ErrHistoricalStateUnavailable, err)
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/olympus-protocol/ogen/internal/blockdb"
+	"github.com/olympus-protocol/ogen/internal/execution"
 	"github.com/olympus-protocol/ogen/pkg/chainhash"
 	"github.com/olympus-protocol/ogen/pkg/logger"
 	"github.com/olympus-protocol/ogen/pkg/params"
@@ -20,6 +21,7 @@ type Blockchain interface {
 	GenesisTime() time.Time
 	GetBlock(h chainhash.Hash) (block *primitives.Block, err error)
 	GetRawBlock(h chainhash.Hash) (block []byte, err error)
+	GetContractReceipts(h chainhash.Hash) ([]*execution.Receipt, error)
 	Notify(n BlockchainNotifee)
 	Unnotify(n BlockchainNotifee)
 	UpdateChainHead(possible chainhash.Hash) error
@@ -41,6 +43,9 @@ type blockchain struct {
 
 	notifees    map[BlockchainNotifee]struct{}
 	notifeeLock sync.Mutex
+
+	prune      bool
+	pruneDepth uint64
 }
 
 func (ch *blockchain) Start() (err error) {
@@ -70,10 +75,23 @@ func (ch *blockchain) GetRawBlock(h chainhash.Hash) (block []byte, err error) {
 	return ch.db.GetRawBlock(h)
 }
 
+// GetContractReceipts gets the receipts of the contract txs of a block from the database. Blocks without contract
+// txs return an empty list.
+func (ch *blockchain) GetContractReceipts(h chainhash.Hash) ([]*execution.Receipt, error) {
+	if _, ok := ch.state.Index().Get(h); !ok {
+		return nil, blockdb.ErrNotFound
+	}
+	receipts, err := ch.db.GetContractReceipts(h)
+	if err == blockdb.ErrNotFound {
+		return nil, nil
+	}
+	return receipts, err
+}
+
 // NewBlockchain constructs a new blockchain.
 func NewBlockchain(db blockdb.Database) (Blockchain, error) {
 
-	log := config.GlobalParams.Logger
+	log := config.GlobalParams.Logger.Module("chain")
 	ip := config.GlobalParams.InitParams
 	netParams := config.GlobalParams.NetParams
 
@@ -85,13 +103,13 @@ func NewBlockchain(db blockdb.Database) (Blockchain, error) {
 
 	genesisTime, err = db.GetGenesisTime()
 	if err != nil {
-		log.Infof("using genesis time %d from params", ip.GenesisTime.Unix())
+		log.With("genesis_time", ip.GenesisTime.Unix(), "source", "params").Info("using genesis time")
 		genesisTime = ip.GenesisTime
 		if err := db.SetGenesisTime(ip.GenesisTime); err != nil {
 			return nil, err
 		}
 	} else {
-		log.Infof("using genesis time %d from db", genesisTime.Unix())
+		log.With("genesis_time", genesisTime.Unix(), "source", "db").Info("using genesis time")
 	}
 	ch := &blockchain{
 		log:         log,
@@ -100,6 +118,13 @@ func NewBlockchain(db blockdb.Database) (Blockchain, error) {
 		state:       s,
 		notifees:    make(map[BlockchainNotifee]struct{}),
 		genesisTime: genesisTime,
+		prune:       config.GlobalFlags.Prune,
+		pruneDepth:  config.GlobalFlags.PruneDepth,
+	}
+	changes := newPendingChanges()
+	if err := ch.setHead(db, ch.chooseHead(nil), changes); err != nil {
+		return nil, err
 	}
-	return ch, ch.UpdateChainHead(s.Tip().Hash)
+	changes.apply()
+	return ch, nil
 }
@@ -0,0 +1,106 @@
+package chain_test
+
+import (
+	"testing"
+
+	"github.com/olympus-protocol/ogen/cmd/ogen/config"
+	"github.com/olympus-protocol/ogen/internal/blockdb"
+	"github.com/olympus-protocol/ogen/internal/chain"
+	"github.com/olympus-protocol/ogen/internal/state"
+	"github.com/olympus-protocol/ogen/pkg/chainhash"
+	"github.com/olympus-protocol/ogen/pkg/primitives"
+	"github.com/olympus-protocol/ogen/test/testchain"
+	"github.com/stretchr/testify/assert"
+)
+
+const testValidators = 10
+
+// testChain is a blockchain with the keys of its genesis validators and the states of the processed blocks.
+type testChain struct {
+	t      *testing.T
+	keys   *testchain.Keys
+	db     blockdb.Database
+	ch     chain.Blockchain
+	states map[chainhash.Hash]state.State
+	blocks map[uint64]*primitives.Block
+}
+
+// newTestChain sets the test params and creates a blockchain on a memory database. The flags can be changed before
+// the blockchain is created.
+func newTestChain(t *testing.T, flags func(f *config.Flags)) *testChain {
+	return newTestChainWithDatabase(t, blockdb.NewMemoryDatabase(), flags)
+}
+
+// newTestChainWithDatabase is like newTestChain using the provided database.
+func newTestChainWithDatabase(t *testing.T, db blockdb.Database, flags func(f *config.Flags)) *testChain {
+	keys, err := testchain.Setup(testValidators)
+	assert.NoError(t, err)
+	if flags != nil {
+		flags(config.GlobalFlags)
+	}
+	ch, err := chain.NewBlockchain(db)
+	assert.NoError(t, err)
+	return &testChain{
+		t:      t,
+		keys:   keys,
+		db:     db,
+		ch:     ch,
+		states: make(map[chainhash.Hash]state.State),
+		blocks: make(map[uint64]*primitives.Block),
+	}
+}
+
+// extend adds a block on top of the tip for every slot. Every block includes the votes of the whole committee of the
+// previous slot, so the chain is justified and finalized.
+func (c *testChain) extend(slots ...uint64) {
+	for _, slot := range slots {
+		tip := c.ch.State().Tip()
+
+		var votes []*primitives.MultiValidatorVote
+		if slot > 1 && tip.Slot == slot-1 {
+			voteState, err := c.ch.State().TipStateAtSlot(slot - 1)
+			assert.NoError(c.t, err)
+			vote, err := c.keys.Vote(voteState, tip.Hash)
+			assert.NoError(c.t, err)
+			votes = append(votes, vote)
+		}
+
+		st, err := c.ch.State().TipStateAtSlot(slot)
+		assert.NoError(c.t, err)
+		block, err := c.keys.Block(st, tip.Hash, func(b *primitives.Block) {
+			b.Votes = votes
+		})
+		assert.NoError(c.t, err)
+		assert.NoError(c.t, c.ch.ProcessBlock(block))
+
+		blockState, ok := c.ch.State().GetStateForHash(block.Hash())
+		assert.True(c.t, ok)
+		c.states[block.Hash()] = blockState.Copy()
+		c.blocks[slot] = block
+	}
+}
+
+// fork adds a block at the slot on top of the parent without votes and returns its hash.
+func (c *testChain) fork(parent chainhash.Hash, slot uint64) chainhash.Hash {
+	view, err := c.ch.State().GetSubView(parent)
+	assert.NoError(c.t, err)
+	st, _, err := c.ch.State().GetStateForHashAtSlot(parent, slot, &view)
+	assert.NoError(c.t, err)
+	block, err := c.keys.Block(st, parent, nil)
+	assert.NoError(c.t, err)
+	assert.NoError(c.t, c.ch.ProcessBlock(block))
+	return block.Hash()
+}
+
+// extendTo adds a block for every slot after the tip up to the slot.
+func (c *testChain) extendTo(slot uint64) {
+	for s := c.ch.State().Tip().Slot + 1; s <= slot; s++ {
+		c.extend(s)
+	}
+}
+
+func stateRoot(t *testing.T, st state.State) chainhash.Hash {
+	root, err := st.StateRoot()
+	assert.NoError(t, err)
+	return root
+}
@@ -60,29 +60,46 @@ func (c *Chain) Genesis() *chainindex.BlockRow {
 	return c.chain[0]
 }
 
+// Next returns the row after a row on the chain. The heights removed by pruning are skipped, so the row after the
+// pruned head is the first row with a block.
 func (c *Chain) Next(row *chainindex.BlockRow) (*chainindex.BlockRow, bool) {
 	c.lock.Lock()
 	defer c.lock.Unlock()
 
-	if uint64(len(c.chain)) <= row.Height+1 {
-		return nil, false
+	for height := row.Height + 1; height < uint64(len(c.chain)); height++ {
+		if c.chain[height] != nil {
+			return c.chain[height], true
+		}
 	}
 
-	return c.chain[row.Height+1], true
+	return nil, false
 }
 
 func (c *Chain) GetNodeByHeight(height uint64) (*chainindex.BlockRow, bool) {
 	c.lock.Lock()
 	defer c.lock.Unlock()
 
-	if height >= uint64(len(c.chain)) {
+	if height >= uint64(len(c.chain)) || c.chain[height] == nil {
 		return nil, false
 	}
 
 	return c.chain[height], true
 }
 
-// GetNodeBySlot returns the node at a specific slot.
+// Prune removes the row at a certain height from the chain.
+func (c *Chain) Prune(height uint64) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if height >= uint64(len(c.chain)) {
+		return
+	}
+
+	c.chain[height] = nil
+}
+
+// GetNodeBySlot returns the node at a specific slot, or the last node before the slot if there is no block at the
+// slot. Returns false if the blocks around the slot were pruned.
 func (c *Chain) GetNodeBySlot(slot uint64) (*chainindex.BlockRow, bool) {
 	tip := c.Tip()
 	if tip == nil {
@@ -91,7 +108,8 @@ func (c *Chain) GetNodeBySlot(slot uint64) (*chainindex.BlockRow, bool) {
 	if tip.Slot < slot {
 		return tip, true
 	}
-	return tip.GetAncestorAtSlot(slot), true
+	row := tip.GetAncestorAtSlot(slot)
+	return row, row != nil
 }
 
 // NewBlockchain creates a new chain.
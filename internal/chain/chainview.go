@@ -2,6 +2,7 @@ package chain
 
 import (
 	"errors"
+	"fmt"
 	"github.com/olympus-protocol/ogen/internal/state"
 
 	"github.com/olympus-protocol/ogen/internal/chainindex"
@@ -30,6 +31,9 @@ func (c *View) SetTipSlot(slot uint64) {
 func (c *View) GetHashBySlot(slot uint64) (chainhash.Hash, error) {
 	ancestor := c.tip.GetAncestorAtSlot(slot)
 	if ancestor == nil {
+		if slot <= c.tip.Slot {
+			return chainhash.Hash{}, fmt.Errorf("block at slot %d was pruned", slot)
+		}
 		if slot > c.effectiveTipSlot {
 			return chainhash.Hash{}, errors.New("could not get block past tip")
 		}
@@ -3,6 +3,7 @@ package chain
 import (
 	"bytes"
 	"encoding/hex"
+	"fmt"
 	"github.com/olympus-protocol/ogen/internal/blockdb"
 	"github.com/olympus-protocol/ogen/internal/chainindex"
 	"github.com/olympus-protocol/ogen/internal/state"
@@ -56,7 +57,7 @@ func (s *stateService) loadBlockIndex(txn blockdb.Database, genesisHash chainhas
 	for len(queue) > 0 {
 		current := queue[0]
 
-		s.log.Debugf("Loading block node %s", hex.EncodeToString(current[:]))
+		s.log.With("block", hex.EncodeToString(current[:])).Debug("Loading block node")
 
 		queue = queue[1:]
 
@@ -97,7 +98,7 @@ func (s *stateService) loadJustifiedAndFinalizedStates(txn blockdb.Database) err
 		return err
 	}
 
-	s.log.Infof("loaded justified head: %s and finalized head %s", justifiedHead, finalizedHead)
+	s.log.With("justified", justifiedHead, "finalized", finalizedHead).Info("loaded justified and finalized heads")
 
 	if err := s.SetFinalizedHead(finalizedHead, finalizedState); err != nil {
 		return err
@@ -113,7 +114,7 @@ func (s *stateService) setBlockState(hash chainhash.Hash, state state.State) {
 	s.stateMapLock.Lock()
 	defer s.stateMapLock.Unlock()
 
-	s.log.Debugf("setting block state for %s", hash)
+	s.log.With("block", hash).Debug("setting block state")
 
 	s.stateMap[hash] = newStateDerivedFromBlock(state)
 }
@@ -146,21 +147,29 @@ func (s *stateService) loadStateMap(txn blockdb.Database) error {
 			return err
 		}
 
-		s.log.Debugf("calculating block state for %s with previous %s", hex.EncodeToString(node.Hash[:]), hex.EncodeToString(node.Parent[:]))
-
-		bl, err := txn.GetBlock(node.Hash)
-		if err != nil {
-			return err
+		if !s.index.Have(node.Hash) {
+			_, err = s.index.LoadBlockNode(node)
+			if err != nil {
+				return err
+			}
 		}
 
-		_, _, err = s.Add(bl)
-		if err != nil {
-			return err
-		}
+		if st, err := txn.GetTreeState(node.Hash); err == nil {
+			s.log.With("block", hex.EncodeToString(node.Hash[:])).Debug("loaded block state")
 
-		_, err = s.index.LoadBlockNode(node)
-		if err != nil {
-			return err
+			s.setBlockState(node.Hash, st)
+		} else {
+			s.log.With("block", hex.EncodeToString(node.Hash[:]), "parent", hex.EncodeToString(node.Parent[:])).Debug("calculating block state")
+
+			bl, err := txn.GetBlock(node.Hash)
+			if err != nil {
+				return err
+			}
+
+			_, _, err = s.Add(bl)
+			if err != nil {
+				return err
+			}
 		}
 
 		loadQueue = append(loadQueue, node.Children...)
@@ -190,11 +199,29 @@ func (s *stateService) loadBlockchainFromDisk(txn blockdb.Database, genesisHash
 	if err != nil {
 		return err
 	}
+	if prunedHead, err := txn.GetPrunedHead(); err == nil {
+		row, found := s.index.Get(prunedHead)
+		if !found {
+			return fmt.Errorf("could not find pruned head %s", prunedHead)
+		}
+		s.SetPrunedHead(row)
+	}
 	s.log.Info("Loading justified and finalized states...")
 	err = s.loadJustifiedAndFinalizedStates(txn)
 	if err != nil {
 		return err
 	}
+	s.log.Info("Loading latest votes...")
+	votes, err := txn.GetLatestVotes()
+	if err != nil {
+		return err
+	}
+	s.latestVotesLock.Lock()
+	s.latestVotes = votes
+	s.latestVotesLock.Unlock()
+	if err := txn.DeleteUnusedVotes(); err != nil {
+		return err
+	}
 	s.log.Info("populating state map")
 	err = s.loadStateMap(txn)
 	if err != nil {
@@ -0,0 +1,33 @@
+package chain
+
+import (
+	"github.com/olympus-protocol/ogen/pkg/primitives"
+)
+
+// pendingChanges collects the in-memory updates made while writing a database transaction. They are applied only
+// after the transaction is committed, so a failed write never leaves the memory ahead of the disk.
+type pendingChanges struct {
+	// latestVotes are the latest votes written by the transaction. The fork choice uses them over the applied ones.
+	latestVotes map[uint64]*primitives.MultiValidatorVote
+
+	updates []func()
+}
+
+func newPendingChanges() *pendingChanges {
+	return &pendingChanges{
+		latestVotes: make(map[uint64]*primitives.MultiValidatorVote),
+	}
+}
+
+// add queues an in-memory update.
+func (p *pendingChanges) add(fn func()) {
+	p.updates = append(p.updates, fn)
+}
+
+// apply runs the queued updates in the order they were added.
+func (p *pendingChanges) apply() {
+	for _, fn := range p.updates {
+		fn()
+	}
+	p.updates = nil
+}
@@ -3,9 +3,12 @@ package chain
 import (
 	"errors"
 	"fmt"
+	"sort"
 	"time"
 
+	"github.com/olympus-protocol/ogen/internal/blockdb"
 	"github.com/olympus-protocol/ogen/internal/chainindex"
+	"github.com/olympus-protocol/ogen/internal/state"
 	"github.com/olympus-protocol/ogen/pkg/bls"
 	"github.com/olympus-protocol/ogen/pkg/chainhash"
 	"github.com/olympus-protocol/ogen/pkg/primitives"
@@ -18,11 +21,43 @@ type blockRowAndValidator struct {
 
 // UpdateChainHead updates the blockchain head if needed
 func (ch *blockchain) UpdateChainHead(possible chainhash.Hash) error {
+	changes := newPendingChanges()
+	if err := ch.updateChainHead(ch.db, possible, changes); err != nil {
+		return err
+	}
+	changes.apply()
+	return nil
+}
+
+func (ch *blockchain) updateChainHead(tx blockdb.Database, possible chainhash.Hash, changes *pendingChanges) error {
+	head := ch.chooseHead(changes.latestVotes)
+	if !head.Hash.IsEqual(&possible) {
+		return nil
+	}
+	return ch.setHead(tx, head, changes)
+}
+
+// setHead stores the head of the chain on the database and queues the update of the in-memory tip.
+func (ch *blockchain) setHead(tx blockdb.Database, head *chainindex.BlockRow, changes *pendingChanges) error {
+	if err := tx.SetTip(head.Hash); err != nil {
+		return err
+	}
+
+	changes.add(func() {
+		ch.state.Chain().SetTip(head)
+		ch.log.With("block", head.Hash, "slot", head.Slot).Info("setting head")
+	})
+	return nil
+}
+
+// chooseHead runs the fork choice rule from the justified head using the latest votes of the active validators.
+// The pending votes are used over the ones already applied.
+func (ch *blockchain) chooseHead(pending map[uint64]*primitives.MultiValidatorVote) *chainindex.BlockRow {
 	_, justifiedState := ch.state.GetJustifiedHead()
 	activeValidatorIndices := justifiedState.GetValidatorIndicesActiveAt(justifiedState.GetEpochIndex())
 	var targets []blockRowAndValidator
 	for _, i := range activeValidatorIndices {
-		bl, err := ch.getLatestAttestationTarget(i)
+		bl, err := ch.getLatestAttestationTarget(i, pending)
 		if err != nil {
 			continue
 		}
@@ -55,18 +90,7 @@ func (ch *blockchain) UpdateChainHead(possible chainhash.Hash) error {
 	for {
 		children := head.Children()
 		if len(children) == 0 {
-			if head.Hash.IsEqual(&possible) {
-				ch.state.Chain().SetTip(head)
-
-				ch.log.Infof("setting head to %s", head.Hash)
-
-				err := ch.db.SetTip(head.Hash)
-				if err != nil {
-					return err
-				}
-
-			}
-			return nil
+			return head
 		}
 		bestVoteCountChild := children[0]
 		bestVotes := uint64(0)
@@ -84,9 +108,11 @@ func (ch *blockchain) UpdateChainHead(possible chainhash.Hash) error {
 	}
 }
 
-func (ch *blockchain) getLatestAttestationTarget(validator uint64) (row *chainindex.BlockRow, err error) {
-	var att *primitives.MultiValidatorVote
-	att, ok := ch.state.GetLatestVote(validator)
+func (ch *blockchain) getLatestAttestationTarget(validator uint64, pending map[uint64]*primitives.MultiValidatorVote) (row *chainindex.BlockRow, err error) {
+	att, ok := pending[validator]
+	if !ok {
+		att, ok = ch.state.GetLatestVote(validator)
+	}
 	if !ok {
 		return nil, fmt.Errorf("attestation target not found")
 	}
@@ -147,7 +173,7 @@ func (ch *blockchain) ProcessBlock(block *primitives.Block) error {
 			return err
 		}
 
-		ch.log.Warnf("found duplicate block at slot %d, reporting...", block.Header.Slot)
+		ch.log.With("slot", block.Header.Slot, "block", blockHash, "other", otherBlock.Hash()).Warn("found duplicate block, reporting...")
 
 		for n := range ch.notifees {
 			var b, os [96]byte
@@ -174,12 +200,11 @@ func (ch *blockchain) ProcessBlock(block *primitives.Block) error {
 
 	newState, receipts, err := ch.State().Add(block)
 	if err != nil {
-		ch.log.Warn(err)
+		ch.log.With("block", blockHash, "slot", block.Header.Slot, "err", err).Warn("unable to apply block")
 		return err
 	}
 
 	if len(receipts) > 0 {
-		msg := "\nEpoch Receipts\n----------\n"
 		receiptTypes := make(map[string]int64)
 
 		for _, r := range receipts {
@@ -190,115 +215,178 @@ func (ch *blockchain) ProcessBlock(block *primitives.Block) error {
 			}
 		}
 
-		for rt, amount := range receiptTypes {
-			if amount > 0 {
-				msg += fmt.Sprintf("rewarded %d for %s\n", amount, rt)
-			} else if amount < 0 {
-				msg += fmt.Sprintf("penalized %d for %s\n", -amount, rt)
-			} else {
-				msg += fmt.Sprintf("neutral increments for %s\n", rt)
-			}
+		// the receipt types are the keys and the total rewarded (positive) or penalized (negative) amounts the values.
+		types := make([]string, 0, len(receiptTypes))
+		for rt := range receiptTypes {
+			types = append(types, rt)
+		}
+		sort.Strings(types)
+		fields := []interface{}{"block", blockHash}
+		for _, rt := range types {
+			fields = append(fields, rt, receiptTypes[rt])
 		}
 
-		ch.log.Debugf(msg)
+		ch.log.With(fields...).Debug("epoch receipts")
 	}
 
-	err = ch.db.AddRawBlock(block)
+	row, err := ch.state.Index().Add(block)
 	if err != nil {
 		return err
 	}
 
-	row, err := ch.state.Index().Add(block)
+	// all the writes of the block import are committed at once, so a crash can't leave a partial import on disk.
+	// The in-memory changes are applied only once the writes succeed.
+	var finalizedSlot uint64
+	changes := newPendingChanges()
+	err = ch.db.Update(func(tx blockdb.Database) error {
+		var err error
+		finalizedSlot, err = ch.importBlock(tx, block, row, newState, changes)
+		return err
+	})
 	if err != nil {
+		ch.state.Index().Remove(row.Hash)
+		row.Parent.RemoveChild(row)
 		return err
 	}
+	changes.apply()
+
+	// To prevent deleting a finalized state, keep 20 slots more before finalized state
+	ch.state.RemoveBeforeSlot(finalizedSlot)
+
+	if err := ch.pruneBlocks(newState.GetFinalizedEpoch()); err != nil {
+		ch.log.With("err", err).Error("unable to prune blocks")
+	}
+
+	blockLog := ch.log.With("block", blockHash, "slot", block.Header.Slot)
+	blockLog.With("votes", len(block.Votes), "deposits", len(block.Deposits), "exits", len(block.Exits), "txs", len(block.Txs)).Debug("processed block items")
+	blockLog.With("vote_slashings", len(block.VoteSlashings), "randao_slashings", len(block.RANDAOSlashings), "proposer_slashings", len(block.ProposerSlashings)).Debug("included block slashings")
+	blockLog.With("finalized", newState.GetFinalizedEpoch(), "justified", newState.GetJustifiedEpoch()).Info("new block")
+
+	voted := 0
+
+	for _, v := range block.Votes {
+		voted += len(v.ParticipationBitfield.BitIndices())
+	}
+
+	comittee, err := newState.GetVoteCommittee(block.Header.Slot)
+	if err == nil {
+		percentage := fmt.Sprintf("%.2f", float64(voted)/float64(len(comittee))*100)
+		blockLog.With("votes", len(block.Votes), "participating", voted, "expected", len(comittee), "percentage", percentage).Info("network participation")
+	}
+
+	ch.notifeeLock.Lock()
+	stateCopy := newState.Copy()
+	for i := range ch.notifees {
+		go i.NewTip(row, block, stateCopy, receipts)
+	}
+	ch.notifeeLock.Unlock()
+	return nil
+}
+
+// importBlock writes the block, its row, its state and the updated heads using the provided database transaction.
+// The in-memory updates are queued on changes.
+func (ch *blockchain) importBlock(tx blockdb.Database, block *primitives.Block, row *chainindex.BlockRow, newState state.State, changes *pendingChanges) (uint64, error) {
+	blockHash := row.Hash
+
+	if err := tx.AddRawBlock(block); err != nil {
+		return 0, err
+	}
+
+	if receipts := newState.ContractReceipts(); len(receipts) > 0 {
+		if err := tx.SetContractReceipts(blockHash, receipts); err != nil {
+			return 0, err
+		}
+	}
 
 	// set current block row in database
-	if err := ch.db.SetBlockRow(row.ToBlockNodeDisk()); err != nil {
-		return err
+	if err := tx.SetBlockRow(row.ToBlockNodeDisk()); err != nil {
+		return 0, err
 	}
 
 	// update parent to point at current
-	if err := ch.db.SetBlockRow(row.Parent.ToBlockNodeDisk()); err != nil {
-		return err
+	if err := tx.SetBlockRow(row.Parent.ToBlockNodeDisk()); err != nil {
+		return 0, err
+	}
+
+	if err := ch.state.StoreBlockState(tx, row, newState); err != nil {
+		return 0, err
 	}
 
 	for _, a := range block.Votes {
 		validators, err := newState.GetVoteCommittee(a.Data.Slot)
 		if err != nil {
-			return err
+			return 0, err
 		}
 
-		ch.state.SetLatestVotesIfNeeded(validators, a)
+		updated := make([]uint64, 0, len(validators))
+		for _, v := range validators {
+			latest, ok := changes.latestVotes[v]
+			if !ok {
+				latest, ok = ch.state.GetLatestVote(v)
+			}
+			if ok && latest.Data.Slot >= a.Data.Slot {
+				continue
+			}
+			changes.latestVotes[v] = a
+			updated = append(updated, v)
+		}
+		if err := tx.SetLatestVotes(updated, a); err != nil {
+			return 0, err
+		}
 	}
+	changes.add(func() {
+		ch.state.SetLatestVotesIfNeeded(changes.latestVotes)
+	})
 
-	if err := ch.UpdateChainHead(blockHash); err != nil {
-		return err
+	if err := ch.updateChainHead(tx, blockHash, changes); err != nil {
+		return 0, err
 	}
 
 	view, err := ch.State().GetSubView(block.Header.PrevBlockHash)
 	if err != nil {
-		return err
+		return 0, err
 	}
 
 	finalizedSlot := newState.GetFinalizedEpoch() * ch.netParams.EpochLength
 	finalizedHash, err := view.GetHashBySlot(finalizedSlot)
 	if err != nil {
-		return err
+		return 0, err
 	}
 	finalizedState, found := ch.state.GetStateForHash(finalizedHash)
 	if !found {
-		return fmt.Errorf("could not find finalized state with hash %s in state map", finalizedHash)
+		return 0, fmt.Errorf("could not find finalized state with hash %s in state map", finalizedHash)
 	}
 
-	if err := ch.db.SetFinalizedHead(finalizedHash); err != nil {
-		return err
+	if _, found := ch.state.GetRowByHash(finalizedHash); !found {
+		return 0, fmt.Errorf("could not find block with hash %s", finalizedHash)
 	}
-	if err := ch.state.SetFinalizedHead(finalizedHash, finalizedState); err != nil {
-		return err
+	if err := tx.SetFinalizedHead(finalizedHash); err != nil {
+		return 0, err
 	}
-	if err := ch.db.SetFinalizedState(finalizedState); err != nil {
-		return err
+	if err := tx.SetFinalizedState(finalizedState); err != nil {
+		return 0, err
 	}
 
 	justifiedState, found := ch.state.GetStateForHash(newState.GetJustifiedEpochHash())
 	if !found {
-		return fmt.Errorf("could not find justified state with hash %s in state map", newState.GetJustifiedEpochHash())
+		return 0, fmt.Errorf("could not find justified state with hash %s in state map", newState.GetJustifiedEpochHash())
 	}
-	if err := ch.db.SetJustifiedHead(newState.GetJustifiedEpochHash()); err != nil {
-		return err
-	}
-	if err := ch.state.SetJustifiedHead(newState.GetJustifiedEpochHash(), justifiedState); err != nil {
-		return err
+	justifiedHash := newState.GetJustifiedEpochHash()
+	if _, found := ch.state.GetRowByHash(justifiedHash); !found {
+		return 0, fmt.Errorf("could not find block with hash %s", justifiedHash)
 	}
-	if err := ch.db.SetJustifiedState(justifiedState); err != nil {
-		return err
+	if err := tx.SetJustifiedHead(justifiedHash); err != nil {
+		return 0, err
 	}
-
-	// To prevent deleting a finalized state, keep 20 slots more before finalized state
-	ch.state.RemoveBeforeSlot(finalizedSlot)
-
-	ch.log.Debugf("processed %d votes %d deposits %d exits and %d transactions", len(block.Votes), len(block.Deposits), len(block.Exits), len(block.Txs))
-	ch.log.Debugf("included %d vote slashing %d randao slashing %d proposer slashing", len(block.VoteSlashings), len(block.RANDAOSlashings), len(block.ProposerSlashings))
-	ch.log.Infof("new block at slot: %d with %d finalized and %d justified", block.Header.Slot, newState.GetFinalizedEpoch(), newState.GetJustifiedEpoch())
-
-	voted := 0
-
-	for _, v := range block.Votes {
-		voted += len(v.ParticipationBitfield.BitIndices())
+	if err := tx.SetJustifiedState(justifiedState); err != nil {
+		return 0, err
 	}
 
-	comittee, err := newState.GetVoteCommittee(block.Header.Slot)
-	if err == nil {
-		percentage := fmt.Sprintf("%.2f", float64(voted)/float64(len(comittee))*100)
-		ch.log.Infof("network participation with %d votes participating %d validators expected %d percentage %s%%", len(block.Votes), voted, len(comittee), percentage)
-	}
+	changes.add(func() {
+		// both rows were found above and the index only loses rows while pruning, which runs after the import.
+		_ = ch.state.SetFinalizedHead(finalizedHash, finalizedState)
+		_ = ch.state.SetJustifiedHead(justifiedHash, justifiedState)
+	})
 
-	ch.notifeeLock.Lock()
-	stateCopy := newState.Copy()
-	for i := range ch.notifees {
-		go i.NewTip(row, block, stateCopy, receipts)
-	}
-	ch.notifeeLock.Unlock()
-	return nil
+	return finalizedSlot, nil
 }
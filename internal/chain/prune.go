@@ -0,0 +1,193 @@
+package chain
+
+import (
+	"github.com/olympus-protocol/ogen/internal/blockdb"
+	"github.com/olympus-protocol/ogen/internal/chainindex"
+)
+
+// PrunedHead returns the last block row kept after pruning. Block bodies before this row are not available.
+func (s *stateService) PrunedHead() *chainindex.BlockRow {
+	s.headLock.Lock()
+	defer s.headLock.Unlock()
+
+	return s.prunedHead
+}
+
+// SetPrunedHead sets the last block row kept after pruning.
+func (s *stateService) SetPrunedHead(row *chainindex.BlockRow) {
+	s.headLock.Lock()
+	defer s.headLock.Unlock()
+
+	s.prunedHead = row
+}
+
+// isEpochCheckpoint returns true if the row is the first block of an epoch.
+func (ch *blockchain) isEpochCheckpoint(row *chainindex.BlockRow) bool {
+	if row.Parent == nil {
+		return true
+	}
+	return row.Slot/ch.netParams.EpochLength != row.Parent.Slot/ch.netParams.EpochLength
+}
+
+// pruneBlocks removes the block bodies older than the prune depth from the finalized epoch and compacts the block
+// index keeping only the epoch checkpoints.
+func (ch *blockchain) pruneBlocks(finalizedEpoch uint64) error {
+	if !ch.prune || finalizedEpoch <= ch.pruneDepth {
+		return nil
+	}
+
+	pruneSlot := (finalizedEpoch - ch.pruneDepth) * ch.netParams.EpochLength
+
+	lastKept := ch.state.PrunedHead()
+	if pruneSlot <= lastKept.Slot {
+		return nil
+	}
+
+	changes := newPendingChanges()
+	err := ch.db.Update(func(tx blockdb.Database) error {
+		return ch.compactBefore(tx, lastKept, pruneSlot, changes)
+	})
+	if err != nil {
+		return err
+	}
+	changes.apply()
+	return nil
+}
+
+// compactBefore deletes the block bodies and non checkpoint rows between the pruned head and the prune slot. The
+// in-memory updates are queued on changes.
+func (ch *blockchain) compactBefore(tx blockdb.Database, lastKept *chainindex.BlockRow, pruneSlot uint64, changes *pendingChanges) error {
+	chain := ch.state.Chain()
+
+	// after compacting, the only child of the pruned head is the first block with data.
+	children := lastKept.Children()
+	if len(children) == 0 {
+		return nil
+	}
+	row, ok := chain.GetNodeByHeight(children[0].Height)
+	if !ok {
+		return nil
+	}
+
+	kept := []*chainindex.BlockRow{lastKept}
+	pruned := 0
+	for row.Slot < pruneSlot {
+		next, ok := chain.Next(row)
+		if !ok {
+			break
+		}
+
+		if err := ch.pruneForks(tx, row, changes); err != nil {
+			return err
+		}
+
+		if err := tx.DeleteBlock(row.Hash); err != nil {
+			return err
+		}
+		if err := tx.DeleteContractReceipts(row.Hash); err != nil {
+			return err
+		}
+
+		if ch.isEpochCheckpoint(row) {
+			kept = append(kept, row)
+		} else {
+			if err := tx.DeleteBlockRow(row.Hash); err != nil {
+				return err
+			}
+			removed := row
+			changes.add(func() {
+				ch.state.Index().Remove(removed.Hash)
+				chain.Prune(removed.Height)
+			})
+		}
+
+		pruned++
+		row = next
+	}
+
+	if err := ch.pruneForks(tx, row, changes); err != nil {
+		return err
+	}
+
+	prunedHead := kept[len(kept)-1]
+	if err := ch.relink(tx, append(kept, row), changes); err != nil {
+		return err
+	}
+
+	if err := tx.SetPrunedHead(prunedHead.Hash); err != nil {
+		return err
+	}
+	changes.add(func() {
+		ch.state.SetPrunedHead(prunedHead)
+		ch.log.With("pruned", pruned, "slot", pruneSlot).Info("pruned blocks")
+	})
+
+	return nil
+}
+
+// relink stores the rows linked in order, every row becoming the only child of the previous one, and queues the
+// same links in memory.
+func (ch *blockchain) relink(tx blockdb.Database, rows []*chainindex.BlockRow, changes *pendingChanges) error {
+	for i, row := range rows {
+		disk := row.ToBlockNodeDisk()
+		if i > 0 {
+			disk.Parent = rows[i-1].Hash
+		}
+		if i < len(rows)-1 {
+			disk.Children = [][32]byte{rows[i+1].Hash}
+		}
+		if err := tx.SetBlockRow(disk); err != nil {
+			return err
+		}
+	}
+
+	changes.add(func() {
+		for i := 1; i < len(rows); i++ {
+			rows[i].Parent = rows[i-1]
+			rows[i-1].SetChildren(rows[i])
+		}
+	})
+	return nil
+}
+
+// pruneForks removes the siblings of a finalized row, as they can't be part of the chain anymore.
+func (ch *blockchain) pruneForks(tx blockdb.Database, row *chainindex.BlockRow, changes *pendingChanges) error {
+	if row.Parent == nil {
+		return nil
+	}
+	for _, sibling := range row.Parent.Children() {
+		if sibling.Hash.IsEqual(&row.Hash) {
+			continue
+		}
+		if err := ch.removeSubtree(tx, sibling, changes); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// removeSubtree removes a row and all of its descendants from the database and queues their removal from the index.
+func (ch *blockchain) removeSubtree(tx blockdb.Database, row *chainindex.BlockRow, changes *pendingChanges) error {
+	for _, c := range row.Children() {
+		if err := ch.removeSubtree(tx, c, changes); err != nil {
+			return err
+		}
+	}
+
+	if err := tx.DeleteBlock(row.Hash); err != nil {
+		return err
+	}
+	if err := tx.DeleteContractReceipts(row.Hash); err != nil {
+		return err
+	}
+	if err := tx.DeleteTreeState(row.Hash); err != nil {
+		return err
+	}
+	if err := tx.DeleteBlockRow(row.Hash); err != nil {
+		return err
+	}
+	changes.add(func() {
+		ch.state.Index().Remove(row.Hash)
+	})
+	return nil
+}
@@ -0,0 +1,129 @@
+package chain_test
+
+import (
+	"testing"
+
+	"github.com/olympus-protocol/ogen/cmd/ogen/config"
+	"github.com/olympus-protocol/ogen/pkg/chainhash"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPruneBlocks(t *testing.T) {
+	c := newTestChain(t, func(f *config.Flags) {
+		f.Prune = true
+		f.PruneDepth = 1
+	})
+	c.extendTo(10)
+	fork := c.fork(c.blocks[9].Hash(), 11)
+	assert.Equal(t, c.blocks[10].Hash(), c.ch.State().Tip().Hash)
+	c.extendTo(60)
+
+	epochLength := config.GlobalParams.NetParams.EpochLength
+	finalized, _ := c.ch.State().GetFinalizedHead()
+	pruned := c.ch.State().PrunedHead()
+	assert.Greater(t, pruned.Slot, uint64(20))
+	assert.Equal(t, uint64(0), pruned.Slot%epochLength)
+	assert.Less(t, pruned.Slot, finalized.Slot)
+
+	// forks of the pruned blocks are removed.
+	_, found := c.ch.State().GetRowByHash(fork)
+	assert.False(t, found)
+	_, err := c.db.GetBlockRow(fork)
+	assert.Error(t, err)
+	_, err = c.db.GetRawBlock(fork)
+	assert.Error(t, err)
+
+	// the only child of the pruned head is the first block with a body.
+	next := pruned.Children()
+	assert.Len(t, next, 1)
+	first := next[0]
+	assert.Greater(t, first.Slot, pruned.Slot)
+	assert.LessOrEqual(t, first.Slot-pruned.Slot, epochLength)
+	assert.Equal(t, pruned, first.Parent)
+
+	for slot := uint64(1); slot < first.Slot; slot++ {
+		hash := c.blocks[slot].Hash()
+		_, err := c.db.GetRawBlock(hash)
+		assert.Error(t, err, "block body of slot %d not removed", slot)
+
+		row, found := c.ch.State().GetRowByHash(hash)
+		if slot%epochLength == 0 {
+			assert.True(t, found, "checkpoint row of slot %d removed", slot)
+			_, err := c.db.GetBlockRow(hash)
+			assert.NoError(t, err)
+			if slot < pruned.Slot {
+				assert.Equal(t, slot-epochLength, row.Parent.Slot)
+			}
+		} else {
+			assert.False(t, found, "row of slot %d not removed", slot)
+			_, err := c.db.GetBlockRow(hash)
+			assert.Error(t, err)
+		}
+	}
+
+	// the blocks after the pruned head are kept and linked to it.
+	for slot := first.Slot; slot <= 60; slot++ {
+		_, err := c.db.GetRawBlock(c.blocks[slot].Hash())
+		assert.NoError(t, err)
+	}
+	disk, err := c.db.GetBlockRow(first.Hash)
+	assert.NoError(t, err)
+	assert.Equal(t, pruned.Hash, chainhash.Hash(disk.Parent))
+	disk, err = c.db.GetBlockRow(pruned.Hash)
+	assert.NoError(t, err)
+	assert.Equal(t, [][32]byte{first.Hash}, disk.Children)
+
+	// the main chain is still walkable from the tip to genesis.
+	row := c.ch.State().Tip()
+	for row.Parent != nil {
+		assert.Less(t, row.Parent.Slot, row.Slot)
+		row = row.Parent
+	}
+	assert.Equal(t, uint64(0), row.Slot)
+
+	// lookups inside the pruned ranges don't return the checkpoint before them.
+	tip := c.ch.State().Tip()
+	assert.Equal(t, pruned, tip.GetAncestorAtSlot(pruned.Slot))
+	assert.Equal(t, pruned, tip.GetAncestorAtHeight(pruned.Height))
+	assert.Equal(t, first, tip.GetAncestorAtSlot(first.Slot))
+	assert.Equal(t, first, tip.GetAncestorAtHeight(first.Height))
+	pruneSlots := []uint64{pruned.Slot - 1}
+	if first.Slot > pruned.Slot+1 {
+		pruneSlots = append(pruneSlots, first.Slot-1)
+	}
+	for _, slot := range pruneSlots {
+		// every slot has a block, so the heights of the main chain are the slots.
+		assert.Nil(t, tip.GetAncestorAtSlot(slot), "slot %d", slot)
+		assert.Nil(t, tip.GetAncestorAtHeight(slot), "height %d", slot)
+
+		_, found := c.ch.State().Chain().GetNodeBySlot(slot)
+		assert.False(t, found, "slot %d", slot)
+		_, err := c.ch.State().GetStateAtSlot(slot)
+		assert.Error(t, err, "slot %d", slot)
+	}
+}
+
+func TestPruneBlocks_SyncFromPrunedHead(t *testing.T) {
+	c := newTestChain(t, func(f *config.Flags) {
+		f.Prune = true
+		f.PruneDepth = 1
+	})
+	c.extendTo(60)
+
+	pruned := c.ch.State().PrunedHead()
+	assert.Greater(t, pruned.Slot, uint64(0))
+
+	// walking the chain from the pruned head returns every stored block up to the tip.
+	var slots []uint64
+	row, ok := c.ch.State().Chain().Next(pruned)
+	for ok {
+		block, err := c.ch.GetBlock(row.Hash)
+		assert.NoError(t, err)
+		slots = append(slots, block.Header.Slot)
+		row, ok = c.ch.State().Chain().Next(row)
+	}
+	first := pruned.Children()[0]
+	assert.Len(t, slots, int(60-first.Slot+1))
+	assert.Equal(t, first.Slot, slots[0])
+	assert.Equal(t, uint64(60), slots[len(slots)-1])
+}
@@ -0,0 +1,43 @@
+package chain_test
+
+import (
+	"testing"
+
+	"github.com/olympus-protocol/ogen/pkg/primitives"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProcessBlock_ContractReceipts(t *testing.T) {
+	c := newTestChain(t, nil)
+	c.extend(1)
+
+	var pub [48]byte
+	copy(pub[:], c.keys.Premine.PublicKey().Marshal())
+	tx := &primitives.ContractDeploy{FromPublicKey: pub, Code: []byte{0x00}, Nonce: 1, GasLimit: 100000, GasPrice: 1}
+	msg := tx.SignatureMessage()
+	copy(tx.Signature[:], c.keys.Premine.Sign(msg[:]).Marshal())
+
+	tip := c.ch.State().Tip()
+	st, err := c.ch.State().TipStateAtSlot(2)
+	assert.NoError(t, err)
+	block, err := c.keys.Block(st, tip.Hash, func(b *primitives.Block) {
+		b.ContractDeploys = []*primitives.ContractDeploy{tx}
+	})
+	assert.NoError(t, err)
+	assert.NoError(t, c.ch.ProcessBlock(block))
+
+	receipts, err := c.ch.GetContractReceipts(block.Hash())
+	assert.NoError(t, err)
+	assert.Len(t, receipts, 1)
+	assert.Equal(t, tx.Hash(), receipts[0].TxHash)
+	assert.True(t, receipts[0].Success())
+
+	contract, err := tx.ContractAddress()
+	assert.NoError(t, err)
+	assert.Equal(t, contract, receipts[0].Contract)
+
+	// blocks without contract txs have no receipts.
+	receipts, err = c.ch.GetContractReceipts(tip.Hash)
+	assert.NoError(t, err)
+	assert.Empty(t, receipts)
+}
@@ -0,0 +1,124 @@
+package chain
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/olympus-protocol/ogen/internal/blockdb"
+	"github.com/olympus-protocol/ogen/pkg/chainhash"
+)
+
+// errHalfWrittenImport is returned when the database contains a block import that was not completely written.
+var errHalfWrittenImport = errors.New("half-written block import")
+
+// checkDatabase verifies that every block imported after the finalized head was completely written and that the
+// tip and justified head point to known rows.
+func (s *stateService) checkDatabase(db blockdb.Database) ([]chainhash.Hash, error) {
+	finalizedHead, err := db.GetFinalizedHead()
+	if err != nil {
+		return nil, err
+	}
+	finalizedRow, err := db.GetBlockRow(finalizedHead)
+	if err != nil {
+		return nil, fmt.Errorf("unable to find finalized row %s: %s", finalizedHead, err)
+	}
+
+	var descendants []chainhash.Hash
+	var inconsistent error
+
+	seen := make(map[chainhash.Hash]struct{})
+	queue := finalizedRow.Children
+	for len(queue) > 0 {
+		current := chainhash.Hash(queue[0])
+		queue = queue[1:]
+
+		descendants = append(descendants, current)
+		seen[current] = struct{}{}
+
+		row, err := db.GetBlockRow(current)
+		if err != nil {
+			inconsistent = fmt.Errorf("%w: missing row %s", errHalfWrittenImport, current)
+			continue
+		}
+		if _, err := db.GetRawBlock(current); err != nil {
+			inconsistent = fmt.Errorf("%w: missing block %s", errHalfWrittenImport, current)
+		}
+		queue = append(queue, row.Children...)
+	}
+
+	for _, get := range []func() (chainhash.Hash, error){db.GetTip, db.GetJustifiedHead} {
+		h, err := get()
+		if err != nil {
+			inconsistent = fmt.Errorf("%w: %s", errHalfWrittenImport, err)
+			continue
+		}
+		if _, found := seen[h]; !found && !h.IsEqual(&finalizedHead) {
+			inconsistent = fmt.Errorf("%w: head %s is not a descendant of the finalized head", errHalfWrittenImport, h)
+		}
+	}
+
+	if _, err := db.GetJustifiedState(); err != nil {
+		inconsistent = fmt.Errorf("%w: %s", errHalfWrittenImport, err)
+	}
+
+	return descendants, inconsistent
+}
+
+// repairDatabase runs the startup consistency check and, if it finds a half-written import, rolls the database back
+// to the last finalized state removing every block imported after it.
+func (s *stateService) repairDatabase(db blockdb.Database) error {
+	descendants, err := s.checkDatabase(db)
+	if err == nil {
+		return nil
+	}
+	if !errors.Is(err, errHalfWrittenImport) {
+		return err
+	}
+
+	s.log.With("err", err).Warn("database is inconsistent, rolling back to the finalized head")
+
+	return db.Update(func(tx blockdb.Database) error {
+		finalizedHead, err := tx.GetFinalizedHead()
+		if err != nil {
+			return err
+		}
+		finalizedState, err := tx.GetFinalizedState()
+		if err != nil {
+			return err
+		}
+		finalizedRow, err := tx.GetBlockRow(finalizedHead)
+		if err != nil {
+			return err
+		}
+
+		for _, h := range descendants {
+			if err := tx.DeleteBlock(h); err != nil {
+				return err
+			}
+			if err := tx.DeleteContractReceipts(h); err != nil {
+				return err
+			}
+			if err := tx.DeleteTreeState(h); err != nil {
+				return err
+			}
+			if err := tx.DeleteBlockRow(h); err != nil {
+				return err
+			}
+		}
+
+		finalizedRow.Children = nil
+		if err := tx.SetBlockRow(finalizedRow); err != nil {
+			return err
+		}
+		if err := tx.SetJustifiedHead(finalizedHead); err != nil {
+			return err
+		}
+		if err := tx.SetJustifiedState(finalizedState); err != nil {
+			return err
+		}
+
+		s.log.With("removed", len(descendants), "finalized", finalizedHead).Info("removed blocks after finalized head")
+
+		return tx.SetTip(finalizedHead)
+	})
+}
@@ -0,0 +1,138 @@
+package chain_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/olympus-protocol/ogen/internal/blockdb"
+	"github.com/olympus-protocol/ogen/internal/chain"
+	"github.com/olympus-protocol/ogen/pkg/chainhash"
+	"github.com/olympus-protocol/ogen/pkg/primitives"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRepair_ConsistentDatabase(t *testing.T) {
+	c := newTestChain(t, nil)
+	c.extendTo(22)
+
+	tip := c.ch.State().Tip()
+	justified, _ := c.ch.State().GetJustifiedHead()
+
+	ch, err := chain.NewBlockchain(c.db)
+	assert.NoError(t, err)
+	assert.Equal(t, tip.Hash, ch.State().Tip().Hash)
+	restartJustified, _ := ch.State().GetJustifiedHead()
+	assert.Equal(t, justified.Hash, restartJustified.Hash)
+	for slot := uint64(1); slot <= 22; slot++ {
+		_, err := c.db.GetRawBlock(c.blocks[slot].Hash())
+		assert.NoError(t, err)
+	}
+}
+
+func TestRepair_HalfWrittenImport(t *testing.T) {
+	c := newTestChain(t, nil)
+	c.extendTo(22)
+
+	finalized, _ := c.ch.State().GetFinalizedHead()
+	assert.Greater(t, finalized.Slot, uint64(0))
+
+	// the body of the tip is missing, as if the node stopped in the middle of the import.
+	assert.NoError(t, c.db.DeleteBlock(c.ch.State().Tip().Hash))
+
+	ch, err := chain.NewBlockchain(c.db)
+	assert.NoError(t, err)
+	assert.Equal(t, finalized.Hash, ch.State().Tip().Hash)
+	justified, _ := ch.State().GetJustifiedHead()
+	assert.Equal(t, finalized.Hash, justified.Hash)
+
+	for slot := uint64(1); slot <= 22; slot++ {
+		hash := c.blocks[slot].Hash()
+		_, err := c.db.GetBlockRow(hash)
+		if slot <= finalized.Slot {
+			assert.NoError(t, err, "row of finalized slot %d removed", slot)
+		} else {
+			assert.Error(t, err, "row of slot %d not removed", slot)
+			_, err = c.db.GetRawBlock(hash)
+			assert.Error(t, err)
+		}
+	}
+
+	row, err := c.db.GetBlockRow(finalized.Hash)
+	assert.NoError(t, err)
+	assert.Empty(t, row.Children)
+
+	// the repaired chain keeps importing blocks from the finalized head.
+	c.ch = ch
+	c.extendTo(finalized.Slot + 3)
+	assert.Equal(t, finalized.Slot+3, ch.State().Tip().Slot)
+}
+
+func TestRepair_UnknownTip(t *testing.T) {
+	c := newTestChain(t, nil)
+	c.extendTo(22)
+
+	finalized, _ := c.ch.State().GetFinalizedHead()
+	assert.NoError(t, c.db.SetTip(chainhash.Hash{1}))
+
+	ch, err := chain.NewBlockchain(c.db)
+	assert.NoError(t, err)
+	assert.Equal(t, finalized.Hash, ch.State().Tip().Hash)
+}
+
+// failingStore fails every batch write while fail is set.
+type failingStore struct {
+	blockdb.Store
+	fail bool
+}
+
+func (s *failingStore) Write(b *blockdb.Batch) error {
+	if s.fail {
+		return errors.New("write failed")
+	}
+	return s.Store.Write(b)
+}
+
+func TestProcessBlock_FailedWrite(t *testing.T) {
+	store := &failingStore{Store: blockdb.NewMemoryStore()}
+	c := newTestChainWithDatabase(t, blockdb.NewDatabaseWithStore(store), nil)
+	c.extendTo(3)
+
+	tip := c.ch.State().Tip()
+	voteState, err := c.ch.State().TipStateAtSlot(3)
+	assert.NoError(t, err)
+	vote, err := c.keys.Vote(voteState, tip.Hash)
+	assert.NoError(t, err)
+	st, err := c.ch.State().TipStateAtSlot(4)
+	assert.NoError(t, err)
+	block, err := c.keys.Block(st, tip.Hash, func(b *primitives.Block) {
+		b.Votes = []*primitives.MultiValidatorVote{vote}
+	})
+	assert.NoError(t, err)
+
+	// nothing changes in memory when the import can't be written.
+	store.fail = true
+	assert.Error(t, c.ch.ProcessBlock(block))
+	assert.Equal(t, tip.Hash, c.ch.State().Tip().Hash)
+	assert.Empty(t, tip.Children())
+	_, found := c.ch.State().GetRowByHash(block.Hash())
+	assert.False(t, found)
+
+	committee, err := voteState.GetVoteCommittee(vote.Data.Slot)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, committee)
+	for _, v := range committee {
+		latest, ok := c.ch.State().GetLatestVote(v)
+		if ok {
+			assert.Less(t, latest.Data.Slot, vote.Data.Slot)
+		}
+	}
+
+	store.fail = false
+	assert.NoError(t, c.ch.ProcessBlock(block))
+	assert.Equal(t, block.Hash(), c.ch.State().Tip().Hash)
+	for _, v := range committee {
+		latest, ok := c.ch.State().GetLatestVote(v)
+		assert.True(t, ok)
+		assert.Equal(t, vote, latest)
+	}
+}
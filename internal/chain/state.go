@@ -79,7 +79,7 @@ type blockNodeAndState struct {
 
 type StateService interface {
 	GetLatestVote(val uint64) (*primitives.MultiValidatorVote, bool)
-	SetLatestVotesIfNeeded(vals []uint64, vote *primitives.MultiValidatorVote)
+	SetLatestVotesIfNeeded(votes map[uint64]*primitives.MultiValidatorVote)
 	Chain() *Chain
 	Index() *chainindex.BlockIndex
 	SetFinalizedHead(finalizedHash chainhash.Hash, finalizedState state.State) error
@@ -88,7 +88,10 @@ type StateService interface {
 	GetJustifiedHead() (*chainindex.BlockRow, state.State)
 	GetStateForHash(hash chainhash.Hash) (state.State, bool)
 	GetStateForHashAtSlot(hash chainhash.Hash, slot uint64, view state.BlockView) (state.State, []*primitives.EpochReceipt, error)
+	GetStateForBlock(hash chainhash.Hash) (state.State, error)
+	GetStateAtSlot(slot uint64) (state.State, error)
 	Add(block *primitives.Block) (state.State, []*primitives.EpochReceipt, error)
+	StoreBlockState(tx blockdb.Database, row *chainindex.BlockRow, st state.State) error
 	RemoveBeforeSlot(slot uint64)
 	GetRowByHash(h chainhash.Hash) (*chainindex.BlockRow, bool)
 	Height() uint64
@@ -96,6 +99,8 @@ type StateService interface {
 	TipStateAtSlot(slot uint64) (state.State, error)
 	GetSubView(tip chainhash.Hash) (View, error)
 	Tip() *chainindex.BlockRow
+	PrunedHead() *chainindex.BlockRow
+	SetPrunedHead(row *chainindex.BlockRow)
 }
 
 // stateService keeps track of the blockchain and its state. This is where pruning should eventually be implemented to
@@ -114,9 +119,14 @@ type stateService struct {
 	headLock      sync.Mutex
 	finalizedHead blockNodeAndState
 	justifiedHead blockNodeAndState
+	prunedHead    *chainindex.BlockRow
 
 	latestVotes     map[uint64]*primitives.MultiValidatorVote
 	latestVotesLock sync.Mutex
+
+	genesisState    state.State
+	archive         bool
+	archiveInterval uint64
 }
 
 var _ StateService = &stateService{}
@@ -129,11 +139,11 @@ func (s *stateService) GetLatestVote(val uint64) (*primitives.MultiValidatorVote
 	return v, ok
 }
 
-// SetLatestVotesIfNeeded sets the latest vote for this validator.
-func (s *stateService) SetLatestVotesIfNeeded(vals []uint64, vote *primitives.MultiValidatorVote) {
+// SetLatestVotesIfNeeded sets the latest vote of every validator whose vote is newer than the stored one.
+func (s *stateService) SetLatestVotesIfNeeded(votes map[uint64]*primitives.MultiValidatorVote) {
 	s.latestVotesLock.Lock()
 	defer s.latestVotesLock.Unlock()
-	for _, v := range vals {
+	for v, vote := range votes {
 		oldVote, ok := s.latestVotes[v]
 		if ok && oldVote.Data.Slot >= vote.Data.Slot {
 			continue
@@ -217,12 +227,16 @@ func (s *stateService) initChainState(db blockdb.Database, genesisState state.St
 
 	s.index = blockIndex
 	s.chain = NewChain(row)
+	s.prunedHead = row
 
 	if _, err := db.GetBlockRow(genesisHash); err != nil {
 		if err := s.initializeDatabase(db, row, genesisState); err != nil {
 			return err
 		}
 	} else {
+		if err := s.repairDatabase(db); err != nil {
+			return err
+		}
 		if err := s.loadBlockchainFromDisk(db, genesisHash); err != nil {
 			return err
 		}
@@ -287,6 +301,14 @@ func (s *stateService) Add(block *primitives.Block) (state.State, []*primitives.
 	return newState, receipts, nil
 }
 
+// StoreBlockState stores the state of a newly added block so it can be restored without processing the block again.
+func (s *stateService) StoreBlockState(tx blockdb.Database, row *chainindex.BlockRow, st state.State) error {
+	if err := tx.SetTreeState(row.Hash, st); err != nil {
+		return err
+	}
+	return s.checkpointIfNeeded(tx, row.Parent.Slot, row.Hash, st)
+}
+
 // RemoveBeforeSlot removes state before a certain slot.
 func (s *stateService) RemoveBeforeSlot(slot uint64) {
 	s.stateMapLock.Lock()
@@ -298,13 +320,16 @@ func (s *stateService) RemoveBeforeSlot(slot uint64) {
 	statesCleaned := 0
 	for i, st := range s.stateMap {
 		if st.firstSlot < slot {
-			s.log.Debugf("deleting state for %s", i)
+			s.log.With("block", i).Debug("deleting state")
 			delete(s.stateMap, i)
+			if err := s.db.DeleteTreeState(i); err != nil {
+				s.log.With("block", i, "err", err).Error("unable to delete tree state")
+			}
 			statesCleaned++
 			continue
 		}
 	}
-	s.log.Debugf("removed %d states on map observing %d states", statesCleaned, len(s.stateMap))
+	s.log.With("removed", statesCleaned, "remaining", len(s.stateMap)).Debug("removed states")
 }
 
 // GetRowByHash gets a specific row by hash.
@@ -341,7 +366,7 @@ func (s *stateService) TipStateAtSlot(slot uint64) (state.State, error) {
 func NewStateService(db blockdb.Database) (StateService, error) {
 	ip := config.GlobalParams.InitParams
 	netParams := config.GlobalParams.NetParams
-	log := config.GlobalParams.Logger
+	log := config.GlobalParams.Logger.Module("chain")
 
 	genesisBlock := primitives.GetGenesisBlock()
 	genesisHash := genesisBlock.Hash()
@@ -357,8 +382,11 @@ func NewStateService(db blockdb.Database) (StateService, error) {
 		stateMap: map[chainhash.Hash]*stateDerivedFromBlock{
 			genesisHash: newStateDerivedFromBlock(genesisState),
 		},
-		latestVotes: make(map[uint64]*primitives.MultiValidatorVote),
-		db:          db,
+		latestVotes:     make(map[uint64]*primitives.MultiValidatorVote),
+		db:              db,
+		genesisState:    genesisState.Copy(),
+		archive:         config.GlobalFlags.Archive,
+		archiveInterval: config.GlobalFlags.ArchiveInterval,
 	}
 
 	err = ss.initChainState(db, genesisState)
@@ -34,6 +34,27 @@ func (br *BlockRow) AddChild(child *BlockRow) {
 	br.children = append(br.children, child)
 }
 
+// RemoveChild removes a child from the block row.
+func (br *BlockRow) RemoveChild(child *BlockRow) {
+	br.childrenLock.Lock()
+	defer br.childrenLock.Unlock()
+
+	for i, c := range br.children {
+		if c.Hash.IsEqual(&child.Hash) {
+			br.children = append(br.children[:i:i], br.children[i+1:]...)
+			return
+		}
+	}
+}
+
+// SetChildren replaces the children of the block row.
+func (br *BlockRow) SetChildren(children ...*BlockRow) {
+	br.childrenLock.Lock()
+	defer br.childrenLock.Unlock()
+
+	br.children = children
+}
+
 // Children gets the children of the block row.
 func (br *BlockRow) Children() []*BlockRow {
 	childrenCopy := make([]*BlockRow, len(br.children))
@@ -43,22 +64,34 @@ func (br *BlockRow) Children() []*BlockRow {
 	return childrenCopy
 }
 
-// GetAncestorAtSlot gets the block row ancestor at a certain slot.
+// GetAncestorAtSlot gets the block row ancestor at a certain slot, or the last ancestor before the slot if there is no
+// block at the slot. Returns nil if the slot is after the row or if the blocks around the slot were pruned.
 func (br *BlockRow) GetAncestorAtSlot(slot uint64) *BlockRow {
 	if br.Slot < slot {
 		return nil
 	}
 
 	current := br
+	compacted := false
 
 	// go up to the slot after the slot we're searching for
 	for slot < current.Slot {
+		if current.Parent == nil {
+			return nil
+		}
+		compacted = current.Parent.Height+1 != current.Height
 		current = current.Parent
 	}
+
+	// a compacted link skips the pruned blocks, the block at the slot may be one of them.
+	if compacted && current.Slot != slot {
+		return nil
+	}
 	return current
 }
 
-// GetAncestorAtHeight gets the block row ancestor at a certain height.
+// GetAncestorAtHeight gets the block row ancestor at a certain height. Returns nil if the height is after the row or
+// if the block at the height was pruned.
 func (br *BlockRow) GetAncestorAtHeight(height uint64) *BlockRow {
 	if br.Height < height {
 		return nil
@@ -68,8 +101,16 @@ func (br *BlockRow) GetAncestorAtHeight(height uint64) *BlockRow {
 
 	// go up to the slot after the slot we're searching for
 	for height < current.Height {
+		if current.Parent == nil {
+			return nil
+		}
 		current = current.Parent
 	}
+
+	// the pruned heights are skipped by the compacted links.
+	if current.Height != height {
+		return nil
+	}
 	return current
 }
 
@@ -121,6 +162,14 @@ func (i *BlockIndex) Get(hash chainhash.Hash) (*BlockRow, bool) {
 	return row, found
 }
 
+// Remove removes a block from the block index.
+func (i *BlockIndex) Remove(hash chainhash.Hash) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	delete(i.index, hash)
+}
+
 // Have checks if the block chainindex contains a certain hash.
 func (i *BlockIndex) Have(hash chainhash.Hash) bool {
 	i.lock.Lock()
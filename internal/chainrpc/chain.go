@@ -9,16 +9,22 @@ import (
 	"reflect"
 
 	"github.com/olympus-protocol/ogen/api/proto"
+	"github.com/olympus-protocol/ogen/cmd/ogen/config"
 	"github.com/olympus-protocol/ogen/internal/chain"
 	"github.com/olympus-protocol/ogen/internal/chainindex"
+	"github.com/olympus-protocol/ogen/internal/mempool"
 	"github.com/olympus-protocol/ogen/pkg/bech32"
 	"github.com/olympus-protocol/ogen/pkg/chainhash"
 	"github.com/olympus-protocol/ogen/pkg/primitives"
+	"github.com/olympus-protocol/ogen/pkg/stateproof"
 	"github.com/shopspring/decimal"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type chainServer struct {
 	chain chain.Blockchain
+	coins mempool.CoinsMempool
 	proto.UnimplementedChainServer
 }
 
@@ -107,7 +113,7 @@ func (s *chainServer) GetBlockHash(ctx context.Context, in *proto.Number) (*prot
 
 	blockRow, exists := s.chain.State().Chain().GetNodeByHeight(in.Number)
 	if !exists {
-		return nil, errors.New("block not found")
+		return nil, status.Error(codes.NotFound, "block not found")
 	}
 	return &proto.Hash{
 		Hash: blockRow.Hash.String(),
@@ -128,9 +134,16 @@ func (s *chainServer) Sync(in *proto.Hash, stream proto.Chain_SyncServer) error
 	if err != nil {
 		return errors.New("unable to decode hash from string")
 	}
+	// The starting point must be part of the main chain, blocks on orphaned branches are not found.
 	currBlockRow, ok := s.chain.State().GetRowByHash(hash)
 	if !ok {
-		return errors.New("block starting point doesnt exist")
+		return status.Error(codes.NotFound, "block starting point doesnt exist")
+	}
+	if row, ok := s.chain.State().Chain().GetNodeByHeight(currBlockRow.Height); !ok || row != currBlockRow {
+		return status.Error(codes.NotFound, "block starting point is not part of the main chain")
+	}
+	if pruned := s.chain.State().PrunedHead(); currBlockRow.Slot < pruned.Slot {
+		return errors.New("block starting point is pruned")
 	}
 	blockRow, ok = s.chain.State().Chain().Next(currBlockRow)
 	if !ok {
@@ -214,72 +227,6 @@ func (s *chainServer) SubscribeBlocks(_ *proto.Empty, stream proto.Chain_Subscri
 	}
 }
 
-func (s *chainServer) SubscribeTransactions(in *proto.KeyPairs, stream proto.Chain_SubscribeTransactionsServer) error {
-	bn := newBlockNotifee(stream.Context(), s.chain)
-	accounts := make(map[[20]byte]struct{})
-	for _, a := range in.Keys {
-		account, err := hex.DecodeString(a)
-		if err != nil {
-			return err
-		}
-		if len(account) != 20 {
-			return fmt.Errorf("expected public key hashes to be 20 bytes but got %d", len(a))
-		}
-
-		var acc [20]byte
-		copy(acc[:], a)
-
-		accounts[acc] = struct{}{}
-	}
-
-	for {
-		select {
-		case bl := <-bn.blocks:
-			err := stream.Send(&proto.RawData{
-				Data: hex.EncodeToString(bl.block.SerializedTx(accounts)),
-			})
-			if err != nil {
-				return err
-			}
-		case <-stream.Context().Done():
-			return nil
-		}
-	}
-}
-
-func (s *chainServer) SubscribeValidatorTransaction(in *proto.KeyPairs, stream proto.Chain_SubscribeValidatorTransactionsServer) error {
-	bn := newBlockNotifee(stream.Context(), s.chain)
-	accounts := make(map[[48]byte]struct{})
-	for _, a := range in.Keys {
-		pubkey, err := hex.DecodeString(a)
-		if err != nil {
-			return err
-		}
-		if len(pubkey) != 48 {
-			return fmt.Errorf("expected public key to be 48 bytes but got %d", len(a))
-		}
-
-		var acc [48]byte
-		copy(acc[:], a)
-
-		accounts[acc] = struct{}{}
-	}
-
-	for {
-		select {
-		case bl := <-bn.blocks:
-			err := stream.Send(&proto.RawData{
-				Data: hex.EncodeToString(bl.block.SerializedEpochs(accounts)),
-			})
-			if err != nil {
-				return err
-			}
-		case <-stream.Context().Done():
-			return nil
-		}
-	}
-}
-
 func (s *chainServer) GetAccountInfo(ctx context.Context, data *proto.Account) (*proto.AccountInfo, error) {
 	defer ctx.Done()
 
@@ -290,14 +237,20 @@ func (s *chainServer) GetAccountInfo(ctx context.Context, data *proto.Account) (
 	}
 
 	copy(account[:], decoded)
-	coinsState := s.chain.State().TipState().GetCoinsState()
+
+	st, err := stateAt(s.chain, data.Slot, data.BlockHash)
+	if err != nil {
+		return nil, err
+	}
+
+	coinsState := st.GetCoinsState()
 	nonce := coinsState.Nonces[account]
 
 	confirmed := decimal.NewFromInt(int64(coinsState.Balances[account])).DivRound(decimal.NewFromInt(1e8), 8)
 
 	lock := decimal.NewFromInt(0)
 
-	for _, v := range s.chain.State().TipState().GetValidatorRegistry() {
+	for _, v := range st.GetValidatorRegistry() {
 		if v.PayeeAddress == account {
 			lock = lock.Add(decimal.NewFromInt(int64(v.Balance)).DivRound(decimal.NewFromInt(1e8), 8))
 		}
@@ -318,4 +271,288 @@ func (s *chainServer) GetAccountInfo(ctx context.Context, data *proto.Account) (
 	return accInfo, nil
 }
 
+// GetAccountProof returns the balance and nonce of an account with the witnesses that prove them against the state root
+// of a block. The block is selected by hash or slot, the tip is used when both are empty.
+func (s *chainServer) GetAccountProof(ctx context.Context, data *proto.Account) (*proto.AccountProof, error) {
+	defer ctx.Done()
+
+	var account [20]byte
+	_, decoded, err := bech32.Decode(data.Account)
+	if err != nil {
+		return nil, err
+	}
+
+	copy(account[:], decoded)
+
+	row, err := blockAt(s.chain, data.Slot, data.BlockHash)
+	if err != nil {
+		return nil, err
+	}
+
+	st, err := s.chain.State().GetStateForBlock(row.Hash)
+	if err != nil {
+		return nil, err
+	}
+
+	stateRoot, witnesses, err := st.ProveKeys(primitives.BalanceKey(account), primitives.NonceKey(account))
+	if err != nil {
+		return nil, err
+	}
+
+	coinsState := st.GetCoinsState()
+
+	proof := &stateproof.AccountProof{
+		Account:        account,
+		Balance:        coinsState.Balances[account],
+		Nonce:          coinsState.Nonces[account],
+		BlockHash:      row.Hash,
+		Slot:           row.Slot,
+		StateRoot:      stateRoot,
+		BalanceWitness: witnesses[0],
+		NonceWitness:   witnesses[1],
+	}
+
+	return proof.ToProto(config.GlobalParams.NetParams.AccountPrefixes.Public), nil
+}
+
+func (s *chainServer) GetContractStorage(ctx context.Context, data *proto.ContractStorageKey) (*proto.ContractStorageValue, error) {
+	defer ctx.Done()
+
+	var contract [20]byte
+	_, decoded, err := bech32.Decode(data.Contract)
+	if err != nil {
+		return nil, err
+	}
+	if len(decoded) != 20 {
+		return nil, errors.New("invalid contract address")
+	}
+	copy(contract[:], decoded)
+
+	keyBytes, err := hex.DecodeString(data.Key)
+	if err != nil {
+		return nil, err
+	}
+	if len(keyBytes) > chainhash.HashSize {
+		return nil, errors.New("invalid storage key")
+	}
+
+	// Keys are words, shorter keys are left padded with zeros.
+	var key chainhash.Hash
+	copy(key[chainhash.HashSize-len(keyBytes):], keyBytes)
+
+	c, ok := s.chain.State().TipState().GetContractsState().Contracts[contract]
+	if !ok {
+		return nil, errors.New("contract not found")
+	}
+
+	value := c.Storage[key]
+	codeHash := c.CodeHash()
+
+	return &proto.ContractStorageValue{
+		Contract: data.Contract,
+		Key:      hex.EncodeToString(key[:]),
+		Value:    hex.EncodeToString(value[:]),
+		CodeHash: hex.EncodeToString(codeHash[:]),
+	}, nil
+}
+
+func (s *chainServer) GetAsset(ctx context.Context, data *proto.AssetID) (*proto.AssetInfo, error) {
+	defer ctx.Done()
+
+	id, err := decodeAssetID(data.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	cs := s.chain.State().TipState().GetCoinsState()
+	a, ok := cs.Assets[id]
+	if !ok {
+		return nil, errors.New("asset not found")
+	}
+
+	holders := uint64(0)
+	for _, b := range a.Balances {
+		if b > 0 {
+			holders++
+		}
+	}
+
+	return &proto.AssetInfo{
+		Id:      hex.EncodeToString(id[:]),
+		Issuer:  bech32.Encode(config.GlobalParams.NetParams.AccountPrefixes.Public, a.Issuer[:]),
+		Supply:  a.Supply,
+		Holders: holders,
+	}, nil
+}
+
+func (s *chainServer) GetBlockReceipts(ctx context.Context, in *proto.Hash) (*proto.BlockReceipts, error) {
+	defer ctx.Done()
+
+	hash, err := chainhash.NewHashFromStr(in.Hash)
+	if err != nil {
+		return nil, err
+	}
+
+	row, ok := s.chain.State().GetRowByHash(hash)
+	if !ok {
+		return nil, errors.New("block not found")
+	}
+	if row.Parent == nil {
+		return &proto.BlockReceipts{BlockHash: row.Hash.String()}, nil
+	}
+
+	parentState, err := s.chain.State().GetStateForBlock(row.Parent.Hash)
+	if err != nil {
+		return nil, err
+	}
+
+	netParams := config.GlobalParams.NetParams
+	res := &proto.BlockReceipts{BlockHash: row.Hash.String()}
+
+	// The slots are processed one by one to know the epoch of each transition. Epoch transitions only modify coin
+	// balances to pay the managers, so the balances that change during a transition are the manager payouts.
+	st := parentState.Copy()
+	view := chain.NewChainView(row.Parent)
+	for slot := st.GetSlot() + 1; slot <= row.Slot; slot++ {
+		var balances map[[20]byte]uint64
+		if st.GetSlot()%netParams.EpochLength == 0 {
+			balances = make(map[[20]byte]uint64)
+			for acc, b := range st.GetCoinsState().Balances {
+				balances[acc] = b
+			}
+		}
+
+		epoch := st.GetEpochIndex()
+		receipts, err := st.ProcessSlots(slot, &view)
+		if err != nil {
+			return nil, err
+		}
+		if st.GetEpochIndex() == epoch {
+			continue
+		}
+		epoch = st.GetEpochIndex()
+
+		registry := st.GetValidatorRegistry()
+		for _, r := range receipts {
+			if r.Validator >= uint64(len(registry)) {
+				continue
+			}
+			res.Receipts = append(res.Receipts, &proto.EpochReceipt{
+				Epoch:              epoch,
+				Type:               r.Type,
+				TypeString:         r.TypeString(),
+				Amount:             r.Amount,
+				ValidatorPublicKey: hex.EncodeToString(registry[r.Validator].PubKey[:]),
+			})
+		}
+
+		for _, v := range registry {
+			res.Balances = append(res.Balances, &proto.ValidatorBalance{
+				Epoch:     epoch,
+				PublicKey: hex.EncodeToString(v.PubKey[:]),
+				Balance:   v.Balance,
+			})
+		}
+
+		for acc, b := range st.GetCoinsState().Balances {
+			if balances == nil || b <= balances[acc] {
+				continue
+			}
+			res.Payouts = append(res.Payouts, &proto.ManagerPayout{
+				Epoch:   epoch,
+				Account: bech32.Encode(netParams.AccountPrefixes.Public, acc[:]),
+				Amount:  b - balances[acc],
+			})
+		}
+	}
+
+	return res, nil
+}
+
+func (s *chainServer) GetContractReceipts(ctx context.Context, in *proto.Hash) (*proto.ContractReceipts, error) {
+	defer ctx.Done()
+
+	hash, err := chainhash.NewHashFromStr(in.Hash)
+	if err != nil {
+		return nil, err
+	}
+
+	receipts, err := s.chain.GetContractReceipts(hash)
+	if err != nil {
+		return nil, errors.New("block not found")
+	}
+
+	res := &proto.ContractReceipts{BlockHash: hash.String()}
+	for _, r := range receipts {
+		receipt := &proto.ContractReceipt{
+			TxHash:   r.TxHash.String(),
+			Contract: bech32.Encode(config.GlobalParams.NetParams.AccountPrefixes.Contract, r.Contract[:]),
+			GasUsed:  r.GasUsed,
+			Return:   hex.EncodeToString(r.Return[:]),
+			Success:  r.Success(),
+		}
+		if r.Err != nil {
+			receipt.Error = r.Err.Error()
+		}
+		res.Receipts = append(res.Receipts, receipt)
+	}
+	return res, nil
+}
+
+// decodeAssetID decodes an hex encoded asset identifier.
+func decodeAssetID(s string) ([20]byte, error) {
+	var id [20]byte
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		return id, err
+	}
+	if len(b) != 20 {
+		return id, errors.New("invalid asset id")
+	}
+	copy(id[:], b)
+	return id, nil
+}
+
+// blockAt returns the block row for the block hash or the slot provided, or the tip if both are empty.
+func blockAt(ch chain.Blockchain, slot *uint64, blockHash string) (*chainindex.BlockRow, error) {
+	if blockHash != "" {
+		hash, err := chainhash.NewHashFromStr(blockHash)
+		if err != nil {
+			return nil, err
+		}
+		row, ok := ch.State().GetRowByHash(hash)
+		if !ok {
+			return nil, errors.New("block not found")
+		}
+		return row, nil
+	}
+	if slot != nil {
+		tip := ch.State().Tip()
+		if *slot > tip.Slot {
+			return nil, fmt.Errorf("slot %d is after the chain tip at slot %d", *slot, tip.Slot)
+		}
+		row := tip.GetAncestorAtSlot(*slot)
+		if row == nil {
+			return nil, errors.New("block not found")
+		}
+		return row, nil
+	}
+	return ch.State().Tip(), nil
+}
+
+// stateAt returns the state for the block hash or the slot when provided, or the tip state otherwise.
+func stateAt(ch chain.Blockchain, slot *uint64, blockHash string) (state.State, error) {
+	if blockHash != "" {
+		hash, err := chainhash.NewHashFromStr(blockHash)
+		if err != nil {
+			return nil, err
+		}
+		return ch.State().GetStateForBlock(hash)
+	}
+	if slot != nil {
+		return ch.State().GetStateAtSlot(*slot)
+	}
+	return ch.State().TipState(), nil
+}
+
 var _ proto.ChainServer = &chainServer{}
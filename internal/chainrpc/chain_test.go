@@ -0,0 +1,72 @@
+package chainrpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/olympus-protocol/ogen/api/proto"
+	"github.com/olympus-protocol/ogen/cmd/ogen/config"
+	"github.com/olympus-protocol/ogen/internal/blockdb"
+	"github.com/olympus-protocol/ogen/internal/chain"
+	"github.com/olympus-protocol/ogen/pkg/stateproof"
+	"github.com/olympus-protocol/ogen/test/testchain"
+	"github.com/stretchr/testify/assert"
+)
+
+// testBlockchain returns a blockchain with only the genesis block. The global params and flags are restored when the
+// test ends.
+func testBlockchain(t *testing.T) (chain.Blockchain, *testchain.Keys) {
+	params, flags := config.GlobalParams, config.GlobalFlags
+	t.Cleanup(func() {
+		config.GlobalParams, config.GlobalFlags = params, flags
+	})
+
+	keys, err := testchain.Setup(10)
+	assert.NoError(t, err)
+	ch, err := chain.NewBlockchain(blockdb.NewMemoryDatabase())
+	assert.NoError(t, err)
+	return ch, keys
+}
+
+func TestBlockAt(t *testing.T) {
+	ch, _ := testBlockchain(t)
+	tip := ch.State().Tip()
+
+	row, err := blockAt(ch, nil, "")
+	assert.NoError(t, err)
+	assert.Equal(t, tip, row)
+
+	slot := uint64(0)
+	row, err = blockAt(ch, &slot, "")
+	assert.NoError(t, err)
+	assert.Equal(t, tip, row)
+
+	row, err = blockAt(ch, nil, tip.Hash.String())
+	assert.NoError(t, err)
+	assert.Equal(t, tip, row)
+
+	slot = 1
+	_, err = blockAt(ch, &slot, "")
+	assert.Error(t, err)
+
+	_, err = blockAt(ch, nil, "0000000000000000000000000000000000000000000000000000000000000001")
+	assert.Error(t, err)
+}
+
+func TestGetAccountProof(t *testing.T) {
+	ch, keys := testBlockchain(t)
+	s := &chainServer{chain: ch}
+
+	res, err := s.GetAccountProof(context.Background(), &proto.Account{Account: keys.InitParams.PremineAddress})
+	assert.NoError(t, err)
+
+	proof, err := stateproof.FromProto(res)
+	assert.NoError(t, err)
+	assert.NoError(t, proof.Verify())
+	assert.Greater(t, proof.Balance, uint64(0))
+	assert.Equal(t, ch.State().Tip().Hash, proof.BlockHash)
+
+	future := uint64(1)
+	_, err = s.GetAccountProof(context.Background(), &proto.Account{Account: keys.InitParams.PremineAddress, Slot: &future})
+	assert.Error(t, err)
+}
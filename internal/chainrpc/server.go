@@ -67,7 +67,7 @@ func (s *rpcServer) registerServices() {
 func (s *rpcServer) registerServicesProxy(ctx context.Context) {
 	certPool, err := LoadCerts()
 	if err != nil {
-		s.log.Fatal(err)
+		s.log.With("err", err).Fatal("unable to load the certificates")
 	}
 	creds := credentials.NewTLS(&tls.Config{
 		InsecureSkipVerify: false,
@@ -76,24 +76,24 @@ func (s *rpcServer) registerServicesProxy(ctx context.Context) {
 	opts := []grpc.DialOption{grpc.WithTransportCredentials(creds)}
 	err = proto.RegisterChainHandlerFromEndpoint(ctx, s.http, "127.0.0.1:24127", opts)
 	if err != nil {
-		s.log.Fatal(err)
+		s.log.With("service", "chain", "err", err).Fatal("unable to register the proxy handler")
 	}
 	err = proto.RegisterValidatorsHandlerFromEndpoint(ctx, s.http, "127.0.0.1:24127", opts)
 	if err != nil {
-		s.log.Fatal(err)
+		s.log.With("service", "validators", "err", err).Fatal("unable to register the proxy handler")
 	}
 	err = proto.RegisterUtilsHandlerFromEndpoint(ctx, s.http, "127.0.0.1:24127", opts)
 	if err != nil {
-		s.log.Fatal(err)
+		s.log.With("service", "utils", "err", err).Fatal("unable to register the proxy handler")
 	}
 	err = proto.RegisterNetworkHandlerFromEndpoint(ctx, s.http, "127.0.0.1:24127", opts)
 	if err != nil {
-		s.log.Fatal(err)
+		s.log.With("service", "network", "err", err).Fatal("unable to register the proxy handler")
 	}
 	if s.config.rpcwallet {
 		err = proto.RegisterWalletHandlerFromEndpoint(ctx, s.http, "127.0.0.1:24127", opts)
 		if err != nil {
-			s.log.Fatal(err)
+			s.log.With("service", "wallet", "err", err).Fatal("unable to register the proxy handler")
 		}
 	}
 }
@@ -132,7 +132,7 @@ func (s *rpcServer) Start() error {
 			handler := c.Handler(s.http)
 			err := http.ListenAndServeTLS(addr+":"+s.config.rpcproxyport, path.Join(config.GlobalFlags.DataPath, "cert", "cert.pem"), path.Join(config.GlobalFlags.DataPath, "cert", "cert_key.pem"), handler)
 			if err != nil {
-				s.log.Fatal(err)
+				s.log.With("addr", addr+":"+s.config.rpcproxyport, "err", err).Fatal("unable to serve the rpc proxy")
 			}
 
 		}()
@@ -149,9 +149,9 @@ func (s *rpcServer) Start() error {
 }
 
 // NewRPCServer Returns an RPC server instance
-func NewRPCServer(chain chain.Blockchain, hostnode hostnode.HostNode, wallet wallet.Wallet, ks keystore.Keystore, cm mempool.CoinsMempool) (RPCServer, error) {
+func NewRPCServer(chain chain.Blockchain, hostnode hostnode.HostNode, wallet wallet.Wallet, ks keystore.Keystore, cm mempool.CoinsMempool, ctm mempool.ContractsMempool, am mempool.AssetsMempool) (RPCServer, error) {
 	datapath := config.GlobalFlags.DataPath
-	log := config.GlobalParams.Logger
+	log := config.GlobalParams.Logger.Module("rpc")
 	netParams := config.GlobalParams.NetParams
 
 	_, err := LoadCerts()
@@ -177,6 +177,7 @@ func NewRPCServer(chain chain.Blockchain, hostnode hostnode.HostNode, wallet wal
 		log: log,
 		chainServer: &chainServer{
 			chain: chain,
+			coins: cm,
 		},
 		validatorsServer: &validatorsServer{
 			netParams: netParams,
@@ -186,9 +187,13 @@ func NewRPCServer(chain chain.Blockchain, hostnode hostnode.HostNode, wallet wal
 			host: hostnode,
 		},
 		utilsServer: &utilsServer{
-			keystore:     ks,
-			host:         hostnode,
-			coinsMempool: cm,
+			keystore:         ks,
+			host:             hostnode,
+			chain:            chain,
+			coinsMempool:     cm,
+			contractsMempool: ctm,
+			assetsMempool:    am,
+			log:              log,
 		},
 		walletServer: &walletServer{
 			wallet:    wallet,
@@ -0,0 +1,356 @@
+package chainrpc
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/olympus-protocol/ogen/api/proto"
+	"github.com/olympus-protocol/ogen/internal/chain"
+	"github.com/olympus-protocol/ogen/internal/chainindex"
+	"github.com/olympus-protocol/ogen/internal/state"
+	"github.com/olympus-protocol/ogen/pkg/bech32"
+	"github.com/olympus-protocol/ogen/pkg/chainhash"
+	"github.com/olympus-protocol/ogen/pkg/primitives"
+)
+
+// Statuses of the subscription events.
+const (
+	statusPending   = "pending"
+	statusIncluded  = "included"
+	statusJustified = "justified"
+	statusFinalized = "finalized"
+	statusOrphaned  = "orphaned"
+)
+
+// eventCursor is the type of the events sent for the blocks without events of the subscribed keys. They only carry the
+// block fields and the cursor, so subscribers resume after the block.
+const eventCursor = "cursor"
+
+// pendingQueueSize is the amount of mempool transactions that can wait to be matched before new ones are dropped.
+const pendingQueueSize = 64
+
+// subscriptionCursor is the view of the chain a subscriber already received: the tip and the heights of the
+// justified and finalized blocks.
+type subscriptionCursor struct {
+	tip       chainhash.Hash
+	justified uint64
+	finalized uint64
+}
+
+func (c subscriptionCursor) String() string {
+	b := make([]byte, 48)
+	copy(b, c.tip[:])
+	binary.BigEndian.PutUint64(b[32:], c.justified)
+	binary.BigEndian.PutUint64(b[40:], c.finalized)
+	return hex.EncodeToString(b)
+}
+
+func parseSubscriptionCursor(s string) (subscriptionCursor, error) {
+	b, err := hex.DecodeString(s)
+	if err != nil || len(b) != 48 {
+		return subscriptionCursor{}, errors.New("invalid cursor")
+	}
+	var c subscriptionCursor
+	copy(c.tip[:], b[:32])
+	c.justified = binary.BigEndian.Uint64(b[32:])
+	c.finalized = binary.BigEndian.Uint64(b[40:])
+	return c, nil
+}
+
+// eventStream is implemented by the streams of the subscriptions.
+type eventStream interface {
+	Send(*proto.SubscriptionEvent) error
+	Context() context.Context
+}
+
+// blockMatcher returns the events of the block items that belong to the subscribed keys. The block fields, status and
+// cursor are filled by the subscription.
+type blockMatcher func(block *primitives.Block) []*proto.SubscriptionEvent
+
+// pendingMatcher returns the events of a mempool transaction that belong to the subscribed keys.
+type pendingMatcher func(tx *primitives.Tx) []*proto.SubscriptionEvent
+
+// subscription sends the events of a subscriber from its cursor up to the current chain. Blocks that were reported
+// and left the main chain are reported as orphaned, then the new main chain blocks as included, and the blocks up to the
+// justified and finalized heads as justified and finalized. Events are sent at least once.
+type subscription struct {
+	chain  chain.Blockchain
+	stream eventStream
+	match  blockMatcher
+	cursor subscriptionCursor
+}
+
+func onMainChain(ch *chain.Chain, row *chainindex.BlockRow) bool {
+	main, ok := ch.GetNodeByHeight(row.Height)
+	return ok && main.Hash.IsEqual(&row.Hash)
+}
+
+// sendBlock sends the events of a block with a status and moves the cursor to next. A block without events is sent as a
+// cursor event, so the subscriber doesn't resume from a cursor that could be pruned.
+func (s *subscription) sendBlock(row *chainindex.BlockRow, status string, next subscriptionCursor) error {
+	block, err := s.chain.GetBlock(row.Hash)
+	if err != nil {
+		return err
+	}
+	events := s.match(block)
+	if len(events) == 0 {
+		events = []*proto.SubscriptionEvent{{Type: eventCursor}}
+	}
+	for i, e := range events {
+		e.Status = status
+		e.BlockHash = row.Hash.String()
+		e.Slot = row.Slot
+		e.Height = row.Height
+		// Only the last event of the block moves the cursor, a subscriber resuming from any other event receives the
+		// whole block again.
+		e.Cursor = s.cursor.String()
+		if i == len(events)-1 {
+			e.Cursor = next.String()
+		}
+		if err := s.stream.Send(e); err != nil {
+			return err
+		}
+	}
+	s.cursor = next
+	return nil
+}
+
+// sendHeights sends the events of the main chain blocks after the height of the cursor up to the height of head.
+func (s *subscription) sendHeights(ch *chain.Chain, from uint64, head *chainindex.BlockRow, status string, set func(c *subscriptionCursor, height uint64)) error {
+	for height := from + 1; height <= head.Height; height++ {
+		row, ok := ch.GetNodeByHeight(height)
+		if !ok {
+			return fmt.Errorf("block at height %d is not available, the cursor is too old", height)
+		}
+		next := s.cursor
+		set(&next, height)
+		if err := s.sendBlock(row, status, next); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// advance sends the events between the cursor and the current chain.
+func (s *subscription) advance() error {
+	st := s.chain.State()
+	ch := st.Chain()
+	tip := st.Tip()
+	justified, _ := st.GetJustifiedHead()
+	finalized, _ := st.GetFinalizedHead()
+
+	row, ok := st.GetRowByHash(s.cursor.tip)
+	if !ok {
+		return fmt.Errorf("unknown cursor block %s", s.cursor.tip)
+	}
+	for !onMainChain(ch, row) {
+		if row.Parent == nil {
+			return fmt.Errorf("unable to find the main chain ancestor of block %s", s.cursor.tip)
+		}
+		next := s.cursor
+		next.tip = row.Parent.Hash
+		if err := s.sendBlock(row, statusOrphaned, next); err != nil {
+			return err
+		}
+		row = row.Parent
+	}
+	if s.cursor.justified > row.Height {
+		s.cursor.justified = row.Height
+	}
+	if s.cursor.finalized > row.Height {
+		s.cursor.finalized = row.Height
+	}
+
+	err := s.sendHeights(ch, row.Height, tip, statusIncluded, func(c *subscriptionCursor, height uint64) {
+		r, _ := ch.GetNodeByHeight(height)
+		c.tip = r.Hash
+	})
+	if err != nil {
+		return err
+	}
+	err = s.sendHeights(ch, s.cursor.justified, justified, statusJustified, func(c *subscriptionCursor, height uint64) {
+		c.justified = height
+	})
+	if err != nil {
+		return err
+	}
+	return s.sendHeights(ch, s.cursor.finalized, finalized, statusFinalized, func(c *subscriptionCursor, height uint64) {
+		c.finalized = height
+	})
+}
+
+type tipNotifee struct {
+	tips chan struct{}
+}
+
+func (n *tipNotifee) NewTip(_ *chainindex.BlockRow, _ *primitives.Block, _ state.State, _ []*primitives.EpochReceipt) {
+	select {
+	case n.tips <- struct{}{}:
+	default:
+	}
+}
+
+func (n *tipNotifee) ProposerSlashingConditionViolated(_ *primitives.ProposerSlashing) {}
+
+type pendingNotifee struct {
+	txs chan *primitives.Tx
+}
+
+func (n *pendingNotifee) NotifyTx(tx *primitives.Tx) {
+	select {
+	case n.txs <- tx:
+	default:
+	}
+}
+
+// subscribe streams the events of the matchers until the stream is closed. Without a cursor the subscription starts
+// from the current chain.
+func (s *chainServer) subscribe(cursor string, stream eventStream, match blockMatcher, pending pendingMatcher) error {
+	tips := &tipNotifee{tips: make(chan struct{}, 1)}
+	s.chain.Notify(tips)
+	defer s.chain.Unnotify(tips)
+
+	txs := &pendingNotifee{txs: make(chan *primitives.Tx, pendingQueueSize)}
+	if pending != nil {
+		s.coins.Notify(txs)
+		defer s.coins.Unnotify(txs)
+	}
+
+	sub := &subscription{
+		chain:  s.chain,
+		stream: stream,
+		match:  match,
+	}
+	if cursor != "" {
+		c, err := parseSubscriptionCursor(cursor)
+		if err != nil {
+			return err
+		}
+		sub.cursor = c
+	} else {
+		st := s.chain.State()
+		justified, _ := st.GetJustifiedHead()
+		finalized, _ := st.GetFinalizedHead()
+		sub.cursor = subscriptionCursor{
+			tip:       st.Tip().Hash,
+			justified: justified.Height,
+			finalized: finalized.Height,
+		}
+	}
+
+	if err := sub.advance(); err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-tips.tips:
+			if err := sub.advance(); err != nil {
+				return err
+			}
+		case tx := <-txs.txs:
+			for _, e := range pending(tx) {
+				e.Status = statusPending
+				e.Cursor = sub.cursor.String()
+				if err := stream.Send(e); err != nil {
+					return err
+				}
+			}
+		case <-stream.Context().Done():
+			return nil
+		}
+	}
+}
+
+func (s *chainServer) SubscribeTransactions(in *proto.SubscribeRequest, stream proto.Chain_SubscribeTransactionsServer) error {
+	if len(in.Keys) == 0 {
+		return errors.New("no accounts to subscribe")
+	}
+	accounts := make(map[[20]byte]string)
+	for _, a := range in.Keys {
+		_, data, err := bech32.Decode(a)
+		if err != nil {
+			return fmt.Errorf("invalid account %s: %s", a, err)
+		}
+		if len(data) != 20 {
+			return fmt.Errorf("expected account %s to be 20 bytes but got %d", a, len(data))
+		}
+		var acc [20]byte
+		copy(acc[:], data)
+		accounts[acc] = a
+	}
+
+	// matchTx returns an event for the receiver and the sender of a transaction when they are subscribed.
+	matchTx := func(eventType string, hash chainhash.Hash, to [20]byte, from [20]byte, amount uint64) []*proto.SubscriptionEvent {
+		var events []*proto.SubscriptionEvent
+		if account, ok := accounts[to]; ok {
+			events = append(events, &proto.SubscriptionEvent{Type: eventType, Hash: hash.String(), Key: account, Amount: amount})
+		}
+		if account, ok := accounts[from]; ok && from != to {
+			events = append(events, &proto.SubscriptionEvent{Type: eventType, Hash: hash.String(), Key: account, Amount: amount})
+		}
+		return events
+	}
+
+	pending := func(tx *primitives.Tx) []*proto.SubscriptionEvent {
+		from, _ := tx.FromPubkeyHash()
+		return matchTx("tx", tx.Hash(), tx.To, from, tx.Amount)
+	}
+
+	match := func(block *primitives.Block) []*proto.SubscriptionEvent {
+		var events []*proto.SubscriptionEvent
+		for _, tx := range block.Txs {
+			from, _ := tx.FromPubkeyHash()
+			events = append(events, matchTx("tx", tx.Hash(), tx.To, from, tx.Amount)...)
+		}
+		return events
+	}
+
+	return s.subscribe(in.Cursor, stream, match, pending)
+}
+
+func (s *chainServer) SubscribeValidatorTransactions(in *proto.SubscribeRequest, stream proto.Chain_SubscribeValidatorTransactionsServer) error {
+	if len(in.Keys) == 0 {
+		return errors.New("no validators to subscribe")
+	}
+	validators := make(map[[48]byte]string)
+	for _, k := range in.Keys {
+		pubkey, err := hex.DecodeString(k)
+		if err != nil {
+			return err
+		}
+		if len(pubkey) != 48 {
+			return fmt.Errorf("expected public key to be 48 bytes but got %d", len(pubkey))
+		}
+		var pub [48]byte
+		copy(pub[:], pubkey)
+		validators[pub] = k
+	}
+
+	match := func(block *primitives.Block) []*proto.SubscriptionEvent {
+		var events []*proto.SubscriptionEvent
+		add := func(eventType string, hash chainhash.Hash, pubkey [48]byte) {
+			if key, ok := validators[pubkey]; ok {
+				events = append(events, &proto.SubscriptionEvent{Type: eventType, Hash: hash.String(), Key: key})
+			}
+		}
+		for _, d := range block.Deposits {
+			add("deposit", d.Hash(), d.Data.PublicKey)
+		}
+		for _, e := range block.Exits {
+			add("exit", e.Hash(), e.ValidatorPubkey)
+		}
+		for _, rs := range block.RANDAOSlashings {
+			add("randao_slashing", rs.Hash(), rs.ValidatorPubkey)
+		}
+		for _, ps := range block.ProposerSlashings {
+			add("proposer_slashing", ps.Hash(), ps.ValidatorPublicKey)
+		}
+		return events
+	}
+
+	return s.subscribe(in.Cursor, stream, match, nil)
+}
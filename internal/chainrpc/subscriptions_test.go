@@ -0,0 +1,133 @@
+package chainrpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/olympus-protocol/ogen/api/proto"
+	"github.com/olympus-protocol/ogen/internal/chain"
+	"github.com/olympus-protocol/ogen/pkg/chainhash"
+	"github.com/olympus-protocol/ogen/pkg/primitives"
+	"github.com/olympus-protocol/ogen/test/testchain"
+	"github.com/stretchr/testify/assert"
+)
+
+type testStream struct {
+	events []*proto.SubscriptionEvent
+}
+
+func (s *testStream) Send(e *proto.SubscriptionEvent) error {
+	s.events = append(s.events, e)
+	return nil
+}
+
+func (s *testStream) Context() context.Context {
+	return context.Background()
+}
+
+// addBlock processes a block at the slot on top of the parent. The votes are added to the block.
+func addBlock(t *testing.T, ch chain.Blockchain, keys *testchain.Keys, parent chainhash.Hash, slot uint64, votes ...*primitives.MultiValidatorVote) *primitives.Block {
+	view, err := ch.State().GetSubView(parent)
+	assert.NoError(t, err)
+	st, _, err := ch.State().GetStateForHashAtSlot(parent, slot, &view)
+	assert.NoError(t, err)
+	block, err := keys.Block(st, parent, func(b *primitives.Block) {
+		b.Votes = votes
+	})
+	assert.NoError(t, err)
+	assert.NoError(t, ch.ProcessBlock(block))
+	return block
+}
+
+// matchBlocks returns a matcher with one event for each of the blocks.
+func matchBlocks(blocks ...*primitives.Block) blockMatcher {
+	return func(block *primitives.Block) []*proto.SubscriptionEvent {
+		for _, b := range blocks {
+			if b.Hash() == block.Hash() {
+				return []*proto.SubscriptionEvent{{Type: "tx", Hash: block.Hash().String()}}
+			}
+		}
+		return nil
+	}
+}
+
+func genesisCursor(ch chain.Blockchain) subscriptionCursor {
+	genesis := ch.State().Chain().Genesis()
+	return subscriptionCursor{tip: genesis.Hash}
+}
+
+func TestSubscription_CursorResume(t *testing.T) {
+	ch, keys := testBlockchain(t)
+	genesis := ch.State().Tip().Hash
+
+	b1 := addBlock(t, ch, keys, genesis, 1)
+	b2 := addBlock(t, ch, keys, b1.Hash(), 2)
+	b3 := addBlock(t, ch, keys, b2.Hash(), 3)
+
+	stream := new(testStream)
+	sub := &subscription{chain: ch, stream: stream, match: matchBlocks(b2), cursor: genesisCursor(ch)}
+	assert.NoError(t, sub.advance())
+
+	// every block moves the cursor, the blocks without events are sent as cursor events.
+	if assert.Len(t, stream.events, 3) {
+		for i, b := range []*primitives.Block{b1, b2, b3} {
+			e := stream.events[i]
+			assert.Equal(t, statusIncluded, e.Status)
+			assert.Equal(t, b.Hash().String(), e.BlockHash)
+			assert.Equal(t, uint64(i+1), e.Height)
+			assert.Equal(t, subscriptionCursor{tip: b.Hash()}.String(), e.Cursor)
+		}
+		assert.Equal(t, eventCursor, stream.events[0].Type)
+		assert.Equal(t, "tx", stream.events[1].Type)
+		assert.Equal(t, eventCursor, stream.events[2].Type)
+	}
+
+	// a subscriber resuming from the event of the second block only receives the third block.
+	cursor, err := parseSubscriptionCursor(stream.events[1].Cursor)
+	assert.NoError(t, err)
+	resumed := new(testStream)
+	sub = &subscription{chain: ch, stream: resumed, match: matchBlocks(b2), cursor: cursor}
+	assert.NoError(t, sub.advance())
+	if assert.Len(t, resumed.events, 1) {
+		assert.Equal(t, b3.Hash().String(), resumed.events[0].BlockHash)
+	}
+
+	// a subscriber at the tip receives nothing.
+	last := new(testStream)
+	sub = &subscription{chain: ch, stream: last, match: matchBlocks(b2), cursor: sub.cursor}
+	assert.NoError(t, sub.advance())
+	assert.Empty(t, last.events)
+}
+
+func TestSubscription_Orphaned(t *testing.T) {
+	ch, keys := testBlockchain(t)
+	genesis := ch.State().Tip().Hash
+
+	// the fork of b1 and b2 has no votes, the main chain stays on the first child of the genesis block.
+	a1 := addBlock(t, ch, keys, genesis, 1)
+	b1 := addBlock(t, ch, keys, genesis, 2)
+	b2 := addBlock(t, ch, keys, b1.Hash(), 3)
+	assert.Equal(t, a1.Hash(), ch.State().Tip().Hash)
+
+	// the subscriber received the fork while it was the main chain.
+	stream := new(testStream)
+	sub := &subscription{chain: ch, stream: stream, match: matchBlocks(b1), cursor: subscriptionCursor{tip: b2.Hash()}}
+	assert.NoError(t, sub.advance())
+
+	if assert.Len(t, stream.events, 3) {
+		for i, b := range []*primitives.Block{b2, b1} {
+			e := stream.events[i]
+			assert.Equal(t, statusOrphaned, e.Status)
+			assert.Equal(t, b.Hash().String(), e.BlockHash)
+			assert.Equal(t, subscriptionCursor{tip: b.Header.PrevBlockHash}.String(), e.Cursor)
+		}
+		assert.Equal(t, eventCursor, stream.events[0].Type)
+		assert.Equal(t, "tx", stream.events[1].Type)
+
+		included := stream.events[2]
+		assert.Equal(t, statusIncluded, included.Status)
+		assert.Equal(t, a1.Hash().String(), included.BlockHash)
+		assert.Equal(t, subscriptionCursor{tip: a1.Hash()}.String(), included.Cursor)
+	}
+	assert.Equal(t, a1.Hash(), sub.cursor.tip)
+}
@@ -4,20 +4,27 @@ import (
 	"context"
 	"encoding/hex"
 	"errors"
+	"github.com/olympus-protocol/ogen/internal/chain"
 	"github.com/olympus-protocol/ogen/internal/hostnode"
 	"github.com/olympus-protocol/ogen/internal/keystore"
 	"github.com/olympus-protocol/ogen/internal/mempool"
 	"github.com/olympus-protocol/ogen/pkg/bls"
+	"github.com/olympus-protocol/ogen/pkg/logger"
 	"github.com/olympus-protocol/ogen/pkg/p2p"
+	"sort"
 
 	"github.com/olympus-protocol/ogen/api/proto"
 	"github.com/olympus-protocol/ogen/pkg/primitives"
 )
 
 type utilsServer struct {
-	keystore     keystore.Keystore
-	host         hostnode.HostNode
-	coinsMempool mempool.CoinsMempool
+	keystore         keystore.Keystore
+	host             hostnode.HostNode
+	chain            chain.Blockchain
+	coinsMempool     mempool.CoinsMempool
+	contractsMempool mempool.ContractsMempool
+	assetsMempool    mempool.AssetsMempool
+	log              logger.Logger
 	proto.UnimplementedUtilsServer
 }
 
@@ -111,6 +118,75 @@ func (s *utilsServer) SubmitRawData(ctx context.Context, data *proto.RawData) (*
 
 		return &proto.Success{Success: true, Data: exit.Hash().String()}, nil
 
+	case "contract_deploy":
+
+		deploy := new(primitives.ContractDeploy)
+
+		err := deploy.Unmarshal(dataBytes)
+		if err != nil {
+			return nil, errors.New("unable to decode raw data")
+		}
+
+		err = s.contractsMempool.AddDeploy(deploy, s.chain.State().TipState())
+		if err != nil {
+			return &proto.Success{Success: false, Error: err.Error()}, nil
+		}
+
+		msg := &p2p.MsgContractDeploy{Data: deploy}
+
+		err = s.host.Broadcast(msg)
+		if err != nil {
+			return &proto.Success{Success: false, Error: err.Error()}, nil
+		}
+
+		return &proto.Success{Success: true, Data: deploy.Hash().String()}, nil
+
+	case "contract_call":
+
+		call := new(primitives.ContractCall)
+
+		err := call.Unmarshal(dataBytes)
+		if err != nil {
+			return nil, errors.New("unable to decode raw data")
+		}
+
+		err = s.contractsMempool.AddCall(call, s.chain.State().TipState())
+		if err != nil {
+			return &proto.Success{Success: false, Error: err.Error()}, nil
+		}
+
+		msg := &p2p.MsgContractCall{Data: call}
+
+		err = s.host.Broadcast(msg)
+		if err != nil {
+			return &proto.Success{Success: false, Error: err.Error()}, nil
+		}
+
+		return &proto.Success{Success: true, Data: call.Hash().String()}, nil
+
+	case "asset_tx":
+
+		tx := new(primitives.AssetTx)
+
+		err := tx.Unmarshal(dataBytes)
+		if err != nil {
+			return nil, errors.New("unable to decode raw data")
+		}
+
+		err = s.assetsMempool.Add(tx, s.chain.State().TipState())
+		if err != nil {
+			return &proto.Success{Success: false, Error: err.Error()}, nil
+		}
+
+		msg := &p2p.MsgAssetTx{Data: tx}
+
+		err = s.host.Broadcast(msg)
+		if err != nil {
+			return &proto.Success{Success: false, Error: err.Error()}, nil
+		}
+
+		return &proto.Success{Success: true, Data: tx.Hash().String()}, nil
+
 	default:
 		return &proto.Success{Success: false, Error: "unknown raw data type"}, nil
 	}
@@ -225,6 +301,36 @@ func (s *utilsServer) SubscribeMempool(_ *proto.Empty, stream proto.Utils_Subscr
 
 }
 
+func (s *utilsServer) GetLogLevels(ctx context.Context, _ *proto.Empty) (*proto.LogLevels, error) {
+	defer ctx.Done()
+
+	levels := s.log.Levels()
+	modules := make([]string, 0, len(levels))
+	for m := range levels {
+		modules = append(modules, m)
+	}
+	sort.Strings(modules)
+
+	res := &proto.LogLevels{Levels: make([]*proto.LogLevel, len(modules))}
+	for i, m := range modules {
+		res.Levels[i] = &proto.LogLevel{Module: m, Level: levels[m].String()}
+	}
+	return res, nil
+}
+
+func (s *utilsServer) SetLogLevel(ctx context.Context, in *proto.LogLevel) (*proto.Success, error) {
+	defer ctx.Done()
+
+	level, err := logger.ParseLevel(in.Level)
+	if err != nil {
+		return &proto.Success{Success: false, Error: err.Error()}, nil
+	}
+	if err := s.log.SetLevel(in.Module, level); err != nil {
+		return &proto.Success{Success: false, Error: err.Error()}, nil
+	}
+	return &proto.Success{Success: true}, nil
+}
+
 type coinNotifee struct {
 	tx chan *primitives.Tx
 }
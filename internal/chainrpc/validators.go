@@ -8,6 +8,7 @@ import (
 	"github.com/olympus-protocol/ogen/api/proto"
 	"github.com/olympus-protocol/ogen/internal/chain"
 	"github.com/olympus-protocol/ogen/internal/keystore"
+	"github.com/olympus-protocol/ogen/internal/state"
 	"github.com/olympus-protocol/ogen/pkg/bech32"
 	"github.com/olympus-protocol/ogen/pkg/params"
 	"github.com/shopspring/decimal"
@@ -20,8 +21,24 @@ type validatorsServer struct {
 	proto.UnimplementedValidatorsServer
 }
 
-func (s *validatorsServer) GetValidatorsList(context.Context, *proto.Empty) (*proto.ValidatorsRegistry, error) {
-	validators := s.chain.State().TipState().GetValidators()
+func (s *validatorsServer) GetValidatorsList(ctx context.Context, _ *proto.Empty) (*proto.ValidatorsRegistry, error) {
+	defer ctx.Done()
+	return s.validatorsList(s.chain.State().TipState()), nil
+}
+
+func (s *validatorsServer) GetValidatorsListAt(ctx context.Context, in *proto.StateQuery) (*proto.ValidatorsRegistry, error) {
+	defer ctx.Done()
+
+	st, err := stateAt(s.chain, in.Slot, in.BlockHash)
+	if err != nil {
+		return nil, err
+	}
+	return s.validatorsList(st), nil
+}
+
+// validatorsList returns the validators registry of a state.
+func (s *validatorsServer) validatorsList(st state.State) *proto.ValidatorsRegistry {
+	validators := st.GetValidators()
 	parsedValidators := make([]*proto.ValidatorRegistry, len(validators.Validators))
 	for i, v := range validators.Validators {
 		newValidator := &proto.ValidatorRegistry{
@@ -39,7 +56,7 @@ func (s *validatorsServer) GetValidatorsList(context.Context, *proto.Empty) (*pr
 		PenaltyExit: validators.PenaltyExit,
 		Exited:      validators.Exited,
 		Starting:    validators.Starting,
-	}}, nil
+	}}
 }
 
 func (s *validatorsServer) GetAccountValidators(ctx context.Context, acc *proto.Account) (*proto.ValidatorsRegistry, error) {
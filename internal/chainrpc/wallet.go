@@ -8,6 +8,7 @@ import (
 	"github.com/olympus-protocol/ogen/api/proto"
 	"github.com/olympus-protocol/ogen/internal/chain"
 	"github.com/olympus-protocol/ogen/internal/wallet"
+	"github.com/olympus-protocol/ogen/pkg/bech32"
 	"github.com/olympus-protocol/ogen/pkg/bls"
 	"github.com/olympus-protocol/ogen/pkg/params"
 	"github.com/shopspring/decimal"
@@ -219,6 +220,134 @@ func (s *walletServer) SendTransaction(ctx context.Context, send *proto.SendTran
 
 	return &proto.Hash{Hash: hash.String()}, nil
 }
+
+func (s *walletServer) DeployContract(ctx context.Context, deploy *proto.DeployContractInfo) (*proto.ContractTx, error) {
+	defer ctx.Done()
+
+	code, err := hex.DecodeString(deploy.Code)
+	if err != nil {
+		return nil, err
+	}
+
+	input, err := hex.DecodeString(deploy.Input)
+	if err != nil {
+		return nil, err
+	}
+
+	amount, err := contractAmount(deploy.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	hash, contract, err := s.wallet.DeployContract(code, input, amount, deploy.GasLimit, deploy.GasPrice)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.ContractTx{Hash: hash.String(), Contract: bech32.Encode(s.netParams.AccountPrefixes.Contract, contract[:])}, nil
+}
+
+func (s *walletServer) CallContract(ctx context.Context, call *proto.CallContractInfo) (*proto.ContractTx, error) {
+	defer ctx.Done()
+
+	input, err := hex.DecodeString(call.Input)
+	if err != nil {
+		return nil, err
+	}
+
+	amount, err := contractAmount(call.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	hash, err := s.wallet.CallContract(call.Contract, input, amount, call.GasLimit, call.GasPrice)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.ContractTx{Hash: hash.String(), Contract: call.Contract}, nil
+}
+
+func (s *walletServer) IssueAsset(ctx context.Context, info *proto.AssetTxInfo) (*proto.AssetTx, error) {
+	defer ctx.Done()
+
+	var asset [20]byte
+	if info.Asset != "" {
+		var err error
+		asset, err = decodeAssetID(info.Asset)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	hash, id, err := s.wallet.IssueAsset(asset, info.Account, info.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.AssetTx{Hash: hash.String(), Asset: hex.EncodeToString(id[:])}, nil
+}
+
+func (s *walletServer) TransferAsset(ctx context.Context, info *proto.AssetTxInfo) (*proto.AssetTx, error) {
+	defer ctx.Done()
+
+	asset, err := decodeAssetID(info.Asset)
+	if err != nil {
+		return nil, err
+	}
+
+	hash, err := s.wallet.TransferAsset(asset, info.Account, info.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.AssetTx{Hash: hash.String(), Asset: info.Asset}, nil
+}
+
+func (s *walletServer) BurnAsset(ctx context.Context, info *proto.AssetTxInfo) (*proto.AssetTx, error) {
+	defer ctx.Done()
+
+	asset, err := decodeAssetID(info.Asset)
+	if err != nil {
+		return nil, err
+	}
+
+	hash, err := s.wallet.BurnAsset(asset, info.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.AssetTx{Hash: hash.String(), Asset: info.Asset}, nil
+}
+
+func (s *walletServer) GetAssetBalances(ctx context.Context, _ *proto.Empty) (*proto.AssetBalances, error) {
+	defer ctx.Done()
+
+	balances, err := s.wallet.GetAssetBalances()
+	if err != nil {
+		return nil, err
+	}
+
+	res := &proto.AssetBalances{}
+	for id, b := range balances {
+		res.Balances = append(res.Balances, &proto.AssetBalance{Asset: hex.EncodeToString(id[:]), Balance: b})
+	}
+
+	return res, nil
+}
+
+// contractAmount converts the amount sent to a contract to units, an empty amount sends nothing.
+func contractAmount(amount string) (uint64, error) {
+	if amount == "" {
+		return 0, nil
+	}
+	a, err := decimal.NewFromString(amount)
+	if err != nil {
+		return 0, err
+	}
+	return uint64(a.Mul(decimal.NewFromInt(1e8)).Round(0).IntPart()), nil
+}
+
 func (s *walletServer) StartValidator(ctx context.Context, key *proto.KeyPair) (*proto.Success, error) {
 	defer ctx.Done()
 
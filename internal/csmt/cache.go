@@ -171,6 +171,11 @@ func (t *TreeMemoryCache) Flush() error {
 			if err != nil {
 				return err
 			}
+		} else {
+			err := tx.SetRoot(nil)
+			if err != nil {
+				return err
+			}
 		}
 
 		for k, v := range t.dirtyKV {
@@ -250,6 +255,11 @@ func (t *TreeMemoryCacheTransaction) SetRoot(n *Node) error {
 		return errors.New("set root called on View transaction")
 	}
 
+	if n == nil {
+		t.root = primitives.EmptyTree
+		return nil
+	}
+
 	nodeHash := n.GetHash()
 	if _, found := t.dirty[nodeHash]; !found {
 		if _, err := t.underlyingTransaction.GetNode(nodeHash); err != nil {
@@ -1,6 +1,8 @@
 package csmt
 
 import (
+	"sort"
+
 	"github.com/olympus-protocol/ogen/pkg/chainhash"
 	"github.com/olympus-protocol/ogen/pkg/primitives"
 )
@@ -15,26 +17,13 @@ func isRight(key chainhash.Hash, level uint8) bool {
 // CalculateSubtreeHashWithOneLeaf calculates the hash of a subtree with only a single leaf at a certain height.
 // atLevel is the height to calculate at.
 func CalculateSubtreeHashWithOneLeaf(key *chainhash.Hash, value *chainhash.Hash, atLevel uint8) chainhash.Hash {
-	return calculateSubtreeHashWithOneLeaf(key, value, atLevel)
+	return primitives.CalculateSubtreeHashWithOneLeaf(key, value, atLevel)
 }
 
 // calculateSubtreeHashWithOneLeaf calculates the hash of a subtree with only a single leaf at a certain height.
 // atLevel is the height to calculate at.
 func calculateSubtreeHashWithOneLeaf(key *chainhash.Hash, value *chainhash.Hash, atLevel uint8) chainhash.Hash {
-	h := *value
-
-	for i := uint8(0); i < atLevel; i++ {
-		right := isRight(*key, i+1)
-
-		// the key is in the right subtree
-		if right {
-			h = primitives.CombineHashes(&primitives.EmptyTrees[i], &h)
-		} else {
-			h = primitives.CombineHashes(&h, &primitives.EmptyTrees[i])
-		}
-	}
-
-	return h
+	return primitives.CalculateSubtreeHashWithOneLeaf(key, value, atLevel)
 }
 
 func insertIntoTree(t TreeDatabaseTransaction, root *Node, key chainhash.Hash, value chainhash.Hash, level uint8) (*Node, error) {
@@ -150,3 +139,199 @@ func insertIntoTree(t TreeDatabaseTransaction, root *Node, key chainhash.Hash, v
 
 	return t.NewNode(newLeftBranch, newRightBranch, newHash)
 }
+
+// newBranchNode creates a node at a certain level from its branches, using the empty subtree hash for empty branches.
+func newBranchNode(t TreeDatabaseTransaction, left *Node, right *Node, level uint8) (*Node, error) {
+	if left != nil && left.Empty() {
+		left = nil
+	}
+	if right != nil && right.Empty() {
+		right = nil
+	}
+
+	lv := primitives.EmptyTrees[level-1]
+	if left != nil {
+		lv = left.GetHash()
+	}
+
+	rv := primitives.EmptyTrees[level-1]
+	if right != nil {
+		rv = right.GetHash()
+	}
+
+	newHash := primitives.CombineHashes(&lv, &rv)
+
+	return t.NewNode(left, right, newHash)
+}
+
+func getBranches(t TreeDatabaseTransaction, root *Node) (*Node, *Node, error) {
+	var left, right *Node
+	var err error
+
+	if h := root.Left(); h != nil {
+		left, err = t.GetNode(*h)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if h := root.Right(); h != nil {
+		right, err = t.GetNode(*h)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return left, right, nil
+}
+
+// deleteFromTree removes a key from the subtree. A subtree left with a single key is collapsed back to a single node,
+// so the tree keeps the same shape it would have if the key was never inserted.
+func deleteFromTree(t TreeDatabaseTransaction, root *Node, key chainhash.Hash, level uint8) (*Node, error) {
+	if root == nil || root.Empty() {
+		return nil, nil
+	}
+
+	if root.IsSingle() {
+		rootKey := root.GetSingleKey()
+		if !rootKey.IsEqual(&key) {
+			return root, nil
+		}
+		return nil, t.DeleteNode(root.GetHash())
+	}
+
+	// bottom leafs are only reached if the whole key matches
+	if level == 0 {
+		return nil, t.DeleteNode(root.GetHash())
+	}
+
+	left, right, err := getBranches(t, root)
+	if err != nil {
+		return nil, err
+	}
+
+	if isRight(key, level) {
+		newRight, err := deleteFromTree(t, right, key, level-1)
+		if err != nil {
+			return nil, err
+		}
+		if newRight == right {
+			return root, nil
+		}
+		right = newRight
+	} else {
+		newLeft, err := deleteFromTree(t, left, key, level-1)
+		if err != nil {
+			return nil, err
+		}
+		if newLeft == left {
+			return root, nil
+		}
+		left = newLeft
+	}
+
+	if err := t.DeleteNode(root.GetHash()); err != nil {
+		return nil, err
+	}
+
+	if left == nil && right == nil {
+		return nil, nil
+	}
+
+	// if the only branch left has a single key, the key moves up to this level
+	var only *Node
+	if left == nil {
+		only = right
+	} else if right == nil {
+		only = left
+	}
+	if only != nil && only.IsSingle() {
+		if err := t.DeleteNode(only.GetHash()); err != nil {
+			return nil, err
+		}
+		k, v := only.GetSingleKey(), only.GetSingleValue()
+		return t.NewSingleNode(k, v, calculateSubtreeHashWithOneLeaf(&k, &v, level))
+	}
+
+	return newBranchNode(t, left, right, level)
+}
+
+// KeyValue is a key and value pair of the tree.
+type KeyValue struct {
+	Key   chainhash.Hash
+	Value chainhash.Hash
+}
+
+// lessTreeOrder sorts hashed keys in the order the tree branches on them, from the root level downwards.
+func lessTreeOrder(a, b chainhash.Hash) bool {
+	for i := len(a) - 1; i >= 0; i-- {
+		if a[i] != b[i] {
+			return a[i] < b[i]
+		}
+	}
+	return false
+}
+
+// insertManyIntoTree inserts a set of hashed keys sorted by lessTreeOrder and without duplicates. Each node in the
+// path shared by several keys is rewritten only once.
+func insertManyIntoTree(t TreeDatabaseTransaction, root *Node, items []KeyValue, level uint8) (*Node, error) {
+	if len(items) == 0 {
+		return root, nil
+	}
+
+	if len(items) == 1 || level == 0 {
+		for _, item := range items {
+			newRoot, err := insertIntoTree(t, root, item.Key, item.Value, level)
+			if err != nil {
+				return nil, err
+			}
+			root = newRoot
+		}
+		return root, nil
+	}
+
+	var left, right *Node
+
+	if root != nil && !root.Empty() {
+		if root.IsSingle() {
+			// the old key is inserted with the rest unless it's being updated
+			rootKey := root.GetSingleKey()
+			i := sort.Search(len(items), func(i int) bool {
+				return !lessTreeOrder(items[i].Key, rootKey)
+			})
+			if i == len(items) || !items[i].Key.IsEqual(&rootKey) {
+				merged := make([]KeyValue, 0, len(items)+1)
+				merged = append(merged, items[:i]...)
+				merged = append(merged, KeyValue{Key: rootKey, Value: root.GetSingleValue()})
+				merged = append(merged, items[i:]...)
+				items = merged
+			}
+		} else {
+			var err error
+			left, right, err = getBranches(t, root)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if err := t.DeleteNode(root.GetHash()); err != nil {
+			return nil, err
+		}
+	}
+
+	split := sort.Search(len(items), func(i int) bool {
+		return isRight(items[i].Key, level)
+	})
+
+	newLeft, err := insertManyIntoTree(t, left, items[:split], level-1)
+	if err != nil {
+		return nil, err
+	}
+
+	newRight, err := insertManyIntoTree(t, right, items[split:], level-1)
+	if err != nil {
+		return nil, err
+	}
+
+	return newBranchNode(t, newLeft, newRight, level)
+}
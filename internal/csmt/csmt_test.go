@@ -6,6 +6,7 @@ import (
 	"github.com/olympus-protocol/ogen/pkg/chainhash"
 	"github.com/olympus-protocol/ogen/pkg/primitives"
 	"github.com/stretchr/testify/assert"
+	"os"
 	"reflect"
 	"testing"
 )
@@ -515,3 +516,139 @@ func TestCheckWitness(t *testing.T) {
 	assert.NoError(t, err)
 
 }
+
+func treeWithKeys(t *testing.T, db csmt.TreeDatabase, from, to int) csmt.Tree {
+	tree := csmt.NewTree(db)
+	err := tree.Update(func(tx csmt.TreeTransactionAccess) error {
+		for i := from; i < to; i++ {
+			if err := tx.Set(ch(fmt.Sprintf("%d", i)), ch(fmt.Sprintf("val%d", i))); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	assert.NoError(t, err)
+	return tree
+}
+
+func TestTree_Delete(t *testing.T) {
+	tree := treeWithKeys(t, csmt.NewInMemoryTreeDB(), 0, 200)
+	expected := treeWithKeys(t, csmt.NewInMemoryTreeDB(), 100, 200)
+
+	err := tree.Update(func(tx csmt.TreeTransactionAccess) error {
+		for i := 0; i < 100; i++ {
+			if err := tx.Delete(ch(fmt.Sprintf("%d", i))); err != nil {
+				return err
+			}
+		}
+
+		// deleting a missing key doesn't change the tree
+		return tx.Delete(ch("missing"))
+	})
+	assert.NoError(t, err)
+
+	treeHash, err := tree.Hash()
+	assert.NoError(t, err)
+	expectedHash, err := expected.Hash()
+	assert.NoError(t, err)
+	assert.Equal(t, expectedHash, treeHash)
+
+	err = tree.Update(func(tx csmt.TreeTransactionAccess) error {
+		v, err := tx.Get(ch("0"))
+		assert.NoError(t, err)
+		assert.Equal(t, chainhash.Hash{}, *v)
+
+		for i := 100; i < 200; i++ {
+			if err := tx.Delete(ch(fmt.Sprintf("%d", i))); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	assert.NoError(t, err)
+
+	treeHash, err = tree.Hash()
+	assert.NoError(t, err)
+	assert.Equal(t, primitives.EmptyTree, treeHash)
+}
+
+func TestTree_DeleteWithWitness(t *testing.T) {
+	db := csmt.NewInMemoryTreeDB()
+	tree := treeWithKeys(t, db, 0, 50)
+
+	for i := 0; i < 50; i += 7 {
+		oldRoot, err := tree.Hash()
+		assert.NoError(t, err)
+
+		var uw *primitives.UpdateWitness
+		err = tree.Update(func(tx csmt.TreeTransactionAccess) error {
+			uw, err = tx.(*csmt.TreeTransaction).DeleteWithWitness(ch(fmt.Sprintf("%d", i)))
+			return err
+		})
+		assert.NoError(t, err)
+
+		newRoot, err := tree.Hash()
+		assert.NoError(t, err)
+
+		calculated, err := csmt.ApplyWitness(*uw, oldRoot)
+		assert.NoError(t, err)
+		assert.Equal(t, newRoot, *calculated)
+	}
+}
+
+func TestTree_SetMany(t *testing.T) {
+	levelDB, dir := newLevelDBTreeDB(t)
+	defer os.RemoveAll(dir)
+	defer levelDB.Close()
+
+	for _, db := range []csmt.TreeDatabase{csmt.NewInMemoryTreeDB(), levelDB} {
+		expected := treeWithKeys(t, csmt.NewInMemoryTreeDB(), 0, 300)
+
+		// start with some keys so the batch updates and splits existing nodes
+		tree := treeWithKeys(t, db, 0, 20)
+
+		kvs := make([]csmt.KeyValue, 0, 300)
+		kvs = append(kvs, csmt.KeyValue{Key: ch("5"), Value: ch("overwritten")})
+		for i := 5; i < 300; i++ {
+			kvs = append(kvs, csmt.KeyValue{Key: ch(fmt.Sprintf("%d", i)), Value: ch(fmt.Sprintf("val%d", i))})
+		}
+
+		err := tree.Update(func(tx csmt.TreeTransactionAccess) error {
+			return tx.SetMany(kvs)
+		})
+		assert.NoError(t, err)
+
+		treeHash, err := tree.Hash()
+		assert.NoError(t, err)
+		expectedHash, err := expected.Hash()
+		assert.NoError(t, err)
+		assert.Equal(t, expectedHash, treeHash)
+
+		err = tree.View(func(tx csmt.TreeTransactionAccess) error {
+			v, err := tx.Get(ch("5"))
+			assert.NoError(t, err)
+			assert.Equal(t, ch("val5"), *v)
+			return nil
+		})
+		assert.NoError(t, err)
+	}
+}
+
+func BenchmarkTree_SetMany(b *testing.B) {
+	kvs := make([]csmt.KeyValue, b.N)
+	val := ch("testval")
+	t := csmt.NewTree(csmt.NewInMemoryTreeDB())
+
+	for i := range kvs {
+		kvs[i] = csmt.KeyValue{Key: ch(fmt.Sprintf("%d", i)), Value: val}
+	}
+
+	b.ResetTimer()
+
+	err := t.Update(func(tx csmt.TreeTransactionAccess) error {
+		return tx.SetMany(kvs)
+	})
+	if err != nil {
+		b.Fatal(err)
+	}
+}
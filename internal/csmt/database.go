@@ -1,6 +1,8 @@
 package csmt
 
 import (
+	"sort"
+
 	"github.com/olympus-protocol/ogen/pkg/chainhash"
 	"github.com/olympus-protocol/ogen/pkg/primitives"
 )
@@ -22,7 +24,7 @@ type TreeDatabaseTransaction interface {
 	// Hash gets the hash of the root node.
 	Hash() (*chainhash.Hash, error)
 
-	// SetRoot sets the root node.
+	// SetRoot sets the root node. A nil node sets an empty tree.
 	SetRoot(*Node) error
 
 	// NewNode creates a new node, adds it to the tree database, and returns it.
@@ -59,6 +61,11 @@ func NewTree(d TreeDatabase) Tree {
 	}
 }
 
+// Database returns the database the tree is stored on.
+func (t *Tree) Database() TreeDatabase {
+	return t.db
+}
+
 // Update creates a transaction for the tree.
 func (t *Tree) Update(cb func(access TreeTransactionAccess) error) error {
 	return t.db.Update(func(tx TreeDatabaseTransaction) error {
@@ -133,6 +140,90 @@ func (t *TreeTransaction) Set(key chainhash.Hash, value chainhash.Hash) error {
 	return t.tx.Set(key, value)
 }
 
+// Delete removes a key from the tree.
+func (t *TreeTransaction) Delete(key chainhash.Hash) error {
+	hk := chainhash.HashH(key[:])
+
+	root, err := t.tx.Root()
+	if err != nil {
+		return err
+	}
+
+	n, err := deleteFromTree(t.tx, root, hk, 255)
+	if err != nil {
+		return err
+	}
+
+	if err := t.tx.SetRoot(n); err != nil {
+		return err
+	}
+
+	return t.tx.Set(key, emptyHash)
+}
+
+// DeleteWithWitness returns an update witness from the current value to an empty value and removes the key from the
+// tree.
+func (t *TreeTransaction) DeleteWithWitness(key chainhash.Hash) (*primitives.UpdateWitness, error) {
+	uw, err := GenerateUpdateWitness(t.tx, key, emptyHash)
+	if err != nil {
+		return nil, err
+	}
+
+	err = t.Delete(key)
+
+	return uw, err
+}
+
+// SetMany inserts/updates a set of values. The keys are sorted so the nodes shared by several keys are only
+// rewritten once. If a key is repeated, the last value is used.
+func (t *TreeTransaction) SetMany(kvs []KeyValue) error {
+	if len(kvs) == 0 {
+		return nil
+	}
+
+	latest := make(map[chainhash.Hash]int, len(kvs))
+	for i, kv := range kvs {
+		latest[kv.Key] = i
+	}
+
+	items := make([]KeyValue, 0, len(latest))
+	for i, kv := range kvs {
+		if latest[kv.Key] != i {
+			continue
+		}
+		items = append(items, KeyValue{Key: chainhash.HashH(kv.Key[:]), Value: kv.Value})
+	}
+
+	sort.Slice(items, func(i, j int) bool {
+		return lessTreeOrder(items[i].Key, items[j].Key)
+	})
+
+	root, err := t.tx.Root()
+	if err != nil {
+		return err
+	}
+
+	n, err := insertManyIntoTree(t.tx, root, items, 255)
+	if err != nil {
+		return err
+	}
+
+	if err := t.tx.SetRoot(n); err != nil {
+		return err
+	}
+
+	for i, kv := range kvs {
+		if latest[kv.Key] != i {
+			continue
+		}
+		if err := t.tx.Set(kv.Key, kv.Value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // SetWithWitness returns an update witness and sets the value in the tree.
 func (t *TreeTransaction) SetWithWitness(key chainhash.Hash, value chainhash.Hash) (*primitives.UpdateWitness, error) {
 	uw, err := GenerateUpdateWitness(t.tx, key, value)
@@ -174,4 +265,7 @@ type TreeTransactionAccess interface {
 	Hash() (*chainhash.Hash, error)
 	Set(key chainhash.Hash, value chainhash.Hash) error
 	Get(key chainhash.Hash) (*chainhash.Hash, error)
+	Prove(key chainhash.Hash) (*primitives.VerificationWitness, error)
+	Delete(key chainhash.Hash) error
+	SetMany(kvs []KeyValue) error
 }
@@ -0,0 +1,554 @@
+package csmt
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/olympus-protocol/ogen/pkg/chainhash"
+	"github.com/olympus-protocol/ogen/pkg/primitives"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+var (
+	nodePrefix     = []byte("n")
+	refCountPrefix = []byte("c")
+	versionPrefix  = []byte("r")
+	headKey        = []byte("head")
+)
+
+// ErrUnknownRoot is returned when a root is not one of the versions kept by the database.
+var ErrUnknownRoot = errors.New("root is not kept by the tree database")
+
+// LevelDBTreeDB is a tree database stored on LevelDB.
+//
+// Nodes are stored by their subtree hash and shared between versions. Every committed root is kept as a version and
+// each node keeps a reference count of the stored nodes and versions pointing at it, so older roots stay readable
+// until they are pruned.
+type LevelDBTreeDB struct {
+	db *leveldb.DB
+
+	root        chainhash.Hash
+	lastVersion uint64
+
+	updateLock *sync.Mutex
+}
+
+// NewLevelDBTreeDB opens or creates a tree database on the provided path.
+func NewLevelDBTreeDB(path string) (*LevelDBTreeDB, error) {
+	db, err := leveldb.OpenFile(path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	t := &LevelDBTreeDB{
+		db:         db,
+		root:       primitives.EmptyTree,
+		updateLock: new(sync.Mutex),
+	}
+
+	head, err := db.Get(headKey, nil)
+	if err == leveldb.ErrNotFound {
+		return t, nil
+	}
+	if err != nil {
+		_ = db.Close()
+		return nil, err
+	}
+
+	t.lastVersion = binary.BigEndian.Uint64(head)
+
+	root, err := db.Get(versionKey(t.lastVersion), nil)
+	if err != nil {
+		_ = db.Close()
+		return nil, err
+	}
+	copy(t.root[:], root)
+
+	return t, nil
+}
+
+// Close closes the database.
+func (t *LevelDBTreeDB) Close() error {
+	return t.db.Close()
+}
+
+// Hash gets the root hash of the latest version of the tree.
+func (t *LevelDBTreeDB) Hash() (*chainhash.Hash, error) {
+	t.updateLock.Lock()
+	defer t.updateLock.Unlock()
+
+	root := t.root
+	return &root, nil
+}
+
+// Update runs cb with an update transaction. If the root changes, the new nodes are written and the new root is kept
+// as a new version.
+func (t *LevelDBTreeDB) Update(cb func(TreeDatabaseTransaction) error) error {
+	t.updateLock.Lock()
+	defer t.updateLock.Unlock()
+
+	snap, err := t.db.GetSnapshot()
+	if err != nil {
+		return err
+	}
+	defer snap.Release()
+
+	tx := &LevelDBTreeTX{
+		snap:   snap,
+		root:   t.root,
+		dirty:  make(map[chainhash.Hash]Node),
+		update: true,
+	}
+
+	if err := cb(tx); err != nil {
+		return err
+	}
+
+	if tx.root.IsEqual(&t.root) {
+		return nil
+	}
+
+	c := &commit{
+		tx:        tx,
+		refCounts: make(map[chainhash.Hash]uint64),
+		batch:     new(leveldb.Batch),
+	}
+
+	if !tx.root.IsEqual(&primitives.EmptyTree) {
+		if err := c.retain(tx.root); err != nil {
+			return err
+		}
+	}
+	c.writeRefCounts()
+
+	version := t.lastVersion + 1
+	var head [8]byte
+	binary.BigEndian.PutUint64(head[:], version)
+	c.batch.Put(versionKey(version), tx.root[:])
+	c.batch.Put(headKey, head[:])
+
+	if err := t.db.Write(c.batch, &opt.WriteOptions{Sync: true}); err != nil {
+		return err
+	}
+
+	t.root = tx.root
+	t.lastVersion = version
+
+	return nil
+}
+
+// View runs cb with a view only transaction on the latest version of the tree.
+func (t *LevelDBTreeDB) View(cb func(TreeDatabaseTransaction) error) error {
+	t.updateLock.Lock()
+	root := t.root
+	t.updateLock.Unlock()
+
+	return t.ViewAt(root, cb)
+}
+
+// ViewAt runs cb with a view only transaction on a kept version of the tree.
+func (t *LevelDBTreeDB) ViewAt(root chainhash.Hash, cb func(TreeDatabaseTransaction) error) error {
+	snap, err := t.db.GetSnapshot()
+	if err != nil {
+		return err
+	}
+	defer snap.Release()
+
+	if !root.IsEqual(&primitives.EmptyTree) {
+		if ok, err := snap.Has(nodeKey(root), nil); err != nil {
+			return err
+		} else if !ok {
+			return ErrUnknownRoot
+		}
+	}
+
+	return cb(&LevelDBTreeTX{
+		snap:   snap,
+		root:   root,
+		dirty:  make(map[chainhash.Hash]Node),
+		update: false,
+	})
+}
+
+// Roots returns the kept versions of the tree, oldest first.
+func (t *LevelDBTreeDB) Roots() ([]chainhash.Hash, error) {
+	var roots []chainhash.Hash
+
+	iter := t.db.NewIterator(util.BytesPrefix(versionPrefix), nil)
+	defer iter.Release()
+
+	for iter.Next() {
+		var h chainhash.Hash
+		copy(h[:], iter.Value())
+		roots = append(roots, h)
+	}
+
+	return roots, iter.Error()
+}
+
+// Prune removes the oldest kept version with the provided root and every node that is no longer referenced. The
+// latest version can't be pruned.
+func (t *LevelDBTreeDB) Prune(root chainhash.Hash) error {
+	t.updateLock.Lock()
+	defer t.updateLock.Unlock()
+
+	var version []byte
+
+	iter := t.db.NewIterator(util.BytesPrefix(versionPrefix), nil)
+	for iter.Next() {
+		if binary.BigEndian.Uint64(iter.Key()[len(versionPrefix):]) == t.lastVersion {
+			break
+		}
+		if h := chainhash.Hash(toHash(iter.Value())); h.IsEqual(&root) {
+			version = append([]byte{}, iter.Key()...)
+			break
+		}
+	}
+	iter.Release()
+	if err := iter.Error(); err != nil {
+		return err
+	}
+
+	if version == nil {
+		return ErrUnknownRoot
+	}
+
+	c := &commit{
+		db:        t.db,
+		refCounts: make(map[chainhash.Hash]uint64),
+		batch:     new(leveldb.Batch),
+	}
+
+	if !root.IsEqual(&primitives.EmptyTree) {
+		if err := c.release(root); err != nil {
+			return err
+		}
+	}
+	c.writeRefCounts()
+	c.batch.Delete(version)
+
+	return t.db.Write(c.batch, &opt.WriteOptions{Sync: true})
+}
+
+// commit keeps track of the reference count changes of an update or a prune.
+type commit struct {
+	tx        *LevelDBTreeTX
+	db        *leveldb.DB
+	refCounts map[chainhash.Hash]uint64
+	batch     *leveldb.Batch
+}
+
+func (c *commit) getRefCount(h chainhash.Hash) (uint64, bool, error) {
+	if rc, found := c.refCounts[h]; found {
+		return rc, true, nil
+	}
+
+	var b []byte
+	var err error
+	if c.tx != nil {
+		b, err = c.tx.snap.Get(refCountKey(h), nil)
+	} else {
+		b, err = c.db.Get(refCountKey(h), nil)
+	}
+	if err == leveldb.ErrNotFound {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, err
+	}
+
+	return binary.BigEndian.Uint64(b), true, nil
+}
+
+// retain adds a reference to a node. If the node is not stored yet, it is written and its children are retained.
+func (c *commit) retain(h chainhash.Hash) error {
+	rc, found, err := c.getRefCount(h)
+	if err != nil {
+		return err
+	}
+
+	if found {
+		c.refCounts[h] = rc + 1
+		return nil
+	}
+
+	n, ok := c.tx.dirty[h]
+	if !ok {
+		return fmt.Errorf("could not find node with hash %s", h)
+	}
+
+	c.refCounts[h] = 1
+	c.batch.Put(nodeKey(h), n.Marshal())
+
+	for _, child := range []*chainhash.Hash{n.left, n.right} {
+		if child == nil {
+			continue
+		}
+		if err := c.retain(*child); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// release removes a reference to a node. When a node is no longer referenced, it is deleted and its children are
+// released.
+func (c *commit) release(h chainhash.Hash) error {
+	rc, found, err := c.getRefCount(h)
+	if err != nil {
+		return err
+	}
+	if !found || rc == 0 {
+		return fmt.Errorf("could not find node with hash %s", h)
+	}
+
+	if rc > 1 {
+		c.refCounts[h] = rc - 1
+		return nil
+	}
+
+	c.refCounts[h] = 0
+
+	b, err := c.db.Get(nodeKey(h), nil)
+	if err != nil {
+		return err
+	}
+	n := new(Node)
+	if err := n.Unmarshal(b); err != nil {
+		return err
+	}
+
+	c.batch.Delete(nodeKey(h))
+
+	for _, child := range []*chainhash.Hash{n.left, n.right} {
+		if child == nil {
+			continue
+		}
+		if err := c.release(*child); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *commit) writeRefCounts() {
+	for h, rc := range c.refCounts {
+		if rc == 0 {
+			c.batch.Delete(refCountKey(h))
+			continue
+		}
+		var b [8]byte
+		binary.BigEndian.PutUint64(b[:], rc)
+		c.batch.Put(refCountKey(h), b[:])
+	}
+}
+
+// LevelDBTreeTX is a transaction on a version of a tree stored on LevelDB. Nodes created during the transaction are
+// kept in memory until the update is committed.
+type LevelDBTreeTX struct {
+	snap   *leveldb.Snapshot
+	root   chainhash.Hash
+	dirty  map[chainhash.Hash]Node
+	update bool
+}
+
+// Hash gets the hash of the tree transaction.
+func (l *LevelDBTreeTX) Hash() (*chainhash.Hash, error) {
+	root := l.root
+	return &root, nil
+}
+
+// Root gets the root of the tree.
+func (l *LevelDBTreeTX) Root() (*Node, error) {
+	if l.root.IsEqual(&primitives.EmptyTree) {
+		return nil, nil
+	}
+	return l.GetNode(l.root)
+}
+
+// SetRoot sets the root of the tree.
+func (l *LevelDBTreeTX) SetRoot(n *Node) error {
+	if !l.update {
+		return errors.New("set root called on view transaction")
+	}
+
+	if n == nil {
+		l.root = primitives.EmptyTree
+		return nil
+	}
+
+	nodeHash := n.GetHash()
+	if _, found := l.dirty[nodeHash]; !found {
+		if ok, err := l.snap.Has(nodeKey(nodeHash), nil); err != nil {
+			return err
+		} else if !ok {
+			l.dirty[nodeHash] = *n
+		}
+	}
+	l.root = nodeHash
+
+	return nil
+}
+
+// NewNode creates a new node with the provided branches.
+func (l *LevelDBTreeTX) NewNode(left *Node, right *Node, subtreeHash chainhash.Hash) (*Node, error) {
+	if !l.update {
+		return nil, errors.New("new node called on view transaction")
+	}
+
+	var leftHash *chainhash.Hash
+	var rightHash *chainhash.Hash
+
+	if left != nil {
+		lh := left.GetHash()
+		leftHash = &lh
+	}
+
+	if right != nil {
+		rh := right.GetHash()
+		rightHash = &rh
+	}
+
+	newNode := &Node{
+		value: subtreeHash,
+		left:  leftHash,
+		right: rightHash,
+	}
+	l.dirty[subtreeHash] = *newNode
+	return newNode, nil
+}
+
+// NewSingleNode creates a new node with only one key-value pair.
+func (l *LevelDBTreeTX) NewSingleNode(key chainhash.Hash, value chainhash.Hash, subtreeHash chainhash.Hash) (*Node, error) {
+	if !l.update {
+		return nil, errors.New("new single node called on view transaction")
+	}
+
+	newNode := &Node{
+		one:      true,
+		oneKey:   &key,
+		oneValue: &value,
+		value:    subtreeHash,
+	}
+	l.dirty[subtreeHash] = *newNode
+	return newNode, nil
+}
+
+// GetNode gets a node from the transaction or the database.
+func (l *LevelDBTreeTX) GetNode(nodeHash chainhash.Hash) (*Node, error) {
+	if n, found := l.dirty[nodeHash]; found {
+		return &n, nil
+	}
+
+	b, err := l.snap.Get(nodeKey(nodeHash), nil)
+	if err == leveldb.ErrNotFound {
+		return nil, fmt.Errorf("could not find node with hash %s", nodeHash)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	n := new(Node)
+	if err := n.Unmarshal(b); err != nil {
+		return nil, err
+	}
+	return n, nil
+}
+
+// SetNode sets a node in the transaction.
+func (l *LevelDBTreeTX) SetNode(n *Node) error {
+	if !l.update {
+		return errors.New("set node called on view transaction")
+	}
+
+	l.dirty[n.GetHash()] = *n
+
+	return nil
+}
+
+// DeleteNode does nothing besides checking the transaction type. Stored nodes may still be used by older versions
+// and only the nodes reachable from the new root are written on commit.
+func (l *LevelDBTreeTX) DeleteNode(chainhash.Hash) error {
+	if !l.update {
+		return errors.New("delete called on view transaction")
+	}
+	return nil
+}
+
+// Get gets a value by walking the tree from the transaction root, so every kept version can be read.
+func (l *LevelDBTreeTX) Get(key chainhash.Hash) (*chainhash.Hash, error) {
+	hk := chainhash.HashH(key[:])
+
+	current, err := l.Root()
+	if err != nil {
+		return nil, err
+	}
+
+	level := 255
+	for current != nil {
+		if current.IsSingle() {
+			if k := current.GetSingleKey(); k.IsEqual(&hk) {
+				v := current.GetSingleValue()
+				return &v, nil
+			}
+			break
+		}
+
+		if level == 0 {
+			v := current.GetHash()
+			return &v, nil
+		}
+
+		next := current.Left()
+		if isRight(hk, uint8(level)) {
+			next = current.Right()
+		}
+		if next == nil {
+			break
+		}
+
+		current, err = l.GetNode(*next)
+		if err != nil {
+			return nil, err
+		}
+		level--
+	}
+
+	return &chainhash.Hash{}, nil
+}
+
+// Set does nothing besides checking the transaction type, as the values are read from the tree nodes.
+func (l *LevelDBTreeTX) Set(chainhash.Hash, chainhash.Hash) error {
+	if !l.update {
+		return errors.New("set called on view transaction")
+	}
+	return nil
+}
+
+func nodeKey(h chainhash.Hash) []byte {
+	return append(append([]byte{}, nodePrefix...), h[:]...)
+}
+
+func refCountKey(h chainhash.Hash) []byte {
+	return append(append([]byte{}, refCountPrefix...), h[:]...)
+}
+
+func versionKey(version uint64) []byte {
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], version)
+	return append(append([]byte{}, versionPrefix...), b[:]...)
+}
+
+func toHash(b []byte) [32]byte {
+	var h [32]byte
+	copy(h[:], b)
+	return h
+}
+
+var _ TreeDatabase = &LevelDBTreeDB{}
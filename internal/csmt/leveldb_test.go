@@ -0,0 +1,216 @@
+package csmt_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/olympus-protocol/ogen/internal/csmt"
+	"github.com/olympus-protocol/ogen/pkg/chainhash"
+	"github.com/stretchr/testify/assert"
+)
+
+func newLevelDBTreeDB(t testing.TB) (*csmt.LevelDBTreeDB, string) {
+	dir, err := ioutil.TempDir("", "csmt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	db, err := csmt.NewLevelDBTreeDB(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return db, dir
+}
+
+func TestLevelDBTree_MatchesMemory(t *testing.T) {
+	db, dir := newLevelDBTreeDB(t)
+	defer os.RemoveAll(dir)
+	defer db.Close()
+
+	diskTree := csmt.NewTree(db)
+	memTree := csmt.NewTree(csmt.NewInMemoryTreeDB())
+
+	for _, tree := range []csmt.Tree{diskTree, memTree} {
+		err := tree.Update(func(tx csmt.TreeTransactionAccess) error {
+			for i := 0; i < 500; i++ {
+				if err := tx.Set(ch(fmt.Sprintf("%d", i)), ch(fmt.Sprintf("val%d", i))); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		assert.NoError(t, err)
+	}
+
+	diskHash, err := diskTree.Hash()
+	assert.NoError(t, err)
+	memHash, err := memTree.Hash()
+	assert.NoError(t, err)
+	assert.Equal(t, memHash, diskHash)
+
+	err = diskTree.View(func(tx csmt.TreeTransactionAccess) error {
+		for i := 0; i < 500; i++ {
+			v, err := tx.Get(ch(fmt.Sprintf("%d", i)))
+			assert.NoError(t, err)
+			assert.Equal(t, ch(fmt.Sprintf("val%d", i)), *v)
+
+			w, err := tx.Prove(ch(fmt.Sprintf("%d", i)))
+			assert.NoError(t, err)
+			assert.True(t, csmt.CheckWitness(w, diskHash))
+		}
+
+		v, err := tx.Get(ch("missing"))
+		assert.NoError(t, err)
+		assert.Equal(t, chainhash.Hash{}, *v)
+		return nil
+	})
+	assert.NoError(t, err)
+}
+
+func TestLevelDBTree_Versions(t *testing.T) {
+	db, dir := newLevelDBTreeDB(t)
+	defer os.RemoveAll(dir)
+
+	tree := csmt.NewTree(db)
+
+	assert.NoError(t, tree.Update(func(tx csmt.TreeTransactionAccess) error {
+		if err := tx.Set(ch("1"), ch("a")); err != nil {
+			return err
+		}
+		return tx.Set(ch("2"), ch("b"))
+	}))
+	root1, err := tree.Hash()
+	assert.NoError(t, err)
+
+	assert.NoError(t, tree.Update(func(tx csmt.TreeTransactionAccess) error {
+		return tx.Set(ch("1"), ch("c"))
+	}))
+	root2, err := tree.Hash()
+	assert.NoError(t, err)
+	assert.NotEqual(t, root1, root2)
+
+	roots, err := db.Roots()
+	assert.NoError(t, err)
+	assert.Equal(t, []chainhash.Hash{root1, root2}, roots)
+
+	// the old version is still readable
+	assert.NoError(t, db.ViewAt(root1, func(tx csmt.TreeDatabaseTransaction) error {
+		v, err := tx.Get(ch("1"))
+		assert.NoError(t, err)
+		assert.Equal(t, ch("a"), *v)
+		return nil
+	}))
+
+	// the latest version can't be pruned
+	assert.Equal(t, csmt.ErrUnknownRoot, db.Prune(root2))
+
+	assert.NoError(t, db.Prune(root1))
+	assert.Equal(t, csmt.ErrUnknownRoot, db.ViewAt(root1, func(tx csmt.TreeDatabaseTransaction) error {
+		return nil
+	}))
+
+	// nodes shared with the pruned version are kept
+	assert.NoError(t, db.Close())
+	db, err = csmt.NewLevelDBTreeDB(dir)
+	assert.NoError(t, err)
+	defer db.Close()
+
+	h, err := db.Hash()
+	assert.NoError(t, err)
+	assert.Equal(t, root2, *h)
+
+	assert.NoError(t, db.View(func(tx csmt.TreeDatabaseTransaction) error {
+		v, err := tx.Get(ch("1"))
+		assert.NoError(t, err)
+		assert.Equal(t, ch("c"), *v)
+
+		v, err = tx.Get(ch("2"))
+		assert.NoError(t, err)
+		assert.Equal(t, ch("b"), *v)
+		return nil
+	}))
+}
+
+func BenchmarkLevelDBTree_Set(b *testing.B) {
+	db, dir := newLevelDBTreeDB(b)
+	defer os.RemoveAll(dir)
+	defer db.Close()
+
+	keys := make([]chainhash.Hash, b.N)
+	val := ch("testval")
+	t := csmt.NewTree(db)
+
+	for i := range keys {
+		keys[i] = ch(fmt.Sprintf("%d", i))
+	}
+
+	b.ResetTimer()
+
+	err := t.Update(func(tx csmt.TreeTransactionAccess) error {
+		for i := 0; i < b.N; i++ {
+			err := tx.Set(keys[i], val)
+			if err != nil {
+				b.Fatal(err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		b.Fatal(err)
+	}
+}
+
+// benchmarkSetPrefilled measures the sets of a block sized update on a tree that already has size keys.
+func benchmarkSetPrefilled(b *testing.B, db csmt.TreeDatabase, size int) {
+	t := csmt.NewTree(db)
+	val := ch("testval")
+
+	err := t.Update(func(tx csmt.TreeTransactionAccess) error {
+		for i := 0; i < size; i++ {
+			if err := tx.Set(ch(fmt.Sprintf("%d", i)), val); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ResetTimer()
+
+	for n := 0; n < b.N; n++ {
+		err := t.Update(func(tx csmt.TreeTransactionAccess) error {
+			for i := 0; i < 1000; i++ {
+				if err := tx.Set(ch(fmt.Sprintf("%d-%d", n, i)), val); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkTree_SetPrefilled(b *testing.B) {
+	for _, size := range []int{10000, 100000, 1000000} {
+		b.Run(fmt.Sprintf("%d", size), func(b *testing.B) {
+			benchmarkSetPrefilled(b, csmt.NewInMemoryTreeDB(), size)
+		})
+	}
+}
+
+func BenchmarkLevelDBTree_SetPrefilled(b *testing.B) {
+	for _, size := range []int{10000, 100000, 1000000} {
+		b.Run(fmt.Sprintf("%d", size), func(b *testing.B) {
+			db, dir := newLevelDBTreeDB(b)
+			defer os.RemoveAll(dir)
+			defer db.Close()
+
+			benchmarkSetPrefilled(b, db, size)
+		})
+	}
+}
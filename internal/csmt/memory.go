@@ -79,25 +79,15 @@ func (t *InMemoryTreeDB) Update(cb func(TreeDatabaseTransaction) error) error {
 
 // View runs cb with a view only transaction.
 func (t *InMemoryTreeDB) View(cb func(TreeDatabaseTransaction) error) error {
-	nodesCopy := make(map[chainhash.Hash]Node)
-	storeCopy := make(map[chainhash.Hash]chainhash.Hash)
-
+	// updates replace the maps instead of modifying them, so the view can keep reading the current ones.
 	t.updateLock.Lock()
-	for k, v := range t.nodes {
-		nodesCopy[k] = v
-	}
-
-	for k, v := range t.store {
-		storeCopy[k] = v
-	}
-	t.updateLock.Unlock()
-
 	tx := &InMemoryTreeTX{
 		root:   t.root,
 		nodes:  t.nodes,
 		store:  t.store,
 		update: false,
 	}
+	t.updateLock.Unlock()
 
 	return cb(tx)
 }
@@ -162,6 +152,11 @@ func (i *InMemoryTreeTX) SetRoot(n *Node) error {
 		return errors.New("set root called on view transaction")
 	}
 
+	if n == nil {
+		i.root = primitives.EmptyTree
+		return nil
+	}
+
 	nodeHash := n.GetHash()
 	if _, found := i.nodes[nodeHash]; !found {
 		err := i.SetNode(n)
@@ -231,8 +226,12 @@ func (i *InMemoryTreeTX) Get(k chainhash.Hash) (*chainhash.Hash, error) {
 	return &chainhash.Hash{}, nil
 }
 
-// Set sets a value in the key-value store.
+// Set sets a value in the key-value store. Empty values are removed from the store.
 func (i *InMemoryTreeTX) Set(k chainhash.Hash, v chainhash.Hash) error {
+	if v.IsEqual(&emptyHash) {
+		delete(i.store, k)
+		return nil
+	}
 	i.store[k] = v
 
 	return nil
@@ -271,6 +270,9 @@ const (
 
 	// FlagBoth designates that this node has both a left and right branch.
 	FlagBoth
+
+	// FlagLeaf designates that this node is a bottom leaf with only a value.
+	FlagLeaf
 )
 
 func readHash(r io.Reader) (*chainhash.Hash, error) {
@@ -343,6 +345,9 @@ func (i *Node) Unmarshal(b []byte) error {
 		i.left = left
 		i.right = right
 		return nil
+	case FlagLeaf:
+		i.value = *hash
+		return nil
 	default:
 		return errors.New("unexpected flag")
 	}
@@ -361,7 +366,7 @@ func (i *Node) Marshal() []byte {
 	} else if i.right != nil {
 		flag = FlagRight
 	} else {
-		panic("improper node (not single and no left/right)")
+		flag = FlagLeaf
 	}
 	buf.WriteByte(flag)
 	buf.Write(i.value[:])
@@ -13,6 +13,7 @@ func TestNodeSerializeDeserialize(t *testing.T) {
 		csmt.NewNode(chainhash.Hash{1, 2, 3}, nil, nil, &chainhash.Hash{2, 3, 4}, nil, false),
 		csmt.NewNode(chainhash.Hash{1, 2, 3}, nil, nil, &chainhash.Hash{3, 4, 5}, &chainhash.Hash{4, 5, 6}, false),
 		csmt.NewNode(chainhash.Hash{1, 2, 3}, nil, nil, nil, &chainhash.Hash{5, 6, 7}, false),
+		csmt.NewNode(chainhash.Hash{1, 2, 3}, nil, nil, nil, nil, false),
 	}
 
 	for _, node := range nodes {
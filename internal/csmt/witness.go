@@ -211,31 +211,7 @@ func GenerateVerificationWitness(tree TreeDatabaseTransaction, key chainhash.Has
 
 // CalculateRoot calculates the root of the tree with the given witness information.
 func CalculateRoot(key chainhash.Hash, value chainhash.Hash, witnessBitfield chainhash.Hash, witnesses []chainhash.Hash, lastLevel uint8) (*chainhash.Hash, error) {
-	hk := chainhash.HashH(key[:])
-	h := calculateSubtreeHashWithOneLeaf(&hk, &value, lastLevel)
-
-	currentWitness := 0
-
-	for i := uint16(lastLevel) + 1; i <= 255; i++ {
-		right := isRight(hk, uint8(i))
-
-		hashToAdd := primitives.EmptyTrees[i-1]
-		if witnessBitfield[i/8]&(1<<uint8(i%8)) != 0 {
-			if currentWitness >= len(witnesses) {
-				return nil, errors.New("not enough witnesses")
-			}
-			hashToAdd = witnesses[currentWitness]
-			currentWitness++
-		}
-
-		if right {
-			h = primitives.CombineHashes(&hashToAdd, &h)
-		} else {
-			h = primitives.CombineHashes(&h, &hashToAdd)
-		}
-	}
-
-	return &h, nil
+	return primitives.CalculateRoot(key, value, witnessBitfield, witnesses, lastLevel)
 }
 
 // ApplyWitness applies a witness to an old state root to generate a new state root.
@@ -262,14 +238,5 @@ func ApplyWitness(uw primitives.UpdateWitness, oldStateRoot chainhash.Hash) (*ch
 
 // CheckWitness ensures the state root matches.
 func CheckWitness(vw *primitives.VerificationWitness, oldStateRoot chainhash.Hash) bool {
-	preRoot, err := CalculateRoot(vw.Key, vw.Value, vw.WitnessBitfield, vw.Witnesses, vw.LastLevel)
-	if err != nil {
-		return false
-	}
-
-	if !preRoot.IsEqual(&oldStateRoot) {
-		return false
-	}
-
-	return true
+	return vw.Check(oldStateRoot)
 }
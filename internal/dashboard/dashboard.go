@@ -3,31 +3,247 @@ package dashboard
 import (
 	"context"
 	"encoding/hex"
+	"fmt"
 	"github.com/gin-gonic/gin"
 	"github.com/gobuffalo/packr"
 	"github.com/olympus-protocol/ogen/cmd/ogen/config"
 	"github.com/olympus-protocol/ogen/internal/chain"
+	"github.com/olympus-protocol/ogen/internal/chainindex"
 	"github.com/olympus-protocol/ogen/internal/hostnode"
+	"github.com/olympus-protocol/ogen/internal/mempool"
 	"github.com/olympus-protocol/ogen/internal/proposer"
+	"github.com/olympus-protocol/ogen/internal/state"
+	"github.com/olympus-protocol/ogen/pkg/logger"
 	"github.com/olympus-protocol/ogen/pkg/primitives"
 	"html/template"
 	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	// updateInterval is the time between updates of the dashboard data when there are no new blocks.
+	updateInterval = 2 * time.Second
+
+	// maxEpochs is the amount of epochs kept for the participation and balance charts.
+	maxEpochs = 32
+
+	// maxMissed is the amount of missed duties kept.
+	maxMissed = 50
 )
 
 type Dashboard struct {
+	log      logger.Logger
 	ctx      context.Context
+	cancel   context.CancelFunc
 	r        *gin.Engine
+	server   *http.Server
 	host     hostnode.HostNode
 	chain    chain.Blockchain
 	proposer proposer.Proposer
+	coins    mempool.CoinsMempool
+
+	// lock protects the data and the duties history.
+	lock sync.Mutex
+	data *Data
+	// updated is closed when the data is replaced.
+	updated   chan struct{}
+	epochs    []EpochData
+	missed    []MissedDuty
+	receipts  []BalanceChange
+	proposals map[uint64]uint64
+
+	tips chan struct{}
 }
 
 func (d *Dashboard) Start() error {
-	port := config.GlobalFlags.DashboardPort
-	return d.r.Run(":" + port)
+	d.update()
+	d.chain.Notify(d)
+	go d.run()
+
+	err := d.server.ListenAndServe()
+	if err == http.ErrServerClosed {
+		return nil
+	}
+	return err
+}
+
+func (d *Dashboard) Stop() error {
+	d.chain.Unnotify(d)
+	d.cancel()
+	return d.server.Close()
+}
+
+// NewTip records the participation, balance changes and missed duties of the local keys.
+func (d *Dashboard) NewTip(row *chainindex.BlockRow, _ *primitives.Block, s state.State, receipts []*primitives.EpochReceipt) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	// Local proposals scheduled before the new tip that are not on the main chain were missed.
+	ch := d.chain.State().Chain()
+	for slot, validator := range d.proposals {
+		if slot >= row.Slot {
+			continue
+		}
+		delete(d.proposals, slot)
+		if r, ok := ch.GetNodeBySlot(slot); ok && r.Slot == slot {
+			continue
+		}
+		d.addMissed(MissedDuty{Epoch: slot / config.GlobalParams.NetParams.EpochLength, Slot: slot, Type: "proposal", Validator: validator})
+	}
+
+	if len(receipts) > 0 {
+		d.addEpoch(s, receipts)
+	}
+
+	select {
+	case d.tips <- struct{}{}:
+	default:
+	}
+}
+
+func (d *Dashboard) ProposerSlashingConditionViolated(_ *primitives.ProposerSlashing) {}
+
+func (d *Dashboard) addMissed(m MissedDuty) {
+	d.missed = append([]MissedDuty{m}, d.missed...)
+	if len(d.missed) > maxMissed {
+		d.missed = d.missed[:maxMissed]
+	}
+}
+
+// addEpoch records the receipts of an epoch transition. Participation is the share of the rewarded and penalized
+// validators that voted.
+func (d *Dashboard) addEpoch(s state.State, receipts []*primitives.EpochReceipt) {
+	netParams := config.GlobalParams.NetParams
+	registry := s.GetValidatorRegistry()
+	ks := d.proposer.Keystore()
+
+	epoch := s.GetEpochIndex()
+	e := EpochData{Epoch: epoch}
+	var changes []BalanceChange
+	local := make(map[uint64]bool)
+
+	for _, r := range receipts {
+		switch r.Type {
+		case primitives.RewardMatchedFromEpoch:
+			e.Voted++
+		case primitives.PenaltyMissingFromEpoch:
+			e.Missed++
+		}
+
+		isLocal, ok := local[r.Validator]
+		if !ok {
+			if r.Validator < uint64(len(registry)) {
+				_, isLocal = ks.GetValidatorKey(registry[r.Validator].PubKey)
+			}
+			local[r.Validator] = isLocal
+		}
+		if !isLocal {
+			continue
+		}
+
+		amount := float64(r.Amount) / float64(netParams.UnitsPerCoin)
+		if r.Amount > 0 {
+			e.Rewards += amount
+		} else {
+			e.Penalties -= amount
+		}
+		changes = append(changes, BalanceChange{Epoch: epoch, Validator: r.Validator, Type: r.TypeString(), Amount: amount})
+		if r.Type == primitives.PenaltyMissingFromEpoch {
+			d.addMissed(MissedDuty{Epoch: epoch, Type: "vote", Validator: r.Validator})
+		}
+	}
+	if e.Voted+e.Missed > 0 {
+		e.Participation = float64(e.Voted) * 100 / float64(e.Voted+e.Missed)
+	}
+
+	d.epochs = append(d.epochs, e)
+	if len(d.epochs) > maxEpochs {
+		d.epochs = d.epochs[len(d.epochs)-maxEpochs:]
+	}
+	d.receipts = changes
+}
+
+// duties returns the slots of the current epoch after the current slot where local keys propose or vote.
+func (d *Dashboard) duties(currentSlot uint64, local map[uint64]bool) []Duty {
+	netParams := config.GlobalParams.NetParams
+
+	s, err := d.chain.State().TipStateAtSlot(currentSlot + 1)
+	if err != nil {
+		d.log.Errorf("unable to get the state for the dashboard duties: %s", err)
+		return nil
+	}
+
+	queue := s.GetProposerQueue()
+	var duties []Duty
+	for slot := currentSlot + 1; slot <= (s.GetEpochIndex()+1)*netParams.EpochLength; slot++ {
+		duty := Duty{Slot: slot}
+
+		slotIndex := (slot + netParams.EpochLength - 1) % netParams.EpochLength
+		if slotIndex < uint64(len(queue)) && local[queue[slotIndex]] {
+			duty.Proposing = true
+			duty.Proposer = queue[slotIndex]
+		}
+
+		committee, err := s.GetVoteCommittee(slot)
+		if err != nil {
+			continue
+		}
+		for _, v := range committee {
+			if local[v] {
+				duty.Voters = append(duty.Voters, v)
+			}
+		}
+
+		if duty.Proposing || len(duty.Voters) > 0 {
+			duties = append(duties, duty)
+		}
+	}
+	return duties
+}
+
+// update replaces the dashboard data and notifies the live clients.
+func (d *Dashboard) update() {
+	data, err := d.fetchData()
+	if err != nil {
+		d.log.Errorf("unable to update the dashboard: %s", err)
+		return
+	}
+
+	d.lock.Lock()
+	for _, duty := range data.Duties {
+		if duty.Proposing {
+			d.proposals[duty.Slot] = duty.Proposer
+		}
+	}
+	data.Epochs = d.epochs
+	data.Missed = d.missed
+	data.Receipts = d.receipts
+	if len(d.epochs) > 0 {
+		data.ParticipationInfo.ParticipationPercentage = formatPercentage(d.epochs[len(d.epochs)-1].Participation)
+	}
+	d.data = data
+	close(d.updated)
+	d.updated = make(chan struct{})
+	d.lock.Unlock()
+}
+
+func (d *Dashboard) run() {
+	ticker := time.NewTicker(updateInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-d.ctx.Done():
+			return
+		case <-ticker.C:
+		case <-d.tips:
+		}
+		d.update()
+	}
 }
 
-func (d *Dashboard) fetchData(c *gin.Context) {
+func (d *Dashboard) fetchData() (*Data, error) {
 	tip := d.chain.State().Tip()
 	justified, _ := d.chain.State().GetJustifiedHead()
 	finalized, _ := d.chain.State().GetFinalizedHead()
@@ -47,11 +263,19 @@ func (d *Dashboard) fetchData(c *gin.Context) {
 		if p.FinalizedHeight < finalized.Height {
 			peersBehind += 1
 		}
+		status := "synced"
+		if p.TipHeight > tip.Height {
+			status = "ahead"
+		}
+		if p.TipHeight < tip.Height {
+			status = "behind"
+		}
 		pData := PeerData{
 			ID:        p.ID.String(),
 			Finalized: p.FinalizedHeight,
 			Justified: p.JustifiedHeight,
 			Tip:       p.TipHeight,
+			Status:    status,
 		}
 		peersData = append(peersData, pData)
 	}
@@ -59,24 +283,26 @@ func (d *Dashboard) fetchData(c *gin.Context) {
 	validators := d.chain.State().TipState().GetValidatorRegistry()
 	keys, err := d.proposer.Keystore().GetValidatorKeys()
 	if err != nil {
-		c.HTML(500, "", nil)
-		return
+		return nil, err
 	}
 
 	activeValidators := 0
 	keysActive := 0
-	for _, v := range validators {
+	local := make(map[uint64]bool)
+	for i, v := range validators {
 		if v.Status == primitives.StatusActive {
 			activeValidators += 1
 		}
 		if _, ok := d.proposer.Keystore().GetValidatorKey(v.PubKey); ok {
 			keysActive += 1
+			local[uint64(i)] = true
 		}
 	}
 
-	slotEpoch := d.proposer.GetCurrentSlot() % config.GlobalParams.NetParams.EpochLength
+	currentSlot := d.proposer.GetCurrentSlot()
+	slotEpoch := currentSlot % config.GlobalParams.NetParams.EpochLength
 	slotEpoch += 1
-	data := Data{
+	data := &Data{
 		NodeData: NodeData{
 			TipHeight:       tip.Height,
 			TipSlot:         tip.Slot,
@@ -101,20 +327,57 @@ func (d *Dashboard) fetchData(c *gin.Context) {
 			KeysParticipating: keysActive,
 		},
 		ProposerData: ProposerData{
-			Slot:      d.proposer.GetCurrentSlot(),
-			Epoch:     d.proposer.GetCurrentSlot() / config.GlobalParams.NetParams.EpochLength,
+			Slot:      currentSlot,
+			Epoch:     currentSlot / config.GlobalParams.NetParams.EpochLength,
 			Voting:    d.proposer.Voting(),
 			Proposing: d.proposer.Proposing(),
 		},
 		PeerData: peersData,
 		ParticipationInfo: ParticipationInfo{
-			EpochSlot:               slotEpoch,
-			Epoch:                   d.proposer.GetCurrentSlot() / config.GlobalParams.NetParams.EpochLength,
-			ParticipationPercentage: "",
+			EpochSlot:   slotEpoch,
+			EpochLength: config.GlobalParams.NetParams.EpochLength,
+			Epoch:       currentSlot / config.GlobalParams.NetParams.EpochLength,
+		},
+		MempoolData: MempoolData{
+			Coins: len(d.coins.GetWithoutApply()),
 		},
+		Duties: d.duties(currentSlot, local),
+	}
+	return data, nil
+}
+
+// snapshot returns the last data and a channel closed when it is replaced.
+func (d *Dashboard) snapshot() (*Data, chan struct{}) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	return d.data, d.updated
+}
+
+func (d *Dashboard) index(c *gin.Context) {
+	data, _ := d.snapshot()
+	if data == nil {
+		c.String(http.StatusServiceUnavailable, "the dashboard data is not available yet")
+		return
 	}
 	c.HTML(http.StatusOK, "index.html", data)
-	return
+}
+
+// events streams the dashboard data to the live clients as server-sent events.
+func (d *Dashboard) events(c *gin.Context) {
+	c.Header("Cache-Control", "no-cache")
+	for {
+		data, updated := d.snapshot()
+		c.SSEvent("update", data)
+		c.Writer.Flush()
+
+		select {
+		case <-updated:
+		case <-c.Request.Context().Done():
+			return
+		case <-d.ctx.Done():
+			return
+		}
+	}
 }
 
 func (d *Dashboard) loadStatic() {
@@ -145,17 +408,33 @@ func (d *Dashboard) loadTemplate() error {
 	return nil
 }
 
-func NewDashboard(h hostnode.HostNode, ch chain.Blockchain, prop proposer.Proposer) (*Dashboard, error) {
+func NewDashboard(h hostnode.HostNode, ch chain.Blockchain, prop proposer.Proposer, coins mempool.CoinsMempool) (*Dashboard, error) {
 	gin.SetMode(gin.ReleaseMode)
 	r := gin.New()
 	r.Use(gin.Recovery())
 
+	log := config.GlobalParams.Logger.Module("dashboard")
+	if config.GlobalFlags.DashboardPass != "" {
+		r.Use(gin.BasicAuth(gin.Accounts{config.GlobalFlags.DashboardUser: config.GlobalFlags.DashboardPass}))
+	} else {
+		log.Warn("the dashboard is exposed without authentication, use --dashboard_password to enable it")
+	}
+
+	ctx, cancel := context.WithCancel(config.GlobalParams.Context)
+
 	d := &Dashboard{
-		ctx:      config.GlobalParams.Context,
-		r:        r,
-		host:     h,
-		chain:    ch,
-		proposer: prop,
+		log:       log,
+		ctx:       ctx,
+		cancel:    cancel,
+		r:         r,
+		server:    &http.Server{Addr: ":" + config.GlobalFlags.DashboardPort, Handler: r},
+		host:      h,
+		chain:     ch,
+		proposer:  prop,
+		coins:     coins,
+		updated:   make(chan struct{}),
+		proposals: make(map[uint64]uint64),
+		tips:      make(chan struct{}, 1),
 	}
 	err := d.loadTemplate()
 	if err != nil {
@@ -164,7 +443,12 @@ func NewDashboard(h hostnode.HostNode, ch chain.Blockchain, prop proposer.Propos
 
 	d.loadStatic()
 
-	r.GET("/", d.fetchData)
+	r.GET("/", d.index)
+	r.GET("/events", d.events)
 
 	return d, nil
 }
+
+func formatPercentage(p float64) string {
+	return fmt.Sprintf("%.2f%%", p)
+}
@@ -0,0 +1,203 @@
+package dashboard
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/olympus-protocol/ogen/cmd/ogen/config"
+	"github.com/olympus-protocol/ogen/internal/chain"
+	"github.com/olympus-protocol/ogen/internal/keystore"
+	"github.com/olympus-protocol/ogen/internal/proposer"
+	"github.com/olympus-protocol/ogen/internal/state"
+	"github.com/olympus-protocol/ogen/pkg/bls"
+	"github.com/olympus-protocol/ogen/pkg/primitives"
+	"github.com/olympus-protocol/ogen/test/testchain"
+	"github.com/stretchr/testify/assert"
+)
+
+// testKeystore has the keys of the local validators.
+type testKeystore struct {
+	keystore.Keystore
+	local map[[48]byte]bool
+}
+
+func (k testKeystore) GetValidatorKey(pub [48]byte) (*bls.SecretKey, bool) {
+	return nil, k.local[pub]
+}
+
+type testProposer struct {
+	proposer.Proposer
+	ks testKeystore
+}
+
+func (p testProposer) Keystore() keystore.Keystore {
+	return p.ks
+}
+
+// testChain returns the same state for every slot.
+type testChain struct {
+	chain.Blockchain
+	chain.StateService
+	st state.State
+}
+
+func (c *testChain) State() chain.StateService {
+	return c
+}
+
+func (c *testChain) TipStateAtSlot(slot uint64) (state.State, error) {
+	st := c.st.Copy()
+	_, err := st.ProcessSlots(slot, testchain.NewView())
+	return st, err
+}
+
+// setup sets the test params and returns a dashboard over the genesis state with the local validators.
+func setup(t *testing.T, local ...uint64) (*Dashboard, state.State) {
+	params, flags := config.GlobalParams, config.GlobalFlags
+	t.Cleanup(func() {
+		config.GlobalParams, config.GlobalFlags = params, flags
+	})
+
+	keys, err := testchain.Setup(10)
+	assert.NoError(t, err)
+	st, err := keys.GenesisState()
+	assert.NoError(t, err)
+
+	ks := testKeystore{local: make(map[[48]byte]bool)}
+	registry := st.GetValidatorRegistry()
+	for _, index := range local {
+		ks.local[registry[index].PubKey] = true
+	}
+
+	d, err := NewDashboard(nil, &testChain{st: st}, testProposer{ks: ks}, nil)
+	assert.NoError(t, err)
+	return d, st
+}
+
+func TestDashboard_Duties(t *testing.T) {
+	d, st := setup(t)
+	netParams := config.GlobalParams.NetParams
+
+	slotState, err := d.chain.State().TipStateAtSlot(1)
+	assert.NoError(t, err)
+	queue := slotState.GetProposerQueue()
+	proposer := queue[2]
+
+	assert.Empty(t, d.duties(0, nil))
+
+	// the duties of a validator are the slots where it proposes or votes, up to the end of the epoch.
+	var want []Duty
+	for slot := uint64(1); slot <= netParams.EpochLength; slot++ {
+		duty := Duty{Slot: slot}
+		if queue[(slot+netParams.EpochLength-1)%netParams.EpochLength] == proposer {
+			duty.Proposing = true
+			duty.Proposer = proposer
+		}
+		committee, err := slotState.GetVoteCommittee(slot)
+		assert.NoError(t, err)
+		for _, v := range committee {
+			if v == proposer {
+				duty.Voters = append(duty.Voters, v)
+			}
+		}
+		if duty.Proposing || len(duty.Voters) > 0 {
+			want = append(want, duty)
+		}
+	}
+	assert.Equal(t, want, d.duties(0, map[uint64]bool{proposer: true}))
+
+	// every slot after the current slot has duties when all validators are local.
+	all := make(map[uint64]bool)
+	for i := range st.GetValidatorRegistry() {
+		all[uint64(i)] = true
+	}
+	duties := d.duties(1, all)
+	assert.Len(t, duties, int(netParams.EpochLength-1))
+	for i, duty := range duties {
+		assert.Equal(t, uint64(i+2), duty.Slot)
+		assert.True(t, duty.Proposing)
+		assert.Equal(t, queue[(duty.Slot+netParams.EpochLength-1)%netParams.EpochLength], duty.Proposer)
+		committee, err := slotState.GetVoteCommittee(duty.Slot)
+		assert.NoError(t, err)
+		assert.Equal(t, committee, duty.Voters)
+	}
+}
+
+func TestDashboard_AddEpoch(t *testing.T) {
+	d, st := setup(t, 1)
+	units := float64(config.GlobalParams.NetParams.UnitsPerCoin)
+
+	d.addEpoch(st, []*primitives.EpochReceipt{
+		{Type: primitives.RewardMatchedFromEpoch, Amount: 100, Validator: 0},
+		{Type: primitives.RewardMatchedFromEpoch, Amount: 200, Validator: 1},
+		{Type: primitives.RewardMatchedToEpoch, Amount: 50, Validator: 1},
+		{Type: primitives.PenaltyMissingFromEpoch, Amount: -300, Validator: 2},
+		{Type: primitives.PenaltyMissingFromEpoch, Amount: -400, Validator: 1},
+		// receipts of validators out of the registry are not local.
+		{Type: primitives.RewardMatchedFromEpoch, Amount: 100, Validator: 1000},
+	})
+
+	assert.Equal(t, []EpochData{{
+		Epoch:         st.GetEpochIndex(),
+		Participation: 60,
+		Voted:         3,
+		Missed:        2,
+		Rewards:       200/units + 50/units,
+		Penalties:     400 / units,
+	}}, d.epochs)
+	assert.Equal(t, []BalanceChange{
+		{Epoch: st.GetEpochIndex(), Validator: 1, Type: "voted for correct from epoch", Amount: 200 / units},
+		{Epoch: st.GetEpochIndex(), Validator: 1, Type: "voted for correct to epoch", Amount: 50 / units},
+		{Epoch: st.GetEpochIndex(), Validator: 1, Type: "voted for wrong from epoch", Amount: -400 / units},
+	}, d.receipts)
+	assert.Equal(t, []MissedDuty{{Epoch: st.GetEpochIndex(), Type: "vote", Validator: 1}}, d.missed)
+
+	// only the last epochs are kept.
+	for i := 0; i < maxEpochs; i++ {
+		d.addEpoch(st, nil)
+	}
+	assert.Len(t, d.epochs, maxEpochs)
+	assert.Equal(t, EpochData{Epoch: st.GetEpochIndex()}, d.epochs[0])
+	assert.Nil(t, d.receipts)
+}
+
+func TestDashboard_BasicAuth(t *testing.T) {
+	params, flags := config.GlobalParams, config.GlobalFlags
+	t.Cleanup(func() {
+		config.GlobalParams, config.GlobalFlags = params, flags
+	})
+	_, err := testchain.Setup(10)
+	assert.NoError(t, err)
+	config.GlobalFlags.DashboardUser = "user"
+	config.GlobalFlags.DashboardPass = "pass"
+
+	d, err := NewDashboard(nil, nil, nil, nil)
+	assert.NoError(t, err)
+
+	tests := []struct {
+		user, pass string
+		auth       bool
+		code       int
+	}{
+		{code: http.StatusUnauthorized},
+		{user: "user", pass: "wrong", auth: true, code: http.StatusUnauthorized},
+		{user: "other", pass: "pass", auth: true, code: http.StatusUnauthorized},
+		// there is no data before the first update.
+		{user: "user", pass: "pass", auth: true, code: http.StatusServiceUnavailable},
+	}
+	for _, tt := range tests {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		if tt.auth {
+			req.SetBasicAuth(tt.user, tt.pass)
+		}
+		w := httptest.NewRecorder()
+		d.r.ServeHTTP(w, req)
+		assert.Equal(t, tt.code, w.Code, "%s:%s", tt.user, tt.pass)
+		if tt.code == http.StatusUnauthorized {
+			assert.NotEmpty(t, w.Header().Get("WWW-Authenticate"))
+		} else {
+			assert.Equal(t, "the dashboard data is not available yet", w.Body.String())
+		}
+	}
+}
@@ -0,0 +1,191 @@
+// Live updates of the dashboard from the /events stream.
+(function () {
+    var svgNS = "http://www.w3.org/2000/svg";
+    var chartWidth = 640;
+    var chartHeight = 160;
+    var maxMempoolSamples = 60;
+    var mempoolSamples = [];
+
+    function lookup(data, path) {
+        return path.split(".").reduce(function (value, key) {
+            return value === undefined || value === null ? undefined : value[key];
+        }, data);
+    }
+
+    function cell(row, text) {
+        var td = document.createElement("td");
+        td.textContent = text;
+        row.appendChild(td);
+    }
+
+    function fillTable(id, items, columns) {
+        var body = document.getElementById(id);
+        if (!body) {
+            return;
+        }
+        body.innerHTML = "";
+        (items || []).forEach(function (item) {
+            var row = document.createElement("tr");
+            columns.forEach(function (column) {
+                cell(row, column(item));
+            });
+            body.appendChild(row);
+        });
+    }
+
+    function svgElement(name, attrs) {
+        var el = document.createElementNS(svgNS, name);
+        Object.keys(attrs).forEach(function (key) {
+            el.setAttribute(key, attrs[key]);
+        });
+        return el;
+    }
+
+    // barChart draws one bar for each value, negative values are drawn below the middle line.
+    function barChart(id, values, labels) {
+        var svg = document.getElementById(id);
+        if (!svg) {
+            return;
+        }
+        svg.innerHTML = "";
+        if (values.length === 0) {
+            return;
+        }
+        var hasNegative = values.some(function (v) {
+            return v < 0;
+        });
+        var max = Math.max.apply(null, values.map(Math.abs)) || 1;
+        var base = hasNegative ? chartHeight / 2 : chartHeight;
+        var scale = base / max;
+        var width = chartWidth / values.length;
+        values.forEach(function (value, i) {
+            var height = Math.abs(value) * scale;
+            var bar = svgElement("rect", {
+                x: i * width + 1,
+                y: value >= 0 ? base - height : base,
+                width: Math.max(width - 2, 1),
+                height: height,
+                "class": value >= 0 ? "bar" : "bar-negative"
+            });
+            var title = svgElement("title", {});
+            title.textContent = labels[i];
+            bar.appendChild(title);
+            svg.appendChild(bar);
+        });
+    }
+
+    function lineChart(id, values) {
+        var svg = document.getElementById(id);
+        if (!svg) {
+            return;
+        }
+        svg.innerHTML = "";
+        if (values.length < 2) {
+            return;
+        }
+        var max = Math.max.apply(null, values) || 1;
+        var step = chartWidth / (maxMempoolSamples - 1);
+        var points = values.map(function (value, i) {
+            return (i * step) + "," + (chartHeight - value / max * (chartHeight - 4));
+        });
+        svg.appendChild(svgElement("polyline", {points: points.join(" "), "class": "line"}));
+    }
+
+    function update(data) {
+        document.querySelectorAll("[data-field]").forEach(function (el) {
+            var value = lookup(data, el.getAttribute("data-field"));
+            el.textContent = value === undefined || value === null ? "" : value;
+        });
+        document.querySelectorAll("[data-flag]").forEach(function (el) {
+            var value = !!lookup(data, el.getAttribute("data-flag"));
+            el.textContent = value ? "true" : "false";
+            el.classList.toggle("badge-primary", value);
+            el.classList.toggle("badge-danger", !value);
+        });
+
+        var peers = document.getElementById("peers");
+        if (peers) {
+            peers.innerHTML = "";
+            (data.PeerData || []).forEach(function (peer) {
+                var item = document.createElement("li");
+                item.className = "list-group-item d-flex justify-content-between align-items-center";
+                var id = document.createElement("span");
+                id.className = "text-overflow";
+                id.style.maxWidth = "200px";
+                id.textContent = peer.ID;
+                var status = document.createElement("span");
+                status.className = "badge badge-pill " + (peer.Status === "behind" ? "badge-warning" : "badge-primary");
+                status.textContent = "Fin: " + peer.Finalized + " Just: " + peer.Justified + " Tip: " + peer.Tip + " (" + peer.Status + ")";
+                item.appendChild(id);
+                item.appendChild(status);
+                peers.appendChild(item);
+            });
+        }
+
+        fillTable("duties", data.Duties, [
+            function (d) {
+                return d.Slot;
+            },
+            function (d) {
+                return d.Proposing ? d.Proposer : "";
+            },
+            function (d) {
+                return (d.Voters || []).join(", ");
+            }
+        ]);
+        fillTable("missed", data.Missed, [
+            function (m) {
+                return m.Epoch;
+            },
+            function (m) {
+                return m.Slot || "";
+            },
+            function (m) {
+                return m.Type;
+            },
+            function (m) {
+                return m.Validator;
+            }
+        ]);
+        fillTable("receipts", data.Receipts, [
+            function (r) {
+                return r.Epoch;
+            },
+            function (r) {
+                return r.Validator;
+            },
+            function (r) {
+                return r.Type;
+            },
+            function (r) {
+                return r.Amount;
+            }
+        ]);
+
+        var epochs = data.Epochs || [];
+        barChart("participationChart", epochs.map(function (e) {
+            return e.Participation;
+        }), epochs.map(function (e) {
+            return "Epoch " + e.Epoch + ": " + e.Participation.toFixed(2) + "% (" + e.Voted + " voted, " + e.Missed + " missed)";
+        }));
+        barChart("balanceChart", epochs.map(function (e) {
+            return e.Rewards - e.Penalties;
+        }), epochs.map(function (e) {
+            return "Epoch " + e.Epoch + ": +" + e.Rewards + " / -" + e.Penalties;
+        }));
+
+        mempoolSamples.push(data.MempoolData.Coins);
+        if (mempoolSamples.length > maxMempoolSamples) {
+            mempoolSamples.shift();
+        }
+        lineChart("mempoolChart", mempoolSamples);
+    }
+
+    if (!window.EventSource) {
+        return;
+    }
+    var source = new EventSource("/events");
+    source.addEventListener("update", function (e) {
+        update(JSON.parse(e.data));
+    });
+})();
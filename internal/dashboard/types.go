@@ -7,6 +7,11 @@ type Data struct {
 	ProposerData      ProposerData
 	PeerData          []PeerData
 	ParticipationInfo ParticipationInfo
+	MempoolData       MempoolData
+	Epochs            []EpochData
+	Duties            []Duty
+	Missed            []MissedDuty
+	Receipts          []BalanceChange
 }
 
 type NodeData struct {
@@ -47,10 +52,50 @@ type PeerData struct {
 	Finalized uint64
 	Justified uint64
 	Tip       uint64
+	Status    string
 }
 
 type ParticipationInfo struct {
 	EpochSlot               uint64
+	EpochLength             uint64
 	Epoch                   uint64
 	ParticipationPercentage string
 }
+
+type MempoolData struct {
+	Coins int
+}
+
+// EpochData is the participation and the balance changes of the local keys on an epoch transition.
+type EpochData struct {
+	Epoch         uint64
+	Participation float64
+	Voted         int
+	Missed        int
+	Rewards       float64
+	Penalties     float64
+}
+
+// Duty is a slot where local keys propose or vote.
+type Duty struct {
+	Slot      uint64
+	Proposing bool
+	Proposer  uint64
+	Voters    []uint64
+}
+
+// MissedDuty is a proposal or vote that a local key did not perform.
+type MissedDuty struct {
+	Epoch     uint64
+	Slot      uint64
+	Type      string
+	Validator uint64
+}
+
+// BalanceChange is an epoch receipt of a local key.
+type BalanceChange struct {
+	Epoch     uint64
+	Validator uint64
+	Type      string
+	Amount    float64
+}
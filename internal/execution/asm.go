@@ -0,0 +1,93 @@
+package execution
+
+import (
+	"bufio"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// Assemble compiles a contract from its mnemonic form. Tokens are separated by spaces or new lines and comments start
+// with ';'. A token ending with ':' defines a label and emits a JUMPDEST, "PUSH <value>" pushes a decimal or 0x
+// prefixed hexadecimal value with the smallest PUSH opcode and "PUSH :label" pushes the position of a label.
+func Assemble(src string) ([]byte, error) {
+	opcodes := make(map[string]Opcode, len(opTable))
+	for op, info := range opTable {
+		opcodes[info.name] = op
+	}
+
+	var tokens []string
+	scanner := bufio.NewScanner(strings.NewReader(src))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if i := strings.Index(line, ";"); i >= 0 {
+			line = line[:i]
+		}
+		tokens = append(tokens, strings.Fields(line)...)
+	}
+
+	var code []byte
+	labels := make(map[string]int)
+	refs := make(map[int]string)
+
+	for i := 0; i < len(tokens); i++ {
+		tok := tokens[i]
+
+		if strings.HasSuffix(tok, ":") {
+			name := strings.TrimSuffix(tok, ":")
+			if _, ok := labels[name]; ok {
+				return nil, fmt.Errorf("label %s defined twice", name)
+			}
+			labels[name] = len(code)
+			code = append(code, byte(JUMPDEST))
+			continue
+		}
+
+		name := strings.ToUpper(tok)
+		if name != "PUSH" {
+			op, ok := opcodes[name]
+			if !ok || op.IsPush() {
+				return nil, fmt.Errorf("unknown instruction %s", tok)
+			}
+			code = append(code, byte(op))
+			continue
+		}
+
+		if i+1 >= len(tokens) {
+			return nil, fmt.Errorf("missing PUSH value")
+		}
+		i++
+		arg := tokens[i]
+
+		if strings.HasPrefix(arg, ":") {
+			refs[len(code)+1] = arg[1:]
+			code = append(code, byte(PUSH1+1), 0, 0)
+			continue
+		}
+
+		value, ok := new(big.Int).SetString(arg, 0)
+		if !ok || value.Sign() < 0 || value.BitLen() > 256 {
+			return nil, fmt.Errorf("invalid PUSH value %s", arg)
+		}
+		data := value.Bytes()
+		if len(data) == 0 {
+			data = []byte{0}
+		}
+		code = append(code, byte(PUSH1+Opcode(len(data)-1)))
+		code = append(code, data...)
+	}
+
+	for pos, name := range refs {
+		dest, ok := labels[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown label %s", name)
+		}
+		if dest > 0xffff {
+			return nil, fmt.Errorf("label %s is out of range", name)
+		}
+		code[pos] = byte(dest >> 8)
+		code[pos+1] = byte(dest)
+	}
+
+	return code, nil
+}
@@ -0,0 +1,32 @@
+package execution_test
+
+import (
+	"github.com/olympus-protocol/ogen/internal/execution"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestAssemble(t *testing.T) {
+	code, err := execution.Assemble(`
+		PUSH 1 PUSH 0x0100 ADD ; comment
+		loop:
+		PUSH :loop JUMP
+	`)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{0x60, 0x01, 0x61, 0x01, 0x00, 0x01, 0x5b, 0x61, 0x00, 0x06, 0x56}, code)
+
+	_, err = execution.Assemble("PUSH 1 FOO")
+	assert.Error(t, err)
+
+	_, err = execution.Assemble("PUSH :missing JUMP")
+	assert.Error(t, err)
+
+	_, err = execution.Assemble("a: a:")
+	assert.Error(t, err)
+
+	_, err = execution.Assemble("PUSH")
+	assert.Error(t, err)
+
+	_, err = execution.Assemble("PUSH2 1")
+	assert.Error(t, err)
+}
@@ -1,31 +1,147 @@
 package execution
 
 import (
-	"github.com/olympus-protocol/ogen/cmd/ogen/config"
-	"github.com/olympus-protocol/ogen/internal/csmt"
-	"github.com/olympus-protocol/ogen/pkg/logger"
+	"errors"
+
+	"github.com/olympus-protocol/ogen/pkg/chainhash"
+	"github.com/olympus-protocol/ogen/pkg/primitives"
 )
 
-type Execution interface {
+var (
+	// ErrIntrinsicGas is returned when the gas limit doesn't cover the gas charged before the execution.
+	ErrIntrinsicGas = errors.New("gas limit is below the intrinsic gas")
+	// ErrContractExists is returned when a deploy targets the address of an existing contract.
+	ErrContractExists = errors.New("contract already exists")
+	// ErrUnknownContract is returned when a call targets an address without a contract.
+	ErrUnknownContract = errors.New("unknown contract")
+	// ErrTooManyContracts is returned when a deploy exceeds primitives.MaxContracts.
+	ErrTooManyContracts = errors.New("too many contracts")
+)
+
+// Receipt is the result of the execution of a contract transaction.
+type Receipt struct {
+	TxHash   chainhash.Hash
+	Contract [20]byte
+	GasUsed  uint64
+	Return   chainhash.Hash
+	Err      error
 }
 
-type execution struct {
-	datapath string
-	db       csmt.Tree
-	log      logger.Logger
+// Success returns true if the execution changes were applied.
+func (r *Receipt) Success() bool {
+	return r.Err == nil
 }
 
+// Execution executes contract transactions. Invalid transactions return an error and must not be included in a block,
+// valid transactions always return a receipt and pay for the gas used even if the execution fails.
+type Execution interface {
+	Deploy(h *Host, from [20]byte, tx *primitives.ContractDeploy) (*Receipt, error)
+	Call(h *Host, from [20]byte, tx *primitives.ContractCall) (*Receipt, error)
+}
+
+type execution struct{}
+
 var _ Execution = &execution{}
 
-func NewExecutionInstance() (Execution, error) {
-	datapath := config.GlobalFlags.DataPath
-	log := config.GlobalParams.Logger
+// NewExecutionInstance returns an execution engine.
+func NewExecutionInstance() Execution {
+	return &execution{}
+}
+
+// DeployGas returns the gas charged for a deploy before executing its code.
+func DeployGas(tx *primitives.ContractDeploy) uint64 {
+	return GasDeploy + GasDeployByte*uint64(len(tx.Code)) + GasInputByte*uint64(len(tx.Input))
+}
+
+// CallGas returns the gas charged for a call before executing the contract code.
+func CallGas(tx *primitives.ContractCall) uint64 {
+	return GasCall + GasInputByte*uint64(len(tx.Input))
+}
+
+// StorageSetGas returns the gas charged for setting a new storage key on a contract storing the given amount of keys.
+func StorageSetGas(keys int) uint64 {
+	return GasStorageSet + GasStorageGrowth*uint64(keys/StorageGrowthStep)
+}
+
+// Deploy stores the code of a new contract, transfers the amount to it and executes the code with the deploy input.
+// The contract is not created if the execution fails.
+func (e *execution) Deploy(h *Host, from [20]byte, tx *primitives.ContractDeploy) (*Receipt, error) {
+	intrinsic := DeployGas(tx)
+	if tx.GasLimit < intrinsic {
+		return nil, ErrIntrinsicGas
+	}
+
+	addr := primitives.ContractAddress(from, tx.Nonce)
+	if _, ok := h.Contracts.Contracts[addr]; ok {
+		return nil, ErrContractExists
+	}
+	if len(h.Contracts.Contracts) >= primitives.MaxContracts {
+		return nil, ErrTooManyContracts
+	}
+
+	j := newJournal(h)
+	j.create(addr, tx.Code)
+
+	ctx := &Context{
+		Address: addr,
+		Caller:  from,
+		Value:   tx.Amount,
+		Input:   tx.Input,
+		Slot:    h.Slot,
+	}
+
+	return e.execute(j, ctx, tx.Code, tx.Hash(), intrinsic, tx.GasLimit)
+}
+
+// Call transfers the amount to the contract and executes its code with the call input.
+func (e *execution) Call(h *Host, from [20]byte, tx *primitives.ContractCall) (*Receipt, error) {
+	intrinsic := CallGas(tx)
+	if tx.GasLimit < intrinsic {
+		return nil, ErrIntrinsicGas
+	}
+
+	c, ok := h.Contracts.Contracts[tx.To]
+	if !ok {
+		return nil, ErrUnknownContract
+	}
+
+	ctx := &Context{
+		Address: tx.To,
+		Caller:  from,
+		Value:   tx.Amount,
+		Input:   tx.Input,
+		Slot:    h.Slot,
+	}
+
+	return e.execute(newJournal(h), ctx, c.Code, tx.Hash(), intrinsic, tx.GasLimit)
+}
+
+func (e *execution) execute(j *journal, ctx *Context, code []byte, txHash chainhash.Hash, intrinsic, gasLimit uint64) (*Receipt, error) {
+	if err := j.transfer(ctx.Caller, ctx.Address, ctx.Value); err != nil {
+		return nil, err
+	}
+
+	v := &vm{
+		code:      code,
+		jumpdests: analyzeJumpdests(code),
+		ctx:       ctx,
+		state:     j,
+		gas:       gasLimit - intrinsic,
+	}
+
+	ret, err := v.run()
+
+	r := &Receipt{
+		TxHash:   txHash,
+		Contract: ctx.Address,
+		GasUsed:  intrinsic + v.gasUsed,
+		Return:   ret,
+		Err:      err,
+	}
 
-	tree := csmt.NewTree(csmt.NewInMemoryTreeDB())
+	if err == nil {
+		j.commit()
+	}
 
-	return &execution{
-		datapath: datapath,
-		db:       tree,
-		log:      log,
-	}, nil
+	return r, nil
 }
@@ -0,0 +1,180 @@
+package execution_test
+
+import (
+	"github.com/olympus-protocol/ogen/internal/execution"
+	"github.com/olympus-protocol/ogen/pkg/chainhash"
+	"github.com/olympus-protocol/ogen/pkg/primitives"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+var (
+	owner = [20]byte{1}
+	other = [20]byte{2}
+)
+
+func newHost() *execution.Host {
+	return &execution.Host{
+		Coins: &primitives.CoinsState{
+			Balances: map[[20]byte]uint64{owner: 1000, other: 1000},
+			Nonces:   map[[20]byte]uint64{},
+		},
+		Contracts: &primitives.ContractsState{Contracts: map[[20]byte]*primitives.Contract{}},
+		Slot:      10,
+	}
+}
+
+func mustAssemble(t *testing.T, src string) []byte {
+	code, err := execution.Assemble(src)
+	assert.NoError(t, err)
+	return code
+}
+
+func deploy(t *testing.T, h *execution.Host, from [20]byte, code []byte, amount uint64) *execution.Receipt {
+	tx := &primitives.ContractDeploy{Code: code, Amount: amount, Nonce: 1, GasLimit: 1000000, GasPrice: 1}
+	r, err := execution.NewExecutionInstance().Deploy(h, from, tx)
+	assert.NoError(t, err)
+	return r
+}
+
+func call(t *testing.T, h *execution.Host, from, to [20]byte, input []byte, amount uint64) *execution.Receipt {
+	tx := &primitives.ContractCall{To: to, Input: input, Amount: amount, Nonce: 1, GasLimit: 100000, GasPrice: 1}
+	r, err := execution.NewExecutionInstance().Call(h, from, tx)
+	assert.NoError(t, err)
+	return r
+}
+
+func TestCounter(t *testing.T) {
+	h := newHost()
+
+	code := mustAssemble(t, `
+		PUSH 0 SLOAD PUSH 1 ADD
+		DUP1 PUSH 0 SSTORE
+		RETURN
+	`)
+
+	r := deploy(t, h, owner, code, 0)
+	assert.True(t, r.Success())
+	assert.Equal(t, primitives.ContractAddress(owner, 1), r.Contract)
+
+	r = call(t, h, other, r.Contract, nil, 0)
+	assert.True(t, r.Success())
+	assert.Equal(t, chainhash.Hash{31: 2}, r.Return)
+	assert.Equal(t, chainhash.Hash{31: 2}, h.Contracts.Contracts[r.Contract].Storage[chainhash.Hash{}])
+
+	// PUSH, SLOAD, PUSH, ADD, DUP1, PUSH, SSTORE and RETURN without a new storage key.
+	assert.Equal(t, uint64(execution.GasCall+3+200+3+3+3+3+5000), r.GasUsed)
+}
+
+func TestEscrow(t *testing.T) {
+	h := newHost()
+
+	// The deployer is stored as the owner, later calls from the owner withdraw the whole contract balance.
+	code := mustAssemble(t, `
+		PUSH 0 SLOAD DUP1 PUSH :owned JUMPI
+		POP CALLER PUSH 0 SSTORE STOP
+		owned:
+		CALLER EQ PUSH :withdraw JUMPI REVERT
+		withdraw:
+		ADDRESS BALANCE CALLER TRANSFER STOP
+	`)
+
+	r := deploy(t, h, owner, code, 100)
+	assert.True(t, r.Success())
+	contract := r.Contract
+	assert.Equal(t, uint64(100), h.Coins.Balances[contract])
+	assert.Equal(t, uint64(900), h.Coins.Balances[owner])
+
+	r = call(t, h, other, contract, nil, 50)
+	assert.Equal(t, execution.ErrReverted, r.Err)
+	assert.Equal(t, uint64(100), h.Coins.Balances[contract])
+	assert.Equal(t, uint64(1000), h.Coins.Balances[other])
+
+	r = call(t, h, owner, contract, nil, 0)
+	assert.True(t, r.Success())
+	assert.Equal(t, uint64(0), h.Coins.Balances[contract])
+	assert.Equal(t, uint64(1000), h.Coins.Balances[owner])
+}
+
+func TestExecutionFailures(t *testing.T) {
+	h := newHost()
+
+	r := deploy(t, h, owner, mustAssemble(t, "loop: PUSH :loop JUMP"), 0)
+	assert.Equal(t, execution.ErrOutOfGas, r.Err)
+	assert.Equal(t, uint64(1000000), r.GasUsed)
+	assert.Equal(t, 0, len(h.Contracts.Contracts))
+
+	r = deploy(t, h, owner, mustAssemble(t, "PUSH 1 JUMP"), 0)
+	assert.Equal(t, execution.ErrInvalidJump, r.Err)
+
+	r = deploy(t, h, owner, mustAssemble(t, "ADD"), 0)
+	assert.Equal(t, execution.ErrStackUnderflow, r.Err)
+
+	r = deploy(t, h, owner, []byte{0xff}, 0)
+	assert.Error(t, r.Err)
+
+	r = deploy(t, h, owner, mustAssemble(t, "PUSH 1 PUSH 1 TRANSFER"), 0)
+	assert.Equal(t, execution.ErrInsufficientBalance, r.Err)
+
+	e := execution.NewExecutionInstance()
+
+	_, err := e.Deploy(h, owner, &primitives.ContractDeploy{Code: []byte{0x00}, GasLimit: 100})
+	assert.Equal(t, execution.ErrIntrinsicGas, err)
+
+	_, err = e.Call(h, owner, &primitives.ContractCall{To: [20]byte{9}, GasLimit: 100000})
+	assert.Equal(t, execution.ErrUnknownContract, err)
+
+	r = deploy(t, h, owner, []byte{0x00}, 0)
+	assert.True(t, r.Success())
+	_, err = e.Deploy(h, owner, &primitives.ContractDeploy{Code: []byte{0x00}, Nonce: 1, GasLimit: 1000000})
+	assert.Equal(t, execution.ErrContractExists, err)
+}
+
+func TestStorageLimit(t *testing.T) {
+	h := newHost()
+	e := execution.NewExecutionInstance()
+
+	// The input word is stored as a key with a value of 1.
+	r := deploy(t, h, owner, mustAssemble(t, "PUSH 1 PUSH 0 INPUT SSTORE STOP"), 0)
+	assert.True(t, r.Success())
+	contract := r.Contract
+
+	storage := h.Contracts.Contracts[contract].Storage
+	for i := 1; i < primitives.MaxContractStorageKeys; i++ {
+		storage[chainhash.Hash{0: 0xff, 29: byte(i >> 16), 30: byte(i >> 8), 31: byte(i)}] = chainhash.Hash{31: 1}
+	}
+	assert.Equal(t, primitives.MaxContractStorageKeys, len(storage))
+
+	store := func(key byte) *execution.Receipt {
+		input := chainhash.Hash{31: key}
+		tx := &primitives.ContractCall{To: contract, Input: input[:], Nonce: 1, GasLimit: 10000000, GasPrice: 1}
+		r, err := e.Call(h, other, tx)
+		assert.NoError(t, err)
+		return r
+	}
+
+	// overwriting a key doesn't add a new one.
+	r = store(0)
+	assert.True(t, r.Success())
+
+	r = store(1)
+	assert.Equal(t, execution.ErrOutOfStorage, r.Err)
+	assert.Equal(t, primitives.MaxContractStorageKeys, len(storage))
+
+	// clearing a key makes room for a new one, which is charged for the keys already stored.
+	delete(storage, chainhash.Hash{0: 0xff, 31: 1})
+	r = store(1)
+	assert.True(t, r.Success())
+	assert.Equal(t, primitives.MaxContractStorageKeys, len(storage))
+	assert.Equal(t, execution.CallGas(&primitives.ContractCall{Input: make([]byte, 32)})+3+3+3+execution.StorageSetGas(primitives.MaxContractStorageKeys-1), r.GasUsed)
+
+	ser := h.Contracts.ToSerializable()
+	b, err := ser.MarshalSSZ()
+	assert.NoError(t, err)
+
+	var cs primitives.ContractsStateSerializable
+	assert.NoError(t, cs.UnmarshalSSZ(b))
+	if assert.Len(t, cs.Contracts, 1) {
+		assert.Len(t, cs.Contracts[0].Storage, primitives.MaxContractStorageKeys)
+	}
+}
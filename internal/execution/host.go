@@ -0,0 +1,140 @@
+package execution
+
+import (
+	"errors"
+
+	"github.com/olympus-protocol/ogen/pkg/chainhash"
+	"github.com/olympus-protocol/ogen/pkg/primitives"
+)
+
+var (
+	// ErrInsufficientBalance is returned when an account doesn't have enough balance for a transfer.
+	ErrInsufficientBalance = errors.New("insufficient balance for transfer")
+)
+
+// Host is the chain state contracts are executed against.
+type Host struct {
+	Coins     *primitives.CoinsState
+	Contracts *primitives.ContractsState
+	Slot      uint64
+
+	// Observer is notified of the changes applied to the host, if set.
+	Observer Observer
+}
+
+// Observer is notified of the accounts and contracts modified by a committed execution.
+type Observer interface {
+	AccountChanged(acc [20]byte)
+	ContractCreated(addr [20]byte)
+	StorageChanged(addr [20]byte, key chainhash.Hash)
+}
+
+// journal buffers the changes of an execution on top of the host, so they can be discarded when the execution fails.
+type journal struct {
+	host     *Host
+	balances map[[20]byte]uint64
+	storage  map[[20]byte]map[chainhash.Hash]chainhash.Hash
+	created  map[[20]byte][]byte
+	keys     map[[20]byte]int
+}
+
+func newJournal(h *Host) *journal {
+	return &journal{
+		host:     h,
+		balances: make(map[[20]byte]uint64),
+		storage:  make(map[[20]byte]map[chainhash.Hash]chainhash.Hash),
+		created:  make(map[[20]byte][]byte),
+		keys:     make(map[[20]byte]int),
+	}
+}
+
+func (j *journal) balance(acc [20]byte) uint64 {
+	if b, ok := j.balances[acc]; ok {
+		return b
+	}
+	return j.host.Coins.Balances[acc]
+}
+
+func (j *journal) transfer(from, to [20]byte, amount uint64) error {
+	fromBalance := j.balance(from)
+	if fromBalance < amount {
+		return ErrInsufficientBalance
+	}
+	j.balances[from] = fromBalance - amount
+	j.balances[to] = j.balance(to) + amount
+	return nil
+}
+
+func (j *journal) load(addr [20]byte, key chainhash.Hash) chainhash.Hash {
+	if s, ok := j.storage[addr]; ok {
+		if v, ok := s[key]; ok {
+			return v
+		}
+	}
+	if c, ok := j.host.Contracts.Contracts[addr]; ok {
+		return c.Storage[key]
+	}
+	return chainhash.Hash{}
+}
+
+// storageKeys returns the amount of nonzero storage keys of a contract including the buffered changes.
+func (j *journal) storageKeys(addr [20]byte) int {
+	keys := j.keys[addr]
+	if c, ok := j.host.Contracts.Contracts[addr]; ok {
+		keys += len(c.Storage)
+	}
+	return keys
+}
+
+func (j *journal) store(addr [20]byte, key, value chainhash.Hash) {
+	prev := j.load(addr, key)
+	if prev == (chainhash.Hash{}) && value != (chainhash.Hash{}) {
+		j.keys[addr]++
+	} else if prev != (chainhash.Hash{}) && value == (chainhash.Hash{}) {
+		j.keys[addr]--
+	}
+	s, ok := j.storage[addr]
+	if !ok {
+		s = make(map[chainhash.Hash]chainhash.Hash)
+		j.storage[addr] = s
+	}
+	s[key] = value
+}
+
+func (j *journal) create(addr [20]byte, code []byte) {
+	j.created[addr] = code
+}
+
+// commit applies the buffered changes to the host and notifies the observer. Zero storage values are removed, as they
+// are the same as a missing key on the state tree.
+func (j *journal) commit() {
+	o := j.host.Observer
+	for addr, code := range j.created {
+		j.host.Contracts.Contracts[addr] = &primitives.Contract{
+			Code:    code,
+			Storage: make(map[chainhash.Hash]chainhash.Hash),
+		}
+		if o != nil {
+			o.ContractCreated(addr)
+		}
+	}
+	for acc, b := range j.balances {
+		j.host.Coins.Balances[acc] = b
+		if o != nil {
+			o.AccountChanged(acc)
+		}
+	}
+	for addr, s := range j.storage {
+		c := j.host.Contracts.Contracts[addr]
+		for k, v := range s {
+			if o != nil {
+				o.StorageChanged(addr, k)
+			}
+			if v == (chainhash.Hash{}) {
+				delete(c.Storage, k)
+				continue
+			}
+			c.Storage[k] = v
+		}
+	}
+}
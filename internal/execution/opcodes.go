@@ -0,0 +1,148 @@
+package execution
+
+import "strconv"
+
+// Opcode is an instruction of the contract VM.
+type Opcode byte
+
+// Arithmetic and comparison opcodes operate on 256 bit unsigned words and wrap around on overflow.
+// Division and modulo by zero return zero.
+const (
+	STOP Opcode = 0x00
+	ADD  Opcode = 0x01
+	SUB  Opcode = 0x02
+	MUL  Opcode = 0x03
+	DIV  Opcode = 0x04
+	MOD  Opcode = 0x05
+
+	LT     Opcode = 0x10
+	GT     Opcode = 0x11
+	EQ     Opcode = 0x12
+	ISZERO Opcode = 0x13
+	AND    Opcode = 0x14
+	OR     Opcode = 0x15
+	XOR    Opcode = 0x16
+	NOT    Opcode = 0x17
+
+	// HASH pops two words and pushes the hash of both, used to derive storage keys.
+	HASH Opcode = 0x20
+
+	ADDRESS   Opcode = 0x30
+	CALLER    Opcode = 0x31
+	CALLVALUE Opcode = 0x32
+	BALANCE   Opcode = 0x33
+	INPUT     Opcode = 0x34
+	INPUTSIZE Opcode = 0x35
+	SLOT      Opcode = 0x36
+
+	POP      Opcode = 0x50
+	SLOAD    Opcode = 0x54
+	SSTORE   Opcode = 0x55
+	JUMP     Opcode = 0x56
+	JUMPI    Opcode = 0x57
+	JUMPDEST Opcode = 0x5b
+
+	// PUSH1 to PUSH32 push the following 1 to 32 bytes of code as a word.
+	PUSH1  Opcode = 0x60
+	PUSH32 Opcode = 0x7f
+
+	// DUP1 to DUP16 duplicate the nth word of the stack.
+	DUP1  Opcode = 0x80
+	DUP16 Opcode = 0x8f
+
+	// SWAP1 to SWAP16 swap the top of the stack with the nth word after it.
+	SWAP1  Opcode = 0x90
+	SWAP16 Opcode = 0x9f
+
+	// TRANSFER pops an account and an amount and sends the amount from the contract balance.
+	TRANSFER Opcode = 0xa0
+
+	RETURN Opcode = 0xf3
+	REVERT Opcode = 0xfd
+)
+
+const (
+	// GasDeploy is the gas charged for a contract deploy before the execution.
+	GasDeploy = 32000
+	// GasDeployByte is the gas charged for each byte of deployed code.
+	GasDeployByte = 200
+	// GasCall is the gas charged for a contract call before the execution.
+	GasCall = 21000
+	// GasInputByte is the gas charged for each byte of input.
+	GasInputByte = 16
+	// GasStorageSet is the gas charged when a storage key goes from zero to a nonzero value.
+	GasStorageSet = 20000
+	// GasStorageGrowth is the gas added to GasStorageSet for every StorageGrowthStep keys the contract already stores,
+	// so filling the storage of a contract up to primitives.MaxContractStorageKeys gets increasingly expensive.
+	GasStorageGrowth = 1000
+	// StorageGrowthStep is the amount of storage keys between each increase of the storage set gas.
+	StorageGrowthStep = 1024
+)
+
+type opInfo struct {
+	name string
+	gas  uint64
+	pops int
+}
+
+var opTable = map[Opcode]opInfo{
+	STOP:      {"STOP", 0, 0},
+	ADD:       {"ADD", 3, 2},
+	SUB:       {"SUB", 3, 2},
+	MUL:       {"MUL", 5, 2},
+	DIV:       {"DIV", 5, 2},
+	MOD:       {"MOD", 5, 2},
+	LT:        {"LT", 3, 2},
+	GT:        {"GT", 3, 2},
+	EQ:        {"EQ", 3, 2},
+	ISZERO:    {"ISZERO", 3, 1},
+	AND:       {"AND", 3, 2},
+	OR:        {"OR", 3, 2},
+	XOR:       {"XOR", 3, 2},
+	NOT:       {"NOT", 3, 1},
+	HASH:      {"HASH", 30, 2},
+	ADDRESS:   {"ADDRESS", 2, 0},
+	CALLER:    {"CALLER", 2, 0},
+	CALLVALUE: {"CALLVALUE", 2, 0},
+	BALANCE:   {"BALANCE", 400, 1},
+	INPUT:     {"INPUT", 3, 1},
+	INPUTSIZE: {"INPUTSIZE", 2, 0},
+	SLOT:      {"SLOT", 2, 0},
+	POP:       {"POP", 2, 1},
+	SLOAD:     {"SLOAD", 200, 1},
+	SSTORE:    {"SSTORE", 5000, 2},
+	JUMP:      {"JUMP", 8, 1},
+	JUMPI:     {"JUMPI", 10, 2},
+	JUMPDEST:  {"JUMPDEST", 1, 0},
+	TRANSFER:  {"TRANSFER", 9000, 2},
+	RETURN:    {"RETURN", 0, 1},
+	REVERT:    {"REVERT", 0, 0},
+}
+
+func init() {
+	for i := 0; i < 32; i++ {
+		opTable[PUSH1+Opcode(i)] = opInfo{"PUSH" + strconv.Itoa(i+1), 3, 0}
+	}
+	for i := 0; i < 16; i++ {
+		opTable[DUP1+Opcode(i)] = opInfo{"DUP" + strconv.Itoa(i+1), 3, i + 1}
+		opTable[SWAP1+Opcode(i)] = opInfo{"SWAP" + strconv.Itoa(i+1), 3, i + 2}
+	}
+}
+
+// String returns the mnemonic of the opcode.
+func (op Opcode) String() string {
+	if info, ok := opTable[op]; ok {
+		return info.name
+	}
+	return "INVALID"
+}
+
+// IsPush returns true if the opcode is one of PUSH1 to PUSH32.
+func (op Opcode) IsPush() bool {
+	return op >= PUSH1 && op <= PUSH32
+}
+
+// pushSize returns the amount of code bytes pushed by a PUSH opcode.
+func (op Opcode) pushSize() int {
+	return int(op-PUSH1) + 1
+}
@@ -0,0 +1,306 @@
+package execution
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/olympus-protocol/ogen/pkg/chainhash"
+	"github.com/olympus-protocol/ogen/pkg/primitives"
+)
+
+var (
+	// ErrOutOfGas is returned when the execution uses more gas than the gas limit.
+	ErrOutOfGas = errors.New("out of gas")
+	// ErrStackUnderflow is returned when an opcode pops more words than the stack has.
+	ErrStackUnderflow = errors.New("stack underflow")
+	// ErrStackOverflow is returned when the stack grows above MaxStackSize.
+	ErrStackOverflow = errors.New("stack overflow")
+	// ErrInvalidJump is returned when a jump destination is not a JUMPDEST.
+	ErrInvalidJump = errors.New("invalid jump destination")
+	// ErrInvalidAddress is returned when a word used as an account has more than 20 bytes.
+	ErrInvalidAddress = errors.New("invalid account word")
+	// ErrInvalidAmount is returned when a word used as an amount doesn't fit 64 bits.
+	ErrInvalidAmount = errors.New("invalid amount word")
+	// ErrReverted is returned when the code executes REVERT.
+	ErrReverted = errors.New("execution reverted")
+	// ErrOutOfStorage is returned when a new storage key exceeds primitives.MaxContractStorageKeys.
+	ErrOutOfStorage = errors.New("out of contract storage")
+)
+
+// MaxStackSize is the maximum amount of words on the stack.
+const MaxStackSize = 1024
+
+var (
+	wordModulus = new(big.Int).Lsh(big.NewInt(1), 256)
+	wordMax     = new(big.Int).Sub(wordModulus, big.NewInt(1))
+	maxUint64   = new(big.Int).SetUint64(^uint64(0))
+)
+
+// Context is the environment a contract code is executed in.
+type Context struct {
+	Address [20]byte
+	Caller  [20]byte
+	Value   uint64
+	Input   []byte
+	Slot    uint64
+}
+
+type vm struct {
+	code      []byte
+	jumpdests []bool
+	ctx       *Context
+	state     *journal
+
+	stack   []*big.Int
+	gas     uint64
+	gasUsed uint64
+}
+
+// analyzeJumpdests marks the JUMPDEST opcodes of the code, skipping the data of the PUSH opcodes.
+func analyzeJumpdests(code []byte) []bool {
+	dests := make([]bool, len(code))
+	for pc := 0; pc < len(code); pc++ {
+		op := Opcode(code[pc])
+		if op == JUMPDEST {
+			dests[pc] = true
+		}
+		if op.IsPush() {
+			pc += op.pushSize()
+		}
+	}
+	return dests
+}
+
+func (v *vm) useGas(amount uint64) error {
+	if v.gas-v.gasUsed < amount {
+		v.gasUsed = v.gas
+		return ErrOutOfGas
+	}
+	v.gasUsed += amount
+	return nil
+}
+
+func (v *vm) push(w *big.Int) error {
+	if len(v.stack) >= MaxStackSize {
+		return ErrStackOverflow
+	}
+	v.stack = append(v.stack, w)
+	return nil
+}
+
+func (v *vm) pop() *big.Int {
+	w := v.stack[len(v.stack)-1]
+	v.stack = v.stack[:len(v.stack)-1]
+	return w
+}
+
+func wordFromBytes(b []byte) *big.Int {
+	return new(big.Int).SetBytes(b)
+}
+
+func wordToHash(w *big.Int) chainhash.Hash {
+	var h chainhash.Hash
+	w.FillBytes(h[:])
+	return h
+}
+
+func wordToAddress(w *big.Int) ([20]byte, error) {
+	var acc [20]byte
+	if w.BitLen() > 160 {
+		return acc, ErrInvalidAddress
+	}
+	w.FillBytes(acc[:])
+	return acc, nil
+}
+
+func wordToUint64(w *big.Int) (uint64, error) {
+	if w.Cmp(maxUint64) > 0 {
+		return 0, ErrInvalidAmount
+	}
+	return w.Uint64(), nil
+}
+
+func boolWord(b bool) *big.Int {
+	if b {
+		return big.NewInt(1)
+	}
+	return new(big.Int)
+}
+
+// run executes the code until it stops, returns or fails. On failure, the journal must be discarded by the caller.
+func (v *vm) run() (chainhash.Hash, error) {
+	for pc := 0; pc < len(v.code); pc++ {
+		op := Opcode(v.code[pc])
+		info, ok := opTable[op]
+		if !ok {
+			v.gasUsed = v.gas
+			return chainhash.Hash{}, fmt.Errorf("invalid opcode 0x%02x at %d", byte(op), pc)
+		}
+		if err := v.useGas(info.gas); err != nil {
+			return chainhash.Hash{}, err
+		}
+		if len(v.stack) < info.pops {
+			v.gasUsed = v.gas
+			return chainhash.Hash{}, ErrStackUnderflow
+		}
+
+		var res *big.Int
+		switch {
+		case op.IsPush():
+			start := pc + 1
+			end := start + op.pushSize()
+			if end > len(v.code) {
+				end = len(v.code)
+			}
+			data := make([]byte, op.pushSize())
+			copy(data, v.code[start:end])
+			res = wordFromBytes(data)
+			pc += op.pushSize()
+		case op >= DUP1 && op <= DUP16:
+			res = new(big.Int).Set(v.stack[len(v.stack)-int(op-DUP1)-1])
+		case op >= SWAP1 && op <= SWAP16:
+			top := len(v.stack) - 1
+			other := top - int(op-SWAP1) - 1
+			v.stack[top], v.stack[other] = v.stack[other], v.stack[top]
+		}
+		if res != nil {
+			if err := v.push(res); err != nil {
+				v.gasUsed = v.gas
+				return chainhash.Hash{}, err
+			}
+			continue
+		}
+
+		switch op {
+		case STOP:
+			return chainhash.Hash{}, nil
+		case ADD:
+			a, b := v.pop(), v.pop()
+			res = a.Add(a, b).Mod(a, wordModulus)
+		case SUB:
+			a, b := v.pop(), v.pop()
+			res = a.Sub(a, b).Mod(a, wordModulus)
+		case MUL:
+			a, b := v.pop(), v.pop()
+			res = a.Mul(a, b).Mod(a, wordModulus)
+		case DIV:
+			a, b := v.pop(), v.pop()
+			if b.Sign() == 0 {
+				res = new(big.Int)
+			} else {
+				res = a.Div(a, b)
+			}
+		case MOD:
+			a, b := v.pop(), v.pop()
+			if b.Sign() == 0 {
+				res = new(big.Int)
+			} else {
+				res = a.Mod(a, b)
+			}
+		case LT:
+			a, b := v.pop(), v.pop()
+			res = boolWord(a.Cmp(b) < 0)
+		case GT:
+			a, b := v.pop(), v.pop()
+			res = boolWord(a.Cmp(b) > 0)
+		case EQ:
+			a, b := v.pop(), v.pop()
+			res = boolWord(a.Cmp(b) == 0)
+		case ISZERO:
+			res = boolWord(v.pop().Sign() == 0)
+		case AND:
+			a, b := v.pop(), v.pop()
+			res = a.And(a, b)
+		case OR:
+			a, b := v.pop(), v.pop()
+			res = a.Or(a, b)
+		case XOR:
+			a, b := v.pop(), v.pop()
+			res = a.Xor(a, b)
+		case NOT:
+			a := v.pop()
+			res = a.Sub(wordMax, a)
+		case HASH:
+			a, b := wordToHash(v.pop()), wordToHash(v.pop())
+			h := chainhash.HashH(append(a[:], b[:]...))
+			res = wordFromBytes(h[:])
+		case ADDRESS:
+			res = wordFromBytes(v.ctx.Address[:])
+		case CALLER:
+			res = wordFromBytes(v.ctx.Caller[:])
+		case CALLVALUE:
+			res = new(big.Int).SetUint64(v.ctx.Value)
+		case BALANCE:
+			acc, err := wordToAddress(v.pop())
+			if err != nil {
+				return chainhash.Hash{}, err
+			}
+			res = new(big.Int).SetUint64(v.state.balance(acc))
+		case INPUT:
+			offset := v.pop()
+			data := make([]byte, 32)
+			if offset.IsUint64() && offset.Uint64() < uint64(len(v.ctx.Input)) {
+				copy(data, v.ctx.Input[offset.Uint64():])
+			}
+			res = wordFromBytes(data)
+		case INPUTSIZE:
+			res = big.NewInt(int64(len(v.ctx.Input)))
+		case SLOT:
+			res = new(big.Int).SetUint64(v.ctx.Slot)
+		case POP:
+			v.pop()
+		case SLOAD:
+			value := v.state.load(v.ctx.Address, wordToHash(v.pop()))
+			res = wordFromBytes(value[:])
+		case SSTORE:
+			key, value := wordToHash(v.pop()), wordToHash(v.pop())
+			if value != (chainhash.Hash{}) && v.state.load(v.ctx.Address, key) == (chainhash.Hash{}) {
+				keys := v.state.storageKeys(v.ctx.Address)
+				if keys >= primitives.MaxContractStorageKeys {
+					return chainhash.Hash{}, ErrOutOfStorage
+				}
+				if err := v.useGas(StorageSetGas(keys) - info.gas); err != nil {
+					return chainhash.Hash{}, err
+				}
+			}
+			v.state.store(v.ctx.Address, key, value)
+		case JUMP, JUMPI:
+			dest := v.pop()
+			if op == JUMPI && v.pop().Sign() == 0 {
+				continue
+			}
+			if !dest.IsUint64() || dest.Uint64() >= uint64(len(v.code)) || !v.jumpdests[dest.Uint64()] {
+				v.gasUsed = v.gas
+				return chainhash.Hash{}, ErrInvalidJump
+			}
+			// The loop increment moves past the JUMPDEST.
+			pc = int(dest.Uint64())
+		case JUMPDEST:
+		case TRANSFER:
+			to, err := wordToAddress(v.pop())
+			if err != nil {
+				return chainhash.Hash{}, err
+			}
+			amount, err := wordToUint64(v.pop())
+			if err != nil {
+				return chainhash.Hash{}, err
+			}
+			if err := v.state.transfer(v.ctx.Address, to, amount); err != nil {
+				return chainhash.Hash{}, err
+			}
+		case RETURN:
+			return wordToHash(v.pop()), nil
+		case REVERT:
+			return chainhash.Hash{}, ErrReverted
+		}
+
+		if res != nil {
+			if err := v.push(res); err != nil {
+				v.gasUsed = v.gas
+				return chainhash.Hash{}, err
+			}
+		}
+	}
+	return chainhash.Hash{}, nil
+}
@@ -48,7 +48,7 @@ type discover struct {
 // NewDiscover creates a new discovery service.
 func NewDiscover(host HostNode) (*discover, error) {
 	ctx := config.GlobalParams.Context
-	log := config.GlobalParams.Logger
+	log := config.GlobalParams.Logger.Module("hostnode")
 	netParams := config.GlobalParams.NetParams
 
 	d, err := dht.New(ctx, host.GetHost(), dht.Mode(dht.ModeAutoServer))
@@ -84,7 +84,7 @@ func NewDiscover(host HostNode) (*discover, error) {
 	initialNodes = append(initialNodes, peerstorePeers...)
 	for _, addr := range initialNodes {
 		if err := dp.host.GetHost().Connect(dp.ctx, addr); err != nil {
-			dp.log.Infof("unable to connect to peer %s", addr.ID)
+			dp.log.With("peer", addr.ID, "err", err).Info("unable to connect to peer")
 		}
 	}
 
@@ -100,7 +100,7 @@ func (d *discover) handleNewPeer(pi peer.AddrInfo) {
 	}
 	err := d.Connect(pi)
 	if err != nil {
-		d.log.Infof("unable to connect to peer %s", pi.ID.String())
+		d.log.With("peer", pi.ID, "err", err).Info("unable to connect to peer")
 	}
 }
 
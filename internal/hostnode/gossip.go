@@ -0,0 +1,149 @@
+package hostnode
+
+import (
+	"context"
+	"runtime"
+	"sync"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/olympus-protocol/ogen/pkg/logger"
+	"github.com/olympus-protocol/ogen/pkg/p2p"
+)
+
+const (
+	// defaultPeerPendingMessages is the amount of gossip messages from a single peer that can wait to be processed.
+	defaultPeerPendingMessages = 64
+	// gossipQueueSize is the amount of gossip messages from all peers that can wait to be processed.
+	gossipQueueSize = 4096
+)
+
+// MessageValidator checks the signatures and stateless rules of a gossip message. It runs before the message handler
+// and without access to the chain state, a message that doesn't pass it is dropped.
+type MessageValidator func(msg p2p.Message) error
+
+// orderedCommands are the commands of the messages handled by a single worker in the order they are received. Blocks
+// must be processed after their parents and the finalization messages of a peer after its previous blocks.
+var orderedCommands = map[string]bool{
+	p2p.MsgBlockCmd:        true,
+	p2p.MsgFinalizationCmd: true,
+}
+
+type gossipMessage struct {
+	from         peer.ID
+	receivedFrom peer.ID
+	msg          p2p.Message
+}
+
+// gossipPool validates and handles the gossip messages on a bounded amount of workers. Each peer can only have a
+// limited amount of messages waiting to be processed, the messages above that limit are dropped. Blocks and
+// finalization messages are handled by a single worker to keep their order.
+type gossipPool struct {
+	handler *handler
+
+	queue   chan *gossipMessage
+	ordered chan *gossipMessage
+
+	maxPeerPending int
+	pending        map[peer.ID]int
+	pendingLock    sync.Mutex
+
+	ctx context.Context
+	log logger.Logger
+}
+
+// submit queues a message to be processed. Returns false if the message was dropped.
+func (gp *gossipPool) submit(from peer.ID, receivedFrom peer.ID, msg p2p.Message) bool {
+	gp.pendingLock.Lock()
+	if gp.pending[receivedFrom] >= gp.maxPeerPending {
+		gp.pendingLock.Unlock()
+		return false
+	}
+	gp.pending[receivedFrom]++
+	gp.pendingLock.Unlock()
+
+	queue := gp.queue
+	if orderedCommands[msg.Command()] {
+		queue = gp.ordered
+	}
+
+	select {
+	case queue <- &gossipMessage{from: from, receivedFrom: receivedFrom, msg: msg}:
+		return true
+	default:
+		gp.release(receivedFrom)
+		return false
+	}
+}
+
+func (gp *gossipPool) release(id peer.ID) {
+	gp.pendingLock.Lock()
+	defer gp.pendingLock.Unlock()
+	gp.pending[id]--
+	if gp.pending[id] <= 0 {
+		delete(gp.pending, id)
+	}
+}
+
+func (gp *gossipPool) worker(queue chan *gossipMessage) {
+	for {
+		select {
+		case <-gp.ctx.Done():
+			return
+		case m := <-queue:
+			gp.process(m)
+			gp.release(m.receivedFrom)
+		}
+	}
+}
+
+func (gp *gossipPool) process(m *gossipMessage) {
+	cmd := m.msg.Command()
+
+	gp.handler.topicHandlersLock.Lock()
+	handler, found := gp.handler.topicHandlers[cmd]
+	validator, hasValidator := gp.handler.topicValidators[cmd]
+	gp.handler.topicHandlersLock.Unlock()
+
+	if !found {
+		return
+	}
+
+	if hasValidator {
+		if err := validator(m.msg); err != nil {
+			gp.log.With("cmd", cmd, "peer", m.receivedFrom, "err", err).Debug("dropping invalid message")
+			return
+		}
+	}
+
+	if err := handler(m.from, m.msg); err != nil {
+		gp.log.With("cmd", cmd, "peer", m.from, "err", err).Error("unable to handle message")
+	}
+}
+
+// newGossipPool starts a gossip pool with the specified amount of workers and the worker of the ordered messages. If
+// workers is zero, it starts one worker for each CPU.
+func newGossipPool(ctx context.Context, log logger.Logger, h *handler, workers int, maxPeerPending int) *gossipPool {
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+	if maxPeerPending <= 0 {
+		maxPeerPending = defaultPeerPendingMessages
+	}
+
+	gp := &gossipPool{
+		handler:        h,
+		queue:          make(chan *gossipMessage, gossipQueueSize),
+		ordered:        make(chan *gossipMessage, gossipQueueSize),
+		maxPeerPending: maxPeerPending,
+		pending:        make(map[peer.ID]int),
+		ctx:            ctx,
+		log:            log,
+	}
+
+	for i := 0; i < workers; i++ {
+		go gp.worker(gp.queue)
+	}
+	go gp.worker(gp.ordered)
+
+	return gp
+}
@@ -0,0 +1,73 @@
+package hostnode
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/olympus-protocol/ogen/pkg/logger"
+	"github.com/olympus-protocol/ogen/pkg/p2p"
+	"github.com/stretchr/testify/assert"
+)
+
+// testGossipPool returns a gossip pool without workers, so the submitted messages stay pending until they are
+// processed by the test.
+func testGossipPool(maxPeerPending int) *gossipPool {
+	return &gossipPool{
+		handler: &handler{
+			topicHandlers:   make(map[string]MessageHandler),
+			topicValidators: make(map[string]MessageValidator),
+		},
+		queue:          make(chan *gossipMessage, gossipQueueSize),
+		ordered:        make(chan *gossipMessage, gossipQueueSize),
+		maxPeerPending: maxPeerPending,
+		pending:        make(map[peer.ID]int),
+		ctx:            context.Background(),
+		log:            logger.New(os.Stdout).Quiet(),
+	}
+}
+
+func TestGossipPool_PeerPendingLimit(t *testing.T) {
+	gp := testGossipPool(2)
+	a, b := peer.ID("a"), peer.ID("b")
+
+	assert.True(t, gp.submit(a, a, &p2p.MsgTx{}))
+	assert.True(t, gp.submit(a, a, &p2p.MsgTx{}))
+	// the third message of the peer is dropped, other peers are not affected.
+	assert.False(t, gp.submit(a, a, &p2p.MsgTx{}))
+	assert.True(t, gp.submit(b, b, &p2p.MsgTx{}))
+
+	// the limit counts the peer that relayed the message.
+	assert.False(t, gp.submit(b, a, &p2p.MsgTx{}))
+
+	// once a message of the peer is processed, a new one is accepted.
+	m := <-gp.queue
+	gp.process(m)
+	gp.release(m.receivedFrom)
+	assert.True(t, gp.submit(a, a, &p2p.MsgTx{}))
+	assert.False(t, gp.submit(a, a, &p2p.MsgTx{}))
+}
+
+func TestGossipPool_OrderedMessages(t *testing.T) {
+	gp := testGossipPool(8)
+	a := peer.ID("a")
+
+	var handled []string
+	gp.handler.topicHandlers[p2p.MsgBlockCmd] = func(id peer.ID, msg p2p.Message) error {
+		handled = append(handled, msg.Command())
+		return nil
+	}
+	gp.handler.topicHandlers[p2p.MsgFinalizationCmd] = gp.handler.topicHandlers[p2p.MsgBlockCmd]
+
+	assert.True(t, gp.submit(a, a, &p2p.MsgBlock{}))
+	assert.True(t, gp.submit(a, a, &p2p.MsgTx{}))
+	assert.True(t, gp.submit(a, a, &p2p.MsgFinalization{}))
+	assert.Len(t, gp.queue, 1)
+	assert.Len(t, gp.ordered, 2)
+
+	for len(gp.ordered) > 0 {
+		gp.process(<-gp.ordered)
+	}
+	assert.Equal(t, []string{p2p.MsgBlockCmd, p2p.MsgFinalizationCmd}, handled)
+}
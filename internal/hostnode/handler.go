@@ -30,6 +30,7 @@ type handler struct {
 	messageHandlersLock sync.Mutex
 
 	topicHandlers     map[string]MessageHandler
+	topicValidators   map[string]MessageValidator
 	topicHandlersLock sync.Mutex
 
 	outgoingMessages     map[peer.ID]chan p2p.Message
@@ -46,10 +47,11 @@ func newHandler(id protocol.ID, host HostNode) (*handler, error) {
 		ID:               id,
 		host:             host,
 		topicHandlers:    make(map[string]MessageHandler),
+		topicValidators:  make(map[string]MessageValidator),
 		messageHandler:   make(map[string]MessageHandler),
 		outgoingMessages: make(map[peer.ID]chan p2p.Message),
 		ctx:              config.GlobalParams.Context,
-		log:              config.GlobalParams.Logger,
+		log:              config.GlobalParams.Logger.Module("hostnode"),
 	}
 
 	host.GetHost().SetStreamHandler(id, ph.handleStream)
@@ -81,6 +83,18 @@ func (p *handler) RegisterTopicHandler(messageName string, handler MessageHandle
 	return nil
 }
 
+// RegisterTopicValidator registers a validator for a topic message.
+func (p *handler) RegisterTopicValidator(messageName string, validator MessageValidator) error {
+	p.topicHandlersLock.Lock()
+	defer p.topicHandlersLock.Unlock()
+	if _, found := p.topicValidators[messageName]; found {
+		return fmt.Errorf("validator for message name %s already exists", messageName)
+	}
+
+	p.topicValidators[messageName] = validator
+	return nil
+}
+
 // processMessages continuously reads from stream and handles any protobuf messages.
 func processMessages(ctx context.Context, net uint32, stream io.Reader, handler func(p2p.Message) error) error {
 	for {
@@ -106,7 +120,7 @@ func (p *handler) receiveMessages(id peer.ID, r io.Reader) {
 	err := processMessages(p.ctx, p.host.GetNetMagic(), r, func(message p2p.Message) error {
 		cmd := message.Command()
 
-		p.log.Tracef("processing message %s from peer %s", cmd, id)
+		p.log.With("cmd", cmd, "peer", id).Trace("processing message")
 
 		p.messageHandlersLock.Lock()
 
@@ -123,7 +137,7 @@ func (p *handler) receiveMessages(id peer.ID, r io.Reader) {
 	})
 	if err != nil {
 		if !strings.Contains(err.Error(), "stream reset") {
-			p.log.Errorf("error receiving messages from peer %s: %s", id, err)
+			p.log.With("peer", id, "err", err).Error("error receiving messages")
 		}
 
 	}
@@ -140,7 +154,7 @@ func (p *handler) sendMessages(id peer.ID, w io.Writer) {
 		for msg := range msgChan {
 			err := p2p.WriteMessage(w, msg, p.host.GetNetMagic())
 			if err != nil {
-				p.log.Errorf("error sending message to peer %s: %s", id, err)
+				p.log.With("peer", id, "err", err).Error("error sending message")
 				_ = p.host.DisconnectPeer(id)
 			}
 		}
@@ -150,7 +164,7 @@ func (p *handler) sendMessages(id peer.ID, w io.Writer) {
 func (p *handler) handleStream(s network.Stream) {
 	if s != nil {
 		p.sendMessages(s.Conn().RemotePeer(), s)
-		p.log.Tracef("handling messages from peer %s for protocol %s", s.Conn().RemotePeer(), p.ID)
+		p.log.With("peer", s.Conn().RemotePeer(), "protocol", p.ID).Trace("handling messages")
 		go p.receiveMessages(s.Conn().RemotePeer(), s)
 	}
 }
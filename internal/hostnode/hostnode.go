@@ -23,6 +23,7 @@ import (
 	"io/ioutil"
 	"os"
 	"path"
+	"sync"
 	"time"
 )
 
@@ -38,9 +39,13 @@ type HostNode interface {
 	GetPeersStats() []*peerInfo
 	RegisterHandler(message string, handler MessageHandler) error
 	RegisterTopicHandler(message string, handler MessageHandler) error
+	RegisterTopicValidator(message string, validator MessageValidator) error
 	HandleStream(s network.Stream)
 	SendMessage(id peer.ID, msg p2p.Message) error
 	Broadcast(msg p2p.Message) error
+	BroadcastVote(msg p2p.Message) error
+	SubscribeVotes() error
+	UnsubscribeVotes()
 }
 
 var _ HostNode = &hostNode{}
@@ -58,16 +63,23 @@ type hostNode struct {
 	discover     *discover
 	synchronizer *synchronizer
 	handler      *handler
+	gossip       *gossipPool
 
 	topic     *pubsub.Topic
 	topicSub  *pubsub.Subscription
 	listening bool
+
+	// votesTopic carries the individual votes. Only the nodes with a validator selected as aggregator subscribe to it
+	// while they aggregate the votes of a slot, the rest of the network only receives the aggregates.
+	votesTopic   *pubsub.Topic
+	votesSub     *pubsub.Subscription
+	votesSubLock sync.Mutex
 }
 
 // NewHostNode creates a host node
 func NewHostNode(blockchain chain.Blockchain) (HostNode, error) {
 	ctx := config.GlobalParams.Context
-	log := config.GlobalParams.Logger
+	log := config.GlobalParams.Logger.Module("hostnode")
 	netParams := config.GlobalParams.NetParams
 
 	node := &hostNode{
@@ -122,7 +134,7 @@ func NewHostNode(blockchain chain.Blockchain) (HostNode, error) {
 	}
 
 	for _, a := range addrs {
-		log.Infof("binding to address: %s", a)
+		log.With("addr", a).Info("binding to address")
 	}
 
 	g, err := pubsub.NewGossipSub(node.ctx, node.host)
@@ -145,12 +157,19 @@ func NewHostNode(blockchain chain.Blockchain) (HostNode, error) {
 		return nil, err
 	}
 
+	node.votesTopic, err = g.Join("votes_channel")
+	if err != nil {
+		return nil, err
+	}
+
 	handler, err := newHandler(params.ProtocolID, node)
 	if err != nil {
 		return nil, err
 	}
 	node.handler = handler
 
+	node.gossip = newGossipPool(ctx, log, handler, config.GlobalFlags.GossipWorkers, config.GlobalFlags.GossipPeerQueue)
+
 	synchronizer, err := NewSyncronizer(node, blockchain)
 	if err != nil {
 		return nil, err
@@ -222,6 +241,10 @@ func (node *hostNode) RegisterTopicHandler(message string, handler MessageHandle
 	return node.handler.RegisterTopicHandler(message, handler)
 }
 
+func (node *hostNode) RegisterTopicValidator(message string, validator MessageValidator) error {
+	return node.handler.RegisterTopicValidator(message, validator)
+}
+
 func (node *hostNode) HandleStream(s network.Stream) {
 	node.handler.handleStream(s)
 }
@@ -239,6 +262,49 @@ func (node *hostNode) Broadcast(msg p2p.Message) error {
 	return node.topic.Publish(node.ctx, buf.Bytes())
 }
 
+// BroadcastVote publishes a message on the individual votes topic.
+func (node *hostNode) BroadcastVote(msg p2p.Message) error {
+	buf := bytes.NewBuffer([]byte{})
+	err := p2p.WriteMessage(buf, msg, node.netMagic)
+	if err != nil {
+		return err
+	}
+	return node.votesTopic.Publish(node.ctx, buf.Bytes())
+}
+
+// SubscribeVotes starts receiving the individual votes. Subscribing more than once has no effect.
+func (node *hostNode) SubscribeVotes() error {
+	node.votesSubLock.Lock()
+	defer node.votesSubLock.Unlock()
+
+	if node.votesSub != nil {
+		return nil
+	}
+
+	sub, err := node.votesTopic.Subscribe()
+	if err != nil {
+		return err
+	}
+	node.votesSub = sub
+
+	go node.listenSubscription(sub)
+
+	return nil
+}
+
+// UnsubscribeVotes stops receiving the individual votes.
+func (node *hostNode) UnsubscribeVotes() {
+	node.votesSubLock.Lock()
+	defer node.votesSubLock.Unlock()
+
+	if node.votesSub == nil {
+		return
+	}
+
+	node.votesSub.Cancel()
+	node.votesSub = nil
+}
+
 func (node *hostNode) GetPeersStats() []*peerInfo {
 	node.synchronizer.peersTrackLock.Lock()
 	var peers []*peerInfo
@@ -300,14 +366,21 @@ func (node *hostNode) listenTopics() {
 		node.listening = false
 	}()
 
+	node.listenSubscription(node.topicSub)
+}
+
+func (node *hostNode) listenSubscription(sub *pubsub.Subscription) {
 	for {
-		msg, err := node.topicSub.Next(node.ctx)
+		msg, err := sub.Next(node.ctx)
 		if err != nil {
+			if err == pubsub.ErrSubscriptionCancelled {
+				return
+			}
 			if err != node.ctx.Err() {
-				node.log.Warnf("error getting next message in votes topic: %s", err)
+				node.log.With("topic", sub.Topic(), "err", err).Warn("error getting next message")
 				continue
 			}
-			continue
+			return
 		}
 
 		if msg.GetFrom() == node.host.ID() {
@@ -318,20 +391,12 @@ func (node *hostNode) listenTopics() {
 
 		msgData, err := p2p.ReadMessage(buf, node.netMagic)
 		if err != nil {
-			node.log.Warnf("unable to decode message: %s", err)
+			node.log.With("topic", sub.Topic(), "peer", msg.ReceivedFrom, "err", err).Warn("unable to decode message")
 			continue
 		}
 
-		cmd := msgData.Command()
-		node.handler.topicHandlersLock.Lock()
-		handler, found := node.handler.topicHandlers[cmd]
-		if !found {
-			continue
-		}
-		err = handler(msg.GetFrom(), msgData)
-		if err != nil {
-			node.log.Error(err)
+		if !node.gossip.submit(msg.GetFrom(), msg.ReceivedFrom, msgData) {
+			node.log.With("cmd", msgData.Command(), "peer", msg.ReceivedFrom).Debug("dropping message, too many pending messages")
 		}
-		node.handler.topicHandlersLock.Unlock()
 	}
 }
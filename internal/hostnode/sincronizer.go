@@ -65,7 +65,7 @@ func NewSyncronizer(host HostNode, chain chain.Blockchain) (*synchronizer, error
 
 	sp := &synchronizer{
 		host:       host,
-		log:        config.GlobalParams.Logger,
+		log:        config.GlobalParams.Logger.Module("hostnode"),
 		ctx:        config.GlobalParams.Context,
 		chain:      chain,
 		sync:       true,
@@ -105,7 +105,7 @@ func NewSyncronizer(host HostNode, chain chain.Blockchain) (*synchronizer, error
 			// open a stream for the sync protocol:
 			s, err := sp.host.GetHost().NewStream(sp.ctx, conn.RemotePeer(), params.ProtocolID)
 			if err != nil {
-				sp.log.Errorf("could not open stream for connection: %s", err)
+				sp.log.With("peer", conn.RemotePeer(), "err", err).Error("could not open stream for connection")
 			}
 
 			sp.host.HandleStream(s)
@@ -182,7 +182,7 @@ func (sp *synchronizer) askForBlocks(id peer.ID) {
 	})
 
 	if err != nil {
-		sp.log.Error("unable to send block request msg")
+		sp.log.With("peer", id, "err", err).Error("unable to send block request msg")
 	}
 }
 
@@ -198,18 +198,24 @@ func (sp *synchronizer) handleBlockMsg(id peer.ID, msg p2p.Message) error {
 	err := sp.processBlock(block.Data)
 	if err != nil {
 		if err == ErrorBlockAlreadyKnown {
-			sp.log.Error(err)
+			sp.log.With("block", block.Data.Hash(), "peer", id).Error(err)
 			return nil
 		}
 		if err == ErrorBlockParentUnknown {
 			if !sp.sync {
-				sp.log.Error(err)
+				sp.log.With("block", block.Data.Hash(), "peer", id).Error(err)
+				sp.peersTrackLock.Lock()
 				p, ok := sp.peersTrack[id]
+				var peerFinalized uint64
+				if ok {
+					peerFinalized = p.FinalizedHeight
+				}
+				sp.peersTrackLock.Unlock()
 				if !ok {
 					return nil
 				}
 				fin, _ := sp.chain.State().GetFinalizedHead()
-				if p.FinalizedHeight >= fin.Height {
+				if peerFinalized >= fin.Height {
 					sp.askForBlocks(id)
 				}
 				return nil
@@ -260,7 +266,7 @@ func (sp *synchronizer) handleSyncEndMsg(id peer.ID, msg p2p.Message) error {
 	if !ok {
 		return errors.New("non syncend msg")
 	}
-	sp.log.Info("syncing finished")
+	sp.log.With("peer", id).Info("syncing finished")
 	if !sp.sync {
 		return nil
 	}
@@ -277,13 +283,18 @@ func (sp *synchronizer) handleGetBlocksMsg(id peer.ID, rawMsg p2p.Message) error
 		return errors.New("did not receive get blocks message")
 	}
 
-	sp.log.Debug("received getblocks")
+	sp.log.With("peer", id, "from", msg.LastBlockHash).Debug("received getblocks")
 
 	// Get the announced last block to make sure we have a common point
 	firstCommon, ok := sp.chain.State().Index().Get(msg.LastBlockHash)
 	if !ok {
-		sp.log.Errorf("unable to find common point for peer %s", id)
-		return nil
+		sp.log.With("peer", id, "from", msg.LastBlockHash).Error("unable to find common point")
+		return sp.host.SendMessage(id, &p2p.MsgSyncEnd{})
+	}
+
+	if pruned := sp.chain.State().PrunedHead(); firstCommon.Slot < pruned.Slot {
+		sp.log.With("peer", id, "slot", firstCommon.Slot, "pruned_slot", pruned.Slot).Warn("peer requested pruned blocks")
+		return sp.host.SendMessage(id, &p2p.MsgSyncEnd{})
 	}
 
 	for {
@@ -321,7 +332,7 @@ func (sp *synchronizer) handleVersionMsg(id peer.ID, msg p2p.Message) error {
 		return fmt.Errorf("did not receive version message")
 	}
 
-	sp.log.Infof("received version message from %s", id)
+	sp.log.With("peer", id).Info("received version message")
 
 	// Send our version message if required
 	ourVersion := sp.versionMsg()
@@ -365,7 +376,7 @@ func (sp *synchronizer) processBlock(block *primitives.Block) error {
 	}
 
 	// Process block
-	sp.log.Debugf("processing block %s", block.Hash())
+	sp.log.With("block", block.Hash(), "slot", block.Header.Slot).Debug("processing block")
 	if err := sp.chain.ProcessBlock(block); err != nil {
 		return err
 	}
@@ -394,7 +405,7 @@ func (sp *synchronizer) processBlock(block *primitives.Block) error {
 
 		err := sp.host.Broadcast(msg)
 		if err != nil {
-			sp.log.Error(err)
+			sp.log.With("err", err).Error("unable to broadcast finalization")
 		}
 
 	}
@@ -432,7 +443,7 @@ func (sp *synchronizer) sendVersion(id peer.ID) {
 	msg := sp.versionMsg()
 	err := sp.host.SendMessage(id, msg)
 	if err != nil {
-		sp.log.Errorf("error sending version message: %s", err)
+		sp.log.With("peer", id, "err", err).Error("error sending version message")
 		_ = sp.host.DisconnectPeer(id)
 	}
 	return
@@ -78,12 +78,12 @@ func (am *actionMempool) NotifyIllegalVotes(slashing *primitives.VoteSlashing) {
 
 	tipState, err := am.chain.State().TipStateAtSlot(maxSlot)
 	if err != nil {
-		am.log.Error(err)
+		am.log.With("slot", maxSlot, "err", err).Error("unable to get tip state")
 		return
 	}
 
 	if _, err := tipState.IsVoteSlashingValid(slashing); err != nil {
-		am.log.Error(err)
+		am.log.With("err", err).Error("invalid vote slashing")
 		return
 	}
 
@@ -115,12 +115,12 @@ func (am *actionMempool) ProposerSlashingConditionViolated(slashing *primitives.
 
 	tipState, err := am.chain.State().TipStateAtSlot(maxSlot)
 	if err != nil {
-		am.log.Error(err)
+		am.log.With("slot", maxSlot, "err", err).Error("unable to get tip state")
 		return
 	}
 
 	if _, err := tipState.IsProposerSlashingValid(slashing); err != nil {
-		am.log.Error(err)
+		am.log.With("err", err).Error("invalid proposer slashing")
 		return
 	}
 
@@ -142,7 +142,7 @@ func (am *actionMempool) ProposerSlashingConditionViolated(slashing *primitives.
 func NewActionMempool(blockchain chain.Blockchain, hostnode hostnode.HostNode) (ActionMempool, error) {
 	netParams := config.GlobalParams.NetParams
 	ctx := config.GlobalParams.Context
-	log := config.GlobalParams.Logger
+	log := config.GlobalParams.Logger.Module("mempool")
 
 	am := &actionMempool{
 		netParams: netParams,
@@ -178,9 +178,75 @@ func NewActionMempool(blockchain chain.Blockchain, hostnode hostnode.HostNode) (
 		return nil, err
 	}
 
+	if err := am.host.RegisterTopicValidator(p2p.MsgDepositCmd, am.validateDeposit); err != nil {
+		return nil, err
+	}
+
+	if err := am.host.RegisterTopicValidator(p2p.MsgDepositsCmd, am.validateDeposits); err != nil {
+		return nil, err
+	}
+
+	if err := am.host.RegisterTopicValidator(p2p.MsgExitCmd, am.validateExit); err != nil {
+		return nil, err
+	}
+
+	if err := am.host.RegisterTopicValidator(p2p.MsgExitsCmd, am.validateExits); err != nil {
+		return nil, err
+	}
+
 	return am, nil
 }
 
+// validateDeposit checks the signatures of a gossip deposit before it is handled.
+func (am *actionMempool) validateDeposit(msg p2p.Message) error {
+	data, ok := msg.(*p2p.MsgDeposit)
+	if !ok {
+		return errors.New("wrong message on deposit topic")
+	}
+
+	return data.Data.VerifySig()
+}
+
+// validateDeposits checks the signatures of every deposit of a gossip deposits message before it is handled.
+func (am *actionMempool) validateDeposits(msg p2p.Message) error {
+	data, ok := msg.(*p2p.MsgDeposits)
+	if !ok {
+		return errors.New("wrong message on deposits topic")
+	}
+
+	for _, d := range data.Data {
+		if err := d.VerifySig(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateExit checks the signature of a gossip exit before it is handled.
+func (am *actionMempool) validateExit(msg p2p.Message) error {
+	data, ok := msg.(*p2p.MsgExit)
+	if !ok {
+		return errors.New("wrong message on exit topic")
+	}
+
+	return data.Data.VerifySig()
+}
+
+// validateExits checks the signatures of every exit of a gossip exits message before it is handled.
+func (am *actionMempool) validateExits(msg p2p.Message) error {
+	data, ok := msg.(*p2p.MsgExits)
+	if !ok {
+		return errors.New("wrong message on exits topic")
+	}
+
+	for _, e := range data.Data {
+		if err := e.VerifySig(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (am *actionMempool) handleDeposit(id peer.ID, msg p2p.Message) error {
 	if id == am.host.GetHost().ID() {
 		return nil
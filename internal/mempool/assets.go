@@ -0,0 +1,220 @@
+package mempool
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/olympus-protocol/ogen/cmd/ogen/config"
+	"github.com/olympus-protocol/ogen/internal/chain"
+	"github.com/olympus-protocol/ogen/internal/hostnode"
+	"github.com/olympus-protocol/ogen/internal/state"
+	"github.com/olympus-protocol/ogen/pkg/chainhash"
+	"github.com/olympus-protocol/ogen/pkg/logger"
+	"github.com/olympus-protocol/ogen/pkg/p2p"
+	"github.com/olympus-protocol/ogen/pkg/params"
+	"github.com/olympus-protocol/ogen/pkg/primitives"
+)
+
+// AssetsMempool is an interface for assetsMempool
+type AssetsMempool interface {
+	Add(tx *primitives.AssetTx, s state.State) error
+	RemoveByBlock(b *primitives.Block)
+	Get(s state.State, feeAddress [20]byte) []*primitives.AssetTx
+	GetMempoolNonce(pkh [20]byte) (uint64, error)
+}
+
+var _ AssetsMempool = &assetsMempool{}
+
+// assetsMempool represents a mempool for asset transactions.
+type assetsMempool struct {
+	chain     chain.Blockchain
+	host      hostnode.HostNode
+	netParams *params.ChainParams
+	log       logger.Logger
+
+	txs  map[chainhash.Hash]*primitives.AssetTx
+	lock sync.Mutex
+}
+
+// Add adds an asset transaction to the mempool.
+func (am *assetsMempool) Add(tx *primitives.AssetTx, s state.State) error {
+	if err := tx.VerifySig(); err != nil {
+		return err
+	}
+	return am.add(tx, s)
+}
+
+// add adds an asset transaction with an already verified signature to the mempool.
+func (am *assetsMempool) add(tx *primitives.AssetTx, s state.State) error {
+	pkh, err := tx.FromPubkeyHash()
+	if err != nil {
+		return err
+	}
+
+	if !tx.Valid() {
+		return fmt.Errorf("unknown asset transaction type %d", tx.Type)
+	}
+
+	cs := s.GetCoinsState()
+
+	if tx.Nonce <= cs.Nonces[pkh] {
+		return errors.New("invalid nonce")
+	}
+
+	if tx.Fee < 5000 {
+		return errors.New("transaction doesn't include enough fee")
+	}
+
+	if cs.Balances[pkh] < tx.Fee {
+		return fmt.Errorf("did not add transaction spending %d with balance of %d", tx.Fee, cs.Balances[pkh])
+	}
+
+	am.lock.Lock()
+	defer am.lock.Unlock()
+
+	asset, exists := cs.Assets[tx.Asset]
+	switch {
+	case tx.Type == primitives.AssetIssue && exists && asset.Issuer != pkh:
+		return errors.New("only the asset issuer can issue units")
+	case tx.Type == primitives.AssetIssue && !exists && tx.Asset != primitives.AssetID(pkh, tx.Nonce) && !am.isPendingAsset(tx.Asset, pkh):
+		return errors.New("unknown asset")
+	case tx.Type != primitives.AssetIssue && !exists && !am.isPendingAsset(tx.Asset, [20]byte{}):
+		return errors.New("unknown asset")
+	}
+
+	am.txs[tx.Hash()] = tx
+
+	return nil
+}
+
+// isPendingAsset returns true if an issue on the mempool creates the asset. If issuer is not empty, the pending issue
+// must be from that account.
+func (am *assetsMempool) isPendingAsset(asset [20]byte, issuer [20]byte) bool {
+	for _, tx := range am.txs {
+		if tx.Type != primitives.AssetIssue {
+			continue
+		}
+		pkh, err := tx.FromPubkeyHash()
+		if err != nil {
+			continue
+		}
+		if primitives.AssetID(pkh, tx.Nonce) == asset && (issuer == [20]byte{} || issuer == pkh) {
+			return true
+		}
+	}
+	return false
+}
+
+// RemoveByBlock removes the asset transactions that were in an accepted block.
+func (am *assetsMempool) RemoveByBlock(b *primitives.Block) {
+	am.lock.Lock()
+	defer am.lock.Unlock()
+	for _, tx := range b.AssetTxs {
+		delete(am.txs, tx.Hash())
+	}
+}
+
+// Get gets asset transactions to be included in a block, sorted by nonce. Transactions that are no longer valid are
+// removed. Mutates state.
+func (am *assetsMempool) Get(s state.State, feeAddress [20]byte) []*primitives.AssetTx {
+	am.lock.Lock()
+	defer am.lock.Unlock()
+
+	txs := make([]*primitives.AssetTx, 0, len(am.txs))
+	for _, tx := range am.txs {
+		txs = append(txs, tx)
+	}
+	sort.Slice(txs, func(i, j int) bool {
+		return txs[i].Nonce < txs[j].Nonce
+	})
+
+	included := make([]*primitives.AssetTx, 0, am.netParams.MaxAssetTxsPerBlock)
+	for _, tx := range txs {
+		if uint64(len(included)) >= am.netParams.MaxAssetTxsPerBlock {
+			break
+		}
+		if err := s.ApplyAssetTx(tx, feeAddress); err != nil {
+			if pkh, err := tx.FromPubkeyHash(); err != nil || tx.Nonce <= s.GetCoinsState().Nonces[pkh] {
+				delete(am.txs, tx.Hash())
+			}
+			continue
+		}
+		included = append(included, tx)
+	}
+
+	return included
+}
+
+// GetMempoolNonce returns the latest nonce used by an account on the asset transactions in mempool.
+func (am *assetsMempool) GetMempoolNonce(pkh [20]byte) (uint64, error) {
+	am.lock.Lock()
+	defer am.lock.Unlock()
+
+	found := false
+	nonce := uint64(0)
+	for _, tx := range am.txs {
+		if from, err := tx.FromPubkeyHash(); err == nil && from == pkh && tx.Nonce >= nonce {
+			nonce = tx.Nonce
+			found = true
+		}
+	}
+	if !found {
+		return 0, ErrorAccountNotOnMempool
+	}
+	return nonce, nil
+}
+
+// validateAssetTx checks the type, the fee and the signature of a gossip asset transaction before it is handled.
+func (am *assetsMempool) validateAssetTx(msg p2p.Message) error {
+	data, ok := msg.(*p2p.MsgAssetTx)
+	if !ok {
+		return errors.New("wrong message on asset tx topic")
+	}
+
+	if !data.Data.Valid() {
+		return fmt.Errorf("unknown asset transaction type %d", data.Data.Type)
+	}
+
+	if data.Data.Fee < 5000 {
+		return errors.New("transaction doesn't include enough fee")
+	}
+
+	return data.Data.VerifySig()
+}
+
+func (am *assetsMempool) handleAssetTx(id peer.ID, msg p2p.Message) error {
+	if id == am.host.GetHost().ID() {
+		return nil
+	}
+
+	data, ok := msg.(*p2p.MsgAssetTx)
+	if !ok {
+		return errors.New("wrong message on asset tx topic")
+	}
+
+	return am.add(data.Data, am.chain.State().TipState())
+}
+
+// NewAssetsMempool constructs a new assets mempool.
+func NewAssetsMempool(ch chain.Blockchain, hostNode hostnode.HostNode) (AssetsMempool, error) {
+	am := &assetsMempool{
+		chain:     ch,
+		host:      hostNode,
+		netParams: config.GlobalParams.NetParams,
+		log:       config.GlobalParams.Logger.Module("mempool"),
+		txs:       make(map[chainhash.Hash]*primitives.AssetTx),
+	}
+
+	if err := am.host.RegisterTopicHandler(p2p.MsgAssetTxCmd, am.handleAssetTx); err != nil {
+		return nil, err
+	}
+
+	if err := am.host.RegisterTopicValidator(p2p.MsgAssetTxCmd, am.validateAssetTx); err != nil {
+		return nil, err
+	}
+
+	return am, nil
+}
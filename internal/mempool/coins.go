@@ -308,6 +308,34 @@ outer:
 	return allTransactions
 }
 
+// validateTx checks the fee and the signature of a gossip transaction before it is handled.
+func (cm *coinsMempool) validateTx(msg p2p.Message) error {
+	data, ok := msg.(*p2p.MsgTx)
+	if !ok {
+		return errors.New("wrong message on tx topic")
+	}
+
+	if data.Data.Fee < 5000 {
+		return errors.New("transaction doesn't include enough fee")
+	}
+
+	return data.Data.VerifySig()
+}
+
+// validateTxMulti checks the fee and the signature of a gossip multisig transaction before it is handled.
+func (cm *coinsMempool) validateTxMulti(msg p2p.Message) error {
+	data, ok := msg.(*p2p.MsgTxMulti)
+	if !ok {
+		return errors.New("wrong message on txmulti topic")
+	}
+
+	if data.Data.Fee < 5000 {
+		return errors.New("transaction doesn't include enough fee")
+	}
+
+	return data.Data.VerifySig()
+}
+
 func (cm *coinsMempool) handleTx(id peer.ID, msg p2p.Message) error {
 	if id == cm.host.GetHost().ID() {
 		return nil
@@ -397,7 +425,7 @@ func (cm *coinsMempool) Unnotify(n CoinsNotifee) {
 // NewCoinsMempool constructs a new coins mempool.
 func NewCoinsMempool(ch chain.Blockchain, hostNode hostnode.HostNode) (CoinsMempool, error) {
 	ctx := config.GlobalParams.Context
-	log := config.GlobalParams.Logger
+	log := config.GlobalParams.Logger.Module("mempool")
 	netParams := config.GlobalParams.NetParams
 
 	cm := &coinsMempool{
@@ -423,5 +451,13 @@ func NewCoinsMempool(ch chain.Blockchain, hostNode hostnode.HostNode) (CoinsMemp
 		return nil, err
 	}
 
+	if err := cm.host.RegisterTopicValidator(p2p.MsgTxCmd, cm.validateTx); err != nil {
+		return nil, err
+	}
+
+	if err := cm.host.RegisterTopicValidator(p2p.MsgTxMultiCmd, cm.validateTxMulti); err != nil {
+		return nil, err
+	}
+
 	return cm, nil
 }
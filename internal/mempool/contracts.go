@@ -0,0 +1,371 @@
+package mempool
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/olympus-protocol/ogen/cmd/ogen/config"
+	"github.com/olympus-protocol/ogen/internal/chain"
+	"github.com/olympus-protocol/ogen/internal/execution"
+	"github.com/olympus-protocol/ogen/internal/hostnode"
+	"github.com/olympus-protocol/ogen/internal/state"
+	"github.com/olympus-protocol/ogen/pkg/chainhash"
+	"github.com/olympus-protocol/ogen/pkg/logger"
+	"github.com/olympus-protocol/ogen/pkg/p2p"
+	"github.com/olympus-protocol/ogen/pkg/params"
+	"github.com/olympus-protocol/ogen/pkg/primitives"
+)
+
+// ContractsMempool is an interface for contractsMempool
+type ContractsMempool interface {
+	AddDeploy(tx *primitives.ContractDeploy, s state.State) error
+	AddCall(tx *primitives.ContractCall, s state.State) error
+	RemoveByBlock(b *primitives.Block)
+	Get(s state.State, feeAddress [20]byte) ([]*primitives.ContractDeploy, []*primitives.ContractCall)
+	GetMempoolNonce(pkh [20]byte) (uint64, error)
+}
+
+var _ ContractsMempool = &contractsMempool{}
+
+// contractsMempool represents a mempool for contract deploys and calls.
+type contractsMempool struct {
+	chain     chain.Blockchain
+	host      hostnode.HostNode
+	netParams *params.ChainParams
+	log       logger.Logger
+
+	deploys map[chainhash.Hash]*primitives.ContractDeploy
+	calls   map[chainhash.Hash]*primitives.ContractCall
+	lock    sync.Mutex
+}
+
+// isContractTxValid checks a contract transaction against the state without executing it.
+func (cm *contractsMempool) isContractTxValid(s state.State, pkh [20]byte, nonce, gasLimit, gasPrice, intrinsic, maxCost uint64) error {
+	cs := s.GetCoinsState()
+
+	if nonce <= cs.Nonces[pkh] {
+		return errors.New("invalid nonce")
+	}
+
+	if gasPrice < cm.netParams.MinContractGasPrice {
+		return errors.New("transaction gas price is too low")
+	}
+
+	if gasLimit < intrinsic {
+		return execution.ErrIntrinsicGas
+	}
+
+	if gasLimit > cm.netParams.MaxContractGasPerBlock {
+		return errors.New("transaction gas limit is above the block gas limit")
+	}
+
+	if cs.Balances[pkh] < maxCost {
+		return fmt.Errorf("did not add transaction spending %d with balance of %d", maxCost, cs.Balances[pkh])
+	}
+
+	return nil
+}
+
+// AddDeploy adds a contract deploy to the mempool.
+func (cm *contractsMempool) AddDeploy(tx *primitives.ContractDeploy, s state.State) error {
+	if err := tx.VerifySig(); err != nil {
+		return err
+	}
+	return cm.addDeploy(tx, s)
+}
+
+// addDeploy adds a contract deploy with an already verified signature to the mempool.
+func (cm *contractsMempool) addDeploy(tx *primitives.ContractDeploy, s state.State) error {
+	pkh, err := tx.FromPubkeyHash()
+	if err != nil {
+		return err
+	}
+
+	maxCost, err := tx.MaxCost()
+	if err != nil {
+		return err
+	}
+
+	if err := cm.isContractTxValid(s, pkh, tx.Nonce, tx.GasLimit, tx.GasPrice, execution.DeployGas(tx), maxCost); err != nil {
+		return err
+	}
+
+	cm.lock.Lock()
+	defer cm.lock.Unlock()
+	cm.deploys[tx.Hash()] = tx
+
+	return nil
+}
+
+// AddCall adds a contract call to the mempool.
+func (cm *contractsMempool) AddCall(tx *primitives.ContractCall, s state.State) error {
+	if err := tx.VerifySig(); err != nil {
+		return err
+	}
+	return cm.addCall(tx, s)
+}
+
+// addCall adds a contract call with an already verified signature to the mempool.
+func (cm *contractsMempool) addCall(tx *primitives.ContractCall, s state.State) error {
+	pkh, err := tx.FromPubkeyHash()
+	if err != nil {
+		return err
+	}
+
+	maxCost, err := tx.MaxCost()
+	if err != nil {
+		return err
+	}
+
+	if err := cm.isContractTxValid(s, pkh, tx.Nonce, tx.GasLimit, tx.GasPrice, execution.CallGas(tx), maxCost); err != nil {
+		return err
+	}
+
+	cm.lock.Lock()
+	defer cm.lock.Unlock()
+
+	if _, ok := s.GetContractsState().Contracts[tx.To]; !ok && !cm.isPendingContract(tx.To) {
+		return execution.ErrUnknownContract
+	}
+
+	cm.calls[tx.Hash()] = tx
+
+	return nil
+}
+
+// isPendingContract returns true if a deploy on the mempool creates the contract.
+func (cm *contractsMempool) isPendingContract(contract [20]byte) bool {
+	for _, tx := range cm.deploys {
+		if addr, err := tx.ContractAddress(); err == nil && addr == contract {
+			return true
+		}
+	}
+	return false
+}
+
+// RemoveByBlock removes the contract transactions that were in an accepted block.
+func (cm *contractsMempool) RemoveByBlock(b *primitives.Block) {
+	cm.lock.Lock()
+	defer cm.lock.Unlock()
+	for _, tx := range b.ContractDeploys {
+		delete(cm.deploys, tx.Hash())
+	}
+	for _, tx := range b.ContractCalls {
+		delete(cm.calls, tx.Hash())
+	}
+}
+
+// contractTx is a contract deploy or call on the mempool.
+type contractTx struct {
+	from   [20]byte
+	nonce  uint64
+	deploy *primitives.ContractDeploy
+	call   *primitives.ContractCall
+}
+
+// Get gets contract transactions to be included in a block within the block gas limit. Deploys and calls are selected
+// in a single list sorted by nonce, so the transactions of an account are included in nonce order regardless of their
+// kind. Blocks apply the deploys before the calls, so a deploy of an account is left for a later block once a call of
+// the same account with a lower nonce was selected. Transactions that are no longer valid are removed. Mutates state.
+func (cm *contractsMempool) Get(s state.State, feeAddress [20]byte) ([]*primitives.ContractDeploy, []*primitives.ContractCall) {
+	cm.lock.Lock()
+	defer cm.lock.Unlock()
+
+	txs := make([]contractTx, 0, len(cm.deploys)+len(cm.calls))
+	for h, tx := range cm.deploys {
+		pkh, err := tx.FromPubkeyHash()
+		if err != nil {
+			delete(cm.deploys, h)
+			continue
+		}
+		txs = append(txs, contractTx{from: pkh, nonce: tx.Nonce, deploy: tx})
+	}
+	for h, tx := range cm.calls {
+		pkh, err := tx.FromPubkeyHash()
+		if err != nil {
+			delete(cm.calls, h)
+			continue
+		}
+		txs = append(txs, contractTx{from: pkh, nonce: tx.Nonce, call: tx})
+	}
+	sort.Slice(txs, func(i, j int) bool {
+		return txs[i].nonce < txs[j].nonce
+	})
+
+	// The selection runs on a copy in nonce order. The selected transactions are then applied to the state in the
+	// order of the block.
+	selection := s.Copy()
+	gas := uint64(0)
+	hasCalls := make(map[[20]byte]bool)
+	deploys := make([]*primitives.ContractDeploy, 0, cm.netParams.MaxContractDeploysPerBlock)
+	calls := make([]*primitives.ContractCall, 0, cm.netParams.MaxContractCallsPerBlock)
+	for _, tx := range txs {
+		var gasLimit uint64
+		if tx.deploy != nil {
+			gasLimit = tx.deploy.GasLimit
+			if hasCalls[tx.from] || uint64(len(deploys)) >= cm.netParams.MaxContractDeploysPerBlock {
+				continue
+			}
+		} else {
+			gasLimit = tx.call.GasLimit
+			if uint64(len(calls)) >= cm.netParams.MaxContractCallsPerBlock {
+				continue
+			}
+		}
+		if gas+gasLimit > cm.netParams.MaxContractGasPerBlock {
+			continue
+		}
+
+		var err error
+		if tx.deploy != nil {
+			_, err = selection.ApplyContractDeploy(tx.deploy, feeAddress)
+		} else {
+			_, err = selection.ApplyContractCall(tx.call, feeAddress)
+		}
+		if err != nil {
+			if tx.nonce <= selection.GetCoinsState().Nonces[tx.from] {
+				cm.remove(tx)
+			}
+			continue
+		}
+
+		gas += gasLimit
+		if tx.deploy != nil {
+			deploys = append(deploys, tx.deploy)
+		} else {
+			calls = append(calls, tx.call)
+			hasCalls[tx.from] = true
+		}
+	}
+
+	includedDeploys := make([]*primitives.ContractDeploy, 0, len(deploys))
+	for _, tx := range deploys {
+		if _, err := s.ApplyContractDeploy(tx, feeAddress); err != nil {
+			continue
+		}
+		includedDeploys = append(includedDeploys, tx)
+	}
+
+	includedCalls := make([]*primitives.ContractCall, 0, len(calls))
+	for _, tx := range calls {
+		if _, err := s.ApplyContractCall(tx, feeAddress); err != nil {
+			continue
+		}
+		includedCalls = append(includedCalls, tx)
+	}
+
+	return includedDeploys, includedCalls
+}
+
+// remove removes a contract transaction from the mempool.
+func (cm *contractsMempool) remove(tx contractTx) {
+	if tx.deploy != nil {
+		delete(cm.deploys, tx.deploy.Hash())
+	} else {
+		delete(cm.calls, tx.call.Hash())
+	}
+}
+
+// GetMempoolNonce returns the latest nonce used by an account on the contract transactions in mempool.
+func (cm *contractsMempool) GetMempoolNonce(pkh [20]byte) (uint64, error) {
+	cm.lock.Lock()
+	defer cm.lock.Unlock()
+
+	found := false
+	nonce := uint64(0)
+	for _, tx := range cm.deploys {
+		if from, err := tx.FromPubkeyHash(); err == nil && from == pkh && tx.Nonce >= nonce {
+			nonce = tx.Nonce
+			found = true
+		}
+	}
+	for _, tx := range cm.calls {
+		if from, err := tx.FromPubkeyHash(); err == nil && from == pkh && tx.Nonce >= nonce {
+			nonce = tx.Nonce
+			found = true
+		}
+	}
+	if !found {
+		return 0, ErrorAccountNotOnMempool
+	}
+	return nonce, nil
+}
+
+// validateContractDeploy checks the signature of a gossip contract deploy before it is handled.
+func (cm *contractsMempool) validateContractDeploy(msg p2p.Message) error {
+	data, ok := msg.(*p2p.MsgContractDeploy)
+	if !ok {
+		return errors.New("wrong message on contract deploy topic")
+	}
+
+	return data.Data.VerifySig()
+}
+
+// validateContractCall checks the signature of a gossip contract call before it is handled.
+func (cm *contractsMempool) validateContractCall(msg p2p.Message) error {
+	data, ok := msg.(*p2p.MsgContractCall)
+	if !ok {
+		return errors.New("wrong message on contract call topic")
+	}
+
+	return data.Data.VerifySig()
+}
+
+func (cm *contractsMempool) handleContractDeploy(id peer.ID, msg p2p.Message) error {
+	if id == cm.host.GetHost().ID() {
+		return nil
+	}
+
+	data, ok := msg.(*p2p.MsgContractDeploy)
+	if !ok {
+		return errors.New("wrong message on contract deploy topic")
+	}
+
+	return cm.addDeploy(data.Data, cm.chain.State().TipState())
+}
+
+func (cm *contractsMempool) handleContractCall(id peer.ID, msg p2p.Message) error {
+	if id == cm.host.GetHost().ID() {
+		return nil
+	}
+
+	data, ok := msg.(*p2p.MsgContractCall)
+	if !ok {
+		return errors.New("wrong message on contract call topic")
+	}
+
+	return cm.addCall(data.Data, cm.chain.State().TipState())
+}
+
+// NewContractsMempool constructs a new contracts mempool.
+func NewContractsMempool(ch chain.Blockchain, hostNode hostnode.HostNode) (ContractsMempool, error) {
+	cm := &contractsMempool{
+		chain:     ch,
+		host:      hostNode,
+		netParams: config.GlobalParams.NetParams,
+		log:       config.GlobalParams.Logger.Module("mempool"),
+		deploys:   make(map[chainhash.Hash]*primitives.ContractDeploy),
+		calls:     make(map[chainhash.Hash]*primitives.ContractCall),
+	}
+
+	if err := cm.host.RegisterTopicHandler(p2p.MsgContractDeployCmd, cm.handleContractDeploy); err != nil {
+		return nil, err
+	}
+
+	if err := cm.host.RegisterTopicHandler(p2p.MsgContractCallCmd, cm.handleContractCall); err != nil {
+		return nil, err
+	}
+
+	if err := cm.host.RegisterTopicValidator(p2p.MsgContractDeployCmd, cm.validateContractDeploy); err != nil {
+		return nil, err
+	}
+
+	if err := cm.host.RegisterTopicValidator(p2p.MsgContractCallCmd, cm.validateContractCall); err != nil {
+		return nil, err
+	}
+
+	return cm, nil
+}
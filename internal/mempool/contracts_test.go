@@ -0,0 +1,67 @@
+package mempool
+
+import (
+	"testing"
+
+	"github.com/olympus-protocol/ogen/cmd/ogen/config"
+	"github.com/olympus-protocol/ogen/pkg/bls"
+	"github.com/olympus-protocol/ogen/pkg/chainhash"
+	"github.com/olympus-protocol/ogen/pkg/primitives"
+	"github.com/olympus-protocol/ogen/test/testchain"
+	"github.com/stretchr/testify/assert"
+)
+
+func signContractTx(key *bls.SecretKey, msg chainhash.Hash) [96]byte {
+	var sig [96]byte
+	copy(sig[:], key.Sign(msg[:]).Marshal())
+	return sig
+}
+
+func TestContractsMempool_GetNonceOrder(t *testing.T) {
+	params, flags := config.GlobalParams, config.GlobalFlags
+	t.Cleanup(func() {
+		config.GlobalParams, config.GlobalFlags = params, flags
+	})
+
+	keys, err := testchain.Setup(10)
+	assert.NoError(t, err)
+	st, err := keys.GenesisState()
+	assert.NoError(t, err)
+
+	var pub [48]byte
+	copy(pub[:], keys.Premine.PublicKey().Marshal())
+	from, err := keys.Premine.PublicKey().Hash()
+	assert.NoError(t, err)
+
+	deploy := func(nonce uint64) *primitives.ContractDeploy {
+		tx := &primitives.ContractDeploy{FromPublicKey: pub, Code: []byte{0x00}, Nonce: nonce, GasLimit: 100000, GasPrice: 1}
+		tx.Signature = signContractTx(keys.Premine, tx.SignatureMessage())
+		return tx
+	}
+	first, last := deploy(1), deploy(3)
+	contract, err := first.ContractAddress()
+	assert.NoError(t, err)
+	call := &primitives.ContractCall{To: contract, FromPublicKey: pub, Nonce: 2, GasLimit: 100000, GasPrice: 1}
+	call.Signature = signContractTx(keys.Premine, call.SignatureMessage())
+
+	cm := &contractsMempool{
+		netParams: config.GlobalParams.NetParams,
+		log:       config.GlobalParams.Logger,
+		deploys:   map[chainhash.Hash]*primitives.ContractDeploy{first.Hash(): first, last.Hash(): last},
+		calls:     map[chainhash.Hash]*primitives.ContractCall{call.Hash(): call},
+	}
+
+	// the call is included after the first deploy and the last deploy waits for the next block, since the block
+	// applies the deploys before the calls.
+	blockState := st.Copy()
+	deploys, calls := cm.Get(blockState, from)
+	assert.Equal(t, []*primitives.ContractDeploy{first}, deploys)
+	assert.Equal(t, []*primitives.ContractCall{call}, calls)
+	assert.Equal(t, uint64(2), blockState.GetCoinsState().Nonces[from])
+	assert.Len(t, cm.deploys, 2)
+
+	cm.RemoveByBlock(&primitives.Block{ContractDeploys: deploys, ContractCalls: calls})
+	deploys, calls = cm.Get(blockState, from)
+	assert.Equal(t, []*primitives.ContractDeploy{last}, deploys)
+	assert.Empty(t, calls)
+}
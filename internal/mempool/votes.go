@@ -17,6 +17,7 @@ import (
 	"github.com/olympus-protocol/ogen/pkg/p2p"
 	"github.com/olympus-protocol/ogen/pkg/params"
 	"github.com/olympus-protocol/ogen/pkg/primitives"
+	"sort"
 	"sync"
 	"time"
 )
@@ -25,9 +26,12 @@ import (
 type VoteMempool interface {
 	AddValidate(vote *primitives.MultiValidatorVote, s state.State) error
 	Add(vote *primitives.MultiValidatorVote)
+	AddAggregate(agg *primitives.AggregateVote, s state.State) error
+	GetAggregate(dataHash chainhash.Hash) (*primitives.MultiValidatorVote, bool)
 	Get(slot uint64, s state.State, proposerIndex uint64) ([]*primitives.MultiValidatorVote, error)
 	Remove(b *primitives.Block)
 	Notify(notifee VoteSlashingNotifee)
+	Observe(observer VoteObserver)
 }
 
 // voteMempool is a mempool that keeps track of votes.
@@ -38,6 +42,9 @@ type voteMempool struct {
 	poolIndividuals     map[chainhash.Hash][]*primitives.MultiValidatorVote
 	poolIndividualsLock sync.Mutex
 
+	// aggregates marks the pool votes that were received from an aggregator.
+	aggregates map[chainhash.Hash]struct{}
+
 	netParams *params.ChainParams
 	log       logger.Logger
 	ctx       context.Context
@@ -48,6 +55,9 @@ type voteMempool struct {
 	notifees     []VoteSlashingNotifee
 	notifeesLock sync.Mutex
 
+	observers     []VoteObserver
+	observersLock sync.Mutex
+
 	lastActionManager actionmanager.LastActionManager
 }
 
@@ -58,12 +68,82 @@ func (m *voteMempool) AddValidate(vote *primitives.MultiValidatorVote, s state.S
 	if err := s.IsVoteValid(vote); err != nil {
 		return err
 	}
+	m.observe(vote, s)
 	m.Add(vote)
 	return nil
 }
 
 // Add adds a vote to the mempool.
 func (m *voteMempool) Add(vote *primitives.MultiValidatorVote) {
+	m.add(vote, false)
+}
+
+// AddAggregate validates an aggregate vote and adds it to the mempool. The aggregator must belong to the vote
+// committee and be selected to aggregate the votes of the slot.
+func (m *voteMempool) AddAggregate(agg *primitives.AggregateVote, s state.State) error {
+	vote := agg.Vote
+
+	committee, err := s.GetVoteCommittee(vote.Data.Slot)
+	if err != nil {
+		return err
+	}
+
+	inCommittee := false
+	for _, idx := range committee {
+		if idx == agg.AggregatorIndex {
+			inCommittee = true
+			break
+		}
+	}
+	if !inCommittee {
+		return errors.New("aggregator is not part of the vote committee")
+	}
+
+	if !primitives.IsAggregator(uint64(len(committee)), m.netParams.TargetAggregatorsPerSlot, agg.SelectionProof) {
+		return errors.New("validator is not an aggregator for the vote slot")
+	}
+
+	aggregator := s.GetValidatorRegistry()[agg.AggregatorIndex]
+	pub, err := bls.PublicKeyFromBytes(aggregator.PubKey[:])
+	if err != nil {
+		return err
+	}
+
+	if !agg.VerifySelection(pub) {
+		return errors.New("invalid aggregator signature")
+	}
+
+	if err := s.IsVoteValid(vote); err != nil {
+		return err
+	}
+
+	m.observe(vote, s)
+	m.add(vote, true)
+	return nil
+}
+
+// GetAggregate returns the votes collected on the mempool for a vote data hash.
+func (m *voteMempool) GetAggregate(dataHash chainhash.Hash) (*primitives.MultiValidatorVote, bool) {
+	m.poolLock.Lock()
+	defer m.poolLock.Unlock()
+
+	v, ok := m.pool[dataHash]
+	if !ok {
+		return nil, false
+	}
+
+	bl := bitfield.NewBitlist(v.ParticipationBitfield.Len())
+	copy(bl, v.ParticipationBitfield)
+	vd := v.Data.Copy()
+
+	return &primitives.MultiValidatorVote{
+		Data:                  &vd,
+		Sig:                   v.Sig,
+		ParticipationBitfield: bl,
+	}, true
+}
+
+func (m *voteMempool) add(vote *primitives.MultiValidatorVote, aggregate bool) {
 	m.poolLock.Lock()
 	m.poolIndividualsLock.Lock()
 	defer m.poolLock.Unlock()
@@ -76,7 +156,7 @@ func (m *voteMempool) Add(vote *primitives.MultiValidatorVote) {
 
 	currentState, err := m.chain.State().TipStateAtSlot(firstSlotAllowedToInclude)
 	if err != nil {
-		m.log.Error(err)
+		m.log.With("slot", firstSlotAllowedToInclude, "err", err).Error("unable to get tip state")
 	}
 
 	// Register voting action for validators included on the vote
@@ -86,6 +166,95 @@ func (m *voteMempool) Add(vote *primitives.MultiValidatorVote) {
 	//}
 	//}
 
+	if m.reportIllegalVotes(vote, currentState) {
+		return
+	}
+
+	// Check if vote is already on pool.
+	// If a vote with same vote data is found we should check the signatures.
+	// If the signatures are the same it means is a duplicated vote for network (probably a relayed vote).
+	// If the signatures don't match and the voters don't overlap, we should aggregate both signatures and merge the
+	// bitlists. Votes with the same data that overlap can't be merged, that happens when a vote is relayed
+	// individually and inside an aggregate, in that case we keep the vote with more voters.
+	// IMPORTANT: 	We should never allow a vote that conflicts a previous vote to be added to the pool.
+	// 				That should be checked against all votes on pool comparing bitlists.
+	v, ok := m.pool[voteHash]
+	if !ok {
+		m.log.With("slot", vote.Data.Slot, "votes", len(vote.ParticipationBitfield.BitIndices())).Debug("adding vote to the mempool")
+		m.pool[voteHash] = vote
+		m.poolIndividuals[voteHash] = []*primitives.MultiValidatorVote{vote}
+		if aggregate {
+			m.aggregates[voteHash] = struct{}{}
+		}
+		return
+	}
+
+	if bytes.Equal(v.Sig[:], vote.Sig[:]) {
+		return
+	}
+
+	overlap, err := v.ParticipationBitfield.Overlaps(vote.ParticipationBitfield)
+	if err != nil {
+		m.log.With("err", err).Error("unable to check the vote participation overlap")
+		return
+	}
+
+	if overlap {
+		if vote.ParticipationBitfield.Count() <= v.ParticipationBitfield.Count() {
+			return
+		}
+		m.pool[voteHash] = vote
+		m.poolIndividuals[voteHash] = append(m.poolIndividuals[voteHash], vote)
+		if aggregate {
+			m.aggregates[voteHash] = struct{}{}
+		}
+		return
+	}
+
+	m.log.With("slot", vote.Data.Slot, "votes", len(vote.ParticipationBitfield.BitIndices())).Debug("received vote with same vote data, aggregating...")
+
+	newBitfield, err := v.ParticipationBitfield.Merge(vote.ParticipationBitfield)
+	if err != nil {
+		m.log.With("err", err).Error("unable to merge the vote participation")
+		return
+	}
+
+	sig1, err := bls.SignatureFromBytes(v.Sig[:])
+	if err != nil {
+		m.log.With("err", err).Error("unable to decode the pool vote signature")
+		return
+	}
+
+	sig2, err := bls.SignatureFromBytes(vote.Sig[:])
+	if err != nil {
+		m.log.With("err", err).Error("unable to decode the vote signature")
+		return
+	}
+
+	newVoteSig := bls.AggregateSignatures([]*bls.Signature{sig1, sig2})
+
+	var voteSig [96]byte
+	copy(voteSig[:], newVoteSig.Marshal())
+
+	newVote := &primitives.MultiValidatorVote{
+		Data:                  v.Data,
+		ParticipationBitfield: newBitfield,
+		Sig:                   voteSig,
+	}
+
+	m.pool[voteHash] = newVote
+	m.poolIndividuals[voteHash] = append(m.poolIndividuals[voteHash], vote)
+	if aggregate {
+		m.aggregates[voteHash] = struct{}{}
+	}
+}
+
+// reportIllegalVotes notifies the vote slashings between a new vote and the votes on the pool. Returns true if the vote
+// is illegal.
+func (m *voteMempool) reportIllegalVotes(vote *primitives.MultiValidatorVote, currentState state.State) bool {
+	voteData := vote.Data
+	voteHash := voteData.Hash()
+
 	// Slashing check
 	// This check iterates over all the votes on the pool.
 	// Checks if the new vote data matches any pool vote data hash.
@@ -107,12 +276,12 @@ func (m *voteMempool) Add(vote *primitives.MultiValidatorVote) {
 
 		vote1Committee, err := currentState.GetVoteCommittee(v.Data.Slot)
 		if err != nil {
-			m.log.Error(err)
+			m.log.With("slot", v.Data.Slot, "err", err).Error("unable to get vote committee")
 		}
 
 		vote2Committee, err := currentState.GetVoteCommittee(vote.Data.Slot)
 		if err != nil {
-			m.log.Error(err)
+			m.log.With("slot", vote.Data.Slot, "err", err).Error("unable to get vote committee")
 		}
 
 		for i, idx := range vote1Committee {
@@ -139,10 +308,10 @@ func (m *voteMempool) Add(vote *primitives.MultiValidatorVote) {
 			if v.Data.IsSurroundVote(voteData) || v.Data.IsDoubleVote(voteData) {
 				// If is a double or surround vote announce it and slash.
 				if v.Data.IsSurroundVote(voteData) {
-					m.log.Warnf("found surround vote for multivalidator, reporting...")
+					m.log.With("slot", vote.Data.Slot).Warn("found surround vote for multivalidator, reporting...")
 				}
 				if v.Data.IsDoubleVote(voteData) {
-					m.log.Warnf("found double vote for multivalidator, reporting...")
+					m.log.With("slot", vote.Data.Slot).Warn("found double vote for multivalidator, reporting...")
 				}
 				for _, n := range m.notifees {
 					n.NotifyIllegalVotes(&primitives.VoteSlashing{
@@ -150,111 +319,56 @@ func (m *voteMempool) Add(vote *primitives.MultiValidatorVote) {
 						Vote2: v,
 					})
 				}
-				return
+				return true
 			}
 		}
 	}
 
-	// Check if vote is already on pool.
-	// If a vote with same vote data is found we should check the signatures.
-	// If the signatures are the same it means is a duplicated vote for network (probably a relayed vote).
-	// If the signatures don't match, we should aggregate both signatures and merge the bitlists.
-	// IMPORTANT: 	We should never allow a vote that conflicts a previous vote to be added to the pool.
-	// 				That should be checked against all votes on pool comparing bitlists.
-	v, ok := m.pool[voteHash]
-	if ok {
-		m.log.Debugf("received vote with same vote data aggregating %d votes...", len(vote.ParticipationBitfield.BitIndices()))
-		if !bytes.Equal(v.Sig[:], vote.Sig[:]) {
-
-			// Check if votes overlaps voters
-			voteCommittee, err := currentState.GetVoteCommittee(v.Data.Slot)
-			if err != nil {
-				m.log.Error(err)
-				return
-			}
-
-			var common []uint64
-
-			for i, idx := range voteCommittee {
-				if v.ParticipationBitfield.Get(uint(i)) && vote.ParticipationBitfield.Get(uint(i)) {
-					common = append(common, idx)
-				}
-			}
-
-			if len(common) != 0 {
-				// If the vote overlaps, that means a validator submitted the same vote multiple times.
-				for _, n := range m.notifees {
-					n.NotifyIllegalVotes(&primitives.VoteSlashing{
-						Vote1: vote,
-						Vote2: v,
-					})
-				}
-				return
-			}
-
-			newBitfield, err := v.ParticipationBitfield.Merge(vote.ParticipationBitfield)
-			if err != nil {
-				m.log.Error(err)
-				return
-			}
-
-			sig1, err := bls.SignatureFromBytes(v.Sig[:])
-			if err != nil {
-				m.log.Error(err)
-				return
-			}
-
-			sig2, err := bls.SignatureFromBytes(vote.Sig[:])
-			if err != nil {
-				m.log.Error(err)
-				return
-			}
-
-			newVoteSig := bls.AggregateSignatures([]*bls.Signature{sig1, sig2})
-
-			var voteSig [96]byte
-			copy(voteSig[:], newVoteSig.Marshal())
-
-			newVote := &primitives.MultiValidatorVote{
-				Data:                  v.Data,
-				ParticipationBitfield: newBitfield,
-				Sig:                   voteSig,
-			}
-
-			m.pool[voteHash] = newVote
-			m.poolIndividuals[voteHash] = append(m.poolIndividuals[voteHash], vote)
-		}
-	} else {
-		m.log.Debugf("adding vote to the mempool with %d votes", len(vote.ParticipationBitfield.BitIndices()))
-		m.pool[voteHash] = vote
-		m.poolIndividuals[voteHash] = []*primitives.MultiValidatorVote{vote}
-	}
+	return false
 }
 
-// Get gets a vote from the mempool.
+// Get gets the votes to include in a block. The votes received from aggregators are preferred, then the votes with
+// more voters.
 func (m *voteMempool) Get(slot uint64, s state.State, proposerIndex uint64) ([]*primitives.MultiValidatorVote, error) {
 	m.poolLock.Lock()
 	defer m.poolLock.Unlock()
 
-	votes := make([]*primitives.MultiValidatorVote, 0)
-
-	for _, vote := range m.pool {
+	type candidate struct {
+		hash      chainhash.Hash
+		vote      *primitives.MultiValidatorVote
+		aggregate bool
+		voters    uint64
+	}
 
+	candidates := make([]candidate, 0, len(m.pool))
+	for h, vote := range m.pool {
 		if slot >= vote.Data.FirstSlotValid(m.netParams) && slot <= vote.Data.LastSlotValid(m.netParams) {
-			err := s.ProcessVote(vote, proposerIndex)
-			if err != nil {
-				m.log.Error(err)
-				m.poolLock.Lock()
-				voteHash := vote.Data.Hash()
-				delete(m.pool, voteHash)
-				m.poolLock.Unlock()
-				continue
-			}
-			if uint64(len(votes)) < m.netParams.MaxVotesPerBlock {
-				votes = append(votes, vote)
-			}
+			_, aggregate := m.aggregates[h]
+			candidates = append(candidates, candidate{hash: h, vote: vote, aggregate: aggregate, voters: vote.ParticipationBitfield.Count()})
 		}
+	}
 
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].aggregate != candidates[j].aggregate {
+			return candidates[i].aggregate
+		}
+		return candidates[i].voters > candidates[j].voters
+	})
+
+	votes := make([]*primitives.MultiValidatorVote, 0)
+
+	for _, c := range candidates {
+		if uint64(len(votes)) >= m.netParams.MaxVotesPerBlock {
+			break
+		}
+		err := s.ProcessVote(c.vote, proposerIndex)
+		if err != nil {
+			m.log.With("slot", c.vote.Data.Slot, "err", err).Error("unable to process vote")
+			delete(m.pool, c.hash)
+			delete(m.aggregates, c.hash)
+			continue
+		}
+		votes = append(votes, c.vote)
 	}
 
 	return votes, nil
@@ -273,6 +387,7 @@ func (m *voteMempool) Remove(b *primitives.Block) {
 		if b.Header.Slot >= v.Data.LastSlotValid(netParams) {
 			delete(m.pool, voteHash)
 			delete(m.poolIndividuals, voteHash)
+			delete(m.aggregates, voteHash)
 		}
 	}
 
@@ -284,6 +399,7 @@ func (m *voteMempool) Remove(b *primitives.Block) {
 		poolVote, ok := m.pool[voteHash]
 		if ok {
 			delete(m.pool, voteHash)
+			delete(m.aggregates, voteHash)
 
 			// If the mempool vote participation is greater than votes included on block we check the poolIndividuals
 			// if there are more votes on the poolIndividuals that were not included on the block, we aggregate them and
@@ -293,16 +409,29 @@ func (m *voteMempool) Remove(b *primitives.Block) {
 				m.log.Debug("incomplete vote submission detected aggregating and constructing missing vote")
 				individuals := m.poolIndividuals[voteHash]
 				// First we extract the included vote for the individuals slice
+				// Individual votes can overlap when an aggregate includes them, so only the ones that don't overlap
+				// the votes already taken are aggregated.
+				newBitfield := bitfield.NewBitlist(poolVote.ParticipationBitfield.Len())
+
 				var votesToAggregate []*primitives.MultiValidatorVote
 				for _, iv := range individuals {
 					intersect := iv.ParticipationBitfield.Intersect(blockVote.ParticipationBitfield)
-					if len(intersect) == 0 {
-						votesToAggregate = append(votesToAggregate, iv)
+					if len(intersect) != 0 {
+						continue
+					}
+					if overlap, err := newBitfield.Overlaps(iv.ParticipationBitfield); err != nil || overlap {
+						continue
+					}
+					votesToAggregate = append(votesToAggregate, iv)
+					for _, idx := range iv.ParticipationBitfield.BitIndices() {
+						newBitfield.Set(uint(idx))
 					}
 				}
-				m.log.Debugf("found %d individual votes not included", len(votesToAggregate))
+				m.log.With("votes", len(votesToAggregate)).Debug("found individual votes not included")
 
-				newBitfield := bitfield.NewBitlist(poolVote.ParticipationBitfield.Len())
+				if len(votesToAggregate) == 0 {
+					continue
+				}
 
 				var sigs []*bls.Signature
 				for _, missingVote := range votesToAggregate {
@@ -311,10 +440,6 @@ func (m *voteMempool) Remove(b *primitives.Block) {
 						return
 					}
 					sigs = append(sigs, sig)
-
-					for _, idx := range missingVote.ParticipationBitfield.BitIndices() {
-						newBitfield.Set(uint(idx))
-					}
 				}
 
 				aggSig := bls.AggregateSignatures(sigs)
@@ -332,7 +457,7 @@ func (m *voteMempool) Remove(b *primitives.Block) {
 		}
 	}
 
-	m.log.Debugf("tracking %d aggregated votes and %d individual votes in vote mempool", len(m.pool), len(m.poolIndividuals))
+	m.log.With("aggregated", len(m.pool), "individual", len(m.poolIndividuals)).Debug("tracking votes in vote mempool")
 }
 
 func (m *voteMempool) getCurrentSlot() uint64 {
@@ -343,6 +468,56 @@ func (m *voteMempool) getCurrentSlot() uint64 {
 	return uint64(slot)
 }
 
+// validateVote checks the stateless rules of a gossip vote before fetching the state to verify its signature.
+func (m *voteMempool) validateVote(msg p2p.Message) error {
+	data, ok := msg.(*p2p.MsgVote)
+	if !ok {
+		return errors.New("wrong message on vote topic")
+	}
+
+	return m.validateVoteRules(data.Data)
+}
+
+// validateAggregateVote checks the stateless rules of a gossip aggregate vote and that its signatures can be parsed.
+func (m *voteMempool) validateAggregateVote(msg p2p.Message) error {
+	data, ok := msg.(*p2p.MsgAggregateVote)
+	if !ok {
+		return errors.New("wrong message on aggregate vote topic")
+	}
+
+	if data.Data.Vote == nil {
+		return errors.New("aggregate doesn't include a vote")
+	}
+
+	if err := m.validateVoteRules(data.Data.Vote); err != nil {
+		return err
+	}
+
+	if _, err := bls.SignatureFromBytes(data.Data.SelectionProof[:]); err != nil {
+		return err
+	}
+
+	_, err := bls.SignatureFromBytes(data.Data.Signature[:])
+	return err
+}
+
+func (m *voteMempool) validateVoteRules(vote *primitives.MultiValidatorVote) error {
+	if vote.Data == nil || vote.Data.Slot == 0 {
+		return state.ErrorVoteSlot
+	}
+
+	if (vote.Data.Slot-1)/m.netParams.EpochLength != vote.Data.ToEpoch {
+		return errors.New("vote slot did not match target epoch")
+	}
+
+	if len(vote.ParticipationBitfield.BitIndices()) == 0 {
+		return errors.New("vote doesn't include any validator")
+	}
+
+	_, err := vote.Signature()
+	return err
+}
+
 func (m *voteMempool) handleVote(id peer.ID, msg p2p.Message) error {
 
 	if id == m.host.GetHost().ID() {
@@ -354,34 +529,58 @@ func (m *voteMempool) handleVote(id peer.ID, msg p2p.Message) error {
 		return errors.New("wrong message on vote topic")
 	}
 
-	vote := data.Data
+	currentState, err := m.inclusionState(data.Data.Data)
+	if err != nil || currentState == nil {
+		return err
+	}
+
+	m.log.With("peer", id, "slot", data.Data.Data.Slot, "votes", len(data.Data.ParticipationBitfield.BitIndices())).Debug("received vote")
+	return m.AddValidate(data.Data, currentState)
+}
 
-	firstSlotAllowedToInclude := vote.Data.Slot + m.netParams.MinAttestationInclusionDelay
+func (m *voteMempool) handleAggregateVote(id peer.ID, msg p2p.Message) error {
+
+	if id == m.host.GetHost().ID() {
+		return nil
+	}
+
+	data, ok := msg.(*p2p.MsgAggregateVote)
+	if !ok {
+		return errors.New("wrong message on aggregate vote topic")
+	}
+
+	currentState, err := m.inclusionState(data.Data.Vote.Data)
+	if err != nil || currentState == nil {
+		return err
+	}
+
+	m.log.With("peer", id, "slot", data.Data.Vote.Data.Slot, "votes", len(data.Data.Vote.ParticipationBitfield.BitIndices())).Debug("received aggregate")
+	return m.AddAggregate(data.Data, currentState)
+}
+
+// inclusionState returns the state at the first slot a vote can be included. Returns nil if the vote is too far ahead
+// of the tip.
+func (m *voteMempool) inclusionState(data *primitives.VoteData) (state.State, error) {
+	firstSlotAllowedToInclude := data.Slot + m.netParams.MinAttestationInclusionDelay
 	tip := m.chain.State().Tip()
 
 	if tip.Slot+m.netParams.EpochLength*2 < firstSlotAllowedToInclude {
-		return nil
+		return nil, nil
 	}
 
 	view, err := m.chain.State().GetSubView(tip.Hash)
 	if err != nil {
-		m.log.Warnf("could not get block view representing current tip: %s", err)
-		return err
+		m.log.With("block", tip.Hash, "err", err).Warn("could not get block view representing current tip")
+		return nil, err
 	}
 
 	currentState, _, err := m.chain.State().GetStateForHashAtSlot(tip.Hash, firstSlotAllowedToInclude, &view)
 	if err != nil {
-		m.log.Warnf("error updating chain to attestation inclusion slot: %s", err)
-		return err
-	}
-	m.log.Debugf("received vote from %s with %d votes", id, len(data.Data.ParticipationBitfield.BitIndices()))
-	err = m.AddValidate(data.Data, currentState)
-	if err != nil {
-
-		return err
+		m.log.With("slot", firstSlotAllowedToInclude, "err", err).Warn("error updating chain to attestation inclusion slot")
+		return nil, err
 	}
 
-	return nil
+	return currentState, nil
 }
 
 // Notify registers a notifee to be notified when illegal votes occur.
@@ -391,15 +590,39 @@ func (m *voteMempool) Notify(notifee VoteSlashingNotifee) {
 	m.notifees = append(m.notifees, notifee)
 }
 
+// Observe registers an observer to be notified of every valid vote received.
+func (m *voteMempool) Observe(observer VoteObserver) {
+	m.observersLock.Lock()
+	defer m.observersLock.Unlock()
+	m.observers = append(m.observers, observer)
+}
+
+func (m *voteMempool) observe(vote *primitives.MultiValidatorVote, s state.State) {
+	m.observersLock.Lock()
+	defer m.observersLock.Unlock()
+	if len(m.observers) == 0 {
+		return
+	}
+
+	committee, err := s.GetVoteCommittee(vote.Data.Slot)
+	if err != nil {
+		return
+	}
+	for _, o := range m.observers {
+		o.ObserveVote(vote, committee)
+	}
+}
+
 // NewVoteMempool creates a new mempool.
 func NewVoteMempool(ch chain.Blockchain, hostnode hostnode.HostNode, manager actionmanager.LastActionManager) (VoteMempool, error) {
 	ctx := config.GlobalParams.Context
-	log := config.GlobalParams.Logger
+	log := config.GlobalParams.Logger.Module("mempool")
 	netParams := config.GlobalParams.NetParams
 
 	vm := &voteMempool{
 		pool:              make(map[chainhash.Hash]*primitives.MultiValidatorVote),
 		poolIndividuals:   make(map[chainhash.Hash][]*primitives.MultiValidatorVote),
+		aggregates:        make(map[chainhash.Hash]struct{}),
 		netParams:         netParams,
 		log:               log,
 		ctx:               ctx,
@@ -413,6 +636,18 @@ func NewVoteMempool(ch chain.Blockchain, hostnode hostnode.HostNode, manager act
 		return nil, err
 	}
 
+	if err := vm.host.RegisterTopicValidator(p2p.MsgVoteCmd, vm.validateVote); err != nil {
+		return nil, err
+	}
+
+	if err := vm.host.RegisterTopicHandler(p2p.MsgAggregateVoteCmd, vm.handleAggregateVote); err != nil {
+		return nil, err
+	}
+
+	if err := vm.host.RegisterTopicValidator(p2p.MsgAggregateVoteCmd, vm.validateAggregateVote); err != nil {
+		return nil, err
+	}
+
 	return vm, nil
 }
 
@@ -420,3 +655,8 @@ func NewVoteMempool(ch chain.Blockchain, hostnode hostnode.HostNode, manager act
 type VoteSlashingNotifee interface {
 	NotifyIllegalVotes(slashing *primitives.VoteSlashing)
 }
+
+// VoteObserver is notified of every valid vote received with the vote committee of its slot.
+type VoteObserver interface {
+	ObserveVote(vote *primitives.MultiValidatorVote, committee []uint64)
+}
@@ -0,0 +1,77 @@
+package mempool
+
+import (
+	"testing"
+
+	"github.com/olympus-protocol/ogen/cmd/ogen/config"
+	"github.com/olympus-protocol/ogen/pkg/primitives"
+	"github.com/olympus-protocol/ogen/test/testchain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVoteMempool_AddAggregateRejected(t *testing.T) {
+	params, flags := config.GlobalParams, config.GlobalFlags
+	t.Cleanup(func() {
+		config.GlobalParams, config.GlobalFlags = params, flags
+	})
+
+	keys, err := testchain.Setup(50)
+	assert.NoError(t, err)
+	genesis, err := keys.GenesisState()
+	assert.NoError(t, err)
+	st := genesis.Copy()
+	_, err = st.ProcessSlots(1, testchain.NewView())
+	assert.NoError(t, err)
+
+	genesisBlock := primitives.GetGenesisBlock()
+	vote, err := keys.Vote(st, genesisBlock.Hash())
+	assert.NoError(t, err)
+	committee, err := st.GetVoteCommittee(1)
+	assert.NoError(t, err)
+	registry := st.GetValidatorRegistry()
+
+	inCommittee := make(map[uint64]bool)
+	for _, index := range committee {
+		inCommittee[index] = true
+	}
+	var outside uint64
+	for inCommittee[outside] {
+		outside++
+	}
+
+	selectionMsg := primitives.AggregatorSelectionMessage(1)
+	proof := func(index uint64) [96]byte {
+		key, ok := keys.Key(registry[index].PubKey)
+		assert.True(t, ok)
+		var p [96]byte
+		copy(p[:], key.Sign(selectionMsg[:]).Marshal())
+		return p
+	}
+
+	netParams := *config.GlobalParams.NetParams
+	m := &voteMempool{netParams: &netParams, log: config.GlobalParams.Logger}
+
+	// every committee member is an aggregator when the committee is smaller than the target.
+	assert.True(t, uint64(len(committee)) <= netParams.TargetAggregatorsPerSlot)
+
+	err = m.AddAggregate(&primitives.AggregateVote{Vote: vote, AggregatorIndex: outside, SelectionProof: proof(outside)}, st)
+	assert.EqualError(t, err, "aggregator is not part of the vote committee")
+
+	// a committee member can't use the selection proof of a validator outside of the committee.
+	err = m.AddAggregate(&primitives.AggregateVote{Vote: vote, AggregatorIndex: committee[0], SelectionProof: proof(outside)}, st)
+	assert.EqualError(t, err, "invalid aggregator signature")
+
+	// with a single aggregator per slot, most of the committee is not selected.
+	netParams.TargetAggregatorsPerSlot = 1
+	rejected := false
+	for _, index := range committee {
+		p := proof(index)
+		if primitives.IsAggregator(uint64(len(committee)), 1, p) {
+			continue
+		}
+		err = m.AddAggregate(&primitives.AggregateVote{Vote: vote, AggregatorIndex: index, SelectionProof: p}, st)
+		assert.EqualError(t, err, "validator is not an aggregator for the vote slot")
+		rejected = true
+	}
+	assert.True(t, rejected)
+}
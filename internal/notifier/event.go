@@ -0,0 +1,86 @@
+package notifier
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"time"
+)
+
+// Event types sent to the webhooks.
+const (
+	// EventFundsPending is sent when a transaction to a watched address enters the mempool.
+	EventFundsPending = "funds_pending"
+
+	// EventFundsReceived is sent when the block with a transaction to a watched address is finalized. Finalized blocks
+	// can't be orphaned, so the funds are not reverted after the event.
+	EventFundsReceived = "funds_received"
+
+	// EventValidatorStatus is sent when the status of a watched validator changes.
+	EventValidatorStatus = "validator_status"
+
+	// EventValidatorSlashed is sent when a watched validator is exited with penalty.
+	EventValidatorSlashed = "validator_slashed"
+
+	// EventFinality is sent when the finalized epoch advances.
+	EventFinality = "finality"
+)
+
+// Event is the JSON body posted to the webhooks. The id is the same on every retry of an event, receivers should use it
+// to discard duplicated deliveries.
+type Event struct {
+	ID   string      `json:"id"`
+	Type string      `json:"type"`
+	Time int64       `json:"time"`
+	Data interface{} `json:"data"`
+}
+
+// FundsData is the data of the funds events.
+type FundsData struct {
+	Account   string `json:"account"`
+	TxHash    string `json:"tx_hash"`
+	Amount    uint64 `json:"amount"`
+	BlockHash string `json:"block_hash,omitempty"`
+	Slot      uint64 `json:"slot,omitempty"`
+}
+
+// ValidatorData is the data of the validator events.
+type ValidatorData struct {
+	PublicKey      string `json:"public_key"`
+	Status         string `json:"status"`
+	PreviousStatus string `json:"previous_status"`
+	Epoch          uint64 `json:"epoch"`
+	BlockHash      string `json:"block_hash"`
+}
+
+// FinalityData is the data of the finality events.
+type FinalityData struct {
+	FinalizedEpoch  uint64 `json:"finalized_epoch"`
+	FinalizedHash   string `json:"finalized_hash"`
+	FinalizedHeight uint64 `json:"finalized_height"`
+	JustifiedEpoch  uint64 `json:"justified_epoch"`
+	JustifiedHash   string `json:"justified_hash"`
+}
+
+// newEvent creates an event with an id derived from the type and the data.
+func newEvent(eventType string, data interface{}, now time.Time) (*Event, error) {
+	b, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	h := sha256.Sum256(append([]byte(eventType), b...))
+	return &Event{
+		ID:   hex.EncodeToString(h[:16]),
+		Type: eventType,
+		Time: now.Unix(),
+		Data: data,
+	}, nil
+}
+
+// Sign returns the hex encoded HMAC-SHA256 of a body with the webhook secret.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
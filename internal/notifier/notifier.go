@@ -0,0 +1,418 @@
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"path"
+	"sync"
+	"time"
+
+	"github.com/olympus-protocol/ogen/cmd/ogen/config"
+	"github.com/olympus-protocol/ogen/internal/blockdb"
+	"github.com/olympus-protocol/ogen/internal/chain"
+	"github.com/olympus-protocol/ogen/internal/chainindex"
+	"github.com/olympus-protocol/ogen/internal/mempool"
+	"github.com/olympus-protocol/ogen/internal/state"
+	"github.com/olympus-protocol/ogen/pkg/bech32"
+	"github.com/olympus-protocol/ogen/pkg/logger"
+	"github.com/olympus-protocol/ogen/pkg/primitives"
+)
+
+const (
+	// SignatureHeader is the header with the signature of the body.
+	SignatureHeader = "X-Ogen-Signature"
+
+	// EventHeader is the header with the event type.
+	EventHeader = "X-Ogen-Event"
+
+	// deliveryTimeout is the maximum time to wait for a webhook response.
+	deliveryTimeout = 10 * time.Second
+
+	// retryBase is the time to wait after the first failed attempt, the time doubles on every failed attempt.
+	retryBase = 5 * time.Second
+
+	// retryMax is the maximum time between attempts.
+	retryMax = time.Hour
+
+	// deliveryBatch is the amount of deliveries attempted on every round.
+	deliveryBatch = 64
+)
+
+// Notifier is the interface for notifier
+type Notifier interface {
+	NewTip(row *chainindex.BlockRow, block *primitives.Block, s state.State, receipts []*primitives.EpochReceipt)
+	ProposerSlashingConditionViolated(slashing *primitives.ProposerSlashing)
+	NotifyTx(tx *primitives.Tx)
+	Start() error
+	Stop() error
+}
+
+var _ Notifier = &notifier{}
+
+// Config is the webhooks configuration.
+type Config struct {
+	URLs        []string
+	Secret      string
+	Addresses   []string
+	Validators  []string
+	MaxAttempts int
+}
+
+// notifier posts signed JSON events to webhooks when watched addresses receive funds, watched validators change their
+// status or the chain finalizes a new epoch. Events are stored on an outbox and retried with exponential backoff until
+// the webhook answers with a 2xx status or the attempts are exhausted.
+type notifier struct {
+	log    logger.Logger
+	ctx    context.Context
+	cancel context.CancelFunc
+	chain  chain.Blockchain
+	coins  mempool.CoinsMempool
+	outbox *Outbox
+	client *http.Client
+	config Config
+
+	addresses  map[[20]byte]string
+	validators map[[48]byte]struct{}
+
+	// lock protects the last seen validator statuses, finalized epoch and the height of the last finalized block with
+	// notified funds.
+	lock            sync.Mutex
+	statuses        map[[48]byte]uint64
+	finalizedEpoch  uint64
+	finalizedHeight uint64
+
+	// wake is signaled when new deliveries are added.
+	wake chan struct{}
+
+	// running is done when the delivery loop returns.
+	running sync.WaitGroup
+}
+
+// NewTip queues the events of a new tip.
+func (n *notifier) NewTip(row *chainindex.BlockRow, block *primitives.Block, s state.State, _ []*primitives.EpochReceipt) {
+	var events []*Event
+
+	blockHash := block.Hash().String()
+	now := time.Now()
+
+	add := func(eventType string, data interface{}) {
+		e, err := newEvent(eventType, data, now)
+		if err != nil {
+			n.log.Errorf("unable to create %s event: %s", eventType, err)
+			return
+		}
+		events = append(events, e)
+	}
+
+	n.lock.Lock()
+	if len(n.addresses) > 0 {
+		finalizedRow, _ := n.chain.State().GetFinalizedHead()
+		if finalizedRow != nil && finalizedRow.Height > n.finalizedHeight {
+			for _, row := range n.finalizedRows(finalizedRow) {
+				b, err := n.chain.GetBlock(row.Hash)
+				if err != nil {
+					n.log.Errorf("unable to load finalized block %s: %s", row.Hash, err)
+					continue
+				}
+				for _, tx := range b.Txs {
+					if account, ok := n.addresses[tx.To]; ok {
+						add(EventFundsReceived, &FundsData{Account: account, TxHash: tx.Hash().String(), Amount: tx.Amount, BlockHash: row.Hash.String(), Slot: row.Slot})
+					}
+				}
+			}
+			n.finalizedHeight = finalizedRow.Height
+		}
+	}
+
+	if len(n.validators) > 0 {
+		for _, v := range s.GetValidatorRegistry() {
+			if _, ok := n.validators[v.PubKey]; !ok {
+				continue
+			}
+			previous, ok := n.statuses[v.PubKey]
+			n.statuses[v.PubKey] = v.Status
+			if ok && previous == v.Status {
+				continue
+			}
+			eventType := EventValidatorStatus
+			if v.Status == primitives.StatusExitedWithPenalty {
+				eventType = EventValidatorSlashed
+			}
+			previousStatus := ""
+			if ok {
+				previousStatus = (&primitives.Validator{Status: previous}).StatusString()
+			}
+			add(eventType, &ValidatorData{
+				PublicKey:      hex.EncodeToString(v.PubKey[:]),
+				Status:         v.StatusString(),
+				PreviousStatus: previousStatus,
+				Epoch:          s.GetEpochIndex(),
+				BlockHash:      blockHash,
+			})
+		}
+	}
+
+	if finalized := s.GetFinalizedEpoch(); finalized > n.finalizedEpoch {
+		n.finalizedEpoch = finalized
+		finalizedRow, _ := n.chain.State().GetFinalizedHead()
+		data := &FinalityData{
+			FinalizedEpoch: finalized,
+			JustifiedEpoch: s.GetJustifiedEpoch(),
+			JustifiedHash:  s.GetJustifiedEpochHash().String(),
+		}
+		if finalizedRow != nil {
+			data.FinalizedHash = finalizedRow.Hash.String()
+			data.FinalizedHeight = finalizedRow.Height
+		}
+		add(EventFinality, data)
+	}
+	n.lock.Unlock()
+
+	n.queue(events...)
+}
+
+// finalizedRows returns the finalized blocks after the last notified finalized height up to the finalized head,
+// sorted by height. It must be called with the lock held.
+func (n *notifier) finalizedRows(head *chainindex.BlockRow) []*chainindex.BlockRow {
+	var rows []*chainindex.BlockRow
+	for row := head; row != nil && row.Height > n.finalizedHeight; row = row.Parent {
+		rows = append(rows, row)
+	}
+	for a, b := 0, len(rows)-1; a < b; a, b = a+1, b-1 {
+		rows[a], rows[b] = rows[b], rows[a]
+	}
+	return rows
+}
+
+// ProposerSlashingConditionViolated implements the chain notifee. Slashed validators are notified once the slashing is
+// included and their status changes.
+func (n *notifier) ProposerSlashingConditionViolated(_ *primitives.ProposerSlashing) {}
+
+// NotifyTx queues a pending funds event for mempool transactions to watched addresses.
+func (n *notifier) NotifyTx(tx *primitives.Tx) {
+	account, ok := n.addresses[tx.To]
+	if !ok {
+		return
+	}
+	e, err := newEvent(EventFundsPending, &FundsData{Account: account, TxHash: tx.Hash().String(), Amount: tx.Amount}, time.Now())
+	if err != nil {
+		n.log.Errorf("unable to create %s event: %s", EventFundsPending, err)
+		return
+	}
+	n.queue(e)
+}
+
+// queue stores the events on the outbox and wakes up the delivery loop.
+func (n *notifier) queue(events ...*Event) {
+	for _, e := range events {
+		body, err := json.Marshal(e)
+		if err != nil {
+			n.log.Errorf("unable to encode %s event: %s", e.Type, err)
+			continue
+		}
+		if err := n.outbox.Add(n.config.URLs, body, time.Now()); err != nil {
+			n.log.Errorf("unable to store %s event: %s", e.Type, err)
+			continue
+		}
+	}
+	if len(events) > 0 {
+		select {
+		case n.wake <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// post sends a delivery body to its webhook.
+func (n *notifier) post(d *Delivery) error {
+	ctx, cancel := context.WithTimeout(n.ctx, deliveryTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, d.URL, bytes.NewReader(d.Body))
+	if err != nil {
+		return err
+	}
+
+	var e Event
+	if err := json.Unmarshal(d.Body, &e); err == nil {
+		req.Header.Set(EventHeader, e.Type)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(SignatureHeader, "sha256="+Sign(n.config.Secret, d.Body))
+
+	res, err := n.client.Do(req)
+	if err != nil {
+		return err
+	}
+	_ = res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return fmt.Errorf("webhook answered with status %d", res.StatusCode)
+	}
+	return nil
+}
+
+// retryDelay returns the time to wait after a failed attempt.
+func retryDelay(attempts int) time.Duration {
+	delay := retryBase
+	for i := 1; i < attempts && delay < retryMax; i++ {
+		delay *= 2
+	}
+	if delay > retryMax {
+		delay = retryMax
+	}
+	return delay
+}
+
+// deliver attempts the due deliveries of the outbox.
+func (n *notifier) deliver() {
+	due, err := n.outbox.Due(time.Now(), deliveryBatch)
+	if err != nil {
+		n.log.Errorf("unable to read the notifications outbox: %s", err)
+		return
+	}
+
+	for _, d := range due {
+		if n.ctx.Err() != nil {
+			return
+		}
+
+		err := n.post(d)
+		if err == nil {
+			if err := n.outbox.Remove(d.ID); err != nil {
+				n.log.Errorf("unable to remove delivery %d: %s", d.ID, err)
+			}
+			continue
+		}
+
+		d.Attempts++
+		if d.Attempts >= n.config.MaxAttempts {
+			n.log.Errorf("dropping delivery %d to %s after %d attempts: %s", d.ID, d.URL, d.Attempts, err)
+			if err := n.outbox.Remove(d.ID); err != nil {
+				n.log.Errorf("unable to remove delivery %d: %s", d.ID, err)
+			}
+			continue
+		}
+
+		n.log.Warnf("unable to deliver notification %d to %s, retrying: %s", d.ID, d.URL, err)
+		d.NextAttempt = time.Now().Add(retryDelay(d.Attempts)).Unix()
+		if err := n.outbox.Update(d); err != nil {
+			n.log.Errorf("unable to update delivery %d: %s", d.ID, err)
+		}
+	}
+}
+
+func (n *notifier) run() {
+	defer n.running.Done()
+
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-n.ctx.Done():
+			return
+		case <-ticker.C:
+		case <-n.wake:
+		}
+		n.deliver()
+	}
+}
+
+// Start starts watching the chain and the mempool and delivering the stored events.
+func (n *notifier) Start() error {
+	tip := n.chain.State().TipState()
+
+	n.lock.Lock()
+	for _, v := range tip.GetValidatorRegistry() {
+		if _, ok := n.validators[v.PubKey]; ok {
+			n.statuses[v.PubKey] = v.Status
+		}
+	}
+	n.finalizedEpoch = tip.GetFinalizedEpoch()
+	if finalized, _ := n.chain.State().GetFinalizedHead(); finalized != nil {
+		n.finalizedHeight = finalized.Height
+	}
+	n.lock.Unlock()
+
+	n.chain.Notify(n)
+	n.coins.Notify(n)
+	n.running.Add(1)
+	go n.run()
+	return nil
+}
+
+// Stop stops the notifier and closes its outbox once the delivery loop returns. Pending deliveries are sent when the
+// node starts again.
+func (n *notifier) Stop() error {
+	n.chain.Unnotify(n)
+	n.coins.Unnotify(n)
+	n.cancel()
+	n.running.Wait()
+	return n.outbox.Close()
+}
+
+// NewNotifier creates a notifier that stores its outbox on the datapath with the configured database backend.
+func NewNotifier(ch chain.Blockchain, coins mempool.CoinsMempool, c Config) (Notifier, error) {
+	if len(c.URLs) == 0 {
+		return nil, errors.New("no webhook urls configured")
+	}
+	if c.Secret == "" {
+		return nil, errors.New("a webhook secret is required to sign the notifications")
+	}
+	if c.MaxAttempts <= 0 {
+		return nil, errors.New("the webhook attempts must be at least 1")
+	}
+
+	addresses := make(map[[20]byte]string)
+	for _, a := range c.Addresses {
+		_, data, err := bech32.Decode(a)
+		if err != nil {
+			return nil, fmt.Errorf("invalid watched address %s: %s", a, err)
+		}
+		if len(data) != 20 {
+			return nil, fmt.Errorf("expected watched address %s to be 20 bytes but got %d", a, len(data))
+		}
+		var acc [20]byte
+		copy(acc[:], data)
+		addresses[acc] = a
+	}
+
+	validators := make(map[[48]byte]struct{})
+	for _, v := range c.Validators {
+		data, err := hex.DecodeString(v)
+		if err != nil || len(data) != 48 {
+			return nil, fmt.Errorf("invalid watched validator %s", v)
+		}
+		var pub [48]byte
+		copy(pub[:], data)
+		validators[pub] = struct{}{}
+	}
+
+	store, err := blockdb.OpenStore(config.GlobalFlags.DBBackend, path.Join(config.GlobalFlags.DataPath, "notifications"))
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(config.GlobalParams.Context)
+
+	return &notifier{
+		log:        config.GlobalParams.Logger.Module("notifier"),
+		ctx:        ctx,
+		cancel:     cancel,
+		chain:      ch,
+		coins:      coins,
+		outbox:     NewOutbox(store),
+		client:     &http.Client{},
+		config:     c,
+		addresses:  addresses,
+		validators: validators,
+		statuses:   make(map[[48]byte]uint64),
+		wake:       make(chan struct{}, 1),
+	}, nil
+}
@@ -0,0 +1,20 @@
+package notifier_test
+
+import (
+	"testing"
+
+	"github.com/olympus-protocol/ogen/internal/notifier"
+	"github.com/olympus-protocol/ogen/pkg/bech32"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewNotifier_InvalidAddress(t *testing.T) {
+	c := notifier.Config{
+		URLs:        []string{"http://a"},
+		Secret:      "secret",
+		Addresses:   []string{bech32.Encode("tolpub", make([]byte, 10))},
+		MaxAttempts: 1,
+	}
+	_, err := notifier.NewNotifier(nil, nil, c)
+	assert.EqualError(t, err, "expected watched address "+c.Addresses[0]+" to be 20 bytes but got 10")
+}
@@ -0,0 +1,142 @@
+package notifier
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/olympus-protocol/ogen/internal/blockdb"
+)
+
+var (
+	deliveryPrefix = []byte("delivery-")
+	sequenceKey    = []byte("sequence")
+)
+
+// Delivery is an event body waiting to be posted to a webhook.
+type Delivery struct {
+	ID          uint64          `json:"id"`
+	URL         string          `json:"url"`
+	Body        json.RawMessage `json:"body"`
+	Attempts    int             `json:"attempts"`
+	NextAttempt int64           `json:"next_attempt"`
+}
+
+// Outbox keeps the deliveries on a store until they succeed, so events are not lost when the node restarts or the
+// webhooks are unavailable.
+type Outbox struct {
+	lock     sync.Mutex
+	store    blockdb.Store
+	sequence uint64
+}
+
+// NewOutbox creates an outbox over a key-value store.
+func NewOutbox(store blockdb.Store) *Outbox {
+	o := &Outbox{store: store}
+	b, err := store.Get(sequenceKey)
+	if err == nil && len(b) == 8 {
+		o.sequence = binary.BigEndian.Uint64(b)
+	}
+	return o
+}
+
+// Close closes the underlying store.
+func (o *Outbox) Close() error {
+	return o.store.Close()
+}
+
+func deliveryKey(id uint64) []byte {
+	key := make([]byte, len(deliveryPrefix)+8)
+	copy(key, deliveryPrefix)
+	binary.BigEndian.PutUint64(key[len(deliveryPrefix):], id)
+	return key
+}
+
+// Add stores a delivery of the body for each url.
+func (o *Outbox) Add(urls []string, body []byte, now time.Time) error {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+
+	batch := blockdb.NewBatch()
+	sequence := o.sequence
+	for _, url := range urls {
+		sequence++
+		d := &Delivery{
+			ID:          sequence,
+			URL:         url,
+			Body:        body,
+			NextAttempt: now.Unix(),
+		}
+		b, err := json.Marshal(d)
+		if err != nil {
+			return err
+		}
+		batch.Put(deliveryKey(d.ID), b)
+	}
+
+	var seq [8]byte
+	binary.BigEndian.PutUint64(seq[:], sequence)
+	batch.Put(sequenceKey, seq[:])
+
+	if err := o.store.Write(batch); err != nil {
+		return err
+	}
+	o.sequence = sequence
+	return nil
+}
+
+// Due returns up to limit deliveries that should be attempted at the time, oldest first.
+func (o *Outbox) Due(now time.Time, limit int) ([]*Delivery, error) {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+
+	var due []*Delivery
+	err := o.store.Iterate(deliveryPrefix, func(_ []byte, value []byte) error {
+		if len(due) >= limit {
+			return nil
+		}
+		d := new(Delivery)
+		if err := json.Unmarshal(value, d); err != nil {
+			return err
+		}
+		if d.NextAttempt <= now.Unix() {
+			due = append(due, d)
+		}
+		return nil
+	})
+	return due, err
+}
+
+// Len returns the amount of deliveries on the outbox.
+func (o *Outbox) Len() (int, error) {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+
+	n := 0
+	err := o.store.Iterate(deliveryPrefix, func(_ []byte, _ []byte) error {
+		n++
+		return nil
+	})
+	return n, err
+}
+
+// Update stores the new attempt information of a delivery.
+func (o *Outbox) Update(d *Delivery) error {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+
+	b, err := json.Marshal(d)
+	if err != nil {
+		return err
+	}
+	return o.store.Put(deliveryKey(d.ID), b)
+}
+
+// Remove removes a delivery from the outbox.
+func (o *Outbox) Remove(id uint64) error {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+
+	return o.store.Delete(deliveryKey(id))
+}
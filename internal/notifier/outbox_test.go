@@ -0,0 +1,79 @@
+package notifier_test
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/olympus-protocol/ogen/internal/blockdb"
+	"github.com/olympus-protocol/ogen/internal/notifier"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOutbox(t *testing.T) {
+	store := blockdb.NewMemoryStore()
+	outbox := notifier.NewOutbox(store)
+
+	now := time.Unix(1000, 0)
+	urls := []string{"http://a", "http://b"}
+
+	assert.NoError(t, outbox.Add(urls, []byte(`{"id":"1"}`), now))
+	assert.NoError(t, outbox.Add(urls[:1], []byte(`{"id":"2"}`), now))
+
+	n, err := outbox.Len()
+	assert.NoError(t, err)
+	assert.Equal(t, 3, n)
+
+	due, err := outbox.Due(now, 10)
+	assert.NoError(t, err)
+	assert.Len(t, due, 3)
+	for i, d := range due {
+		assert.Equal(t, uint64(i+1), d.ID)
+	}
+	assert.Equal(t, "http://b", due[1].URL)
+	assert.Equal(t, `{"id":"2"}`, string(due[2].Body))
+
+	due, err = outbox.Due(now, 2)
+	assert.NoError(t, err)
+	assert.Len(t, due, 2)
+
+	// A rescheduled delivery is not due until its next attempt.
+	d := due[0]
+	d.Attempts++
+	d.NextAttempt = now.Add(time.Minute).Unix()
+	assert.NoError(t, outbox.Update(d))
+
+	due, err = outbox.Due(now, 10)
+	assert.NoError(t, err)
+	assert.Len(t, due, 2)
+
+	due, err = outbox.Due(now.Add(time.Minute), 10)
+	assert.NoError(t, err)
+	assert.Len(t, due, 3)
+	assert.Equal(t, 1, due[0].Attempts)
+
+	assert.NoError(t, outbox.Remove(2))
+	n, err = outbox.Len()
+	assert.NoError(t, err)
+	assert.Equal(t, 2, n)
+
+	// The sequence is kept on the store, a new outbox doesn't reuse the ids.
+	outbox = notifier.NewOutbox(store)
+	assert.NoError(t, outbox.Add(urls[:1], []byte(`{"id":"3"}`), now))
+	due, err = outbox.Due(now.Add(time.Minute), 10)
+	assert.NoError(t, err)
+	assert.Len(t, due, 3)
+	assert.Equal(t, uint64(4), due[2].ID)
+}
+
+func TestSign(t *testing.T) {
+	body := []byte(`{"id":"1","type":"finality"}`)
+
+	mac := hmac.New(sha256.New, []byte("secret"))
+	mac.Write(body)
+
+	assert.Equal(t, hex.EncodeToString(mac.Sum(nil)), notifier.Sign("secret", body))
+	assert.NotEqual(t, notifier.Sign("secret", body), notifier.Sign("other", body))
+}
@@ -55,20 +55,31 @@ type proposer struct {
 	voting    bool
 	proposing bool
 
-	voteMempool    mempool.VoteMempool
-	coinsMempool   mempool.CoinsMempool
-	actionsMempool mempool.ActionMempool
-	host           hostnode.HostNode
+	// aggregations is the amount of slots our validators aggregate. The node is subscribed to the votes topic while it
+	// is positive.
+	aggregations     int
+	aggregationsLock sync.Mutex
+
+	// subscribedSlot is the slot for which the votes topic was subscribed ahead of the aggregation, it is guarded by
+	// voteLock.
+	subscribedSlot uint64
+
+	voteMempool      mempool.VoteMempool
+	coinsMempool     mempool.CoinsMempool
+	contractsMempool mempool.ContractsMempool
+	assetsMempool    mempool.AssetsMempool
+	actionsMempool   mempool.ActionMempool
+	host             hostnode.HostNode
 
 	lastActionManager actionmanager.LastActionManager
 }
 
 // NewProposer creates a new proposer from the parameters.
-func NewProposer(chain chain.Blockchain, hostnode hostnode.HostNode, voteMempool mempool.VoteMempool, coinsMempool mempool.CoinsMempool, actionsMempool mempool.ActionMempool, manager actionmanager.LastActionManager, ks keystore.Keystore) (Proposer, error) {
+func NewProposer(chain chain.Blockchain, hostnode hostnode.HostNode, voteMempool mempool.VoteMempool, coinsMempool mempool.CoinsMempool, contractsMempool mempool.ContractsMempool, assetsMempool mempool.AssetsMempool, actionsMempool mempool.ActionMempool, manager actionmanager.LastActionManager, ks keystore.Keystore) (Proposer, error) {
 	ctx, cancel := context.WithCancel(context.Background())
 
 	prop := &proposer{
-		log:               config.GlobalParams.Logger,
+		log:               config.GlobalParams.Logger.Module("proposer"),
 		netParams:         config.GlobalParams.NetParams,
 		keystore:          ks,
 		chain:             chain,
@@ -77,6 +88,8 @@ func NewProposer(chain chain.Blockchain, hostnode hostnode.HostNode, voteMempool
 		stop:              cancel,
 		voteMempool:       voteMempool,
 		coinsMempool:      coinsMempool,
+		contractsMempool:  contractsMempool,
+		assetsMempool:     assetsMempool,
 		actionsMempool:    actionsMempool,
 		host:              hostnode,
 		lastActionManager: manager,
@@ -110,6 +123,8 @@ func (p *proposer) Proposing() bool {
 func (p *proposer) NewTip(_ *chainindex.BlockRow, block *primitives.Block, newState state.State, _ []*primitives.EpochReceipt) {
 	p.voteMempool.Remove(block)
 	p.coinsMempool.RemoveByBlock(block)
+	p.contractsMempool.RemoveByBlock(block)
+	p.assetsMempool.RemoveByBlock(block)
 	p.actionsMempool.RemoveByBlock(block, newState)
 }
 
@@ -164,14 +179,17 @@ func (p *proposer) ProposeBlocks() {
 			tip := p.chain.State().Tip()
 			tipHash := tip.Hash
 
-			blockState, err := p.chain.State().TipStateAtSlot(slotToPropose)
+			tipState, err := p.chain.State().TipStateAtSlot(slotToPropose)
 			if err != nil {
-				p.log.Errorf("unable to get tip state at slot %d", slotToPropose)
+				p.log.With("slot", slotToPropose, "err", err).Error("unable to get tip state")
 				blockTimer = time.NewTimer(time.Second * 2)
 				p.proposerLock.Unlock()
 				continue
 			}
 
+			// the mempools apply the selected actions to the state, so they must use a copy of the tip state.
+			blockState := tipState.Copy()
+
 			slotIndex := (slotToPropose + p.netParams.EpochLength - 1) % p.netParams.EpochLength
 			proposerIndex := blockState.GetProposerQueue()[slotIndex]
 			proposer := blockState.GetValidatorRegistry()[proposerIndex]
@@ -183,11 +201,11 @@ func (p *proposer) ProposeBlocks() {
 				//	continue
 				//}
 
-				p.log.Infof("proposing for slot %d", slotToPropose)
+				p.log.With("slot", slotToPropose).Info("proposing block")
 
 				votes, err := p.voteMempool.Get(slotToPropose, blockState, proposerIndex)
 				if err != nil {
-					p.log.Error(err)
+					p.log.With("slot", slotToPropose, "err", err).Error("unable to get votes")
 					blockTimer = time.NewTimer(time.Second * 2)
 					p.proposerLock.Unlock()
 					continue
@@ -195,7 +213,7 @@ func (p *proposer) ProposeBlocks() {
 
 				depositTxs, blockState, err := p.actionsMempool.GetDeposits(int(p.netParams.MaxDepositsPerBlock), blockState)
 				if err != nil {
-					p.log.Error(err)
+					p.log.With("slot", slotToPropose, "err", err).Error("unable to get deposits")
 					blockTimer = time.NewTimer(time.Second * 2)
 					p.proposerLock.Unlock()
 					continue
@@ -205,9 +223,13 @@ func (p *proposer) ProposeBlocks() {
 
 				coinTxMulti := p.coinsMempool.GetMulti(p.netParams.MaxTxsMultiPerBlock, blockState)
 
+				contractDeploys, contractCalls := p.contractsMempool.Get(blockState, proposer.PayeeAddress)
+
+				assetTxs := p.assetsMempool.Get(blockState, proposer.PayeeAddress)
+
 				exitTxs, err := p.actionsMempool.GetExits(int(p.netParams.MaxExitsPerBlock), blockState)
 				if err != nil {
-					p.log.Error(err)
+					p.log.With("slot", slotToPropose, "err", err).Error("unable to get exits")
 					blockTimer = time.NewTimer(time.Second * 2)
 					p.proposerLock.Unlock()
 					continue
@@ -215,7 +237,7 @@ func (p *proposer) ProposeBlocks() {
 
 				randaoSlashings, err := p.actionsMempool.GetRANDAOSlashings(int(p.netParams.MaxRANDAOSlashingsPerBlock), blockState)
 				if err != nil {
-					p.log.Error(err)
+					p.log.With("slot", slotToPropose, "err", err).Error("unable to get RANDAO slashings")
 					blockTimer = time.NewTimer(time.Second * 2)
 					p.proposerLock.Unlock()
 					continue
@@ -223,7 +245,7 @@ func (p *proposer) ProposeBlocks() {
 
 				voteSlashings, err := p.actionsMempool.GetVoteSlashings(int(p.netParams.MaxVoteSlashingsPerBlock), blockState)
 				if err != nil {
-					p.log.Error(err)
+					p.log.With("slot", slotToPropose, "err", err).Error("unable to get vote slashings")
 					blockTimer = time.NewTimer(time.Second * 2)
 					p.proposerLock.Unlock()
 					continue
@@ -231,7 +253,7 @@ func (p *proposer) ProposeBlocks() {
 
 				proposerSlashings, err := p.actionsMempool.GetProposerSlashings(int(p.netParams.MaxProposerSlashingsPerBlock), blockState)
 				if err != nil {
-					p.log.Error(err)
+					p.log.With("slot", slotToPropose, "err", err).Error("unable to get proposer slashings")
 					blockTimer = time.NewTimer(time.Second * 2)
 					p.proposerLock.Unlock()
 					continue
@@ -239,7 +261,7 @@ func (p *proposer) ProposeBlocks() {
 
 				governanceVotes, err := p.actionsMempool.GetGovernanceVotes(int(p.netParams.MaxGovernanceVotesPerBlock), blockState)
 				if err != nil {
-					p.log.Error(err)
+					p.log.With("slot", slotToPropose, "err", err).Error("unable to get governance votes")
 					blockTimer = time.NewTimer(time.Second * 2)
 					p.proposerLock.Unlock()
 					continue
@@ -263,6 +285,9 @@ func (p *proposer) ProposeBlocks() {
 					VoteSlashings:     voteSlashings,
 					ProposerSlashings: proposerSlashings,
 					GovernanceVotes:   governanceVotes,
+					ContractDeploys:   contractDeploys,
+					ContractCalls:     contractCalls,
+					AssetTxs:          assetTxs,
 				}
 
 				block.Header.VoteMerkleRoot = block.VotesMerkleRoot()
@@ -274,19 +299,30 @@ func (p *proposer) ProposeBlocks() {
 				block.Header.RANDAOSlashingMerkleRoot = block.RANDAOSlashingsRoot()
 				block.Header.VoteSlashingMerkleRoot = block.VoteSlashingRoot()
 				block.Header.GovernanceVotesMerkleRoot = block.GovernanceVoteMerkleRoot()
+				block.Header.ContractMerkleRoot = block.ContractMerkleRoot()
+				block.Header.AssetTxMerkleRoot = block.AssetTxMerkleRoot()
 
-				blockHash := block.Hash()
 				randaoHash := chainhash.HashH([]byte(fmt.Sprintf("%d", slotToPropose)))
-
-				blockSig := k.Sign(blockHash[:])
 				randaoSig := k.Sign(randaoHash[:])
 				var s, rs [96]byte
-				copy(s[:], blockSig.Marshal())
 				copy(rs[:], randaoSig.Marshal())
-				block.Signature = s
 				block.RandaoSignature = rs
+
+				stateRoot, err := p.getStateRoot(&block)
+				if err != nil {
+					p.log.With("slot", slotToPropose, "err", err).Error("unable to calculate the state root")
+					blockTimer = time.NewTimer(time.Second * 2)
+					p.proposerLock.Unlock()
+					continue
+				}
+				block.Header.StateRoot = stateRoot
+
+				blockHash := block.Hash()
+				blockSig := k.Sign(blockHash[:])
+				copy(s[:], blockSig.Marshal())
+				block.Signature = s
 				if err := p.chain.ProcessBlock(&block); err != nil {
-					p.log.Error(err)
+					p.log.With("slot", slotToPropose, "err", err).Error("unable to process proposed block")
 					blockTimer = time.NewTimer(time.Second * 2)
 					p.proposerLock.Unlock()
 					continue
@@ -296,7 +332,7 @@ func (p *proposer) ProposeBlocks() {
 
 				err = p.host.Broadcast(msg)
 				if err != nil {
-					p.log.Error(err)
+					p.log.With("slot", slotToPropose, "err", err).Error("unable to broadcast proposed block")
 					blockTimer = time.NewTimer(time.Second * 2)
 					p.proposerLock.Unlock()
 					continue
@@ -313,8 +349,26 @@ func (p *proposer) ProposeBlocks() {
 	}
 }
 
+// getStateRoot applies the block to a copy of the tip state and returns the resulting state root.
+func (p *proposer) getStateRoot(block *primitives.Block) (chainhash.Hash, error) {
+	tipState, err := p.chain.State().TipStateAtSlot(block.Header.Slot)
+	if err != nil {
+		return chainhash.Hash{}, err
+	}
+
+	postState := tipState.Copy()
+	if err := postState.ApplyBlock(block); err != nil {
+		return chainhash.Hash{}, err
+	}
+
+	return postState.StateRoot()
+}
+
 func (p *proposer) VoteForBlocks() {
 	defer func() {
+		p.voteLock.Lock()
+		p.releaseSlot()
+		p.voteLock.Unlock()
 		p.voting = false
 	}()
 
@@ -331,6 +385,8 @@ func (p *proposer) VoteForBlocks() {
 			p.voteLock.Lock()
 			// Check if we're an attester for this slot
 			if p.host.Syncing() {
+				// the slot won't be aggregated while syncing.
+				p.releaseSlot()
 				p.voting = false
 				voteTimer = time.NewTimer(time.Second * 10)
 				p.log.Info("blockchain not synced... trying to vote in 10 seconds")
@@ -343,7 +399,7 @@ func (p *proposer) VoteForBlocks() {
 
 			voteState, err := s.TipStateAtSlot(slotToVote)
 			if err != nil {
-				p.log.Errorf("unable to get tip at slot %d", slotToVote)
+				p.log.With("slot", slotToVote, "err", err).Error("unable to get tip state")
 				voteTimer = time.NewTimer(time.Second * 2)
 				p.voteLock.Unlock()
 				continue
@@ -351,17 +407,17 @@ func (p *proposer) VoteForBlocks() {
 
 			validators, err := voteState.GetVoteCommittee(slotToVote)
 			if err != nil {
-				p.log.Errorf("error getting vote committee: %s", err.Error())
+				p.log.With("slot", slotToVote, "err", err).Error("error getting vote committee")
 				voteTimer = time.NewTimer(time.Second * 2)
 				p.voteLock.Unlock()
 				continue
 			}
 
-			p.log.Debugf("committing for slot %d with %d validators", slotToVote, len(validators))
+			p.log.With("slot", slotToVote, "validators", len(validators)).Debug("committing votes")
 
 			beaconBlock, found := s.Chain().GetNodeBySlot(slotToVote - 1)
 			if !found {
-				p.log.Errorf("unable to find block at slot %d", slotToVote-1)
+				p.log.With("slot", slotToVote-1).Error("unable to find block")
 				voteTimer = time.NewTimer(time.Second * 2)
 				p.voteLock.Unlock()
 				continue
@@ -386,6 +442,15 @@ func (p *proposer) VoteForBlocks() {
 			bitlistVotes := bitfield.NewBitlist(uint64(len(validators)))
 
 			validatorRegistry := voteState.GetValidatorRegistry()
+
+			// The first of our validators selected as aggregator publishes the votes of the slot.
+			aggregator, aggregatorIndex, selectionProof := p.selectAggregator(slotToVote, validators, validatorRegistry)
+			if aggregator != nil {
+				p.subscribeSlot(slotToVote)
+			} else {
+				p.releaseSlot()
+			}
+
 			for i, index := range validators {
 				votingValidator := validatorRegistry[index]
 				key, found := p.keystore.GetValidatorKey(votingValidator.PubKey)
@@ -416,25 +481,42 @@ func (p *proposer) VoteForBlocks() {
 
 				err = p.voteMempool.AddValidate(vote, voteState)
 				if err != nil {
-					p.log.Error(err)
+					p.log.With("slot", slotToVote, "err", err).Error("unable to add vote to the mempool")
 					voteTimer = time.NewTimer(time.Second * 2)
 					p.voteLock.Unlock()
 					continue
 				}
 
-				p.log.Infof("sending votes for slot %d for %d validators", slotToVote, len(signatures))
+				p.log.With("slot", slotToVote, "validators", len(signatures)).Info("sending votes")
 
 				msg := &p2p.MsgVote{Data: vote}
-				err = p.host.Broadcast(msg)
+				err = p.host.BroadcastVote(msg)
 				if err != nil {
-					p.log.Error(err)
+					p.log.With("slot", slotToVote, "err", err).Error("unable to broadcast vote")
 					voteTimer = time.NewTimer(time.Second * 2)
 					p.voteLock.Unlock()
 					continue
 				}
+
+				if aggregator != nil {
+					// the aggregation ends the subscription.
+					p.subscribedSlot = 0
+					go p.aggregateVotes(data, aggregatorIndex, aggregator, selectionProof)
+				}
 			}
 
+			p.releaseSlot()
 			slotToVote++
+
+			// Subscribe before the votes of the next slot are sent if one of our validators aggregates them.
+			if nextState, err := s.TipStateAtSlot(slotToVote); err == nil {
+				if committee, err := nextState.GetVoteCommittee(slotToVote); err == nil {
+					if key, _, _ := p.selectAggregator(slotToVote, committee, nextState.GetValidatorRegistry()); key != nil {
+						p.subscribeSlot(slotToVote)
+					}
+				}
+			}
+
 			p.voteLock.Unlock()
 			voteTimer = time.NewTimer(time.Until(p.getNextVoteTime(slotToVote)))
 		case <-p.context.Done():
@@ -444,6 +526,97 @@ func (p *proposer) VoteForBlocks() {
 	}
 }
 
+// selectAggregator returns the key, index and selection proof of the first of our validators of the committee selected
+// as aggregator for the slot. The key is nil if none of them is selected.
+func (p *proposer) selectAggregator(slot uint64, committee []uint64, registry []*primitives.Validator) (*bls.SecretKey, uint64, [96]byte) {
+	selectionMsg := primitives.AggregatorSelectionMessage(slot)
+	for _, index := range committee {
+		key, found := p.keystore.GetValidatorKey(registry[index].PubKey)
+		if !found {
+			continue
+		}
+		var proof [96]byte
+		copy(proof[:], key.Sign(selectionMsg[:]).Marshal())
+		if primitives.IsAggregator(uint64(len(committee)), p.netParams.TargetAggregatorsPerSlot, proof) {
+			return key, index, proof
+		}
+	}
+	return nil, 0, [96]byte{}
+}
+
+// startAggregation subscribes to the votes topic for an aggregation.
+func (p *proposer) startAggregation() {
+	p.aggregationsLock.Lock()
+	defer p.aggregationsLock.Unlock()
+	p.aggregations++
+	if p.aggregations == 1 {
+		if err := p.host.SubscribeVotes(); err != nil {
+			p.log.With("err", err).Error("unable to subscribe to the votes topic")
+		}
+	}
+}
+
+// endAggregation unsubscribes from the votes topic once there are no more aggregations.
+func (p *proposer) endAggregation() {
+	p.aggregationsLock.Lock()
+	defer p.aggregationsLock.Unlock()
+	p.aggregations--
+	if p.aggregations == 0 {
+		p.host.UnsubscribeVotes()
+	}
+}
+
+// subscribeSlot subscribes to the votes topic ahead of the aggregation of a slot. A subscription taken for another slot
+// is released.
+func (p *proposer) subscribeSlot(slot uint64) {
+	if p.subscribedSlot == slot {
+		return
+	}
+	p.releaseSlot()
+	p.startAggregation()
+	p.subscribedSlot = slot
+}
+
+// releaseSlot ends the aggregation subscribed ahead for a slot that is not aggregated.
+func (p *proposer) releaseSlot() {
+	if p.subscribedSlot == 0 {
+		return
+	}
+	p.endAggregation()
+	p.subscribedSlot = 0
+}
+
+// aggregateVotes publishes the votes collected for the vote data once the committee had time to send their votes.
+func (p *proposer) aggregateVotes(data *primitives.VoteData, aggregatorIndex uint64, key *bls.SecretKey, selectionProof [96]byte) {
+	defer p.endAggregation()
+
+	select {
+	case <-time.After(time.Until(p.getNextBlockTime(data.Slot))):
+	case <-p.context.Done():
+		return
+	}
+
+	vote, ok := p.voteMempool.GetAggregate(data.Hash())
+	if !ok {
+		return
+	}
+
+	agg := &primitives.AggregateVote{
+		Vote:            vote,
+		AggregatorIndex: aggregatorIndex,
+		SelectionProof:  selectionProof,
+	}
+	msg := agg.SignatureMessage()
+	copy(agg.Signature[:], key.Sign(msg[:]).Marshal())
+
+	p.log.With("slot", data.Slot, "votes", vote.ParticipationBitfield.Count()).Info("sending aggregate")
+
+	err := p.host.Broadcast(&p2p.MsgAggregateVote{Data: agg})
+	if err != nil {
+		p.log.With("slot", data.Slot, "err", err).Error("unable to broadcast aggregate")
+	}
+}
+
 // Start runs the proposer.
 func (p *proposer) Start() error {
 	p.chain.Notify(p)
@@ -481,7 +654,7 @@ check:
 		goto check
 	}
 
-	p.log.Infof("starting proposer with %d/%d active validators", numOurs, numTotal)
+	p.log.With("active", numOurs, "total", numTotal).Info("starting proposer")
 
 	go p.VoteForBlocks()
 	go p.ProposeBlocks()
@@ -0,0 +1,122 @@
+package proposer
+
+import (
+	"context"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/olympus-protocol/ogen/internal/chain"
+	"github.com/olympus-protocol/ogen/internal/hostnode"
+	"github.com/olympus-protocol/ogen/pkg/logger"
+	testdata "github.com/olympus-protocol/ogen/test"
+	"github.com/stretchr/testify/assert"
+)
+
+// syncingHost is a host that is always syncing and tracks the votes topic subscription.
+type syncingHost struct {
+	hostnode.HostNode
+
+	lock       sync.Mutex
+	subscribed bool
+}
+
+func (h *syncingHost) Syncing() bool {
+	return true
+}
+
+func (h *syncingHost) SubscribeVotes() error {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	h.subscribed = true
+	return nil
+}
+
+func (h *syncingHost) UnsubscribeVotes() {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	h.subscribed = false
+}
+
+func (h *syncingHost) isSubscribed() bool {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	return h.subscribed
+}
+
+type genesisChain struct {
+	chain.Blockchain
+	genesis time.Time
+}
+
+func (c genesisChain) GenesisTime() time.Time {
+	return c.genesis
+}
+
+func TestVoteForBlocks_SyncingReleasesAggregation(t *testing.T) {
+	slotDuration := time.Duration(testdata.TestParams.SlotDuration) * time.Second
+	host := &syncingHost{}
+	ctx, cancel := context.WithCancel(context.Background())
+	p := &proposer{
+		log:       logger.New(os.Stdout).Quiet(),
+		netParams: &testdata.TestParams,
+		// the vote time of the next slot already passed.
+		chain:   genesisChain{genesis: time.Now().Add(-10*slotDuration - slotDuration/2)},
+		context: ctx,
+		stop:    cancel,
+		host:    host,
+	}
+
+	// the votes topic was subscribed for the aggregation of the next slot before the node started syncing.
+	p.subscribeSlot(p.getCurrentSlot() + 1)
+	assert.True(t, host.isSubscribed())
+
+	done := make(chan struct{})
+	go func() {
+		p.VoteForBlocks()
+		close(done)
+	}()
+
+	assert.Eventually(t, func() bool {
+		return !host.isSubscribed()
+	}, 5*time.Second, 10*time.Millisecond)
+
+	p.aggregationsLock.Lock()
+	assert.Zero(t, p.aggregations)
+	p.aggregationsLock.Unlock()
+
+	// stopping the voter doesn't end the released aggregation again.
+	cancel()
+	<-done
+	assert.Zero(t, p.aggregations)
+	assert.False(t, host.isSubscribed())
+}
+
+func TestVoteForBlocks_StopReleasesAggregation(t *testing.T) {
+	host := &syncingHost{}
+	ctx, cancel := context.WithCancel(context.Background())
+	p := &proposer{
+		log:       logger.New(os.Stdout).Quiet(),
+		netParams: &testdata.TestParams,
+		// the vote time of the next slot is not reached before the voter stops.
+		chain:   genesisChain{genesis: time.Now()},
+		context: ctx,
+		stop:    cancel,
+		host:    host,
+	}
+
+	p.subscribeSlot(p.getCurrentSlot() + 1)
+	assert.True(t, host.isSubscribed())
+
+	done := make(chan struct{})
+	go func() {
+		p.VoteForBlocks()
+		close(done)
+	}()
+	cancel()
+	<-done
+
+	assert.Zero(t, p.aggregations)
+	assert.False(t, host.isSubscribed())
+}
@@ -10,7 +10,9 @@ import (
 	"github.com/olympus-protocol/ogen/internal/hostnode"
 	"github.com/olympus-protocol/ogen/internal/keystore"
 	"github.com/olympus-protocol/ogen/internal/mempool"
+	"github.com/olympus-protocol/ogen/internal/notifier"
 	"github.com/olympus-protocol/ogen/internal/proposer"
+	"github.com/olympus-protocol/ogen/internal/slasher"
 	"github.com/olympus-protocol/ogen/internal/wallet"
 	"github.com/olympus-protocol/ogen/pkg/bls"
 	"github.com/olympus-protocol/ogen/pkg/logger"
@@ -32,6 +34,8 @@ type server struct {
 	hn        hostnode.HostNode
 	rpc       chainrpc.RPCServer
 	prop      proposer.Proposer
+	slasher   slasher.Slasher
+	notifier  notifier.Notifier
 	dashboard *dashboard.Dashboard
 }
 
@@ -65,6 +69,18 @@ func (s *server) Start() {
 	if err != nil {
 		s.log.Fatal("unable to start proposer")
 	}
+	if s.slasher != nil {
+		err = s.slasher.Start()
+		if err != nil {
+			s.log.Fatal("unable to start slasher")
+		}
+	}
+	if s.notifier != nil {
+		err = s.notifier.Start()
+		if err != nil {
+			s.log.Fatal("unable to start notifier")
+		}
+	}
 	if config.GlobalFlags.Dashboard {
 		go func() {
 			err = s.dashboard.Start()
@@ -79,6 +95,19 @@ func (s *server) Start() {
 func (s *server) Stop() error {
 	s.ch.Stop()
 	s.rpc.Stop()
+	if s.dashboard != nil {
+		if err := s.dashboard.Stop(); err != nil {
+			return err
+		}
+	}
+	if s.notifier != nil {
+		if err := s.notifier.Stop(); err != nil {
+			return err
+		}
+	}
+	if s.slasher != nil {
+		return s.slasher.Stop()
+	}
 	return nil
 }
 
@@ -114,6 +143,16 @@ func NewServer(db blockdb.Database) (Server, error) {
 		return nil, err
 	}
 
+	ctpool, err := mempool.NewContractsMempool(ch, hn)
+	if err != nil {
+		return nil, err
+	}
+
+	aspool, err := mempool.NewAssetsMempool(ch, hn)
+	if err != nil {
+		return nil, err
+	}
+
 	vpool, err := mempool.NewVoteMempool(ch, hn, lam)
 	if err != nil {
 		return nil, err
@@ -126,19 +165,19 @@ func NewServer(db blockdb.Database) (Server, error) {
 
 	vpool.Notify(apool)
 
-	w, err := wallet.NewWallet(ch, hn, cpool, apool)
+	w, err := wallet.NewWallet(ch, hn, cpool, ctpool, aspool, apool)
 	if err != nil {
 		return nil, err
 	}
 
 	ks := keystore.NewKeystore()
 
-	prop, err := proposer.NewProposer(ch, hn, vpool, cpool, apool, lam, ks)
+	prop, err := proposer.NewProposer(ch, hn, vpool, cpool, ctpool, aspool, apool, lam, ks)
 	if err != nil {
 		return nil, err
 	}
 
-	rpc, err := chainrpc.NewRPCServer(ch, hn, w, ks, cpool)
+	rpc, err := chainrpc.NewRPCServer(ch, hn, w, ks, cpool, ctpool, aspool)
 	if err != nil {
 		return nil, err
 	}
@@ -152,8 +191,28 @@ func NewServer(db blockdb.Database) (Server, error) {
 		prop: prop,
 	}
 
+	if config.GlobalFlags.Slasher {
+		s.slasher, err = slasher.NewSlasher(ch, vpool, apool)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(config.GlobalFlags.Webhooks) > 0 {
+		s.notifier, err = notifier.NewNotifier(ch, cpool, notifier.Config{
+			URLs:        config.GlobalFlags.Webhooks,
+			Secret:      config.GlobalFlags.WebhookSecret,
+			Addresses:   config.GlobalFlags.WebhookAddresses,
+			Validators:  config.GlobalFlags.WebhookValidators,
+			MaxAttempts: config.GlobalFlags.WebhookAttempts,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	if config.GlobalFlags.Dashboard {
-		s.dashboard, err = dashboard.NewDashboard(hn, ch, prop)
+		s.dashboard, err = dashboard.NewDashboard(hn, ch, prop, cpool)
 		if err != nil {
 			return nil, err
 		}
@@ -0,0 +1,224 @@
+package slasher
+
+import (
+	"encoding/binary"
+	"errors"
+
+	"github.com/olympus-protocol/ogen/internal/blockdb"
+	"github.com/olympus-protocol/ogen/pkg/chainhash"
+	"github.com/olympus-protocol/ogen/pkg/primitives"
+)
+
+var (
+	votePrefix          = []byte("vote-")
+	validatorVotePrefix = []byte("validator-vote-")
+	proposalPrefix      = []byte("proposal-")
+)
+
+// DB keeps the history of votes and block headers seen by the slasher.
+//
+// Votes are stored once by hash and each validator that participates on a vote gets a record keyed by the validator
+// index and the vote target epoch. Block headers are keyed by the proposer public key and the block slot.
+type DB struct {
+	store blockdb.Store
+}
+
+// NewDB creates a slasher database over a key-value store.
+func NewDB(store blockdb.Store) *DB {
+	return &DB{store: store}
+}
+
+// Close closes the underlying store.
+func (db *DB) Close() error {
+	return db.store.Close()
+}
+
+func validatorVoteKey(validator uint64, toEpoch uint64, dataHash chainhash.Hash) []byte {
+	key := make([]byte, 0, len(validatorVotePrefix)+48)
+	key = append(key, validatorVotePrefix...)
+	key = appendUint64(key, validator)
+	key = appendUint64(key, toEpoch)
+	return append(key, dataHash[:]...)
+}
+
+func proposalKey(pub [48]byte, slot uint64, headerHash chainhash.Hash) []byte {
+	key := make([]byte, 0, len(proposalPrefix)+88)
+	key = append(key, proposalPrefix...)
+	key = append(key, pub[:]...)
+	key = appendUint64(key, slot)
+	return append(key, headerHash[:]...)
+}
+
+// appendUint64 appends a big endian integer so keys are iterated in numeric order.
+func appendUint64(b []byte, n uint64) []byte {
+	var buf [8]byte
+	binary.BigEndian.PutUint64(buf[:], n)
+	return append(b, buf[:]...)
+}
+
+func (db *DB) getVote(h chainhash.Hash) (*primitives.MultiValidatorVote, error) {
+	b, err := db.store.Get(append(append([]byte{}, votePrefix...), h[:]...))
+	if err != nil {
+		return nil, err
+	}
+	vote := new(primitives.MultiValidatorVote)
+	if err := vote.Unmarshal(b); err != nil {
+		return nil, err
+	}
+	return vote, nil
+}
+
+// AddVote records a vote for the validators of the committee that participate on it and returns the slashings it
+// forms with the votes already recorded. The committee must be the vote committee of the vote slot.
+func (db *DB) AddVote(vote *primitives.MultiValidatorVote, committee []uint64) ([]*primitives.VoteSlashing, error) {
+	if vote.Data == nil {
+		return nil, errors.New("vote without data")
+	}
+
+	voteBytes, err := vote.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	voteHash := chainhash.HashH(voteBytes)
+	// The nonce is not part of the data hash, votes that only differ on the nonce are not slashable.
+	dataHash := vote.Data.Hash()
+
+	var slashings []*primitives.VoteSlashing
+	reported := make(map[chainhash.Hash]struct{})
+
+	batch := blockdb.NewBatch()
+	stored := false
+
+	for i, validator := range committee {
+		if !vote.ParticipationBitfield.Get(uint(i)) {
+			continue
+		}
+
+		key := validatorVoteKey(validator, vote.Data.ToEpoch, dataHash)
+		if _, err := db.store.Get(key); err == nil {
+			continue
+		}
+
+		found, err := db.findSlashableVotes(validator, vote.Data, dataHash)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, h := range found {
+			if _, ok := reported[h]; ok {
+				continue
+			}
+			reported[h] = struct{}{}
+
+			other, err := db.getVote(h)
+			if err != nil {
+				return nil, err
+			}
+
+			// The state expects the surrounding vote first.
+			slashing := &primitives.VoteSlashing{Vote1: vote, Vote2: other}
+			if other.Data.IsSurroundVote(vote.Data) {
+				slashing = &primitives.VoteSlashing{Vote1: other, Vote2: vote}
+			}
+			slashings = append(slashings, slashing)
+		}
+
+		value := make([]byte, 0, 40)
+		value = appendUint64(value, vote.Data.FromEpoch)
+		value = append(value, voteHash[:]...)
+		batch.Put(key, value)
+		stored = true
+	}
+
+	if stored {
+		batch.Put(append(append([]byte{}, votePrefix...), voteHash[:]...), voteBytes)
+	}
+
+	if err := db.store.Write(batch); err != nil {
+		return nil, err
+	}
+
+	return slashings, nil
+}
+
+// findSlashableVotes returns the hashes of the recorded votes of a validator that are double or surround votes with
+// the vote data.
+func (db *DB) findSlashableVotes(validator uint64, data *primitives.VoteData, dataHash chainhash.Hash) ([]chainhash.Hash, error) {
+	prefix := append(append([]byte{}, validatorVotePrefix...), make([]byte, 8)...)
+	binary.BigEndian.PutUint64(prefix[len(validatorVotePrefix):], validator)
+
+	var found []chainhash.Hash
+	err := db.store.Iterate(prefix, func(key []byte, value []byte) error {
+		if len(key) != len(prefix)+40 || len(value) != 40 {
+			return nil
+		}
+
+		toEpoch := binary.BigEndian.Uint64(key[len(prefix):])
+		var otherDataHash chainhash.Hash
+		copy(otherDataHash[:], key[len(prefix)+8:])
+
+		other := &primitives.VoteData{
+			FromEpoch: binary.BigEndian.Uint64(value[:8]),
+			ToEpoch:   toEpoch,
+		}
+
+		double := toEpoch == data.ToEpoch && !otherDataHash.IsEqual(&dataHash)
+		if double || other.IsSurroundVote(data) || data.IsSurroundVote(other) {
+			var h chainhash.Hash
+			copy(h[:], value[8:])
+			found = append(found, h)
+		}
+		return nil
+	})
+
+	return found, err
+}
+
+// AddProposal records a signed block header and returns a proposer slashing if the proposer already signed a different
+// header for the same slot.
+func (db *DB) AddProposal(header *primitives.BlockHeader, sig [96]byte, pub [48]byte) (*primitives.ProposerSlashing, error) {
+	headerHash := header.Hash()
+	key := proposalKey(pub, header.Slot, headerHash)
+
+	if _, err := db.store.Get(key); err == nil {
+		return nil, nil
+	}
+
+	prefix := key[:len(proposalPrefix)+56]
+
+	var slashing *primitives.ProposerSlashing
+	err := db.store.Iterate(prefix, func(k []byte, value []byte) error {
+		if slashing != nil || len(value) < 96 {
+			return nil
+		}
+		other := new(primitives.BlockHeader)
+		if err := other.Unmarshal(value[96:]); err != nil {
+			return err
+		}
+		var otherSig [96]byte
+		copy(otherSig[:], value[:96])
+
+		slashing = &primitives.ProposerSlashing{
+			BlockHeader1:       header,
+			BlockHeader2:       other,
+			Signature1:         sig,
+			Signature2:         otherSig,
+			ValidatorPublicKey: pub,
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	headerBytes, err := header.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := db.store.Put(key, append(sig[:], headerBytes...)); err != nil {
+		return nil, err
+	}
+
+	return slashing, nil
+}
@@ -0,0 +1,120 @@
+package slasher_test
+
+import (
+	"testing"
+
+	"github.com/olympus-protocol/ogen/internal/blockdb"
+	"github.com/olympus-protocol/ogen/internal/slasher"
+	"github.com/olympus-protocol/ogen/pkg/bitfield"
+	"github.com/olympus-protocol/ogen/pkg/primitives"
+	"github.com/stretchr/testify/assert"
+)
+
+func newVote(from, to uint64, beacon byte, voters ...uint) *primitives.MultiValidatorVote {
+	bl := bitfield.NewBitlist(8)
+	for _, v := range voters {
+		bl.Set(v)
+	}
+	return &primitives.MultiValidatorVote{
+		Data: &primitives.VoteData{
+			Slot:            to*5 + 1,
+			FromEpoch:       from,
+			ToEpoch:         to,
+			BeaconBlockHash: [32]byte{beacon},
+		},
+		ParticipationBitfield: bl,
+	}
+}
+
+func TestDB_AddVote(t *testing.T) {
+	db := slasher.NewDB(blockdb.NewMemoryStore())
+	defer db.Close()
+
+	committee := []uint64{10, 11, 12, 13, 14, 15, 16, 17}
+
+	slashings, err := db.AddVote(newVote(1, 2, 1, 0, 1), committee)
+	assert.NoError(t, err)
+	assert.Len(t, slashings, 0)
+
+	// The same vote again, or a vote for the same data by other validators, is not slashable.
+	slashings, err = db.AddVote(newVote(1, 2, 1, 0, 1, 2), committee)
+	assert.NoError(t, err)
+	assert.Len(t, slashings, 0)
+
+	// Votes that only differ on the nonce are not slashable.
+	nonce := newVote(1, 2, 1, 0, 1)
+	nonce.Data.Nonce = 7
+	slashings, err = db.AddVote(nonce, committee)
+	assert.NoError(t, err)
+	assert.Len(t, slashings, 0)
+
+	// Validators that don't overlap can vote anything.
+	slashings, err = db.AddVote(newVote(1, 2, 2, 3), committee)
+	assert.NoError(t, err)
+	assert.Len(t, slashings, 0)
+
+	// Double vote.
+	double := newVote(1, 2, 3, 1, 2)
+	slashings, err = db.AddVote(double, committee)
+	assert.NoError(t, err)
+	assert.Len(t, slashings, 2)
+	for _, s := range slashings {
+		assert.Equal(t, double, s.Vote1)
+		assert.True(t, s.Vote1.Data.IsDoubleVote(s.Vote2.Data))
+	}
+
+	// Surround vote, the surrounding vote goes first.
+	slashings, err = db.AddVote(newVote(4, 8, 1, 5), committee)
+	assert.NoError(t, err)
+	assert.Len(t, slashings, 0)
+
+	surrounded := newVote(5, 6, 1, 5)
+	slashings, err = db.AddVote(surrounded, committee)
+	assert.NoError(t, err)
+	assert.Len(t, slashings, 1)
+	assert.Equal(t, surrounded, slashings[0].Vote2)
+	assert.True(t, slashings[0].Vote1.Data.IsSurroundVote(slashings[0].Vote2.Data))
+
+	surrounding := newVote(3, 9, 1, 5)
+	slashings, err = db.AddVote(surrounding, committee)
+	assert.NoError(t, err)
+	assert.Len(t, slashings, 2)
+	for _, s := range slashings {
+		assert.Equal(t, surrounding, s.Vote1)
+		assert.True(t, s.Vote1.Data.IsSurroundVote(s.Vote2.Data))
+	}
+}
+
+func TestDB_AddProposal(t *testing.T) {
+	db := slasher.NewDB(blockdb.NewMemoryStore())
+	defer db.Close()
+
+	pub := [48]byte{1}
+	h1 := &primitives.BlockHeader{Slot: 5, Nonce: 1}
+	h2 := &primitives.BlockHeader{Slot: 5, Nonce: 2}
+
+	slashing, err := db.AddProposal(h1, [96]byte{1}, pub)
+	assert.NoError(t, err)
+	assert.Nil(t, slashing)
+
+	slashing, err = db.AddProposal(h1, [96]byte{1}, pub)
+	assert.NoError(t, err)
+	assert.Nil(t, slashing)
+
+	slashing, err = db.AddProposal(&primitives.BlockHeader{Slot: 6}, [96]byte{3}, pub)
+	assert.NoError(t, err)
+	assert.Nil(t, slashing)
+
+	slashing, err = db.AddProposal(h2, [96]byte{2}, [48]byte{2})
+	assert.NoError(t, err)
+	assert.Nil(t, slashing)
+
+	slashing, err = db.AddProposal(h2, [96]byte{2}, pub)
+	assert.NoError(t, err)
+	assert.NotNil(t, slashing)
+	assert.Equal(t, h2, slashing.BlockHeader1)
+	assert.Equal(t, h1, slashing.BlockHeader2)
+	assert.Equal(t, [96]byte{2}, slashing.Signature1)
+	assert.Equal(t, [96]byte{1}, slashing.Signature2)
+	assert.Equal(t, pub, slashing.ValidatorPublicKey)
+}
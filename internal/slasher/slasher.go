@@ -0,0 +1,156 @@
+package slasher
+
+import (
+	"context"
+	"path"
+
+	"github.com/olympus-protocol/ogen/cmd/ogen/config"
+	"github.com/olympus-protocol/ogen/internal/blockdb"
+	"github.com/olympus-protocol/ogen/internal/chain"
+	"github.com/olympus-protocol/ogen/internal/chainindex"
+	"github.com/olympus-protocol/ogen/internal/mempool"
+	"github.com/olympus-protocol/ogen/internal/state"
+	"github.com/olympus-protocol/ogen/pkg/logger"
+	"github.com/olympus-protocol/ogen/pkg/primitives"
+)
+
+// observedQueueSize is the amount of observed votes and blocks that can wait to be checked.
+const observedQueueSize = 1024
+
+// Slasher is the interface for slasher
+type Slasher interface {
+	NewTip(row *chainindex.BlockRow, block *primitives.Block, s state.State, receipts []*primitives.EpochReceipt)
+	ProposerSlashingConditionViolated(slashing *primitives.ProposerSlashing)
+	ObserveVote(vote *primitives.MultiValidatorVote, committee []uint64)
+	Start() error
+	Stop() error
+}
+
+var _ Slasher = &slasher{}
+
+type observedVote struct {
+	vote      *primitives.MultiValidatorVote
+	committee []uint64
+}
+
+type observedProposal struct {
+	header *primitives.BlockHeader
+	sig    [96]byte
+	pub    [48]byte
+}
+
+// slasher records every vote and block header seen by the node and reports the double votes, surround votes and
+// double proposals found across the whole history to the action mempool.
+type slasher struct {
+	log     logger.Logger
+	ctx     context.Context
+	cancel  context.CancelFunc
+	chain   chain.Blockchain
+	db      *DB
+	actions mempool.ActionMempool
+
+	votes     chan *observedVote
+	proposals chan *observedProposal
+}
+
+// NewTip records the header and the votes of a processed block.
+func (s *slasher) NewTip(_ *chainindex.BlockRow, block *primitives.Block, st state.State, _ []*primitives.EpochReceipt) {
+	pub, err := st.GetProposerPublicKey(block)
+	if err == nil {
+		p := &observedProposal{header: block.Header, sig: block.Signature}
+		copy(p.pub[:], pub.Marshal())
+		select {
+		case s.proposals <- p:
+		default:
+			s.log.Warnf("slasher queue is full, skipping block at slot %d", block.Header.Slot)
+		}
+	}
+
+	for _, v := range block.Votes {
+		committee, err := st.GetVoteCommittee(v.Data.Slot)
+		if err != nil {
+			continue
+		}
+		s.ObserveVote(v, committee)
+	}
+}
+
+// ProposerSlashingConditionViolated implements the chain notifee. Duplicate blocks are reported by the chain itself.
+func (s *slasher) ProposerSlashingConditionViolated(_ *primitives.ProposerSlashing) {}
+
+// ObserveVote queues a validated vote to be recorded and checked.
+func (s *slasher) ObserveVote(vote *primitives.MultiValidatorVote, committee []uint64) {
+	select {
+	case s.votes <- &observedVote{vote: vote, committee: committee}:
+	default:
+		s.log.Warnf("slasher queue is full, skipping vote for slot %d", vote.Data.Slot)
+	}
+}
+
+func (s *slasher) run() {
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case v := <-s.votes:
+			slashings, err := s.db.AddVote(v.vote, v.committee)
+			if err != nil {
+				s.log.Errorf("unable to record vote: %s", err)
+				continue
+			}
+			for _, sl := range slashings {
+				s.log.Warnf("found slashable votes %s and %s, reporting...", sl.Vote1.Data, sl.Vote2.Data)
+				s.actions.NotifyIllegalVotes(sl)
+			}
+		case p := <-s.proposals:
+			slashing, err := s.db.AddProposal(p.header, p.sig, p.pub)
+			if err != nil {
+				s.log.Errorf("unable to record block header: %s", err)
+				continue
+			}
+			if slashing != nil {
+				s.log.Warnf("found double proposal at slot %d, reporting...", p.header.Slot)
+				s.actions.ProposerSlashingConditionViolated(slashing)
+			}
+		}
+	}
+}
+
+// Start starts recording the votes and blocks.
+func (s *slasher) Start() error {
+	s.chain.Notify(s)
+	go s.run()
+	return nil
+}
+
+// Stop stops the slasher and closes its database.
+func (s *slasher) Stop() error {
+	s.chain.Unnotify(s)
+	s.cancel()
+	return s.db.Close()
+}
+
+// NewSlasher creates a slasher that stores its history on the datapath with the configured database backend.
+func NewSlasher(ch chain.Blockchain, votes mempool.VoteMempool, actions mempool.ActionMempool) (Slasher, error) {
+	store, err := blockdb.OpenStore(config.GlobalFlags.DBBackend, path.Join(config.GlobalFlags.DataPath, "slasher"))
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(config.GlobalParams.Context)
+
+	s := &slasher{
+		log:       config.GlobalParams.Logger.Module("slasher"),
+		ctx:       ctx,
+		cancel:    cancel,
+		chain:     ch,
+		db:        NewDB(store),
+		actions:   actions,
+		votes:     make(chan *observedVote, observedQueueSize),
+		proposals: make(chan *observedProposal, observedQueueSize),
+	}
+
+	votes.Observe(s)
+
+	return s, nil
+}
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"github.com/olympus-protocol/ogen/cmd/ogen/config"
+	"github.com/olympus-protocol/ogen/internal/execution"
 	"github.com/olympus-protocol/ogen/pkg/bitfield"
 	"github.com/olympus-protocol/ogen/pkg/bls"
 	"github.com/olympus-protocol/ogen/pkg/burnproof"
@@ -230,11 +231,19 @@ func (s *state) ApplyTransactionsSingle(txs []*primitives.Tx, blockWithdrawalAdd
 		txsPubs[i] = pub
 	}
 
-	sig := bls.AggregateSignatures(txsSigs)
+	if s.sigBatch != nil {
+		for i, tx := range txs {
+			if err := s.verifySignature(txsSigs[i], txsPubs[i], txsMsgs[i], fmt.Errorf("tx %s: %w", tx.Hash(), primitives.ErrorInvalidSignature)); err != nil {
+				return err
+			}
+		}
+	} else {
+		sig := bls.AggregateSignatures(txsSigs)
 
-	valid := sig.AggregateVerify(txsPubs, txsMsgs)
-	if !valid {
-		return errors.New("invalid txs signatures")
+		valid := sig.AggregateVerify(txsPubs, txsMsgs)
+		if !valid {
+			return errors.New("invalid txs signatures")
+		}
 	}
 
 	for _, tx := range txs {
@@ -246,6 +255,7 @@ func (s *state) ApplyTransactionsSingle(txs []*primitives.Tx, blockWithdrawalAdd
 		u.Balances[tx.To] += tx.Amount
 		u.Balances[blockWithdrawalAddress] += tx.Fee
 		u.Nonces[pkh] = tx.Nonce
+		s.trees.markAccounts(pkh, tx.To, blockWithdrawalAddress)
 
 		if _, ok := s.Governance.ReplaceVotes[pkh]; u.Balances[pkh] < netParams.UnitsPerCoin*netParams.MinVotingBalance && ok {
 			delete(s.Governance.ReplaceVotes, pkh)
@@ -271,7 +281,7 @@ func (s *state) ApplyTransactionSingle(tx *primitives.Tx, blockWithdrawalAddress
 		return fmt.Errorf("nonce is too small (already processed: %d, trying: %d)", u.Nonces[pkh], tx.Nonce)
 	}
 
-	if err := tx.VerifySig(); err != nil {
+	if err := s.verifyRawSignature(tx.Signature, tx.FromPublicKey, tx.SignatureMessage(), fmt.Errorf("tx %s: %w", tx.Hash(), primitives.ErrorInvalidSignature)); err != nil {
 		return err
 	}
 
@@ -279,6 +289,7 @@ func (s *state) ApplyTransactionSingle(tx *primitives.Tx, blockWithdrawalAddress
 	u.Balances[tx.To] += tx.Amount
 	u.Balances[blockWithdrawalAddress] += tx.Fee
 	u.Nonces[pkh] = tx.Nonce
+	s.trees.markAccounts(pkh, tx.To, blockWithdrawalAddress)
 
 	if _, ok := s.Governance.ReplaceVotes[pkh]; u.Balances[pkh] < netParams.UnitsPerCoin*netParams.MinVotingBalance && ok {
 		delete(s.Governance.ReplaceVotes, pkh)
@@ -312,6 +323,210 @@ func (s *state) ApplyTransactionMulti(tx *primitives.TxMulti, blockWithdrawalAdd
 	u.Balances[tx.To] += tx.Amount
 	u.Balances[blockWithdrawalAddress] += tx.Fee
 	u.Nonces[pkh] = tx.Nonce
+	s.trees.markAccounts(pkh, tx.To, blockWithdrawalAddress)
+
+	if _, ok := s.Governance.ReplaceVotes[pkh]; u.Balances[pkh] < netParams.UnitsPerCoin*netParams.MinVotingBalance && ok {
+		delete(s.Governance.ReplaceVotes, pkh)
+	}
+
+	return nil
+}
+
+var contractEngine = execution.NewExecutionInstance()
+
+// isContractTxValid checks the fields shared by contract deploys and calls against the coin state.
+func (s *state) isContractTxValid(pkh [20]byte, nonce uint64, gasPrice uint64, maxCost uint64) error {
+	netParams := config.GlobalParams.NetParams
+
+	u := s.CoinsState
+	if gasPrice < netParams.MinContractGasPrice {
+		return fmt.Errorf("gas price %d is below the minimum of %d", gasPrice, netParams.MinContractGasPrice)
+	}
+
+	if u.Balances[pkh] < maxCost {
+		return fmt.Errorf("insufficient balance of %d for %d contract transaction", u.Balances[pkh], maxCost)
+	}
+
+	if u.Nonces[pkh] >= nonce {
+		return fmt.Errorf("nonce is too small (already processed: %d, trying: %d)", u.Nonces[pkh], nonce)
+	}
+
+	return nil
+}
+
+// payContractGas charges the gas used by a contract transaction and updates the account nonce.
+func (s *state) payContractGas(pkh [20]byte, nonce uint64, gasUsed uint64, gasPrice uint64, blockWithdrawalAddress [20]byte) {
+	netParams := config.GlobalParams.NetParams
+
+	u := s.CoinsState
+	fee := gasUsed * gasPrice
+	u.Balances[pkh] -= fee
+	u.Balances[blockWithdrawalAddress] += fee
+	u.Nonces[pkh] = nonce
+	s.trees.markAccounts(pkh, blockWithdrawalAddress)
+
+	if _, ok := s.Governance.ReplaceVotes[pkh]; u.Balances[pkh] < netParams.UnitsPerCoin*netParams.MinVotingBalance && ok {
+		delete(s.Governance.ReplaceVotes, pkh)
+	}
+}
+
+// ApplyContractDeploy deploys a contract and pays the gas used. A failed execution is still applied and charged, only
+// the changes made by the contract are discarded.
+func (s *state) ApplyContractDeploy(tx *primitives.ContractDeploy, blockWithdrawalAddress [20]byte) (*execution.Receipt, error) {
+	pkh, err := tx.FromPubkeyHash()
+	if err != nil {
+		return nil, err
+	}
+
+	maxCost, err := tx.MaxCost()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.isContractTxValid(pkh, tx.Nonce, tx.GasPrice, maxCost); err != nil {
+		return nil, err
+	}
+
+	if err := s.verifyRawSignature(tx.Signature, tx.FromPublicKey, tx.SignatureMessage(), fmt.Errorf("contract deploy %s: %w", tx.Hash(), primitives.ErrorInvalidSignature)); err != nil {
+		return nil, err
+	}
+
+	receipt, err := contractEngine.Deploy(s.contractHost(), pkh, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	s.payContractGas(pkh, tx.Nonce, receipt.GasUsed, tx.GasPrice, blockWithdrawalAddress)
+
+	return receipt, nil
+}
+
+// ApplyContractCall executes a contract call and pays the gas used. A failed execution is still applied and charged,
+// only the changes made by the contract are discarded.
+func (s *state) ApplyContractCall(tx *primitives.ContractCall, blockWithdrawalAddress [20]byte) (*execution.Receipt, error) {
+	pkh, err := tx.FromPubkeyHash()
+	if err != nil {
+		return nil, err
+	}
+
+	maxCost, err := tx.MaxCost()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.isContractTxValid(pkh, tx.Nonce, tx.GasPrice, maxCost); err != nil {
+		return nil, err
+	}
+
+	if err := s.verifyRawSignature(tx.Signature, tx.FromPublicKey, tx.SignatureMessage(), fmt.Errorf("contract call %s: %w", tx.Hash(), primitives.ErrorInvalidSignature)); err != nil {
+		return nil, err
+	}
+
+	receipt, err := contractEngine.Call(s.contractHost(), pkh, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	s.payContractGas(pkh, tx.Nonce, receipt.GasUsed, tx.GasPrice, blockWithdrawalAddress)
+
+	return receipt, nil
+}
+
+// ContractReceipts returns the receipts of the contract deploys and calls of the last block applied to the state.
+func (s *state) ContractReceipts() []*execution.Receipt {
+	return s.contractReceipts
+}
+
+func (s *state) contractHost() *execution.Host {
+	if s.ContractsState.Contracts == nil {
+		s.ContractsState.Contracts = make(map[[20]byte]*primitives.Contract)
+	}
+	return &execution.Host{
+		Coins:     &s.CoinsState,
+		Contracts: &s.ContractsState,
+		Slot:      s.Slot,
+		Observer:  contractObserver{s.trees},
+	}
+}
+
+// ApplyAssetTx applies an asset issue, transfer or burn to the coin state. The fee is paid with native coins.
+func (s *state) ApplyAssetTx(tx *primitives.AssetTx, blockWithdrawalAddress [20]byte) error {
+	netParams := config.GlobalParams.NetParams
+
+	if s.CoinsState.Assets == nil {
+		s.CoinsState.Assets = make(map[[20]byte]*primitives.Asset)
+	}
+
+	u := s.CoinsState
+	pkh, err := tx.FromPubkeyHash()
+	if err != nil {
+		return err
+	}
+
+	if !tx.Valid() {
+		return fmt.Errorf("unknown asset transaction type %d", tx.Type)
+	}
+
+	if u.Balances[pkh] < tx.Fee {
+		return fmt.Errorf("insufficient balance of %d for %d asset transaction fee", u.Balances[pkh], tx.Fee)
+	}
+
+	if u.Nonces[pkh] >= tx.Nonce {
+		return fmt.Errorf("nonce is too small (already processed: %d, trying: %d)", u.Nonces[pkh], tx.Nonce)
+	}
+
+	asset, exists := u.Assets[tx.Asset]
+
+	switch tx.Type {
+	case primitives.AssetIssue:
+		if !exists {
+			if tx.Asset != primitives.AssetID(pkh, tx.Nonce) {
+				return fmt.Errorf("asset %s doesn't exist", hex.EncodeToString(tx.Asset[:]))
+			}
+			asset = &primitives.Asset{Issuer: pkh, Balances: make(map[[20]byte]uint64)}
+		}
+		if asset.Issuer != pkh {
+			return fmt.Errorf("only the asset issuer can issue units of %s", hex.EncodeToString(tx.Asset[:]))
+		}
+		if asset.Supply+tx.Amount < asset.Supply {
+			return fmt.Errorf("asset %s supply overflows", hex.EncodeToString(tx.Asset[:]))
+		}
+	default:
+		if !exists {
+			return fmt.Errorf("asset %s doesn't exist", hex.EncodeToString(tx.Asset[:]))
+		}
+		if asset.Balances[pkh] < tx.Amount {
+			return fmt.Errorf("insufficient asset balance of %d for %d transaction", asset.Balances[pkh], tx.Amount)
+		}
+	}
+
+	if err := s.verifyRawSignature(tx.Signature, tx.FromPublicKey, tx.SignatureMessage(), fmt.Errorf("asset tx %s: %w", tx.Hash(), primitives.ErrorInvalidSignature)); err != nil {
+		return err
+	}
+
+	switch tx.Type {
+	case primitives.AssetIssue:
+		asset.Supply += tx.Amount
+		asset.Balances[tx.To] += tx.Amount
+	case primitives.AssetTransfer:
+		asset.Balances[pkh] -= tx.Amount
+		asset.Balances[tx.To] += tx.Amount
+	case primitives.AssetBurn:
+		asset.Balances[pkh] -= tx.Amount
+		asset.Supply -= tx.Amount
+	}
+
+	if asset.Balances[pkh] == 0 {
+		delete(asset.Balances, pkh)
+	}
+
+	u.Assets[tx.Asset] = asset
+
+	u.Balances[pkh] -= tx.Fee
+	u.Balances[blockWithdrawalAddress] += tx.Fee
+	u.Nonces[pkh] = tx.Nonce
+	s.trees.markAccounts(pkh, blockWithdrawalAddress)
+	s.trees.markAsset(tx.Asset, pkh, tx.To)
 
 	if _, ok := s.Governance.ReplaceVotes[pkh]; u.Balances[pkh] < netParams.UnitsPerCoin*netParams.MinVotingBalance && ok {
 		delete(s.Governance.ReplaceVotes, pkh)
@@ -375,12 +590,12 @@ func (s *state) IsProposerSlashingValid(ps *primitives.ProposerSlashing) (uint64
 	if err != nil {
 		return 0, err
 	}
-	if !s1.Verify(pub, h1[:]) {
-		return 0, fmt.Errorf("proposer-slashing: signature does not validate for block header 1")
+	if err := s.verifySignature(s1, pub, h1, fmt.Errorf("proposer-slashing: signature does not validate for block header 1")); err != nil {
+		return 0, err
 	}
 
-	if !s2.Verify(pub, h2[:]) {
-		return 0, fmt.Errorf("proposer-slashing: signature does not validate for block header 2")
+	if err := s.verifySignature(s2, pub, h2, fmt.Errorf("proposer-slashing: signature does not validate for block header 2")); err != nil {
+		return 0, err
 	}
 
 	pubkeyBytes := ps.ValidatorPublicKey
@@ -471,8 +686,8 @@ func (s *state) IsVoteSlashingValid(vs *primitives.VoteSlashing) ([]uint64, erro
 	if err != nil {
 		return nil, err
 	}
-	if !v1Sig.FastAggregateVerify(aggPubs1, vs.Vote1.Data.Hash()) {
-		return nil, fmt.Errorf("vote-slashing: vote 1 does not validate")
+	if err := s.verifyAggregateSignature(v1Sig, aggPubs1, vs.Vote1.Data.Hash(), fmt.Errorf("vote-slashing: vote 1 does not validate")); err != nil {
+		return nil, err
 	}
 
 	if len(common) == 0 {
@@ -483,8 +698,8 @@ func (s *state) IsVoteSlashingValid(vs *primitives.VoteSlashing) ([]uint64, erro
 	if err != nil {
 		return nil, err
 	}
-	if !v2Sig.FastAggregateVerify(aggPubs2, vs.Vote2.Data.Hash()) {
-		return nil, fmt.Errorf("vote-slashing: vote 2 does not validate")
+	if err := s.verifyAggregateSignature(v2Sig, aggPubs2, vs.Vote2.Data.Hash(), fmt.Errorf("vote-slashing: vote 2 does not validate")); err != nil {
+		return nil, err
 	}
 
 	return common, nil
@@ -522,8 +737,8 @@ func (s *state) IsRANDAOSlashingValid(rs *primitives.RANDAOSlashing) (uint64, er
 	if err != nil {
 		return 0, err
 	}
-	if !sig.Verify(pub, slotHash[:]) {
-		return 0, fmt.Errorf("randao-slashing: RANDAO reveal does not verify")
+	if err := s.verifySignature(sig, pub, slotHash, fmt.Errorf("randao-slashing: RANDAO reveal does not verify")); err != nil {
+		return 0, err
 	}
 
 	pubkeyBytes := rs.ValidatorPubkey
@@ -586,9 +801,8 @@ func (s *state) IsExitValid(exit *primitives.Exit) error {
 	if err != nil {
 		return err
 	}
-	valid := sig.Verify(wPubKey, msgHash[:])
-	if !valid {
-		return fmt.Errorf("exit signature is not valid")
+	if err := s.verifySignature(sig, wPubKey, msgHash, fmt.Errorf("exit signature is not valid")); err != nil {
+		return err
 	}
 
 	pkh, err := wPubKey.Hash()
@@ -627,6 +841,7 @@ func (s *state) ApplyExit(exit *primitives.Exit) error {
 		if bytes.Equal(v.PubKey[:], pubkeySerialized[:]) && v.IsActive() {
 			s.ValidatorRegistry[i].Status = primitives.StatusActivePendingExit
 			s.ValidatorRegistry[i].LastActiveEpoch = s.EpochIndex + 2
+			s.trees.markValidators(uint64(i))
 		}
 	}
 
@@ -706,6 +921,18 @@ func (s *state) AreDepositsValid(deposits []*primitives.Deposit) error {
 		}
 	}
 
+	if s.sigBatch != nil {
+		for i := range deposits {
+			if err := s.verifySignature(depSigs[i], depPubs[i], depMsgs[i], errors.New("deposit signatures don't verify")); err != nil {
+				return err
+			}
+			if err := s.verifySignature(proofsSigs[i], proofsPubs[i], proofsMsgs[i], errors.New("proof-of-possession signatures don't verify")); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
 	depositsSig := bls.AggregateSignatures(depSigs)
 	proofOfPossessionSig := bls.AggregateSignatures(proofsSigs)
 
@@ -807,6 +1034,8 @@ func (s *state) ApplyDeposits(deposits []*primitives.Deposit) error {
 			Status:           primitives.StatusStarting,
 			FirstActiveEpoch: s.EpochIndex + 2,
 		})
+		s.trees.markAccounts(pkh)
+		s.trees.markValidators(uint64(len(s.ValidatorRegistry) - 1))
 	}
 	return nil
 }
@@ -836,6 +1065,8 @@ func (s *state) ApplyDeposit(deposit *primitives.Deposit) error {
 		Status:           primitives.StatusStarting,
 		FirstActiveEpoch: s.EpochIndex + 2,
 	})
+	s.trees.markAccounts(pkh)
+	s.trees.markValidators(uint64(len(s.ValidatorRegistry) - 1))
 
 	return nil
 }
@@ -905,12 +1136,7 @@ func (s *state) IsVoteValid(v *primitives.MultiValidatorVote) error {
 		return err
 	}
 
-	valid := vSig.FastAggregateVerify(aggPubs, h)
-	if !valid {
-		return ErrorVoteSignature
-	}
-
-	return nil
+	return s.verifyAggregateSignature(vSig, aggPubs, h, ErrorVoteSignature)
 }
 
 func (s *state) ProcessVote(v *primitives.MultiValidatorVote, proposerIndex uint64) error {
@@ -990,33 +1216,64 @@ func (s *state) CheckBlockSignature(b *primitives.Block) error {
 		return err
 	}
 
-	valid := blockSig.Verify(validatorPub, blockHash[:])
-	if !valid {
-		return errors.New("error validating signature for block")
+	if err := s.verifySignature(blockSig, validatorPub, blockHash, errors.New("error validating signature for block")); err != nil {
+		return err
 	}
 
 	slotHash := chainhash.HashH([]byte(fmt.Sprintf("%d", b.Header.Slot)))
 
-	valid = randaoSig.Verify(validatorPub, slotHash[:])
-	if !valid {
-		return errors.New("error validating RANDAO signature for block")
-	}
-
-	return nil
+	return s.verifySignature(randaoSig, validatorPub, slotHash, errors.New("error validating RANDAO signature for block"))
 }
 
-// ProcessBlock runs a block transition on the state and mutates state.
+// ProcessBlock runs a block transition on the state and mutates state. The signatures of the block are collected while
+// the block is applied and verified at once before checking the state root.
 func (s *state) ProcessBlock(b *primitives.Block) error {
-	netParams := config.GlobalParams.NetParams
-
 	if b.Header.Slot != s.Slot {
 		return fmt.Errorf("state is not updated to slot %d, instead got %d", b.Header.Slot, s.Slot)
 	}
 
+	s.sigBatch = &signatureBatch{batch: bls.NewSignatureBatch()}
+	defer func() {
+		s.sigBatch = nil
+	}()
+
 	if err := s.CheckBlockSignature(b); err != nil {
 		return err
 	}
 
+	if err := s.ApplyBlock(b); err != nil {
+		return err
+	}
+
+	if err := s.sigBatch.verify(); err != nil {
+		return err
+	}
+
+	// blocks before the activation slot were created without a state root.
+	if b.Header.Slot < config.GlobalParams.NetParams.StateRootActivationSlot {
+		return nil
+	}
+
+	stateRoot, err := s.StateRoot()
+	if err != nil {
+		return err
+	}
+
+	if !bytes.Equal(stateRoot[:], b.Header.StateRoot[:]) {
+		return fmt.Errorf("expected state root to be %s but got %s", hex.EncodeToString(stateRoot[:]), hex.EncodeToString(b.Header.StateRoot[:]))
+	}
+
+	return nil
+}
+
+// ApplyBlock applies the block contents to the state without checking the block signature or the state root.
+func (s *state) ApplyBlock(b *primitives.Block) error {
+	netParams := config.GlobalParams.NetParams
+
+	if b.Header.Slot != s.Slot {
+		return fmt.Errorf("state is not updated to slot %d, instead got %d", b.Header.Slot, s.Slot)
+	}
+
 	voteMerkleRoot := b.VotesMerkleRoot()
 	transactionMerkleRoot := b.TransactionMerkleRoot()
 	transactionMultiMerkleRoot := b.TransactionMultiMerkleRoot()
@@ -1026,6 +1283,8 @@ func (s *state) ProcessBlock(b *primitives.Block) error {
 	proposerSlashingMerkleRoot := b.ProposerSlashingsRoot()
 	randaoSlashingMerkleRoot := b.RANDAOSlashingsRoot()
 	governanceVoteMerkleRoot := b.GovernanceVoteMerkleRoot()
+	contractMerkleRoot := b.ContractMerkleRoot()
+	assetTxMerkleRoot := b.AssetTxMerkleRoot()
 
 	if !bytes.Equal(transactionMerkleRoot[:], b.Header.TxMerkleRoot[:]) {
 		return fmt.Errorf("expected transaction merkle root to be %s but got %s", hex.EncodeToString(transactionMerkleRoot[:]), hex.EncodeToString(b.Header.TxMerkleRoot[:]))
@@ -1062,6 +1321,14 @@ func (s *state) ProcessBlock(b *primitives.Block) error {
 		return fmt.Errorf("expected exit merkle root to be %s but got %s", hex.EncodeToString(governanceVoteMerkleRoot[:]), hex.EncodeToString(b.Header.GovernanceVotesMerkleRoot[:]))
 	}
 
+	if !bytes.Equal(contractMerkleRoot[:], b.Header.ContractMerkleRoot[:]) {
+		return fmt.Errorf("expected contract merkle root to be %s but got %s", hex.EncodeToString(contractMerkleRoot[:]), hex.EncodeToString(b.Header.ContractMerkleRoot[:]))
+	}
+
+	if !bytes.Equal(assetTxMerkleRoot[:], b.Header.AssetTxMerkleRoot[:]) {
+		return fmt.Errorf("expected asset tx merkle root to be %s but got %s", hex.EncodeToString(assetTxMerkleRoot[:]), hex.EncodeToString(b.Header.AssetTxMerkleRoot[:]))
+	}
+
 	if uint64(len(b.Votes)) > netParams.MaxVotesPerBlock {
 		return fmt.Errorf("block has too many votes (max: %d, got: %d)", netParams.MaxVotesPerBlock, len(b.Votes))
 	}
@@ -1090,6 +1357,22 @@ func (s *state) ProcessBlock(b *primitives.Block) error {
 		return fmt.Errorf("block has too many proposer slashings (max: %d, got: %d)", netParams.MaxProposerSlashingsPerBlock, len(b.ProposerSlashings))
 	}
 
+	if uint64(len(b.ContractDeploys)) > netParams.MaxContractDeploysPerBlock {
+		return fmt.Errorf("block has too many contract deploys (max: %d, got: %d)", netParams.MaxContractDeploysPerBlock, len(b.ContractDeploys))
+	}
+
+	if uint64(len(b.ContractCalls)) > netParams.MaxContractCallsPerBlock {
+		return fmt.Errorf("block has too many contract calls (max: %d, got: %d)", netParams.MaxContractCallsPerBlock, len(b.ContractCalls))
+	}
+
+	if gas := b.ContractGasLimit(); gas > netParams.MaxContractGasPerBlock {
+		return fmt.Errorf("block contract gas limit is too big (max: %d, got: %d)", netParams.MaxContractGasPerBlock, gas)
+	}
+
+	if uint64(len(b.AssetTxs)) > netParams.MaxAssetTxsPerBlock {
+		return fmt.Errorf("block has too many asset txs (max: %d, got: %d)", netParams.MaxAssetTxsPerBlock, len(b.AssetTxs))
+	}
+
 	//if uint64(len(b.MigrationProofs)) > netParams.MaxMigrationsProofsPerBlock {
 	//	return fmt.Errorf("block has too many migration proofs (max: %d, got: %d)", netParams.MaxMigrationsProofsPerBlock, len(b.MigrationProofs))
 	//}
@@ -1106,6 +1389,29 @@ func (s *state) ProcessBlock(b *primitives.Block) error {
 		}
 	}
 
+	s.contractReceipts = nil
+	for _, tx := range b.ContractDeploys {
+		receipt, err := s.ApplyContractDeploy(tx, b.Header.FeeAddress)
+		if err != nil {
+			return err
+		}
+		s.contractReceipts = append(s.contractReceipts, receipt)
+	}
+
+	for _, tx := range b.ContractCalls {
+		receipt, err := s.ApplyContractCall(tx, b.Header.FeeAddress)
+		if err != nil {
+			return err
+		}
+		s.contractReceipts = append(s.contractReceipts, receipt)
+	}
+
+	for _, tx := range b.AssetTxs {
+		if err := s.ApplyAssetTx(tx, b.Header.FeeAddress); err != nil {
+			return err
+		}
+	}
+
 	for _, vote := range b.GovernanceVotes {
 		if err := s.ProcessGovernanceVote(vote); err != nil {
 			return err
@@ -0,0 +1,118 @@
+package state_test
+
+import (
+	"testing"
+
+	"github.com/olympus-protocol/ogen/cmd/ogen/config"
+	"github.com/olympus-protocol/ogen/internal/execution"
+	"github.com/olympus-protocol/ogen/internal/state"
+	"github.com/olympus-protocol/ogen/pkg/chainhash"
+	"github.com/olympus-protocol/ogen/pkg/primitives"
+	"github.com/olympus-protocol/ogen/test/testchain"
+	"github.com/stretchr/testify/assert"
+)
+
+// slotState returns a copy of the genesis state updated to the slot.
+func slotState(t *testing.T, keys *testchain.Keys, slot uint64) state.State {
+	genesis, err := keys.GenesisState()
+	assert.NoError(t, err)
+	st := genesis.Copy()
+	_, err = st.ProcessSlots(slot, testchain.NewView())
+	assert.NoError(t, err)
+	return st
+}
+
+func TestProcessBlock_StateRoot(t *testing.T) {
+	keys, err := testchain.Setup(10)
+	assert.NoError(t, err)
+
+	st := slotState(t, keys, 1)
+	genesis := primitives.GetGenesisBlock()
+	block, err := keys.Block(st, genesis.Hash(), nil)
+	assert.NoError(t, err)
+
+	assert.NoError(t, st.Copy().ProcessBlock(block))
+
+	block.Header.StateRoot[0] ^= 0xff
+	assert.NoError(t, keys.Sign(st, block))
+	err = st.Copy().ProcessBlock(block)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "state root")
+
+	// blocks before the activation slot are not checked.
+	netParams := *config.GlobalParams.NetParams
+	netParams.StateRootActivationSlot = 2
+	config.GlobalParams.NetParams = &netParams
+	assert.NoError(t, st.Copy().ProcessBlock(block))
+}
+
+func TestProcessBlock_IncrementalStateRoot(t *testing.T) {
+	keys, err := testchain.Setup(10)
+	assert.NoError(t, err)
+
+	genesis, err := keys.GenesisState()
+	assert.NoError(t, err)
+	st := genesis.Copy()
+	_, err = st.StateRoot()
+	assert.NoError(t, err)
+
+	var pub [48]byte
+	copy(pub[:], keys.Premine.PublicKey().Marshal())
+	code, err := execution.Assemble("PUSH 0 SLOAD PUSH 1 ADD DUP1 PUSH 0 SSTORE RETURN")
+	assert.NoError(t, err)
+
+	view := testchain.NewView()
+	genesisBlock := primitives.GetGenesisBlock()
+	parent := genesisBlock.Hash()
+	var contract [20]byte
+	var votes []*primitives.MultiValidatorVote
+	nonce := uint64(0)
+	for slot := uint64(1); slot <= 3*config.GlobalParams.NetParams.EpochLength; slot++ {
+		_, err := st.ProcessSlots(slot, view)
+		assert.NoError(t, err)
+
+		block, err := keys.Block(st, parent, func(b *primitives.Block) {
+			b.Header.Timestamp += slot
+			b.Votes = votes
+			nonce++
+			tx := &primitives.Tx{To: [20]byte{byte(slot)}, FromPublicKey: pub, Amount: slot, Nonce: nonce, Fee: 1}
+			msg := tx.SignatureMessage()
+			copy(tx.Signature[:], keys.Premine.Sign(msg[:]).Marshal())
+			b.Txs = []*primitives.Tx{tx}
+
+			nonce++
+			if slot == 1 {
+				deploy := &primitives.ContractDeploy{FromPublicKey: pub, Code: code, Nonce: nonce, GasLimit: 100000, GasPrice: 1}
+				msg = deploy.SignatureMessage()
+				copy(deploy.Signature[:], keys.Premine.Sign(msg[:]).Marshal())
+				contract, err = deploy.ContractAddress()
+				assert.NoError(t, err)
+				b.ContractDeploys = []*primitives.ContractDeploy{deploy}
+				return
+			}
+			call := &primitives.ContractCall{To: contract, FromPublicKey: pub, Nonce: nonce, GasLimit: 100000, GasPrice: 1}
+			msg = call.SignatureMessage()
+			copy(call.Signature[:], keys.Premine.Sign(msg[:]).Marshal())
+			b.ContractCalls = []*primitives.ContractCall{call}
+		})
+		assert.NoError(t, err)
+		assert.NoError(t, st.ProcessBlock(block))
+		view.Add(block)
+		parent = block.Hash()
+		vote, err := keys.Vote(st, parent)
+		assert.NoError(t, err)
+		votes = []*primitives.MultiValidatorVote{vote}
+
+		// the root of the changed keys is the root of a state hashed from scratch.
+		root, err := st.StateRoot()
+		assert.NoError(t, err)
+		buf, err := st.Marshal()
+		assert.NoError(t, err)
+		fresh := state.NewEmptyState()
+		assert.NoError(t, fresh.Unmarshal(buf))
+		want, err := fresh.StateRoot()
+		assert.NoError(t, err)
+		assert.Equal(t, want, root, "state root of slot %d", slot)
+	}
+	assert.Equal(t, chainhash.Hash{31: byte(3 * config.GlobalParams.NetParams.EpochLength)}, st.GetContractsState().Contracts[contract].Storage[chainhash.Hash{}])
+}
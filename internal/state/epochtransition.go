@@ -44,6 +44,7 @@ func (s *state) ActivateValidator(index uint64) error {
 	}
 
 	validator.Status = primitives.StatusActive
+	s.trees.markValidators(index)
 	return nil
 }
 
@@ -55,6 +56,7 @@ func (s *state) InitiateValidatorExit(index uint64) error {
 	}
 
 	validator.Status = primitives.StatusActivePendingExit
+	s.trees.markValidators(index)
 	return nil
 }
 
@@ -70,6 +72,7 @@ func (s *state) ExitValidator(index uint64, status uint64) error {
 	}
 
 	validator.Status = status
+	s.trees.markValidators(index)
 
 	if status == primitives.StatusExitedWithPenalty {
 		slotIndex := (s.Slot + netParams.EpochLength - 1) % netParams.EpochLength
@@ -80,11 +83,13 @@ func (s *state) ExitValidator(index uint64, status uint64) error {
 
 		s.ValidatorRegistry[proposerIndex].Balance += whistleblowerReward
 		s.ValidatorRegistry[index].Balance -= whistleblowerReward
+		s.trees.markValidators(proposerIndex)
 
 		return nil
 	}
 	s.CoinsState.Balances[validator.PayeeAddress] += validator.Balance
 	validator.Balance = 0
+	s.trees.markAccounts(validator.PayeeAddress)
 
 	return nil
 }
@@ -256,6 +261,7 @@ func (s *state) CheckForVoteTransitions() {
 
 		multipub := multisig.PublicKeyHashesToMultisigHash(s.CurrentManagers, 5)
 		s.CoinsState.Balances[multipub] += perGroup
+		s.trees.markAccounts(multipub)
 		if len(s.CurrentManagers) != len(netParams.GovernancePercentages) {
 			return
 		}
@@ -263,6 +269,7 @@ func (s *state) CheckForVoteTransitions() {
 		for group, address := range s.CurrentManagers {
 			percent := netParams.GovernancePercentages[group]
 			s.CoinsState.Balances[address] += perGroup * uint64(percent) / 100
+			s.trees.markAccounts(address)
 		}
 
 		s.LastPaidSlot = s.Slot
@@ -273,6 +280,9 @@ func (s *state) CheckForVoteTransitions() {
 func (s *state) ProcessEpochTransition() ([]*primitives.EpochReceipt, error) {
 	netParams := config.GlobalParams.NetParams
 
+	// the rewards and penalties change the balances of the whole registry.
+	s.trees.markAllValidators()
+
 	s.CheckForVoteTransitions()
 
 	totalBalance := s.getActiveBalance()
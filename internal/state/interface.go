@@ -1,6 +1,7 @@
 package state
 
 import (
+	"github.com/olympus-protocol/ogen/internal/execution"
 	"github.com/olympus-protocol/ogen/pkg/bls"
 	"github.com/olympus-protocol/ogen/pkg/burnproof"
 	"github.com/olympus-protocol/ogen/pkg/chainhash"
@@ -26,6 +27,10 @@ type State interface {
 	ApplyTransactionSingle(tx *primitives.Tx, blockWithdrawalAddress [20]byte) error
 	ApplyTransactionMulti(tx *primitives.TxMulti, blockWithdrawalAddress [20]byte) error
 	ApplyMigrationProof(p *burnproof.CoinsProof) error
+	ApplyContractDeploy(tx *primitives.ContractDeploy, blockWithdrawalAddress [20]byte) (*execution.Receipt, error)
+	ApplyContractCall(tx *primitives.ContractCall, blockWithdrawalAddress [20]byte) (*execution.Receipt, error)
+	ContractReceipts() []*execution.Receipt
+	ApplyAssetTx(tx *primitives.AssetTx, blockWithdrawalAddress [20]byte) error
 	IsProposerSlashingValid(ps *primitives.ProposerSlashing) (uint64, error)
 	ApplyProposerSlashing(ps *primitives.ProposerSlashing) error
 	IsVoteSlashingValid(vs *primitives.VoteSlashing) ([]uint64, error)
@@ -44,6 +49,9 @@ type State interface {
 	GetProposerPublicKey(b *primitives.Block) (*bls.PublicKey, error)
 	CheckBlockSignature(b *primitives.Block) error
 	ProcessBlock(b *primitives.Block) error
+	ApplyBlock(b *primitives.Block) error
+	StateRoot() (chainhash.Hash, error)
+	ProveKeys(keys ...chainhash.Hash) (chainhash.Hash, []*primitives.VerificationWitness, error)
 	ToSerializable() *primitives.SerializableState
 	FromSerializable(ser *primitives.SerializableState)
 	Marshal() ([]byte, error)
@@ -53,6 +61,7 @@ type State interface {
 	GetValidatorsForAccount(acc []byte) ValidatorsInfo
 	Copy() State
 	GetCoinsState() primitives.CoinsState
+	GetContractsState() primitives.ContractsState
 	GetValidatorRegistry() []*primitives.Validator
 	GetProposerQueue() []uint64
 	GetSlot() uint64
@@ -66,6 +75,10 @@ func (s *state) GetCoinsState() primitives.CoinsState {
 	return s.CoinsState
 }
 
+func (s *state) GetContractsState() primitives.ContractsState {
+	return s.ContractsState
+}
+
 func (s *state) GetValidatorRegistry() []*primitives.Validator {
 	return s.ValidatorRegistry
 }
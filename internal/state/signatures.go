@@ -0,0 +1,75 @@
+package state
+
+import (
+	"errors"
+
+	"github.com/olympus-protocol/ogen/pkg/bls"
+)
+
+// signatureBatch collects the signatures of a block to verify them at once. Each signature keeps the error returned if
+// it is the one that fails.
+type signatureBatch struct {
+	batch *bls.SignatureBatch
+	errs  []error
+}
+
+// verify checks all the signatures of the batch. If the batch fails, the signatures are checked one by one to return
+// the error of the offending one.
+func (sb *signatureBatch) verify() error {
+	valid, err := sb.batch.Verify()
+	if err == nil && valid {
+		return nil
+	}
+	if i := sb.batch.FindInvalid(); i >= 0 {
+		return sb.errs[i]
+	}
+	if err != nil {
+		return err
+	}
+	return errors.New("block signatures don't verify")
+}
+
+// verifySignature checks the signature of a message and returns errInvalid if it doesn't verify. While a block is being
+// processed the signature is added to the block batch instead and checked with the rest of the block signatures.
+func (s *state) verifySignature(sig *bls.Signature, pub *bls.PublicKey, msg [32]byte, errInvalid error) error {
+	if s.sigBatch != nil {
+		s.sigBatch.batch.Add(sig, pub, msg)
+		s.sigBatch.errs = append(s.sigBatch.errs, errInvalid)
+		return nil
+	}
+	if !sig.Verify(pub, msg[:]) {
+		return errInvalid
+	}
+	return nil
+}
+
+// verifyAggregateSignature checks a signature of the same message by multiple public keys.
+func (s *state) verifyAggregateSignature(sig *bls.Signature, pubs []*bls.PublicKey, msg [32]byte, errInvalid error) error {
+	if len(pubs) == 0 {
+		return errInvalid
+	}
+	if s.sigBatch == nil {
+		if !sig.FastAggregateVerify(pubs, msg) {
+			return errInvalid
+		}
+		return nil
+	}
+	aggPub := pubs[0].Copy()
+	for _, p := range pubs[1:] {
+		aggPub.Aggregate(p)
+	}
+	return s.verifySignature(sig, aggPub, msg, errInvalid)
+}
+
+// verifyRawSignature parses a serialized signature and public key before checking the signature.
+func (s *state) verifyRawSignature(sig [96]byte, pub [48]byte, msg [32]byte, errInvalid error) error {
+	signature, err := bls.SignatureFromBytes(sig[:])
+	if err != nil {
+		return err
+	}
+	pubKey, err := bls.PublicKeyFromBytes(pub[:])
+	if err != nil {
+		return err
+	}
+	return s.verifySignature(signature, pubKey, msg, errInvalid)
+}
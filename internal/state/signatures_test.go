@@ -0,0 +1,83 @@
+package state_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/olympus-protocol/ogen/internal/state"
+	"github.com/olympus-protocol/ogen/pkg/chainhash"
+	"github.com/olympus-protocol/ogen/pkg/primitives"
+	"github.com/olympus-protocol/ogen/test/testchain"
+	"github.com/stretchr/testify/assert"
+)
+
+// resign updates the merkle roots of a block after its items were changed and signs it again. The state root is not
+// updated, the signatures are checked before it.
+func resign(t *testing.T, keys *testchain.Keys, st state.State, b *primitives.Block) {
+	b.Header.VoteMerkleRoot = b.VotesMerkleRoot()
+	b.Header.TxMerkleRoot = b.TransactionMerkleRoot()
+	assert.NoError(t, keys.Sign(st, b))
+}
+
+func TestProcessBlock_BatchInvalidTxSignature(t *testing.T) {
+	keys, err := testchain.Setup(10)
+	assert.NoError(t, err)
+
+	var pub [48]byte
+	copy(pub[:], keys.Premine.PublicKey().Marshal())
+	txs := make([]*primitives.Tx, 3)
+	for i := range txs {
+		txs[i] = &primitives.Tx{To: [20]byte{byte(i + 1)}, FromPublicKey: pub, Amount: 10, Nonce: uint64(i + 1), Fee: 1}
+		msg := txs[i].SignatureMessage()
+		copy(txs[i].Signature[:], keys.Premine.Sign(msg[:]).Marshal())
+	}
+
+	st := slotState(t, keys, 1)
+	genesis := primitives.GetGenesisBlock()
+	block, err := keys.Block(st, genesis.Hash(), func(b *primitives.Block) {
+		b.Txs = txs
+	})
+	assert.NoError(t, err)
+	assert.NoError(t, st.Copy().ProcessBlock(block))
+
+	// a valid signature of another message.
+	other := chainhash.HashH([]byte("other"))
+	copy(txs[1].Signature[:], keys.Premine.Sign(other[:]).Marshal())
+	resign(t, keys, st, block)
+
+	err = st.Copy().ProcessBlock(block)
+	assert.True(t, errors.Is(err, primitives.ErrorInvalidSignature))
+	assert.Contains(t, err.Error(), txs[1].Hash().String())
+}
+
+func TestProcessBlock_BatchInvalidVoteSignature(t *testing.T) {
+	keys, err := testchain.Setup(10)
+	assert.NoError(t, err)
+
+	genesisState := slotState(t, keys, 1)
+	genesis := primitives.GetGenesisBlock()
+	first, err := keys.Block(genesisState, genesis.Hash(), nil)
+	assert.NoError(t, err)
+
+	parentState := genesisState.Copy()
+	assert.NoError(t, parentState.ProcessBlock(first))
+	vote, err := keys.Vote(parentState, first.Hash())
+	assert.NoError(t, err)
+
+	view := testchain.NewView()
+	view.Add(first)
+	st := parentState.Copy()
+	_, err = st.ProcessSlots(2, view)
+	assert.NoError(t, err)
+	block, err := keys.Block(st, first.Hash(), func(b *primitives.Block) {
+		b.Votes = []*primitives.MultiValidatorVote{vote}
+	})
+	assert.NoError(t, err)
+	assert.NoError(t, st.Copy().ProcessBlock(block))
+
+	other := chainhash.HashH([]byte("other"))
+	copy(vote.Sig[:], keys.Premine.Sign(other[:]).Marshal())
+	resign(t, keys, st, block)
+
+	assert.Equal(t, state.ErrorVoteSignature, st.Copy().ProcessBlock(block))
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"github.com/olympus-protocol/ogen/cmd/ogen/initialization"
+	"github.com/olympus-protocol/ogen/internal/execution"
 	"github.com/olympus-protocol/ogen/pkg/bech32"
 	"github.com/olympus-protocol/ogen/pkg/bitfield"
 	"github.com/olympus-protocol/ogen/pkg/chainhash"
@@ -108,12 +109,25 @@ type state struct {
 	VotingState        uint64
 
 	LastPaidSlot uint64
+
+	// ContractsState keeps the code and storage of the deployed contracts.
+	ContractsState primitives.ContractsState
+
+	// sigBatch collects the signatures of the block being processed.
+	sigBatch *signatureBatch
+
+	// trees keeps the last state tree, so the next state root only writes the changed keys.
+	trees *treeCache
+
+	// contractReceipts are the receipts of the contract txs of the last block applied.
+	contractReceipts []*execution.Receipt
 }
 
 // ToSerializable converts the struct to a serializable struct
 func (s *state) ToSerializable() *primitives.SerializableState {
 	serCoin := s.CoinsState.ToSerializable()
 	serGov := s.Governance.ToSerializable()
+	serContracts := s.ContractsState.ToSerializable()
 	ser := &primitives.SerializableState{
 		CoinsState:                    &serCoin,
 		ValidatorRegistry:             s.ValidatorRegistry,
@@ -142,12 +156,14 @@ func (s *state) ToSerializable() *primitives.SerializableState {
 		VoteEpochStartSlot:            s.VoteEpochStartSlot,
 		VotingState:                   s.VotingState,
 		LastPaidSlot:                  s.LastPaidSlot,
+		Contracts:                     &serContracts,
 	}
 	return ser
 }
 
 // FromSerializable converts the struct to a serializable struct
 func (s *state) FromSerializable(ser *primitives.SerializableState) {
+	s.trees = new(treeCache)
 	s.ValidatorRegistry = ser.ValidatorRegistry
 	s.LatestValidatorRegistryChange = ser.LatestValidatorRegistryChange
 	s.RANDAO = ser.RANDAO
@@ -175,6 +191,7 @@ func (s *state) FromSerializable(ser *primitives.SerializableState) {
 	s.LastPaidSlot = ser.LastPaidSlot
 	s.CoinsState.FromSerializable(ser.CoinsState)
 	s.Governance.FromSerializable(ser.Governance)
+	s.ContractsState.FromSerializable(ser.Contracts)
 	return
 }
 
@@ -275,6 +292,10 @@ func (s *state) GetValidatorsForAccount(acc []byte) ValidatorsInfo {
 func (s *state) Copy() State {
 	s2 := *s
 
+	s2.sigBatch = nil
+	s2.contractReceipts = nil
+	s2.trees = s.trees.copy()
+
 	s2.CoinsState = s.CoinsState.Copy()
 
 	s2.ValidatorRegistry = make([]*primitives.Validator, len(s.ValidatorRegistry))
@@ -330,6 +351,7 @@ func (s *state) Copy() State {
 	}
 
 	s2.Governance = s.Governance.Copy()
+	s2.ContractsState = s.ContractsState.Copy()
 	return &s2
 }
 
@@ -357,6 +379,10 @@ func NewState(cs primitives.CoinsState, gs primitives.Governance, validators []*
 		VoteEpochStartSlot:            0,
 		VotingState:                   GovernanceStateActive,
 		LastPaidSlot:                  0,
+		ContractsState: primitives.ContractsState{
+			Contracts: make(map[[20]byte]*primitives.Contract),
+		},
+		trees: new(treeCache),
 	}
 	activeValidators := s.GetValidatorIndicesActiveAt(0)
 	s.ProposerQueue = DetermineNextProposers(chainhash.Hash{}, activeValidators)
@@ -368,7 +394,7 @@ func NewState(cs primitives.CoinsState, gs primitives.Governance, validators []*
 }
 
 func NewEmptyState() State {
-	return new(state)
+	return &state{trees: new(treeCache)}
 }
 
 // GetGenesisStateWithInitializationParameters gets the genesis state with certain parameters.
@@ -416,6 +442,7 @@ func GetGenesisStateWithInitializationParameters(genesisHash chainhash.Hash, ip
 			premineAddrArr: 400000 * p.UnitsPerCoin,
 		},
 		Nonces: make(map[[20]byte]uint64),
+		Assets: make(map[[20]byte]*primitives.Asset),
 	}
 
 	gs := primitives.Governance{
@@ -0,0 +1,430 @@
+package state
+
+import (
+	"sync"
+
+	"github.com/olympus-protocol/ogen/internal/csmt"
+	"github.com/olympus-protocol/ogen/internal/execution"
+	"github.com/olympus-protocol/ogen/pkg/chainhash"
+	"github.com/olympus-protocol/ogen/pkg/primitives"
+)
+
+// maxStateTreeLayers is the number of trees that can be built on top of a fully built tree. After that the tree is
+// built again from the state values, so the lookups don't go through too many layers.
+const maxStateTreeLayers = 32
+
+// stateTree is a CSMT with the values of a state. A built tree is never modified, so the copies of a state share it and
+// the tree of the next block is a layer on top of it with only the nodes of the changed keys.
+type stateTree struct {
+	tree       csmt.Tree
+	layers     int
+	validators int
+}
+
+// dirtyValues are the keys of the state values changed since the last tree was built. Only the values of these keys
+// are calculated again for the next tree.
+type dirtyValues struct {
+	accounts      map[[20]byte]struct{}
+	validators    map[uint64]struct{}
+	allValidators bool
+	assets        map[[20]byte]map[[20]byte]struct{}
+	contracts     map[[20]byte]map[chainhash.Hash]struct{}
+}
+
+func newDirtyValues() *dirtyValues {
+	return &dirtyValues{
+		accounts:   make(map[[20]byte]struct{}),
+		validators: make(map[uint64]struct{}),
+		assets:     make(map[[20]byte]map[[20]byte]struct{}),
+		contracts:  make(map[[20]byte]map[chainhash.Hash]struct{}),
+	}
+}
+
+func (d *dirtyValues) copy() *dirtyValues {
+	d2 := newDirtyValues()
+	d2.allValidators = d.allValidators
+	for acc := range d.accounts {
+		d2.accounts[acc] = struct{}{}
+	}
+	for i := range d.validators {
+		d2.validators[i] = struct{}{}
+	}
+	for id, accs := range d.assets {
+		d2.assets[id] = make(map[[20]byte]struct{}, len(accs))
+		for acc := range accs {
+			d2.assets[id][acc] = struct{}{}
+		}
+	}
+	for addr, keys := range d.contracts {
+		d2.contracts[addr] = make(map[chainhash.Hash]struct{}, len(keys))
+		for k := range keys {
+			d2.contracts[addr][k] = struct{}{}
+		}
+	}
+	return d2
+}
+
+// treeCache keeps the last tree built for a state and the values changed after it. The changes are only tracked once
+// there is a tree, the first tree is built from all the state values.
+type treeCache struct {
+	lock  sync.Mutex
+	last  *stateTree
+	dirty *dirtyValues
+}
+
+func (c *treeCache) get() *stateTree {
+	if c == nil {
+		return nil
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.last
+}
+
+// copy returns a cache for a copy of the state, with the same last tree and changes.
+func (c *treeCache) copy() *treeCache {
+	c2 := new(treeCache)
+	if c == nil {
+		return c2
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.last != nil {
+		c2.last = c.last
+		c2.dirty = c.dirty.copy()
+	}
+	return c2
+}
+
+// mark runs fn with the changes tracked since the last tree, if there is one.
+func (c *treeCache) mark(fn func(d *dirtyValues)) {
+	if c == nil {
+		return
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.dirty != nil {
+		fn(c.dirty)
+	}
+}
+
+// markAccounts marks the balances and nonces of the accounts as changed.
+func (c *treeCache) markAccounts(accs ...[20]byte) {
+	c.mark(func(d *dirtyValues) {
+		for _, acc := range accs {
+			d.accounts[acc] = struct{}{}
+		}
+	})
+}
+
+// markValidators marks the validators at the indices as changed.
+func (c *treeCache) markValidators(indices ...uint64) {
+	c.mark(func(d *dirtyValues) {
+		for _, i := range indices {
+			d.validators[i] = struct{}{}
+		}
+	})
+}
+
+// markAllValidators marks the whole validator registry as changed.
+func (c *treeCache) markAllValidators() {
+	c.mark(func(d *dirtyValues) {
+		d.allValidators = true
+	})
+}
+
+// markAsset marks the supply of an asset and the asset balances of the accounts as changed.
+func (c *treeCache) markAsset(asset [20]byte, accs ...[20]byte) {
+	c.mark(func(d *dirtyValues) {
+		balances, ok := d.assets[asset]
+		if !ok {
+			balances = make(map[[20]byte]struct{})
+			d.assets[asset] = balances
+		}
+		for _, acc := range accs {
+			balances[acc] = struct{}{}
+		}
+	})
+}
+
+// markContract marks the code of a contract and the storage keys as changed.
+func (c *treeCache) markContract(addr [20]byte, keys ...chainhash.Hash) {
+	c.mark(func(d *dirtyValues) {
+		storage, ok := d.contracts[addr]
+		if !ok {
+			storage = make(map[chainhash.Hash]struct{})
+			d.contracts[addr] = storage
+		}
+		for _, k := range keys {
+			storage[k] = struct{}{}
+		}
+	})
+}
+
+// contractObserver marks the accounts and contracts changed by the contract executions.
+type contractObserver struct {
+	trees *treeCache
+}
+
+var _ execution.Observer = contractObserver{}
+
+func (o contractObserver) AccountChanged(acc [20]byte) {
+	o.trees.markAccounts(acc)
+}
+
+func (o contractObserver) ContractCreated(addr [20]byte) {
+	o.trees.markContract(addr)
+}
+
+func (o contractObserver) StorageChanged(addr [20]byte, key chainhash.Hash) {
+	o.trees.markContract(addr, key)
+}
+
+// stateValues returns the account balances, the account nonces, the validator registry, the assets and the contracts
+// code and storage as the keys and values of the state tree.
+// Empty values are not included, as a zero value is the same as a missing key on the tree.
+func (s *state) stateValues() (map[chainhash.Hash]chainhash.Hash, error) {
+	values := make(map[chainhash.Hash]chainhash.Hash, len(s.CoinsState.Balances)+len(s.CoinsState.Nonces)+len(s.ValidatorRegistry))
+
+	for acc, balance := range s.CoinsState.Balances {
+		if balance == 0 {
+			continue
+		}
+		values[primitives.BalanceKey(acc)] = primitives.Uint64Value(balance)
+	}
+
+	for acc, nonce := range s.CoinsState.Nonces {
+		if nonce == 0 {
+			continue
+		}
+		values[primitives.NonceKey(acc)] = primitives.Uint64Value(nonce)
+	}
+
+	for i, v := range s.ValidatorRegistry {
+		root, err := v.HashTreeRoot()
+		if err != nil {
+			return nil, err
+		}
+		values[primitives.ValidatorKey(uint64(i))] = root
+	}
+
+	for id, a := range s.CoinsState.Assets {
+		values[primitives.AssetKey(id)] = primitives.AssetValue(a)
+		for acc, balance := range a.Balances {
+			if balance == 0 {
+				continue
+			}
+			values[primitives.AssetBalanceKey(id, acc)] = primitives.Uint64Value(balance)
+		}
+	}
+
+	for addr, c := range s.ContractsState.Contracts {
+		values[primitives.ContractCodeKey(addr)] = c.CodeHash()
+		for k, v := range c.Storage {
+			values[primitives.ContractStorageKey(addr, k)] = v
+		}
+	}
+
+	return values, nil
+}
+
+// dirtyKeyValues returns the values of the changed keys of the state and the changed keys that are empty. The
+// validators after the end of the registry are removed.
+func (s *state) dirtyKeyValues(d *dirtyValues, lastValidators int) ([]csmt.KeyValue, []chainhash.Hash, error) {
+	var changed []csmt.KeyValue
+	var removed []chainhash.Hash
+	setUint64 := func(key chainhash.Hash, n uint64) {
+		if n == 0 {
+			removed = append(removed, key)
+			return
+		}
+		changed = append(changed, csmt.KeyValue{Key: key, Value: primitives.Uint64Value(n)})
+	}
+
+	for acc := range d.accounts {
+		setUint64(primitives.BalanceKey(acc), s.CoinsState.Balances[acc])
+		setUint64(primitives.NonceKey(acc), s.CoinsState.Nonces[acc])
+	}
+
+	setValidator := func(i uint64) error {
+		if i >= uint64(len(s.ValidatorRegistry)) {
+			removed = append(removed, primitives.ValidatorKey(i))
+			return nil
+		}
+		root, err := s.ValidatorRegistry[i].HashTreeRoot()
+		if err != nil {
+			return err
+		}
+		changed = append(changed, csmt.KeyValue{Key: primitives.ValidatorKey(i), Value: root})
+		return nil
+	}
+	if d.allValidators {
+		for i := range s.ValidatorRegistry {
+			if err := setValidator(uint64(i)); err != nil {
+				return nil, nil, err
+			}
+		}
+	} else {
+		for i := range d.validators {
+			if err := setValidator(i); err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+	for i := len(s.ValidatorRegistry); i < lastValidators; i++ {
+		removed = append(removed, primitives.ValidatorKey(uint64(i)))
+	}
+
+	for id, accs := range d.assets {
+		a, ok := s.CoinsState.Assets[id]
+		if !ok {
+			removed = append(removed, primitives.AssetKey(id))
+			for acc := range accs {
+				removed = append(removed, primitives.AssetBalanceKey(id, acc))
+			}
+			continue
+		}
+		changed = append(changed, csmt.KeyValue{Key: primitives.AssetKey(id), Value: primitives.AssetValue(a)})
+		for acc := range accs {
+			setUint64(primitives.AssetBalanceKey(id, acc), a.Balances[acc])
+		}
+	}
+
+	for addr, keys := range d.contracts {
+		c, ok := s.ContractsState.Contracts[addr]
+		if !ok {
+			removed = append(removed, primitives.ContractCodeKey(addr))
+			for k := range keys {
+				removed = append(removed, primitives.ContractStorageKey(addr, k))
+			}
+			continue
+		}
+		changed = append(changed, csmt.KeyValue{Key: primitives.ContractCodeKey(addr), Value: c.CodeHash()})
+		for k := range keys {
+			v, ok := c.Storage[k]
+			if !ok || v == (chainhash.Hash{}) {
+				removed = append(removed, primitives.ContractStorageKey(addr, k))
+				continue
+			}
+			changed = append(changed, csmt.KeyValue{Key: primitives.ContractStorageKey(addr, k), Value: v})
+		}
+	}
+
+	return changed, removed, nil
+}
+
+// stateTree returns the tree of the current state values. The first tree of a state, and every maxStateTreeLayers
+// trees, is built from all the state values. The other trees are a layer on top of the last tree with the values of
+// the keys changed since.
+func (s *state) stateTree() (*csmt.Tree, error) {
+	s.trees.lock.Lock()
+	defer s.trees.lock.Unlock()
+
+	last := s.trees.last
+	if last == nil || last.layers >= maxStateTreeLayers {
+		values, err := s.stateValues()
+		if err != nil {
+			return nil, err
+		}
+		next, err := buildStateTree(values)
+		if err != nil {
+			return nil, err
+		}
+		next.validators = len(s.ValidatorRegistry)
+		s.trees.last = next
+		s.trees.dirty = newDirtyValues()
+		return &next.tree, nil
+	}
+
+	changed, removed, err := s.dirtyKeyValues(s.trees.dirty, last.validators)
+	if err != nil {
+		return nil, err
+	}
+	if len(changed) == 0 && len(removed) == 0 {
+		return &last.tree, nil
+	}
+
+	layer, err := csmt.NewTreeMemoryCache(last.tree.Database())
+	if err != nil {
+		return nil, err
+	}
+	next := &stateTree{
+		tree:       csmt.NewTree(layer),
+		layers:     last.layers + 1,
+		validators: len(s.ValidatorRegistry),
+	}
+	err = next.tree.Update(func(tx csmt.TreeTransactionAccess) error {
+		for _, k := range removed {
+			if err := tx.Delete(k); err != nil {
+				return err
+			}
+		}
+		return tx.SetMany(changed)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.trees.last = next
+	s.trees.dirty = newDirtyValues()
+	return &next.tree, nil
+}
+
+// buildStateTree builds a tree with all the values from an empty tree.
+func buildStateTree(values map[chainhash.Hash]chainhash.Hash) (*stateTree, error) {
+	kvs := make([]csmt.KeyValue, 0, len(values))
+	for k, v := range values {
+		kvs = append(kvs, csmt.KeyValue{Key: k, Value: v})
+	}
+
+	tree := csmt.NewTree(csmt.NewInMemoryTreeDB())
+	err := tree.Update(func(tx csmt.TreeTransactionAccess) error {
+		return tx.SetMany(kvs)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &stateTree{tree: tree}, nil
+}
+
+// StateRoot calculates the root of the state tree.
+func (s *state) StateRoot() (chainhash.Hash, error) {
+	tree, err := s.stateTree()
+	if err != nil {
+		return chainhash.Hash{}, err
+	}
+	return tree.Hash()
+}
+
+// ProveKeys returns the state root with a verification witness for each of the keys.
+func (s *state) ProveKeys(keys ...chainhash.Hash) (chainhash.Hash, []*primitives.VerificationWitness, error) {
+	tree, err := s.stateTree()
+	if err != nil {
+		return chainhash.Hash{}, nil, err
+	}
+
+	var root chainhash.Hash
+	witnesses := make([]*primitives.VerificationWitness, len(keys))
+	err = tree.View(func(tx csmt.TreeTransactionAccess) error {
+		h, err := tx.Hash()
+		if err != nil {
+			return err
+		}
+		root = *h
+
+		for i, k := range keys {
+			w, err := tx.Prove(k)
+			if err != nil {
+				return err
+			}
+			witnesses[i] = w
+		}
+		return nil
+	})
+	if err != nil {
+		return chainhash.Hash{}, nil, err
+	}
+
+	return root, witnesses, nil
+}
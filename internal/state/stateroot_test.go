@@ -0,0 +1,69 @@
+package state
+
+import (
+	"testing"
+
+	"github.com/olympus-protocol/ogen/pkg/bitfield"
+	"github.com/olympus-protocol/ogen/pkg/primitives"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStateRoot_Layers(t *testing.T) {
+	cs := primitives.CoinsState{
+		Balances: map[[20]byte]uint64{{1}: 10, {2}: 20},
+		Nonces:   map[[20]byte]uint64{{1}: 1},
+		Assets:   map[[20]byte]*primitives.Asset{},
+	}
+	s := &state{
+		CoinsState:         cs,
+		ValidatorRegistry:  []*primitives.Validator{{Balance: 100}},
+		ManagerReplacement: bitfield.NewBitlist(8),
+		ContractsState: primitives.ContractsState{
+			Contracts: make(map[[20]byte]*primitives.Contract),
+		},
+		trees: new(treeCache),
+	}
+
+	for i := 0; i < 2*maxStateTreeLayers; i++ {
+		parentRoot, err := s.StateRoot()
+		assert.NoError(t, err)
+
+		next := s.Copy().(*state)
+		next.CoinsState.Balances[[20]byte{byte(i + 3)}] = uint64(i + 1)
+		next.CoinsState.Nonces[[20]byte{1}]++
+		next.ValidatorRegistry[0].Balance++
+		next.trees.markAccounts([20]byte{byte(i + 3)}, [20]byte{1})
+		next.trees.markValidators(0)
+		if i%3 == 0 {
+			// a balance set to zero is removed from the tree.
+			next.CoinsState.Balances[[20]byte{byte(i + 2)}] = 0
+			next.trees.markAccounts([20]byte{byte(i + 2)})
+		}
+
+		root, err := next.StateRoot()
+		assert.NoError(t, err)
+
+		values, err := next.stateValues()
+		assert.NoError(t, err)
+		full, err := buildStateTree(values)
+		assert.NoError(t, err)
+		want, err := full.tree.Hash()
+		assert.NoError(t, err)
+		assert.Equal(t, want, root, "root of block %d", i)
+		assert.LessOrEqual(t, next.trees.get().layers, maxStateTreeLayers)
+
+		// the tree of the copied state is not modified.
+		again, err := s.StateRoot()
+		assert.NoError(t, err)
+		assert.Equal(t, parentRoot, again)
+
+		s = next
+	}
+
+	root, witnesses, err := s.ProveKeys(primitives.BalanceKey([20]byte{4}))
+	assert.NoError(t, err)
+	sroot, err := s.StateRoot()
+	assert.NoError(t, err)
+	assert.Equal(t, sroot, root)
+	assert.Len(t, witnesses, 1)
+}
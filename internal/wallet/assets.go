@@ -0,0 +1,154 @@
+package wallet
+
+import (
+	"fmt"
+
+	"github.com/olympus-protocol/ogen/pkg/bech32"
+	"github.com/olympus-protocol/ogen/pkg/chainhash"
+	"github.com/olympus-protocol/ogen/pkg/p2p"
+	"github.com/olympus-protocol/ogen/pkg/primitives"
+)
+
+// IssueAsset issues units of an asset to an account using the current open wallet private key. An empty asset creates
+// a new one issued by the wallet account and an empty account issues the units to the wallet account.
+func (w *wallet) IssueAsset(asset [20]byte, to string, amount uint64) (*chainhash.Hash, [20]byte, error) {
+	acc, err := w.GetAccountRaw()
+	if err != nil {
+		return nil, [20]byte{}, err
+	}
+
+	toPkh := acc
+	if to != "" {
+		toPkh, err = decodeAccount(to)
+		if err != nil {
+			return nil, [20]byte{}, err
+		}
+	}
+
+	tx, err := w.sendAssetTx(primitives.AssetIssue, asset, toPkh, amount)
+	if err != nil {
+		return nil, [20]byte{}, err
+	}
+
+	txHash := tx.Hash()
+
+	return &txHash, tx.Asset, nil
+}
+
+// TransferAsset sends units of an asset to an account using the current open wallet private key.
+func (w *wallet) TransferAsset(asset [20]byte, to string, amount uint64) (*chainhash.Hash, error) {
+	toPkh, err := decodeAccount(to)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := w.sendAssetTx(primitives.AssetTransfer, asset, toPkh, amount)
+	if err != nil {
+		return nil, err
+	}
+
+	txHash := tx.Hash()
+
+	return &txHash, nil
+}
+
+// BurnAsset destroys units of an asset owned by the current open wallet.
+func (w *wallet) BurnAsset(asset [20]byte, amount uint64) (*chainhash.Hash, error) {
+	tx, err := w.sendAssetTx(primitives.AssetBurn, asset, [20]byte{}, amount)
+	if err != nil {
+		return nil, err
+	}
+
+	txHash := tx.Hash()
+
+	return &txHash, nil
+}
+
+// GetAssetBalances returns the confirmed balances of the assets held by the current open wallet.
+func (w *wallet) GetAssetBalances() (map[[20]byte]uint64, error) {
+	if !w.open {
+		return nil, errorNotOpen
+	}
+
+	acc, err := w.GetAccountRaw()
+	if err != nil {
+		return nil, err
+	}
+
+	cs := w.chain.State().TipState().GetCoinsState()
+
+	balances := make(map[[20]byte]uint64)
+	for id, a := range cs.Assets {
+		if b := a.Balances[acc]; b > 0 {
+			balances[id] = b
+		}
+	}
+
+	return balances, nil
+}
+
+func decodeAccount(account string) ([20]byte, error) {
+	var pkh [20]byte
+	_, data, err := bech32.Decode(account)
+	if err != nil {
+		return pkh, err
+	}
+	if len(data) != 20 {
+		return pkh, fmt.Errorf("invalid address")
+	}
+	copy(pkh[:], data)
+	return pkh, nil
+}
+
+// sendAssetTx signs an asset transaction, adds it to the mempool and broadcasts it.
+func (w *wallet) sendAssetTx(txType uint64, asset [20]byte, to [20]byte, amount uint64) (*primitives.AssetTx, error) {
+	if !w.open {
+		return nil, errorNotOpen
+	}
+	priv, err := w.GetSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	acc, err := w.GetAccountRaw()
+	if err != nil {
+		return nil, err
+	}
+
+	latestNonce, err := w.latestNonce(acc)
+	if err != nil {
+		return nil, err
+	}
+
+	var p [48]byte
+	copy(p[:], priv.PublicKey().Marshal())
+
+	tx := &primitives.AssetTx{
+		Type:          txType,
+		Asset:         asset,
+		To:            to,
+		FromPublicKey: p,
+		Amount:        amount,
+		Nonce:         latestNonce + 1,
+		Fee:           5000,
+	}
+
+	if txType == primitives.AssetIssue && asset == [20]byte{} {
+		tx.Asset = primitives.AssetID(acc, tx.Nonce)
+	}
+
+	sigMsg := tx.SignatureMessage()
+	sig := priv.Sign(sigMsg[:])
+	copy(tx.Signature[:], sig.Marshal())
+
+	if err := w.assetsmempool.Add(tx, w.chain.State().TipState()); err != nil {
+		return nil, err
+	}
+
+	err = w.host.Broadcast(&p2p.MsgAssetTx{Data: tx})
+	if err != nil {
+		return nil, err
+	}
+
+	return tx, nil
+}
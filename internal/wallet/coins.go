@@ -34,6 +34,37 @@ func (w *wallet) GetBalance() (uint64, uint64, error) {
 	return confirmed - mempoolRemove, mempoolAddition, nil
 }
 
+// latestNonce returns the latest nonce used by the account, including the transactions on the mempools.
+func (w *wallet) latestNonce(acc [20]byte) (uint64, error) {
+	latestNonce := w.chain.State().TipState().GetCoinsState().Nonces[acc]
+
+	coinsNonce, err := w.coinsmempool.GetMempoolNonce(acc)
+	if err != nil && err != mempool.ErrorAccountNotOnMempool {
+		return 0, err
+	}
+	if err == nil && coinsNonce > latestNonce {
+		latestNonce = coinsNonce
+	}
+
+	contractsNonce, err := w.contractsmempool.GetMempoolNonce(acc)
+	if err != nil && err != mempool.ErrorAccountNotOnMempool {
+		return 0, err
+	}
+	if err == nil && contractsNonce > latestNonce {
+		latestNonce = contractsNonce
+	}
+
+	assetsNonce, err := w.assetsmempool.GetMempoolNonce(acc)
+	if err != nil && err != mempool.ErrorAccountNotOnMempool {
+		return 0, err
+	}
+	if err == nil && assetsNonce > latestNonce {
+		latestNonce = assetsNonce
+	}
+
+	return latestNonce, nil
+}
+
 // SendToAddress sends an amount to an account using the current open wallet private key.
 func (w *wallet) SendToAddress(to string, amount uint64) (*chainhash.Hash, error) {
 	if !w.open {
@@ -63,14 +94,9 @@ func (w *wallet) SendToAddress(to string, amount uint64) (*chainhash.Hash, error
 		return nil, err
 	}
 
-	var latestNonce uint64
-	latestNonce, err = w.coinsmempool.GetMempoolNonce(acc)
+	latestNonce, err := w.latestNonce(acc)
 	if err != nil {
-		if err == mempool.ErrorAccountNotOnMempool {
-			latestNonce = w.chain.State().TipState().GetCoinsState().Nonces[acc]
-		} else {
-			return nil, err
-		}
+		return nil, err
 	}
 	var p [48]byte
 	copy(p[:], pub.Marshal())
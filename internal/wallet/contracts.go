@@ -0,0 +1,120 @@
+package wallet
+
+import (
+	"fmt"
+
+	"github.com/olympus-protocol/ogen/pkg/bech32"
+	"github.com/olympus-protocol/ogen/pkg/chainhash"
+	"github.com/olympus-protocol/ogen/pkg/p2p"
+	"github.com/olympus-protocol/ogen/pkg/primitives"
+)
+
+// DeployContract deploys a contract using the current open wallet private key and returns the contract account.
+func (w *wallet) DeployContract(code []byte, input []byte, amount uint64, gasLimit uint64, gasPrice uint64) (*chainhash.Hash, [20]byte, error) {
+	if !w.open {
+		return nil, [20]byte{}, errorNotOpen
+	}
+	priv, err := w.GetSecret()
+	if err != nil {
+		return nil, [20]byte{}, err
+	}
+
+	acc, err := w.GetAccountRaw()
+	if err != nil {
+		return nil, [20]byte{}, err
+	}
+
+	latestNonce, err := w.latestNonce(acc)
+	if err != nil {
+		return nil, [20]byte{}, err
+	}
+
+	var p [48]byte
+	copy(p[:], priv.PublicKey().Marshal())
+
+	tx := &primitives.ContractDeploy{
+		FromPublicKey: p,
+		Code:          code,
+		Input:         input,
+		Amount:        amount,
+		Nonce:         latestNonce + 1,
+		GasLimit:      gasLimit,
+		GasPrice:      gasPrice,
+	}
+
+	sigMsg := tx.SignatureMessage()
+	sig := priv.Sign(sigMsg[:])
+	copy(tx.Signature[:], sig.Marshal())
+
+	if err := w.contractsmempool.AddDeploy(tx, w.chain.State().TipState()); err != nil {
+		return nil, [20]byte{}, err
+	}
+
+	err = w.host.Broadcast(&p2p.MsgContractDeploy{Data: tx})
+	if err != nil {
+		return nil, [20]byte{}, err
+	}
+
+	txHash := tx.Hash()
+
+	return &txHash, primitives.ContractAddress(acc, tx.Nonce), nil
+}
+
+// CallContract calls a contract using the current open wallet private key.
+func (w *wallet) CallContract(contract string, input []byte, amount uint64, gasLimit uint64, gasPrice uint64) (*chainhash.Hash, error) {
+	if !w.open {
+		return nil, errorNotOpen
+	}
+	priv, err := w.GetSecret()
+	if err != nil {
+		return nil, err
+	}
+	_, data, err := bech32.Decode(contract)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(data) != 20 {
+		return nil, fmt.Errorf("invalid contract address")
+	}
+
+	acc, err := w.GetAccountRaw()
+	if err != nil {
+		return nil, err
+	}
+
+	latestNonce, err := w.latestNonce(acc)
+	if err != nil {
+		return nil, err
+	}
+
+	var p [48]byte
+	copy(p[:], priv.PublicKey().Marshal())
+
+	tx := &primitives.ContractCall{
+		FromPublicKey: p,
+		Input:         input,
+		Amount:        amount,
+		Nonce:         latestNonce + 1,
+		GasLimit:      gasLimit,
+		GasPrice:      gasPrice,
+	}
+	copy(tx.To[:], data)
+
+	sigMsg := tx.SignatureMessage()
+	sig := priv.Sign(sigMsg[:])
+	copy(tx.Signature[:], sig.Marshal())
+
+	if err := w.contractsmempool.AddCall(tx, w.chain.State().TipState()); err != nil {
+		return nil, err
+	}
+
+	err = w.host.Broadcast(&p2p.MsgContractCall{Data: tx})
+	if err != nil {
+		return nil, err
+	}
+
+	txHash := tx.Hash()
+
+	return &txHash, nil
+}
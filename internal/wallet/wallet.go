@@ -42,6 +42,12 @@ type Wallet interface {
 	StartValidator(validatorPrivBytes *bls.SecretKey) (bool, error)
 	ExitValidator(validatorPubKey *bls.PublicKey) (bool, error)
 	SendToAddress(to string, amount uint64) (*chainhash.Hash, error)
+	DeployContract(code []byte, input []byte, amount uint64, gasLimit uint64, gasPrice uint64) (*chainhash.Hash, [20]byte, error)
+	CallContract(contract string, input []byte, amount uint64, gasLimit uint64, gasPrice uint64) (*chainhash.Hash, error)
+	IssueAsset(asset [20]byte, to string, amount uint64) (*chainhash.Hash, [20]byte, error)
+	TransferAsset(asset [20]byte, to string, amount uint64) (*chainhash.Hash, error)
+	BurnAsset(asset [20]byte, amount uint64) (*chainhash.Hash, error)
+	GetAssetBalances() (map[[20]byte]uint64, error)
 }
 
 var _ Wallet = &wallet{}
@@ -54,8 +60,10 @@ type wallet struct {
 	chain     chain.Blockchain
 	host      hostnode.HostNode
 
-	coinsmempool   mempool.CoinsMempool
-	actionsmempool mempool.ActionMempool
+	coinsmempool     mempool.CoinsMempool
+	contractsmempool mempool.ContractsMempool
+	assetsmempool    mempool.AssetsMempool
+	actionsmempool   mempool.ActionMempool
 
 	directory string
 	ctx       context.Context
@@ -71,21 +79,23 @@ type wallet struct {
 }
 
 // NewWallet creates a new wallet.
-func NewWallet(ch chain.Blockchain, hostnode hostnode.HostNode, mempool mempool.CoinsMempool, actionMempool mempool.ActionMempool) (Wallet, error) {
+func NewWallet(ch chain.Blockchain, hostnode hostnode.HostNode, mempool mempool.CoinsMempool, contractsMempool mempool.ContractsMempool, assetsMempool mempool.AssetsMempool, actionMempool mempool.ActionMempool) (Wallet, error) {
 	netParams := config.GlobalParams.NetParams
 	ctx := config.GlobalParams.Context
-	log := config.GlobalParams.Logger
+	log := config.GlobalParams.Logger.Module("wallet")
 
 	wall := &wallet{
-		log:            log,
-		directory:      config.GlobalFlags.DataPath,
-		netParams:      netParams,
-		open:           false,
-		chain:          ch,
-		host:           hostnode,
-		coinsmempool:   mempool,
-		ctx:            ctx,
-		actionsmempool: actionMempool,
+		log:              log,
+		directory:        config.GlobalFlags.DataPath,
+		netParams:        netParams,
+		open:             false,
+		chain:            ch,
+		host:             hostnode,
+		coinsmempool:     mempool,
+		contractsmempool: contractsMempool,
+		assetsmempool:    assetsMempool,
+		ctx:              ctx,
+		actionsmempool:   actionMempool,
 	}
 	return wall, nil
 }
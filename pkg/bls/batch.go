@@ -0,0 +1,49 @@
+package bls
+
+// SignatureBatch collects signatures with their public keys and messages to verify all of them at once.
+type SignatureBatch struct {
+	sigs []*Signature
+	pubs []*PublicKey
+	msgs [][32]byte
+}
+
+// NewSignatureBatch creates an empty signature batch.
+func NewSignatureBatch() *SignatureBatch {
+	return &SignatureBatch{}
+}
+
+// Add appends a signature of a message to the batch.
+func (b *SignatureBatch) Add(sig *Signature, pub *PublicKey, msg [32]byte) {
+	b.sigs = append(b.sigs, sig)
+	b.pubs = append(b.pubs, pub)
+	b.msgs = append(b.msgs, msg)
+}
+
+// Len returns the amount of signatures on the batch.
+func (b *SignatureBatch) Len() int {
+	return len(b.sigs)
+}
+
+// Verify checks all the signatures of the batch with a single randomized multi-pairing. An empty batch is valid.
+func (b *SignatureBatch) Verify() (bool, error) {
+	if len(b.sigs) == 0 {
+		return true, nil
+	}
+	for _, p := range b.pubs {
+		if p == nil || p.IsInfinite() {
+			return false, nil
+		}
+	}
+	return VerifyMultipleSignatures(b.sigs, b.msgs, b.pubs)
+}
+
+// FindInvalid checks the signatures one by one and returns the index of the first invalid signature or -1 if all
+// of them are valid.
+func (b *SignatureBatch) FindInvalid() int {
+	for i := range b.sigs {
+		if b.pubs[i] == nil || !b.sigs[i].Verify(b.pubs[i], b.msgs[i][:]) {
+			return i
+		}
+	}
+	return -1
+}
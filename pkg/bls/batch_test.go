@@ -0,0 +1,41 @@
+package bls_test
+
+import (
+	"testing"
+
+	"github.com/olympus-protocol/ogen/pkg/bls"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSignatureBatch(t *testing.T) {
+	batch := bls.NewSignatureBatch()
+
+	valid, err := batch.Verify()
+	assert.NoError(t, err)
+	assert.True(t, valid)
+
+	for i := 0; i < 50; i++ {
+		msg := [32]byte{'h', 'e', 'l', 'l', 'o', byte(i)}
+		priv, err := bls.RandKey()
+		assert.NoError(t, err)
+		batch.Add(priv.Sign(msg[:]), priv.PublicKey(), msg)
+	}
+	assert.Equal(t, 50, batch.Len())
+
+	valid, err = batch.Verify()
+	assert.NoError(t, err)
+	assert.True(t, valid)
+	assert.Equal(t, -1, batch.FindInvalid())
+
+	priv, err := bls.RandKey()
+	assert.NoError(t, err)
+	other, err := bls.RandKey()
+	assert.NoError(t, err)
+	msg := [32]byte{'w', 'r', 'o', 'n', 'g'}
+	batch.Add(priv.Sign(msg[:]), other.PublicKey(), msg)
+
+	valid, err = batch.Verify()
+	assert.NoError(t, err)
+	assert.False(t, valid)
+	assert.Equal(t, 50, batch.FindInvalid())
+}
@@ -0,0 +1,92 @@
+// Log levels and per module level configuration
+
+package logger
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Level is the severity of a log message, a logger outputs the messages with a level lower or equal to its level.
+type Level int
+
+const (
+	LevelFatal Level = iota
+	LevelError
+	LevelWarn
+	LevelInfo
+	LevelDebug
+	LevelTrace
+)
+
+// DefaultModule is the name used for the level of the loggers without a module.
+const DefaultModule = "default"
+
+var levelNames = []string{"fatal", "error", "warn", "info", "debug", "trace"}
+
+// Modules are the names of the node modules with their own level.
+var Modules = []string{"hostnode", "chain", "mempool", "proposer", "rpc", "wallet", "slasher", "notifier", "dashboard",
+	"actionmanager"}
+
+// IsModule returns true if levels can be set for the module. The DefaultModule is a valid module.
+func IsModule(module string) bool {
+	if module == DefaultModule {
+		return true
+	}
+	for _, m := range Modules {
+		if m == module {
+			return true
+		}
+	}
+	return false
+}
+
+// String returns the name of the level
+func (l Level) String() string {
+	if l < LevelFatal || l > LevelTrace {
+		return fmt.Sprintf("level(%d)", int(l))
+	}
+	return levelNames[l]
+}
+
+// ParseLevel returns the level for a level name
+func ParseLevel(s string) (Level, error) {
+	name := strings.ToLower(strings.TrimSpace(s))
+	if name == "warning" {
+		name = "warn"
+	}
+	for i, n := range levelNames {
+		if n == name {
+			return Level(i), nil
+		}
+	}
+	return 0, fmt.Errorf("unknown log level %s", s)
+}
+
+// ParseLevels parses a comma separated list of levels. Entries with the form module=level set the level of a module,
+// a single level without a module sets the default level, e.g. "info,chain=debug,mempool=warn". Modules that are not
+// part of Modules are rejected.
+func ParseLevels(spec string) (map[string]Level, error) {
+	levels := make(map[string]Level)
+	for _, entry := range strings.Split(spec, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		module := DefaultModule
+		name := entry
+		if i := strings.Index(entry, "="); i >= 0 {
+			module = strings.TrimSpace(entry[:i])
+			name = entry[i+1:]
+		}
+		if !IsModule(module) {
+			return nil, fmt.Errorf("unknown log module %s", module)
+		}
+		level, err := ParseLevel(name)
+		if err != nil {
+			return nil, err
+		}
+		levels[module] = level
+	}
+	return levels, nil
+}
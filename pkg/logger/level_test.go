@@ -0,0 +1,55 @@
+package logger_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/olympus-protocol/ogen/pkg/logger"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseLevels(t *testing.T) {
+	tests := []struct {
+		spec   string
+		levels map[string]logger.Level
+		err    string
+	}{
+		{spec: "", levels: map[string]logger.Level{}},
+		{spec: "debug", levels: map[string]logger.Level{logger.DefaultModule: logger.LevelDebug}},
+		{
+			spec: "info, chain=debug,mempool=WARNING,",
+			levels: map[string]logger.Level{
+				logger.DefaultModule: logger.LevelInfo,
+				"chain":              logger.LevelDebug,
+				"mempool":            logger.LevelWarn,
+			},
+		},
+		{spec: "default=trace", levels: map[string]logger.Level{logger.DefaultModule: logger.LevelTrace}},
+		{spec: "chain=verbose", err: "unknown log level verbose"},
+		{spec: "loud", err: "unknown log level loud"},
+		{spec: "chian=debug", err: "unknown log module chian"},
+	}
+
+	for _, tt := range tests {
+		levels, err := logger.ParseLevels(tt.spec)
+		if tt.err != "" {
+			assert.EqualError(t, err, tt.err, tt.spec)
+			continue
+		}
+		assert.NoError(t, err, tt.spec)
+		assert.Equal(t, tt.levels, levels, tt.spec)
+	}
+}
+
+func TestLogger_SetLevel(t *testing.T) {
+	log := logger.New(os.Stdout)
+
+	assert.NoError(t, log.SetLevel("chain", logger.LevelDebug))
+	assert.NoError(t, log.SetLevel("", logger.LevelWarn))
+	assert.EqualError(t, log.SetLevel("chian", logger.LevelDebug), "unknown log module chian")
+
+	assert.Equal(t, map[string]logger.Level{
+		logger.DefaultModule: logger.LevelWarn,
+		"chain":              logger.LevelDebug,
+	}, log.Levels())
+}
@@ -4,11 +4,13 @@
 package logger
 
 import (
+	"encoding/json"
 	"fmt"
 	"io"
 	"os"
 	"path/filepath"
 	"runtime"
+	"strings"
 	"sync"
 	"time"
 )
@@ -28,9 +30,15 @@ type Logger interface {
 	IsDebug() bool
 	WithTimestamp() Logger
 	WithoutTimestamp() Logger
+	WithJSON() Logger
+	WithoutJSON() Logger
 	Quiet() Logger
 	NoQuiet() Logger
 	IsQuiet() bool
+	Module(name string) Logger
+	With(keyvals ...interface{}) Logger
+	SetLevel(module string, level Level) error
+	Levels() map[string]Level
 	Output(depth int, prefix Prefix, data string) error
 	Fatal(v ...interface{})
 	Fatalf(format string, v ...interface{})
@@ -48,15 +56,23 @@ type Logger interface {
 
 var _ Logger = &logger{}
 
-// logger struct define the underlying storage for sing	le logger
-type logger struct {
+// core is the output and configuration shared by a logger and its module and field loggers
+type core struct {
 	mu        sync.Mutex
 	color     bool
 	out       FdWriter
-	debug     bool
 	timestamp bool
 	quiet     bool
+	json      bool
 	buf       ColorBuffer
+	levels    map[string]Level
+}
+
+// logger struct define the underlying storage for single logger
+type logger struct {
+	*core
+	module string
+	fields []interface{}
 }
 
 // Prefix struct define plain and color byte
@@ -64,6 +80,7 @@ type Prefix struct {
 	Plain []byte
 	Color []byte
 	File  bool
+	Level Level
 }
 
 var (
@@ -80,6 +97,7 @@ var (
 		Plain: plainFatal,
 		Color: Red(plainFatal),
 		File:  true,
+		Level: LevelFatal,
 	}
 
 	// ErrorPrefix show error prefix
@@ -87,18 +105,21 @@ var (
 		Plain: plainError,
 		Color: Red(plainError),
 		File:  true,
+		Level: LevelError,
 	}
 
 	// WarnPrefix show warn prefix
 	WarnPrefix = Prefix{
 		Plain: plainWarn,
 		Color: Orange(plainWarn),
+		Level: LevelWarn,
 	}
 
 	// InfoPrefix show info prefix
 	InfoPrefix = Prefix{
 		Plain: plainInfo,
 		Color: Green(plainInfo),
+		Level: LevelInfo,
 	}
 
 	// DebugPrefix show info prefix
@@ -106,12 +127,14 @@ var (
 		Plain: plainDebug,
 		Color: Purple(plainDebug),
 		File:  true,
+		Level: LevelDebug,
 	}
 
 	// TracePrefix show info prefix
 	TracePrefix = Prefix{
 		Plain: plainTrace,
 		Color: Cyan(plainTrace),
+		Level: LevelTrace,
 	}
 )
 
@@ -119,9 +142,12 @@ var (
 // automatically detect terminal coloring support
 func New(out FdWriter) Logger {
 	return &logger{
-		color:     false,
-		out:       out,
-		timestamp: true,
+		core: &core{
+			color:     false,
+			out:       out,
+			timestamp: true,
+			levels:    map[string]Level{DefaultModule: LevelInfo},
+		},
 	}
 }
 
@@ -143,25 +169,88 @@ func (l *logger) WithoutColor() Logger {
 
 // WithDebug turn on debugging output on the log to reveal debug and trace level
 func (l *logger) WithDebug() Logger {
-	l.mu.Lock()
-	defer l.mu.Unlock()
-	l.debug = true
+	_ = l.SetLevel(DefaultModule, LevelTrace)
 	return l
 }
 
 // WithoutDebug turn off debugging output on the log
 func (l *logger) WithoutDebug() Logger {
-	l.mu.Lock()
-	defer l.mu.Unlock()
-	l.debug = false
+	_ = l.SetLevel(DefaultModule, LevelInfo)
 	return l
 }
 
 // IsDebug check the state of debugging output
 func (l *logger) IsDebug() bool {
+	return l.enabled(LevelDebug)
+}
+
+// WithJSON turn on JSON output, every message is written as a JSON object on a single line
+func (l *logger) WithJSON() Logger {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.json = true
+	return l
+}
+
+// WithoutJSON turn off JSON output
+func (l *logger) WithoutJSON() Logger {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.json = false
+	return l
+}
+
+// Module returns a logger for a module, the module level is used instead of the default level when it is set
+func (l *logger) Module(name string) Logger {
+	return &logger{core: l.core, module: name, fields: l.fields}
+}
+
+// With returns a logger that adds the key value pairs to every message
+func (l *logger) With(keyvals ...interface{}) Logger {
+	if len(keyvals)%2 != 0 {
+		keyvals = append(keyvals, nil)
+	}
+	fields := make([]interface{}, 0, len(l.fields)+len(keyvals))
+	fields = append(fields, l.fields...)
+	fields = append(fields, keyvals...)
+	return &logger{core: l.core, module: l.module, fields: fields}
+}
+
+// SetLevel sets the level of a module, the DefaultModule level is used for modules without a level. Modules that are
+// not part of Modules are rejected.
+func (l *logger) SetLevel(module string, level Level) error {
+	if module == "" {
+		module = DefaultModule
+	}
+	if !IsModule(module) {
+		return fmt.Errorf("unknown log module %s", module)
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.levels[module] = level
+	return nil
+}
+
+// Levels returns the default level and the levels set for modules
+func (l *logger) Levels() map[string]Level {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	levels := make(map[string]Level, len(l.levels))
+	for m, lvl := range l.levels {
+		levels[m] = lvl
+	}
+	return levels
+}
+
+// enabled check if messages of the level are written for the module of the logger
+func (l *logger) enabled(level Level) bool {
 	l.mu.Lock()
 	defer l.mu.Unlock()
-	return l.debug
+	current, ok := l.levels[l.module]
+	if !ok {
+		current = l.levels[DefaultModule]
+	}
+	return level <= current
 }
 
 // WithTimestamp turn on timestamp output on the log
@@ -209,6 +298,10 @@ func (l *logger) Output(depth int, prefix Prefix, data string) error {
 	if l.IsQuiet() {
 		return nil
 	}
+	// Check if the module writes messages of the prefix level
+	if !l.enabled(prefix.Level) {
+		return nil
+	}
 	// Get current time
 	now := time.Now()
 	// Temporary storage for file and line tracing
@@ -235,6 +328,18 @@ func (l *logger) Output(depth int, prefix Prefix, data string) error {
 	defer l.mu.Unlock()
 	// Reset buffer so it start from the beginning
 	l.buf.Reset()
+	if l.json {
+		l.appendJSON(now, prefix, fn, file, line, data)
+	} else {
+		l.appendText(now, prefix, fn, file, line, data)
+	}
+	// Flush buffer to output
+	_, err := l.out.Write(l.buf.Buffer)
+	return err
+}
+
+// appendText writes a message to the buffer as colored or plain text
+func (l *logger) appendText(now time.Time, prefix Prefix, fn, file string, line int, data string) {
 	// Write prefix to the buffer
 	if l.color {
 		l.buf.Append(prefix.Color)
@@ -267,6 +372,12 @@ func (l *logger) Output(depth int, prefix Prefix, data string) error {
 			l.buf.Off()
 		}
 	}
+	// Add the module name if the logger has one
+	if l.module != "" {
+		l.buf.AppendByte('[')
+		l.buf.Append([]byte(l.module))
+		l.buf.Append([]byte("] "))
+	}
 	// Add caller filename and line if enabled
 	if prefix.File {
 		// Print color start if enabled
@@ -286,13 +397,57 @@ func (l *logger) Output(depth int, prefix Prefix, data string) error {
 		}
 	}
 	// Print the actual string data from caller
-	l.buf.Append([]byte(data))
-	if len(data) == 0 || data[len(data)-1] != '\n' {
-		l.buf.AppendByte('\n')
+	l.buf.Append([]byte(strings.TrimSuffix(data, "\n")))
+	// Print the fields as key=value pairs
+	for i := 0; i < len(l.fields); i += 2 {
+		l.buf.AppendByte(' ')
+		l.buf.Append([]byte(fmt.Sprint(l.fields[i])))
+		l.buf.AppendByte('=')
+		l.buf.Append([]byte(fmt.Sprint(l.fields[i+1])))
 	}
-	// Flush buffer to output
-	_, err := l.out.Write(l.buf.Buffer)
-	return err
+	l.buf.AppendByte('\n')
+}
+
+// appendJSON writes a message to the buffer as a JSON object
+func (l *logger) appendJSON(now time.Time, prefix Prefix, fn, file string, line int, data string) {
+	appendField := func(key string, value interface{}) {
+		if len(l.buf.Buffer) > 1 {
+			l.buf.AppendByte(',')
+		}
+		k, _ := json.Marshal(key)
+		v, err := json.Marshal(value)
+		if err != nil {
+			v, _ = json.Marshal(fmt.Sprint(value))
+		}
+		l.buf.Append(k)
+		l.buf.AppendByte(':')
+		l.buf.Append(v)
+	}
+
+	l.buf.AppendByte('{')
+	if l.timestamp {
+		appendField("time", now.Format(time.RFC3339Nano))
+	}
+	appendField("level", prefix.Level.String())
+	if l.module != "" {
+		appendField("module", l.module)
+	}
+	if prefix.File {
+		appendField("caller", fmt.Sprintf("%s:%s:%d", fn, file, line))
+	}
+	appendField("msg", strings.TrimSuffix(data, "\n"))
+	for i := 0; i < len(l.fields); i += 2 {
+		key, ok := l.fields[i].(string)
+		if !ok {
+			key = fmt.Sprint(l.fields[i])
+		}
+		value := l.fields[i+1]
+		if err, ok := value.(error); ok {
+			value = err.Error()
+		}
+		appendField(key, value)
+	}
+	l.buf.Append([]byte("}\n"))
 }
 
 // Fatal print fatal message to output and quit the application with status 1
@@ -338,30 +493,30 @@ func (l *logger) Infof(format string, v ...interface{}) {
 	_ = l.Output(1, InfoPrefix, fmt.Sprintf(format, v...))
 }
 
-// Debug print debug message to output if debug output enabled
+// Debug print debug message to output if the debug level is enabled
 func (l *logger) Debug(v ...interface{}) {
-	if l.IsDebug() {
+	if l.enabled(LevelDebug) {
 		_ = l.Output(1, DebugPrefix, fmt.Sprintln(v...))
 	}
 }
 
-// Debugf print formatted debug message to output if debug output enabled
+// Debugf print formatted debug message to output if the debug level is enabled
 func (l *logger) Debugf(format string, v ...interface{}) {
-	if l.IsDebug() {
+	if l.enabled(LevelDebug) {
 		_ = l.Output(1, DebugPrefix, fmt.Sprintf(format, v...))
 	}
 }
 
-// Trace print trace message to output if debug output enabled
+// Trace print trace message to output if the debug level is enabled
 func (l *logger) Trace(v ...interface{}) {
-	if l.IsDebug() {
+	if l.enabled(LevelTrace) {
 		_ = l.Output(1, TracePrefix, fmt.Sprintln(v...))
 	}
 }
 
-// Tracef print formatted trace message to output if debug output enabled
+// Tracef print formatted trace message to output if the debug level is enabled
 func (l *logger) Tracef(format string, v ...interface{}) {
-	if l.IsDebug() {
+	if l.enabled(LevelTrace) {
 		_ = l.Output(1, TracePrefix, fmt.Sprintf(format, v...))
 	}
 }
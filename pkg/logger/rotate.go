@@ -0,0 +1,132 @@
+// Log file rotation
+
+package logger
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+)
+
+// rotationTimeFormat is the suffix added to the rotated files.
+const rotationTimeFormat = "20060102-150405.000"
+
+// RotatingFile is a log file that is rotated when it reaches a maximum size or when a new time interval starts.
+// Rotated files are renamed with the rotation time as suffix and the oldest are removed to keep at most maxBackups.
+type RotatingFile struct {
+	mu         sync.Mutex
+	path       string
+	maxSize    int64
+	interval   time.Duration
+	maxBackups int
+
+	file   *os.File
+	size   int64
+	period time.Time
+}
+
+var _ FdWriter = &RotatingFile{}
+
+// OpenRotatingFile opens a log file for appending. A zero maxSize or interval disables the rotation by size or time and
+// a zero maxBackups keeps all the rotated files.
+func OpenRotatingFile(path string, maxSize int64, interval time.Duration, maxBackups int) (*RotatingFile, error) {
+	f := &RotatingFile{
+		path:       path,
+		maxSize:    maxSize,
+		interval:   interval,
+		maxBackups: maxBackups,
+	}
+	if err := f.open(); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+func (f *RotatingFile) open() error {
+	file, err := os.OpenFile(f.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+	f.file = file
+	f.size = info.Size()
+	f.period = f.periodOf(info.ModTime())
+	return nil
+}
+
+func (f *RotatingFile) periodOf(t time.Time) time.Time {
+	if f.interval <= 0 {
+		return time.Time{}
+	}
+	return t.Truncate(f.interval)
+}
+
+// rotate renames the current file, opens a new one and removes the old backups.
+func (f *RotatingFile) rotate(now time.Time) error {
+	if err := f.file.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(f.path, f.path+"."+now.Format(rotationTimeFormat)); err != nil {
+		return err
+	}
+	if err := f.open(); err != nil {
+		return err
+	}
+	f.period = f.periodOf(now)
+
+	if f.maxBackups <= 0 {
+		return nil
+	}
+	backups, err := filepath.Glob(f.path + ".*")
+	if err != nil {
+		return err
+	}
+	sort.Strings(backups)
+	for len(backups) > f.maxBackups {
+		_ = os.Remove(backups[0])
+		backups = backups[1:]
+	}
+	return nil
+}
+
+// Write writes the data to the file, rotating it first if the data doesn't fit or the time interval changed.
+func (f *RotatingFile) Write(p []byte) (int, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	now := time.Now()
+	sizeExceeded := f.maxSize > 0 && f.size > 0 && f.size+int64(len(p)) > f.maxSize
+	periodChanged := f.interval > 0 && !f.periodOf(now).Equal(f.period)
+	if f.size == 0 {
+		// an empty file is not rotated, it starts the current interval.
+		f.period = f.periodOf(now)
+	} else if sizeExceeded || periodChanged {
+		if err := f.rotate(now); err != nil {
+			return 0, err
+		}
+	}
+
+	n, err := f.file.Write(p)
+	f.size += int64(n)
+	return n, err
+}
+
+// Fd returns the file descriptor of the current file
+func (f *RotatingFile) Fd() uintptr {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.file.Fd()
+}
+
+// Close closes the current file
+func (f *RotatingFile) Close() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.file.Close()
+}
@@ -0,0 +1,97 @@
+package logger_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/olympus-protocol/ogen/pkg/logger"
+	"github.com/stretchr/testify/assert"
+)
+
+func tempLogFile(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "logger")
+	assert.NoError(t, err)
+	t.Cleanup(func() {
+		_ = os.RemoveAll(dir)
+	})
+	return filepath.Join(dir, "ogen.log")
+}
+
+func backups(t *testing.T, path string) []string {
+	files, err := filepath.Glob(path + ".*")
+	assert.NoError(t, err)
+	return files
+}
+
+func TestRotatingFile_Size(t *testing.T) {
+	path := tempLogFile(t)
+	f, err := logger.OpenRotatingFile(path, 10, 0, 2)
+	assert.NoError(t, err)
+	defer f.Close()
+
+	// writes that fit don't rotate the file.
+	_, err = f.Write([]byte("12345"))
+	assert.NoError(t, err)
+	_, err = f.Write([]byte("12345"))
+	assert.NoError(t, err)
+	assert.Empty(t, backups(t, path))
+
+	for i := 0; i < 3; i++ {
+		time.Sleep(2 * time.Millisecond)
+		_, err = f.Write([]byte("abcdefgh"))
+		assert.NoError(t, err)
+	}
+
+	// only the last maxBackups rotated files are kept.
+	rotated := backups(t, path)
+	if assert.Len(t, rotated, 2) {
+		data, err := ioutil.ReadFile(rotated[1])
+		assert.NoError(t, err)
+		assert.Equal(t, "abcdefgh", string(data))
+	}
+	data, err := ioutil.ReadFile(path)
+	assert.NoError(t, err)
+	assert.Equal(t, "abcdefgh", string(data))
+
+	// a write larger than the maximum size is written to an empty file.
+	_, err = f.Write([]byte("0123456789abcdef"))
+	assert.NoError(t, err)
+	data, err = ioutil.ReadFile(path)
+	assert.NoError(t, err)
+	assert.Equal(t, "0123456789abcdef", string(data))
+}
+
+func TestRotatingFile_Time(t *testing.T) {
+	path := tempLogFile(t)
+	interval := 200 * time.Millisecond
+
+	// start at the beginning of an interval so the first writes are on the same interval.
+	time.Sleep(time.Until(time.Now().Truncate(interval).Add(interval)))
+
+	f, err := logger.OpenRotatingFile(path, 0, interval, 0)
+	assert.NoError(t, err)
+	defer f.Close()
+
+	_, err = f.Write([]byte("first\n"))
+	assert.NoError(t, err)
+	_, err = f.Write([]byte("second\n"))
+	assert.NoError(t, err)
+	assert.Empty(t, backups(t, path))
+
+	time.Sleep(interval)
+	_, err = f.Write([]byte("third\n"))
+	assert.NoError(t, err)
+
+	rotated := backups(t, path)
+	if assert.Len(t, rotated, 1) {
+		data, err := ioutil.ReadFile(rotated[0])
+		assert.NoError(t, err)
+		assert.Equal(t, "first\nsecond\n", string(data))
+	}
+	data, err := ioutil.ReadFile(path)
+	assert.NoError(t, err)
+	assert.Equal(t, "third\n", string(data))
+}
@@ -31,6 +31,8 @@ const (
 	MsgDepositsCmd = "deposits"
 	// MsgVoteCmd is a single vote element
 	MsgVoteCmd = "vote"
+	// MsgAggregateVoteCmd is an aggregated vote element
+	MsgAggregateVoteCmd = "aggregate_vote"
 	// MsgValidatorStart is a validator hello element
 	MsgValidatorStartCmd = "validator_hello"
 	// MsgExitCmd is a exit element
@@ -41,6 +43,12 @@ const (
 	MsgGovernanceCmd = "governance_vote"
 	// MsgTxMultiCmd is a exit element
 	MsgTxMultiCmd = "tx_multi"
+	// MsgContractDeployCmd is a contract deploy element
+	MsgContractDeployCmd = "contract_deploy"
+	// MsgContractCallCmd is a contract call element
+	MsgContractCallCmd = "contract_call"
+	// MsgAssetTxCmd is an asset transaction element
+	MsgAssetTxCmd = "asset_tx"
 	// MsgVersionCmd is for version handshake
 	MsgVersionCmd = "version"
 	// MsgGetBlocksCmd ask a node for blocks
@@ -90,12 +98,20 @@ func makeEmptyMessage(command string) (Message, error) {
 		msg = &MsgTx{}
 	case MsgTxMultiCmd:
 		msg = &MsgTxMulti{}
+	case MsgContractDeployCmd:
+		msg = &MsgContractDeploy{}
+	case MsgContractCallCmd:
+		msg = &MsgContractCall{}
+	case MsgAssetTxCmd:
+		msg = &MsgAssetTx{}
 	case MsgDepositCmd:
 		msg = &MsgDeposit{}
 	case MsgDepositsCmd:
 		msg = &MsgDeposits{}
 	case MsgVoteCmd:
 		msg = &MsgVote{}
+	case MsgAggregateVoteCmd:
+		msg = &MsgAggregateVote{}
 	case MsgExitCmd:
 		msg = &MsgExit{}
 	case MsgExitsCmd:
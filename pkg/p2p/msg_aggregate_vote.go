@@ -0,0 +1,30 @@
+package p2p
+
+import (
+	"github.com/olympus-protocol/ogen/pkg/primitives"
+)
+
+// MsgAggregateVote is the struct of the message that transmits an aggregated vote.
+type MsgAggregateVote struct {
+	Data *primitives.AggregateVote
+}
+
+// Marshal serializes the data to bytes
+func (m *MsgAggregateVote) Marshal() ([]byte, error) {
+	return m.MarshalSSZ()
+}
+
+// Unmarshal deserializes the data
+func (m *MsgAggregateVote) Unmarshal(b []byte) error {
+	return m.UnmarshalSSZ(b)
+}
+
+// Command returns the message topic
+func (m *MsgAggregateVote) Command() string {
+	return MsgAggregateVoteCmd
+}
+
+// MaxPayloadLength returns the maximum size of the MsgAggregateVote message.
+func (m *MsgAggregateVote) MaxPayloadLength() uint64 {
+	return 6694
+}
@@ -0,0 +1,30 @@
+package p2p
+
+import (
+	"github.com/olympus-protocol/ogen/pkg/primitives"
+)
+
+// MsgAssetTx is the struct of the message the is transmitted upon the network.
+type MsgAssetTx struct {
+	Data *primitives.AssetTx
+}
+
+// Marshal serializes the data to bytes
+func (m *MsgAssetTx) Marshal() ([]byte, error) {
+	return m.MarshalSSZ()
+}
+
+// Unmarshal deserializes the data
+func (m *MsgAssetTx) Unmarshal(b []byte) error {
+	return m.UnmarshalSSZ(b)
+}
+
+// Command returns the message topic
+func (m *MsgAssetTx) Command() string {
+	return MsgAssetTxCmd
+}
+
+// MaxPayloadLength returns the maximum size of the MsgAssetTx message.
+func (m *MsgAssetTx) MaxPayloadLength() uint64 {
+	return primitives.MaxAssetTxSize
+}
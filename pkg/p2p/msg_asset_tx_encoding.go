@@ -0,0 +1,70 @@
+// Code generated by fastssz. DO NOT EDIT.
+package p2p
+
+import (
+	ssz "github.com/ferranbt/fastssz"
+	"github.com/olympus-protocol/ogen/pkg/primitives"
+)
+
+// MarshalSSZ ssz marshals the MsgAssetTx object
+func (m *MsgAssetTx) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(m)
+}
+
+// MarshalSSZTo ssz marshals the MsgAssetTx object to a target array
+func (m *MsgAssetTx) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Data'
+	if m.Data == nil {
+		m.Data = new(primitives.AssetTx)
+	}
+	if dst, err = m.Data.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the MsgAssetTx object
+func (m *MsgAssetTx) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 216 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'Data'
+	if m.Data == nil {
+		m.Data = new(primitives.AssetTx)
+	}
+	if err = m.Data.UnmarshalSSZ(buf[0:216]); err != nil {
+		return err
+	}
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the MsgAssetTx object
+func (m *MsgAssetTx) SizeSSZ() (size int) {
+	size = 216
+	return
+}
+
+// HashTreeRoot ssz hashes the MsgAssetTx object
+func (m *MsgAssetTx) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(m)
+}
+
+// HashTreeRootWith ssz hashes the MsgAssetTx object with a hasher
+func (m *MsgAssetTx) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Data'
+	if err = m.Data.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	hh.Merkleize(indx)
+	return
+}
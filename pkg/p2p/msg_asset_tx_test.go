@@ -0,0 +1,25 @@
+package p2p_test
+
+import (
+	"github.com/olympus-protocol/ogen/pkg/p2p"
+	testdata "github.com/olympus-protocol/ogen/test"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestMsgAssetTx(t *testing.T) {
+	v := new(p2p.MsgAssetTx)
+	v.Data = testdata.FuzzAssetTx(1)[0]
+
+	ser, err := v.Marshal()
+	assert.NoError(t, err)
+
+	desc := new(p2p.MsgAssetTx)
+	err = desc.Unmarshal(ser)
+	assert.NoError(t, err)
+
+	assert.Equal(t, v, desc)
+
+	assert.Equal(t, p2p.MsgAssetTxCmd, v.Command())
+	assert.Equal(t, uint64(216), v.MaxPayloadLength())
+}
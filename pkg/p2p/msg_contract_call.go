@@ -0,0 +1,30 @@
+package p2p
+
+import (
+	"github.com/olympus-protocol/ogen/pkg/primitives"
+)
+
+// MsgContractCall is the struct of the message the is transmitted upon the network.
+type MsgContractCall struct {
+	Data *primitives.ContractCall
+}
+
+// Marshal serializes the data to bytes
+func (m *MsgContractCall) Marshal() ([]byte, error) {
+	return m.MarshalSSZ()
+}
+
+// Unmarshal deserializes the data
+func (m *MsgContractCall) Unmarshal(b []byte) error {
+	return m.UnmarshalSSZ(b)
+}
+
+// Command returns the message topic
+func (m *MsgContractCall) Command() string {
+	return MsgContractCallCmd
+}
+
+// MaxPayloadLength returns the maximum size of the MsgContractCall message.
+func (m *MsgContractCall) MaxPayloadLength() uint64 {
+	return primitives.MaxContractCallSize + 4
+}
@@ -0,0 +1,30 @@
+package p2p
+
+import (
+	"github.com/olympus-protocol/ogen/pkg/primitives"
+)
+
+// MsgContractDeploy is the struct of the message the is transmitted upon the network.
+type MsgContractDeploy struct {
+	Data *primitives.ContractDeploy
+}
+
+// Marshal serializes the data to bytes
+func (m *MsgContractDeploy) Marshal() ([]byte, error) {
+	return m.MarshalSSZ()
+}
+
+// Unmarshal deserializes the data
+func (m *MsgContractDeploy) Unmarshal(b []byte) error {
+	return m.UnmarshalSSZ(b)
+}
+
+// Command returns the message topic
+func (m *MsgContractDeploy) Command() string {
+	return MsgContractDeployCmd
+}
+
+// MaxPayloadLength returns the maximum size of the MsgContractDeploy message.
+func (m *MsgContractDeploy) MaxPayloadLength() uint64 {
+	return primitives.MaxContractDeploySize + 4
+}
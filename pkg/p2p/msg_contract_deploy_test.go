@@ -0,0 +1,25 @@
+package p2p_test
+
+import (
+	"github.com/olympus-protocol/ogen/pkg/p2p"
+	testdata "github.com/olympus-protocol/ogen/test"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestMsgContractDeploy(t *testing.T) {
+	v := new(p2p.MsgContractDeploy)
+	v.Data = testdata.FuzzContractDeploy(1)[0]
+
+	ser, err := v.Marshal()
+	assert.NoError(t, err)
+
+	desc := new(p2p.MsgContractDeploy)
+	err = desc.Unmarshal(ser)
+	assert.NoError(t, err)
+
+	assert.Equal(t, v, desc)
+
+	assert.Equal(t, p2p.MsgContractDeployCmd, v.Command())
+	assert.Equal(t, uint64(9404), v.MaxPayloadLength())
+}
@@ -41,6 +41,7 @@ type ChainParams struct {
 	EjectionBalance              uint64
 	MaxBalanceChurnQuotient      uint64
 	MaxVotesPerBlock             uint64
+	TargetAggregatorsPerSlot     uint64
 	MaxTxsPerBlock               uint64
 	MaxTxsMultiPerBlock          uint64
 	LatestBlockRootsLength       uint64
@@ -58,11 +59,17 @@ type ChainParams struct {
 	MaxVoteSlashingsPerBlock     uint64
 	MaxGovernanceVotesPerBlock   uint64
 	MaxMigrationsProofsPerBlock  uint64
+	MaxContractDeploysPerBlock   uint64
+	MaxContractCallsPerBlock     uint64
+	MaxContractGasPerBlock       uint64
+	MinContractGasPrice          uint64
+	MaxAssetTxsPerBlock          uint64
 	WhistleblowerRewardQuotient  uint64
 	GovernancePercentages        []uint8
 	MinVotingBalance             uint64
 	CommunityOverrideQuotient    uint64
 	VotingPeriodSlots            uint64
+	StateRootActivationSlot      uint64 // first slot whose block header must commit to the state root
 	InitialManagers              [][20]byte
 	RendevouzStrings             map[int]string
 	Relayers                     map[string]string
@@ -92,6 +99,7 @@ var Mainnet = ChainParams{
 	InactivityPenaltyQuotient:    17179869184,
 	SlotDuration:                 30,
 	MaxVotesPerBlock:             32,
+	TargetAggregatorsPerSlot:     16,
 	MaxTxsPerBlock:               5000,
 	MaxTxsMultiPerBlock:          128,
 	MaxDepositsPerBlock:          128,
@@ -101,6 +109,11 @@ var Mainnet = ChainParams{
 	MaxVoteSlashingsPerBlock:     10,
 	MaxGovernanceVotesPerBlock:   128,
 	MaxMigrationsProofsPerBlock:  5,
+	MaxContractDeploysPerBlock:   16,
+	MaxContractCallsPerBlock:     128,
+	MaxContractGasPerBlock:       10000000,
+	MinContractGasPrice:          1,
+	MaxAssetTxsPerBlock:          1024,
 	WhistleblowerRewardQuotient:  2, // Validator loses half their deposit
 	GovernancePercentages: []uint8{
 		30, // tech
@@ -112,6 +125,8 @@ var Mainnet = ChainParams{
 	MinVotingBalance:          100,
 	CommunityOverrideQuotient: 3,
 	VotingPeriodSlots:         20160, // minutes in a week
+	// the blocks already on mainnet have no state root, the activation is not scheduled yet.
+	StateRootActivationSlot: math.MaxUint64,
 	InitialManagers: [][20]byte{
 		{},
 		{},
@@ -164,6 +179,7 @@ var TestNet = ChainParams{
 	InactivityPenaltyQuotient:    17179869184,
 	SlotDuration:                 30,
 	MaxVotesPerBlock:             32,
+	TargetAggregatorsPerSlot:     16,
 	MaxTxsPerBlock:               5000,
 	MaxTxsMultiPerBlock:          128,
 	MaxDepositsPerBlock:          128,
@@ -172,6 +188,11 @@ var TestNet = ChainParams{
 	MaxProposerSlashingsPerBlock: 2,
 	MaxVoteSlashingsPerBlock:     10,
 	MaxMigrationsProofsPerBlock:  5,
+	MaxContractDeploysPerBlock:   16,
+	MaxContractCallsPerBlock:     128,
+	MaxContractGasPerBlock:       10000000,
+	MinContractGasPrice:          1,
+	MaxAssetTxsPerBlock:          1024,
 	WhistleblowerRewardQuotient:  2,
 	GovernancePercentages: []uint8{
 		30, // tech
@@ -183,6 +204,8 @@ var TestNet = ChainParams{
 	MinVotingBalance:          100,
 	CommunityOverrideQuotient: 3,
 	VotingPeriodSlots:         20160, // minutes in a week
+	// the blocks already on testnet have no state root, the activation is not scheduled yet.
+	StateRootActivationSlot: math.MaxUint64,
 	InitialManagers: [][20]byte{
 		daoTest1, // tlpub1tppnrl6hv7gs2je6vrpa0xzrxyjuh32pnw4uua | tlprv1pfmtg75uva0vdepd2w83ldlsrt3ayj7te8a95mrmuu8t0adylqvqxq9cz0
 		daoTest2, // tlpub1nnpct659h9vwf2u3ty787txzy2kamwxp8zh42w | tlprv1zplv47hw33y2ks3mt2pqywxmhg3g7ct5u06074qxfvk73cvtz3qqnyvjnv
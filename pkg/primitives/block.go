@@ -10,7 +10,7 @@ const MaxBlockSize = 1024 * 1024 * 2.5 // 2.5 MB
 
 // Block is a block in the blockchain.
 type Block struct {
-	Header            *BlockHeader          // 												= 372 bytes
+	Header            *BlockHeader          // 												= 436 bytes
 	Votes             []*MultiValidatorVote `ssz-max:"32"`   // MaxVotesPerBlock 				32 * 6474 		= 207168 bytes
 	Txs               []*Tx                 `ssz-max:"5000"` // MaxTxsPerBlock					204 * 5000  	= 1020000 bytes
 	TxsMulti          []*TxMulti            `ssz-max:"128"`  // MaxTxsPerBlock
@@ -20,6 +20,9 @@ type Block struct {
 	RANDAOSlashings   []*RANDAOSlashing     `ssz-max:"20"`   // MaxRANDAOSlashingPerBlock   	152 * 20 		= 3040 bytes
 	ProposerSlashings []*ProposerSlashing   `ssz-max:"2"`    // MaxProposerSlashingPerBlock 	984 * 2 		= 1968 bytes
 	GovernanceVotes   []*GovernanceVote     `ssz-max:"128"`  // MaxGovernanceVotesPerBlock		260 * 128		= 33280 bytes
+	ContractDeploys   []*ContractDeploy     `ssz-max:"16"`   // MaxContractDeploysPerBlock		9400 * 16		= 150400 bytes
+	ContractCalls     []*ContractCall       `ssz-max:"128"`  // MaxContractCallsPerBlock		1224 * 128		= 156672 bytes
+	AssetTxs          []*AssetTx            `ssz-max:"1024"` // MaxAssetTxsPerBlock				216 * 1024		= 221184 bytes
 	Signature         [96]byte              `ssz-size:"96"`  // 												= 96 bytes
 	RandaoSignature   [96]byte              `ssz-size:"96"`  // 												= 96 bytes
 }
@@ -66,6 +69,64 @@ func merkleRootGovernanceVotes(governanceVote []*GovernanceVote) chainhash.Hash
 	return chainhash.HashH(append(h1[:], h2[:]...))
 }
 
+// ContractMerkleRoot calculates the merkle root of the ContractDeploys and ContractCalls in the block.
+func (b *Block) ContractMerkleRoot() chainhash.Hash {
+	if len(b.ContractDeploys) == 0 && len(b.ContractCalls) == 0 {
+		return chainhash.Hash{}
+	}
+	h1 := merkleRootContractDeploys(b.ContractDeploys)
+	h2 := merkleRootContractCalls(b.ContractCalls)
+
+	return chainhash.HashH(append(h1[:], h2[:]...))
+}
+
+func merkleRootContractDeploys(deploys []*ContractDeploy) chainhash.Hash {
+	if len(deploys) == 0 {
+		return chainhash.Hash{}
+	}
+	if len(deploys) == 1 {
+		return deploys[0].Hash()
+	}
+	mid := len(deploys) / 2
+	h1 := merkleRootContractDeploys(deploys[:mid])
+	h2 := merkleRootContractDeploys(deploys[mid:])
+
+	return chainhash.HashH(append(h1[:], h2[:]...))
+}
+
+func merkleRootContractCalls(calls []*ContractCall) chainhash.Hash {
+	if len(calls) == 0 {
+		return chainhash.Hash{}
+	}
+	if len(calls) == 1 {
+		return calls[0].Hash()
+	}
+	mid := len(calls) / 2
+	h1 := merkleRootContractCalls(calls[:mid])
+	h2 := merkleRootContractCalls(calls[mid:])
+
+	return chainhash.HashH(append(h1[:], h2[:]...))
+}
+
+// AssetTxMerkleRoot calculates the merkle root of the AssetTxs in the block.
+func (b *Block) AssetTxMerkleRoot() chainhash.Hash {
+	return merkleRootAssetTxs(b.AssetTxs)
+}
+
+func merkleRootAssetTxs(txs []*AssetTx) chainhash.Hash {
+	if len(txs) == 0 {
+		return chainhash.Hash{}
+	}
+	if len(txs) == 1 {
+		return txs[0].Hash()
+	}
+	mid := len(txs) / 2
+	h1 := merkleRootAssetTxs(txs[:mid])
+	h2 := merkleRootAssetTxs(txs[mid:])
+
+	return chainhash.HashH(append(h1[:], h2[:]...))
+}
+
 // ExitMerkleRoot calculates the merkle root of the Exits in the block.
 func (b *Block) ExitMerkleRoot() chainhash.Hash {
 	return merkleRootExits(b.Exits)
@@ -218,6 +279,25 @@ func merkleRootVoteSlashing(slashings []*VoteSlashing) chainhash.Hash {
 	return chainhash.HashH(append(h1[:], h2[:]...))
 }
 
+// ContractGasLimit returns the sum of the gas limits of the ContractDeploys and ContractCalls in the block, saturating
+// instead of overflowing.
+func (b *Block) ContractGasLimit() uint64 {
+	gas := uint64(0)
+	for _, tx := range b.ContractDeploys {
+		if gas+tx.GasLimit < gas {
+			return ^uint64(0)
+		}
+		gas += tx.GasLimit
+	}
+	for _, tx := range b.ContractCalls {
+		if gas+tx.GasLimit < gas {
+			return ^uint64(0)
+		}
+		gas += tx.GasLimit
+	}
+	return gas
+}
+
 // GetTxs returns a slice with tx hashes
 func (b *Block) GetTxs() []string {
 	txs := make([]string, len(b.Txs))
@@ -226,13 +306,3 @@ func (b *Block) GetTxs() []string {
 	}
 	return txs
 }
-
-// SerializedTx return a slice serialized transactions that include one of the passed accounts.
-func (b *Block) SerializedTx(accounts map[[20]byte]struct{}) []byte {
-	return []byte{}
-}
-
-// SerializedEpochs return a slice serialized epochs that include one of the passed public keys.
-func (b *Block) SerializedEpochs(accounts map[[48]byte]struct{}) []byte {
-	return []byte{}
-}
@@ -13,7 +13,7 @@ func (b *Block) MarshalSSZ() ([]byte, error) {
 // MarshalSSZTo ssz marshals the Block object to a target array
 func (b *Block) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = buf
-	offset := int(632)
+	offset := int(708)
 
 	// Field (0) 'Header'
 	if b.Header == nil {
@@ -62,7 +62,7 @@ func (b *Block) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 
 	// Offset (8) 'ProposerSlashings'
 	dst = ssz.WriteOffset(dst, offset)
-	offset += len(b.ProposerSlashings) * 1048
+	offset += len(b.ProposerSlashings) * 1176
 
 	// Offset (9) 'GovernanceVotes'
 	dst = ssz.WriteOffset(dst, offset)
@@ -71,10 +71,28 @@ func (b *Block) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 		offset += b.GovernanceVotes[ii].SizeSSZ()
 	}
 
-	// Field (10) 'Signature'
+	// Offset (10) 'ContractDeploys'
+	dst = ssz.WriteOffset(dst, offset)
+	for ii := 0; ii < len(b.ContractDeploys); ii++ {
+		offset += 4
+		offset += b.ContractDeploys[ii].SizeSSZ()
+	}
+
+	// Offset (11) 'ContractCalls'
+	dst = ssz.WriteOffset(dst, offset)
+	for ii := 0; ii < len(b.ContractCalls); ii++ {
+		offset += 4
+		offset += b.ContractCalls[ii].SizeSSZ()
+	}
+
+	// Offset (12) 'AssetTxs'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.AssetTxs) * 216
+
+	// Field (13) 'Signature'
 	dst = append(dst, b.Signature[:]...)
 
-	// Field (11) 'RandaoSignature'
+	// Field (14) 'RandaoSignature'
 	dst = append(dst, b.RandaoSignature[:]...)
 
 	// Field (1) 'Votes'
@@ -204,6 +222,53 @@ func (b *Block) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 		}
 	}
 
+	// Field (10) 'ContractDeploys'
+	if len(b.ContractDeploys) > 16 {
+		err = ssz.ErrListTooBig
+		return
+	}
+	{
+		offset = 4 * len(b.ContractDeploys)
+		for ii := 0; ii < len(b.ContractDeploys); ii++ {
+			dst = ssz.WriteOffset(dst, offset)
+			offset += b.ContractDeploys[ii].SizeSSZ()
+		}
+	}
+	for ii := 0; ii < len(b.ContractDeploys); ii++ {
+		if dst, err = b.ContractDeploys[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (11) 'ContractCalls'
+	if len(b.ContractCalls) > 128 {
+		err = ssz.ErrListTooBig
+		return
+	}
+	{
+		offset = 4 * len(b.ContractCalls)
+		for ii := 0; ii < len(b.ContractCalls); ii++ {
+			dst = ssz.WriteOffset(dst, offset)
+			offset += b.ContractCalls[ii].SizeSSZ()
+		}
+	}
+	for ii := 0; ii < len(b.ContractCalls); ii++ {
+		if dst, err = b.ContractCalls[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (12) 'AssetTxs'
+	if len(b.AssetTxs) > 1024 {
+		err = ssz.ErrListTooBig
+		return
+	}
+	for ii := 0; ii < len(b.AssetTxs); ii++ {
+		if dst, err = b.AssetTxs[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
 	return
 }
 
@@ -211,71 +276,86 @@ func (b *Block) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 func (b *Block) UnmarshalSSZ(buf []byte) error {
 	var err error
 	size := uint64(len(buf))
-	if size < 632 {
+	if size < 708 {
 		return ssz.ErrSize
 	}
 
 	tail := buf
-	var o1, o2, o3, o4, o5, o6, o7, o8, o9 uint64
+	var o1, o2, o3, o4, o5, o6, o7, o8, o9, o10, o11, o12 uint64
 
 	// Field (0) 'Header'
 	if b.Header == nil {
 		b.Header = new(BlockHeader)
 	}
-	if err = b.Header.UnmarshalSSZ(buf[0:404]); err != nil {
+	if err = b.Header.UnmarshalSSZ(buf[0:468]); err != nil {
 		return err
 	}
 
 	// Offset (1) 'Votes'
-	if o1 = ssz.ReadOffset(buf[404:408]); o1 > size {
+	if o1 = ssz.ReadOffset(buf[468:472]); o1 > size {
 		return ssz.ErrOffset
 	}
 
 	// Offset (2) 'Txs'
-	if o2 = ssz.ReadOffset(buf[408:412]); o2 > size || o1 > o2 {
+	if o2 = ssz.ReadOffset(buf[472:476]); o2 > size || o1 > o2 {
 		return ssz.ErrOffset
 	}
 
 	// Offset (3) 'TxsMulti'
-	if o3 = ssz.ReadOffset(buf[412:416]); o3 > size || o2 > o3 {
+	if o3 = ssz.ReadOffset(buf[476:480]); o3 > size || o2 > o3 {
 		return ssz.ErrOffset
 	}
 
 	// Offset (4) 'Deposits'
-	if o4 = ssz.ReadOffset(buf[416:420]); o4 > size || o3 > o4 {
+	if o4 = ssz.ReadOffset(buf[480:484]); o4 > size || o3 > o4 {
 		return ssz.ErrOffset
 	}
 
 	// Offset (5) 'Exits'
-	if o5 = ssz.ReadOffset(buf[420:424]); o5 > size || o4 > o5 {
+	if o5 = ssz.ReadOffset(buf[484:488]); o5 > size || o4 > o5 {
 		return ssz.ErrOffset
 	}
 
 	// Offset (6) 'VoteSlashings'
-	if o6 = ssz.ReadOffset(buf[424:428]); o6 > size || o5 > o6 {
+	if o6 = ssz.ReadOffset(buf[488:492]); o6 > size || o5 > o6 {
 		return ssz.ErrOffset
 	}
 
 	// Offset (7) 'RANDAOSlashings'
-	if o7 = ssz.ReadOffset(buf[428:432]); o7 > size || o6 > o7 {
+	if o7 = ssz.ReadOffset(buf[492:496]); o7 > size || o6 > o7 {
 		return ssz.ErrOffset
 	}
 
 	// Offset (8) 'ProposerSlashings'
-	if o8 = ssz.ReadOffset(buf[432:436]); o8 > size || o7 > o8 {
+	if o8 = ssz.ReadOffset(buf[496:500]); o8 > size || o7 > o8 {
 		return ssz.ErrOffset
 	}
 
 	// Offset (9) 'GovernanceVotes'
-	if o9 = ssz.ReadOffset(buf[436:440]); o9 > size || o8 > o9 {
+	if o9 = ssz.ReadOffset(buf[500:504]); o9 > size || o8 > o9 {
 		return ssz.ErrOffset
 	}
 
-	// Field (10) 'Signature'
-	copy(b.Signature[:], buf[440:536])
+	// Offset (10) 'ContractDeploys'
+	if o10 = ssz.ReadOffset(buf[504:508]); o10 > size || o9 > o10 {
+		return ssz.ErrOffset
+	}
 
-	// Field (11) 'RandaoSignature'
-	copy(b.RandaoSignature[:], buf[536:632])
+	// Offset (11) 'ContractCalls'
+	if o11 = ssz.ReadOffset(buf[508:512]); o11 > size || o10 > o11 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (12) 'AssetTxs'
+	if o12 = ssz.ReadOffset(buf[512:516]); o12 > size || o11 > o12 {
+		return ssz.ErrOffset
+	}
+
+	// Field (13) 'Signature'
+	copy(b.Signature[:], buf[516:612])
+
+	// Field (14) 'RandaoSignature'
+	copy(b.RandaoSignature[:], buf[612:708])
 
 	// Field (1) 'Votes'
 	{
@@ -418,7 +498,7 @@ func (b *Block) UnmarshalSSZ(buf []byte) error {
 	// Field (8) 'ProposerSlashings'
 	{
 		buf = tail[o8:o9]
-		num, err := ssz.DivideInt2(len(buf), 1048, 2)
+		num, err := ssz.DivideInt2(len(buf), 1176, 2)
 		if err != nil {
 			return err
 		}
@@ -427,7 +507,7 @@ func (b *Block) UnmarshalSSZ(buf []byte) error {
 			if b.ProposerSlashings[ii] == nil {
 				b.ProposerSlashings[ii] = new(ProposerSlashing)
 			}
-			if err = b.ProposerSlashings[ii].UnmarshalSSZ(buf[ii*1048 : (ii+1)*1048]); err != nil {
+			if err = b.ProposerSlashings[ii].UnmarshalSSZ(buf[ii*1176 : (ii+1)*1176]); err != nil {
 				return err
 			}
 		}
@@ -435,7 +515,7 @@ func (b *Block) UnmarshalSSZ(buf []byte) error {
 
 	// Field (9) 'GovernanceVotes'
 	{
-		buf = tail[o9:]
+		buf = tail[o9:o10]
 		num, err := ssz.DecodeDynamicLength(buf, 128)
 		if err != nil {
 			return err
@@ -454,12 +534,74 @@ func (b *Block) UnmarshalSSZ(buf []byte) error {
 			return err
 		}
 	}
+
+	// Field (10) 'ContractDeploys'
+	{
+		buf = tail[o10:o11]
+		num, err := ssz.DecodeDynamicLength(buf, 16)
+		if err != nil {
+			return err
+		}
+		b.ContractDeploys = make([]*ContractDeploy, num)
+		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+			if b.ContractDeploys[indx] == nil {
+				b.ContractDeploys[indx] = new(ContractDeploy)
+			}
+			if err = b.ContractDeploys[indx].UnmarshalSSZ(buf); err != nil {
+				return err
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	// Field (11) 'ContractCalls'
+	{
+		buf = tail[o11:o12]
+		num, err := ssz.DecodeDynamicLength(buf, 128)
+		if err != nil {
+			return err
+		}
+		b.ContractCalls = make([]*ContractCall, num)
+		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+			if b.ContractCalls[indx] == nil {
+				b.ContractCalls[indx] = new(ContractCall)
+			}
+			if err = b.ContractCalls[indx].UnmarshalSSZ(buf); err != nil {
+				return err
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	// Field (12) 'AssetTxs'
+	{
+		buf = tail[o12:]
+		num, err := ssz.DivideInt2(len(buf), 216, 1024)
+		if err != nil {
+			return err
+		}
+		b.AssetTxs = make([]*AssetTx, num)
+		for ii := 0; ii < num; ii++ {
+			if b.AssetTxs[ii] == nil {
+				b.AssetTxs[ii] = new(AssetTx)
+			}
+			if err = b.AssetTxs[ii].UnmarshalSSZ(buf[ii*216 : (ii+1)*216]); err != nil {
+				return err
+			}
+		}
+	}
 	return err
 }
 
 // SizeSSZ returns the ssz encoded size in bytes for the Block object
 func (b *Block) SizeSSZ() (size int) {
-	size = 632
+	size = 708
 
 	// Field (1) 'Votes'
 	for ii := 0; ii < len(b.Votes); ii++ {
@@ -492,7 +634,7 @@ func (b *Block) SizeSSZ() (size int) {
 	size += len(b.RANDAOSlashings) * 152
 
 	// Field (8) 'ProposerSlashings'
-	size += len(b.ProposerSlashings) * 1048
+	size += len(b.ProposerSlashings) * 1176
 
 	// Field (9) 'GovernanceVotes'
 	for ii := 0; ii < len(b.GovernanceVotes); ii++ {
@@ -500,6 +642,21 @@ func (b *Block) SizeSSZ() (size int) {
 		size += b.GovernanceVotes[ii].SizeSSZ()
 	}
 
+	// Field (10) 'ContractDeploys'
+	for ii := 0; ii < len(b.ContractDeploys); ii++ {
+		size += 4
+		size += b.ContractDeploys[ii].SizeSSZ()
+	}
+
+	// Field (11) 'ContractCalls'
+	for ii := 0; ii < len(b.ContractCalls); ii++ {
+		size += 4
+		size += b.ContractCalls[ii].SizeSSZ()
+	}
+
+	// Field (12) 'AssetTxs'
+	size += len(b.AssetTxs) * 216
+
 	return
 }
 
@@ -661,10 +818,58 @@ func (b *Block) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 		hh.MerkleizeWithMixin(subIndx, num, 128)
 	}
 
-	// Field (10) 'Signature'
+	// Field (10) 'ContractDeploys'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.ContractDeploys))
+		if num > 16 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for i := uint64(0); i < num; i++ {
+			if err = b.ContractDeploys[i].HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 16)
+	}
+
+	// Field (11) 'ContractCalls'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.ContractCalls))
+		if num > 128 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for i := uint64(0); i < num; i++ {
+			if err = b.ContractCalls[i].HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 128)
+	}
+
+	// Field (12) 'AssetTxs'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.AssetTxs))
+		if num > 1024 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for i := uint64(0); i < num; i++ {
+			if err = b.AssetTxs[i].HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 1024)
+	}
+
+	// Field (13) 'Signature'
 	hh.PutBytes(b.Signature[:])
 
-	// Field (11) 'RandaoSignature'
+	// Field (14) 'RandaoSignature'
 	hh.PutBytes(b.RandaoSignature[:])
 
 	hh.Merkleize(indx)
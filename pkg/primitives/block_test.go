@@ -49,7 +49,7 @@ func TestBlock(t *testing.T) {
 func TestBlocksMerkle(t *testing.T) {
 
 	// Serialized snappy compressed block
-	blockRaw, err := hex.DecodeString("83b101f42402adaa077cf48df0160b768f2a6b78c58b8aeba71e90e7e5f04b41b2d8f91272bd037b64d5b5293120e219d1dd23b92adb03593206f667ab8cbd6fa78ed9f3d0711ecf1458ee9e3d9a2938b7f7c86001904915dd35c9448ba6292275b84a625bce903b90ae9d66f35f533440d52b0dcfb5e5b4137c4753510b79369fcf2f3ecfa606f01ec1912b85890a70b9f12bf6fa0a2e6a127b723a97488ec57945d2b99204fd28f7d1f8a6cee5ebff33868e1829e7ef8dc5ec2aef1e9fd6549e513653f1ebeb6db4580ba8f26af7b8f431fcbe2473aa26f4ae4cb6e060535dade76c5f275404a2e09ce03dafee52a494137d893cbc635b05b420d7417a5dcf841e167b0d2b892df99d914fa1ffb334ca0e078353626b24f107595ecea828ee513c4976d9539758d429e000d6a671744d69211019036a81ebb9ab471efbbab3fc2440108d12c421421bdc61739165a156917dc5a505c937828fc80c720de49f3503f40b3893350c366aeeba2003223d4f53d21b2aaa6bfbdb6668b47125eed29e3ae25113d76618c8f6423836a34087b2b53ee52b977f942a357802000041160000b9170000d51f0000d92500009929000075390000a53a0000d5420000ae09507041b2ccb9e3b3f9cda71ffae3dc8b2c83f331ebdc98cc4269c56bd4db05706bf317c8877608bc751b36d9af380c5fea6bc804d2080940b3910acc8f222fc4b59166630d8a3b31eba539325c2c60aaaa0408e986241cb462fad8652bdcae09507041b2ccb9e3b3f9cda7fe60004a6000f0de1400000005040000f6070000e70b0000d80f00009525b42b5d2a565683ab4f733e051077418f017d31230847eee84a165704b8c2a4ace8bf5714a614820de92e7bd3700b62ae304bda7684e5b6114b52abf835af34cfd6c8b0e2968392145044537481f82422dfe04934047248edd9bce4d0efca04cafc1a49a4068ded875fbe0db1be42e76cba630b374e9777bda6fcf631bc48ae09507041b2ccb9e3b3f9cda71ffae3dc8b2c83f331ebdc98cc4269c56bd4db05706bf317c8877608bc751b36d9af380c5fea6bc804d2080940b3910acc8f222fc4b59166630d8a3b31eb52540104e400fe0100fe0100fe0100fe0100fe0100fe0100fe0100fe0100fe0100fe0100fe0100fe0100360100f0b6049ba44d6dec0841d87cf096d73b9d5481150a1213bad856773c7d120403df9894b8dec4f62e4b946f6c90218a6a88079d47baa39f01ed19e24724aaa28e898c0aa55002b2cae431432d9ab9efeaa7a56f3ca11fd356af8907c82f9ca67726b0edcc402a3a5f0971ea13d0166fa342fc384073187c6eeafcf5777b778aa85543d9ae09507041b2ccb9e3b3f9cda71ffae3dc8b2c83f331ebdc98cc4269c56bd4db05706bf317c8877608bc751b36d9af380c5fea6bc804fef103fef103fef103fef103fef103fef103fef103fef103fef103fef103fef103fef103eaf103f0816230f6ca936682786f305798a86bafdec6713cbc181137ca3816b23ec5ad4b1d3b7eefa943f3f507d62a9fa84e573e4f1ce88b14ba26f7f87ddf8e4d73619e25376183f9169e862a06dee8ad8bb4201f14f3bc3005addd3937e0a968513cbc48e257fe614d17c78c38074d018457010b36102867f6724ff2297073bbdb74d835ae09fee207fee207fee207fee207fee207fee207fee207fee207fee207fee207fee207fee207fee207bae207f0815270c1aefe76cfb027cd03651f259bf5edfa5d06adb5ab2c99f6000d297aa946c77168dc70d6016983ba389a01c1ea53ec1184761fafbbd403e23912095139eafcd37e7fd2eea7655ce4c6fa3289d370aedd946878134e4646e9782ede200aaa3f316c03fd00f7e8461e6d56a11a6ef4558e2fb1e178f693d78b0639d50a5527ae09fef103fef103fef103fef103fef103fef103fef103fef103fef103fef103fef103fef103fef103baf103f081e86b194858a17f0d5559f64ecd814f3172e75bb123337a11bec85be9c35c91d0f2718ae5a30095d853b6ab35f1ee2f3fbff94824c2a67570030792ab5601c34c27f8c0c3e12a4dec7f16da93f07083f85873b45e6bd3c33cd1fed9005b734a0d812051ed55ad4cc4fff3933f96e322cf29a4b3f575b934349254dd8e2135d0f2ae09fef103fef103fef103fef103fef103fef103fef103fef103fef103fef103fef103fef103fef103baf103f4940bbe2e96edd44518b15d956bb9540806f3a031855faa8aba7173d0c8881f42819c5899a579bb3429b3359b7922727d3aa17935d5fe5065e8130017ad464547614380ad9ea28cf97e70754840137185cb2684a373f72b42651fa07bab35b94c617074bf36bbbc91104da8c193d3b9976b8c6f73c60f2070cd524e0210409146491046cecda649af344aed303a990623bf6a62b853310099dbbaf3aef0bcbb5bad81d96818c42e85bb72d46d9f4745557acd22ec1aae0feeb438a3316775eab62b47cbb544d4380001f699a6ad4662857789905b924e0e23de47f2bc2ac6b5dc3c1d2c8207633097db26c0a6365aca2cae2197ce7be713044a3219cf89d3983fac23fd09f8accab1a6ca539a1628b424e828fc83d155fde04ea381bdf7b8575f25e8d00b48e62458c3afdfb938b06c7be915a53fa9baf12be275b2892cca1991e384ad04804ece745a6506b7abdf7dcd553acfbf125ea758e8a6dc2e32286b7866a6d0c7eed23a40f00c68827099736da5e35b7174bfa1bddf4b08000000120400005ac7efd2c24a18202dc094ce93315b48f9d082b07fbc49597c29b001bb08affc1e2619bb98ef888b7e022246300000000c000000f8010000d80300000c0000000500000000000000a889879436f347bb3b82d4d51d644262a0d4b3390aff76d7d535f4c122358ca43f6af9d41712168a39395898871c7be499065f9f458ab4bd6af233646e5d63f6a266059a49743a97bacdc66ae07ef687067e36c0641303846d27779efa7133ce973fe2b3d6cc48a97b31d2215953a731dc930f062077d61f5c8f52206261d3f7462b869babbb131f613a45be85e2e76489865a5107f9a60c6d862c2055b21bd1eff79e00219105a6846a0b929f796fabafc88901d0bf01902407eadf2945bc43a19b27621edf310af1e2f99aa9e9bda45a1fac592d9badc9220af25047012593fa1ae1ac0f4622af26bd35367cc173229632f21e6567d50102b350f812415b13731c9ad4fb1f38565b69881f642b602b1ab0b2cb76d6af0c18dec0232dc3e0a68ff0b8d3a42bf8ceeed48f6ddd7c2f3290aae4549d523301d6cccf6776bdee1bb18b6b66a884696a6d2ffb5b038f79bf8f90f15664577767fba5b16024637848ddc7ebf1950e5f62a6145b89ac9a9c7085cb3977f6306ee8c5cb886d2b48e28480c1d0f2b81d34a072637d1e0b065839502d65c274ff9c061fea4a17b6ebb92a83832f66edb088daf0d24e9828f6dc3ab2f385cf47f73f7d99280d5c457c41874d5446a587f0162d16231195d59299df9bd6fdb249bf9872d964d9146f1a9d7bb9a24dd09e2266d3b82f0fc55ab486f1e938db47f959ba6834ceb01548454708311cc4a372b20dc25deb49286eeff2d5035f8f2f9d48c813152b273d90aa09a3b8644b5b020d798fef2e452ab10afc7fb33f57e5fabc6bf37ed17cc03e9b286aa2b4e825c7e195ba72c592d00287ee9b48fab007886fea56568d003efc5936f7af4e3930a93ecfca68f2c590c531b06214927d9a87f38af7267c17037a46863b744d78883b07ee72aa4bd155aaed326830018a0b4c0ab56e41e58646ed02f5dba412bd2ba4a3dd318fdc0ec64fbb017fef43728b17617343c9056a5bb9cef283ff310bfd9ac7cb96b74503bc32c4e3e51820147e6cb3e277593a8acfb581308a6f2ec74fdf18f2c6665ac5385c3bdf69a8d28974a3a704fbd17859ee9783680d9567117816f616fcb869d915136aff23d60ff0487ed468823342434dbdcef788e15242f1ec279a35a178e98eea69a8f0194027a89fe98bd343267a7cc414a55592cf5fa819d03a65c79122f5a492de56b4ab73e41a193a6e04307286657329ff89d06e84d847d206d96405ad2cba3e28738fbe4697450b0fff40bbbb4233bc056a04d0ee8ac4abfaa8af81873de81633007941544c9626370d13780e9298c64978aeaabe1591d1043063728e235ba3053a884d0a18086858f5a73211bb7d353d1f04af429e6cd05634ff94afdf6ccc77dc082671e98ead3b86fff07440918244c662d26176323edaa4276aaa6d16300000000c000000f8010000d80300000c000000050000000000000092a19131d439d13f266e8a14ed840a57f7078f8af7524c935d669640a15a7275e2ce1ef8416f37ef5112fa3c4d0e343fb8365b266fd05471be31b740dd2b4bfb71041115b3f04cef09bbb6b2373a5070f4a8d2ce01e42da54550b4408abaf6d0b73fcd5a3d35a55d8e23252144b929dec3fb3ffcd5a68db665e3faadff2843c46499e556063524a0c295ed6080e543bfb854c55e9fc99e0ab45f4d50ba46792550acc74f89199cbb960a1b4fc92f0578a7b717831341502184e2c7679920b8a1922e88c46dc110815e3b2384d12eb8d60a73162ddbb2dac8c4917dcfe5472029c49d315ae5c49aae21aa458180db280f84539bd1799d41f511bd2171a52a9cda2ae70b4cc672572a57a079210b4da8cf69811522ddd936132f68e5b42721ca788de9a016bf7a840a706984ff32f07c5243757e69ec2d76c11cfef609fb8fe16a599e60c49d9e7029c8e631656ebd8da093a99c5a8b6c885928670fc8843b6985207fdf9a83347465b950aeb8663d73ef4fc4b4235b48fd5fd820292b738515e5ae9f23c661067e45fdf4e932bf092dbffaf134bb77109e2ebc131096357ba0cc2d83fdf8e5f91975cac393b3fb647919b28bcf17bd2d9cca54ad409a65c7093b2f96ac256504b902944f341e1f6bf84ed252aaef8cf5fb62f0a145faf8cbbbd89280f7ade58685f8c0f4c3ad93f40048d6a1de7da24dbcf8ae6ff82d35f900f6eb01055aec6794c1e7a28addb670d68403d305e5d7faf68b8cdbe0d26b48869e2561f23a1b9aa13fee64ca9f307d6ec02d03b670f294a098fc7683caf8e5e19f93a034011542d4a609de023cf3612d19a07cd474a5f057e0ef8e4f4272a320dac58e4c33e75e05c8d8a33e761295f82115b34dd8656e16570461581184fa9e8fe50d095809c7ea01939ac9218f94b8e4e1064b51dcffbfd366cbce763bc5bff98e6f5c193a489f741267ccf47546914d585d08941d86577f45381285174c363f61dea72c590322c5f36200200747561d0dbca19af70ec002c50cfd660022c31f820e50427cbcfa3943547a16f6f10170b087da4e2f53874c4da8bfa7fcb406bfb39c48240363afe6dc57e9d3040ef441a2d2c4aa5923daaf9818524ceeca99242541974f4c4b09a7fdd44e220505cdfe10f43d11aa241b42c00fe134c3c27850a43ccced25e5cd1f06312d274a6872880f306170aec9e6b322a28f1f55abcaec8a8cbb8053789e365b4dc96382244617d1d574bcc763b6d296b5954754b81dce1f064727f0f2d7421af3760778560e1b167f983e7e9ecde0124775c93dadcec629201d299f506dcf35986815b0d71de2440e92961d0399f7885e9634e924795a1f04a98e2ce661a695cbf6c36a9a56f0abda6a37a5874529a4de809ec009c57c351cb65d65cb6c2ecb2f0e816404e610d7d6ae09507041b2ccb9e3b3f9cda71ffae3dc8b2c83f331ebdc98cc4269c56bd4db05706bf317c8877608bc751b36d9af380c5fea6bc804d2080940b3910acc8f222fc4b59166630d8a3b31eba539325c2c60aaaa0408e986241cb462fad8652bdcb7876c43a4a4c38d7fe6633f22d27c25d345572ff3b079e5533cf824b7b0268c39eb9edf1a358e0cba6679429482ef92ae09507041b2ccb9e3b3f9cda71ffae3dc8b2c83f331ebdc98cc4269c56bd4db05706bf317c8877608bc751b36d9af380c5fea6bc804d2080940b3910acc8f222fc4b59166630d8a3b31eba539325c2c60aaaa0408e986241cb462fad8652bdc76a8d4aa2998dfa82aae05dfcd167c72a58ed9e2850a62a0b3064bf53456845535f10417986f634990bfcd36eca0d7505335105d5d075144edf91624bcd57e5faa1d687aae09507041b2ccb9e3b3f9cda71ffae3dc8b2c83f331ebdc98cc4269c56bd4db05706bf317c8877608bc751b36d9af380c5fea6bc804d2080940b3910acc8f222fc4b59166630d8a3b31eba539325c2c60aaaa0408e986241cb462fad8652bdcb75e896810fde906022b199a0cb1a7c10759e0293b9bfdb3d4ef599813a4a3f42f4065d9d210c04f992b9ced3ffd28aeae09507041b2ccb9e3b3f9cda7feb11f4ab11ff0497719dc22de45442b68751bcfb5d9250cb052e6638b2780bcda1f318bd6ec2455e8df3639410cacb039544d0c2326df079cd5b786a5a78eb80ae52c5b25915f66685bb990ae09507041b2fe701b66701bc08334985abbc8a14b512654727c0323b5c07f5119b1d925cb6d6e7c7b4d7f84ddeacc9402ef55db444f6e44cde15ce352aefe001c7a001cf04355de0ec164a66553fcbc73d61278025cf6780e0ab50c3395ed93f57a06fa22be0b3a49b0195f52846b8fcda9c2d2a39e71bccce882bf10bf48633bd0fc4a515f81ad1178fe34017e3401c0b6de2313d6cb644eeaf45e1d4029cd42c5146354eedc7e9a23746da2bad9cd359e9e781a70cdbb71b975df5fd22b3e55aefe34017a3401f043316566aad07129a82d566136a8d69e5f9898e121a59be9d6a8b5dafd477f95dae3f102cde6e1f2428e5f766778a91151d93ef79e7b54cd66e34cfad1834122f7fefc7dfcfe34017e3401c0a816684d2215d7219ce836f5eaa659427ffc775a778088e60513f35e910fbdbe4872d8ee1faadfc0a0c5c91124154f6baefe34017a3401f0431acaa43e2a56cbe46b6499d1ece1fe69e33bf9fdb70ab30c742514116b33639635f1c9d5c9062b0136468967d6cba6fc7fa8e5b29c8495875f696b5355877b4cb1531479be3000fe64017e6401c091c0b0716f54765c737d34ff6af94c24f16a818c8cbb9b51c5e6743409bd27838c0efc81b37974af494433729005209d91ba3000fec0007ec000c08697f0fc2f4a8f623e9932f1660b80e42b0f4b501a07452e701eeacdca1f8e4d6e0ed08452d5406317e675095728702186ba3000fec0007ec000c0941530211ece7e73744b9b846485ea8c5a5578e921642bd7c1f973acef0f0cbe5201c93c1d75e1830e7f8730487d212c94ba3000fec0007ec000c08b46385ffe7179ceb69caac9e4fe242e5f0f3952ea0a4897c835016e9304c064c0b647752102384e911ccb932c8645f48bba3000fec0007ec0001c08000000f20700000108f086f9030000a93e1f31e9dc83bfab10ffda7add8cd135b146862fe650a4fcec9943e309f7d819f441c53b11a3068601100d1e7108c94b1c59a844e2caf8bd4fb605525acec8626998f63cdc01ba2b511af46bffa7196bdc396e56afafba3bedfde1279c4b5579263e46223e1e38cfc919e79e21821f60d81485e4afb6fef2816525b4e1a51dae0950febc0772bc07fe1d27fe1d27fe1d27fe1d27fe1d27fe1d27fe1d27fe1d27fe1d27fe1d27fe1d27fe1d27421d27f0812d568d3ccc991ac68112d13ef70f8d65b14cc3f8aae9b487b49e304fa73fe12d7dbfad3dc72455ee3e669573ecc333b499d41034eb5e414d4c8352297a10605dad472d64a147a3b052d79c9387a42a4308404161e524820220c35f972e2a25e3c6847dff7bac51819724c9aef772fe773866606f59f3acadf76b12707fc42935ae09fe4a1bfe4a1bfe4a1bfe4a1bfe4a1bfe4a1bfe4a1bfe4a1bfe4a1bfe4a1bfe4a1bfe4a1bfe4a1bba4a1bf1eaf081ecefa6363812fe62f271ea37754a6f124c389fbf6644de38b66b1c024d1fd2987dd9153a629f1ca9698ea90b3c430379d54deb6b38befc8dd0a57504a4811af64ea2b2240e7a73c19dc58a60479ad221665295b6c3a791ecab3d5c1615e78843c4377c0d9eb65fe03e03f4e31052de2963e10d45859e2c4527e6176145e67856ae09fef903fef903fef903fef903fef903fef903fef903fef903fef903fef903fef903fef903fef903baf903f0818e6d799d55f2c19a76a4ec0c66d641c0d1555bae1ab1a3382a878e0511f18e8a2c82ceca3568f4055a5c0458d93f1af7f8e1be76e1cae8389a48b9cde762d157add946dae4c26d3c1b55252838ea020fcecb53c425ac33957abec6dd556136acfecc2988184d6e752769c451ead380c1e2e74d63c4da92b609bc70128f8c9d8fae09fef103fef103fef103fef103fef103fef103fef103fef103fef103fef103fef103fef103fef103baf103fe3c107e3c10e01d385cd6de69bab58c66c2a7df44e52dc66595991f62e5b5aa875b2c07ed7d3dcb55c355b2641a1c6397b11cd601c587a96abc9a70a7cb6aaefea8147aa814f47003ffa667cdaa9cf796ae74736163c64eb83f0c2e6fcc51dec9cd6e34d30caba8d83a1170f147ac16ca17a3dd4e231b8dec2a4aebc6e27d7ca0e7f2809aff02212ac64962186ad36670314903b2c157db946a08d6669bd5f7524aa326bd67b42a9525a08eb2a647e07de9f011b2072bef1bda155f490d29fca46f784602eec4e9090972424e15b10e5bc9aa191ce69d181646c8061cd8f885220257aa50bdec2f87bd706a775553308d4e8791ddb99dad646f0d3c58a24fba3584393ef266683dd54215816939cfbb051c62f5903478c3a377f39b16c2fecd227ca1cb113aae0afbf94d28678de9be86a26b14276cdd666f4984f302102f9c8e046ae096fa37f797e925f754e8b6951b9082bfceea1b04aa861a3efc2c83fd47cd8f22ce9caa2529d3f898b76007058807aaf23f08d1d67ff9751f52932cbd643ff9f470abf6000781e9dc2422d154c28d8bc271b3aadc7037700e8994bd2e220f81c7c02ba64537a54967babe390f192bc646bca2fd941f168bca3c4f448c5d7a1e4311a43eb1559290c1efc2459d7009959f6821b63060a6621210de35a8b9aac57e37c92b27c5470d049276f4763299c8842107cf39c483ed949dea2529d5a0415c9c7d5794b344cf198cceae4c92958909a2efa57dd09bd4e972e0eba0430e51ec65118b89a22b700be3a735642ba57eb37c4dbca752c75c98cd978ecd1eabd18c895da8dc867cb35edfb8fb910840415f13c07e3b8d0fced5ace4fe7e1071634811d2505bb726cdf7784a90b5e9e79fd081e93bc16584496102d6b4290da22da9a0d10764821875f4763677e4c45df3202d9e7126efb4c461ab74406f337edb720470f67a197fe5bd8cb1a2b065827149849437b388246569339ffe3ebac8bd6433dd330130f3dbcbc6c13e12a568d2d4d8c36873b9372838a874c1e5e3edbe6e3684ce5c0e60a126acc9cabf3df7bf0ba06e36d7aafc2d46b8009d4d5f98c4dc4d16b69c9a9a84a68760cb35effced4bbccbb4c7bc041c10bf765058e84eee1f6063147f0fb90cbc0eaeb46c7bdf43a0f68ac005ad2f74a0c354b04eefd4709f0c9fbfc2f87a06bb715c7096e7c6f0e47b9d57853f1ffb6ffbe2889af81af8225fb33212a23684df3d563f6e87c10c3ed5bf168c93c545575c7b571cb646ab09b7d97e23e8521b3a9f18e93fcc9e9c2f1953a851c24e65ab32f49caf7d713b5e402fd9c7aeae09507041b2ccb9e3b3f9cda71ffae3dcfec13a3ac13afeb8047eb804f4c7038d041c6130c54e36a075a42137e171631a53484e402da8315dd77cd2689efada9db28492ca97a35000e80279924612d068080359eea4571440b83de6ac57d83913a88df9d2347eed53a177a4029d074ebd39b0abd266654d1eba119fdd40ec3776d004baa58b486930bc6409ecb16409daafe6ba592130825e67ce17ba63c322a47d16063f4e95d43ff85b13cb0d4ea3332edd4394f74e13d0ed2fe4dc43072681ba5b5e4f04456f2b829c6d84b898b6b4b7bf37a503975ecb1cee0413e0db37460ccad62471fee9fbccff9f52e29268ed8c1975c8855b175dcb740670b29fd77d69dd8e36839a38be681ec4ae45ec7f09b0421dd646a00c3a026230661007af72492b1b904b93bde4c087cc4740b0f13b911f2dd93d1b044231beac6ab1426b804def2ff8baa014be311a41096d4c3f0169cecc4b4ea9c593fca64740745e3720d2e0e85ad31659cfb1614022d1871339b0d571652f57d50211f235d75206d3286e9b8887af1706e40298baeb2cc16889dad9a788db536797c9edf5ffbe3fc1bcfc3e1265ecb0bb8f6c38f8866cde2aad4b3487581f4f0483709cd39e7d60c61be9dc8d7db2f66c8097665e43d2bf3c09852846776371d8ab94d3c25b834e516dce0018152a9acfaf11fdf8ceebc2ef2f2fea6b9d197ffa111b7d57126512ad27bbe8e164c71feffb01199b6fe656fd9e1801f95b2240955ac57a02f4c6b15cb9f8627a926a130fd97602164286e9aeb9d64869a5594fbd28ae662ef6be84e51580763bba2ff59ac4e2690d21af10d9a93fa154f78653edcdeb0fe5633672d727e301a712481b2633943bf0063b2ba13a179bc277f2f30aeacb325bce8b0008e451583be4dfe14945ffe8b2296bef4074773b720558e198b71bbf8c189de4f2feab0a1fc3c57ce18490349df7dce4b4467358d40336fb3fa862e37dbfb776e51013e051b6aca05c9493e74cd1b0b785cf35ef6018c4b25b025d29f601e07c518660462379d5fb44339fad515d98dbc332500d794f565884d32b7790fcfd247ec0c33be8d17edd7cbcea6bad033bef60dc80daa419aa5c2345f3f26913e2cfb823aeee5deec409af910731b3b44f305e14e5aac644d8650cc3eaa92bdace53546d5a68e43c014c6c8452cad191f607a6f11a39eb49e0fb60ea53417f4dc135cacb3a21384c1383a736f9c1214198588caac1c50c317459c9ae09507041b2ccb9e3b3f9cda71ffae3dc8b2c83f331ebdc98cc4269c56bd4db05706bf317c8877608bc751b36d9af380c5fea6bc804d2080940b3910acc8f222fc4b59166630d8a3b31eba539325c2c60aaaa0408e986241cb462fad8652bdcae09507041b2ccb9e3b3f9cda71ffae3fe483b3e483bf0c2b1ec881b2608f575142e285db98c59e039e100c5ee94b7670d778e893ff99e8130d34a88fa949b6ca36a0e8347dc78f81400000066040000b80800000a0d00005c1100003e7790f385fce163049b9e71db3be069f62a67171aaed782ca4ec4018b305f7824dc04fab3b252180efe29dcef531c1f51b45fa1e02276495cd31da88970a0e24e7f2333bb31c17967e3fd04aa464c5419c58b927fdea173657174ef105c0a77fec18e7218bad2c6dd1fc70f78000000f7fe7d9d272777060c000000f8010042702bf409159182d171cdff011a5c1b4e142046d1e1741f5a6cff5507d72209d820a0dcb8e8187115420a8e3ea99ce320780ed3dcf293b038dcf885ef250959e4261588255c91d756dec059bedc7fdb958a342c2aac95fd7e3df44b681d43e88a3314f4ac6aa692ff59660005917fbea71591f06d02bde5edbc07fbfcae7c91307557cd412ad0823e379ead9a74bd56d01dbd4aa5c4965fbbe7c5a0b451aa555beb119cdb70b62defe55a8b023d3688d1ef900a2536ffa0b250ed8257f4dfa53c0602b6c083b3153ab6bf8753c5d9358716992aaf9bee1fe82b8762e58cb5953444bbcea9071478cc82dbf8dd88121ae1064badd47bb135fca09ab5fcae8f2dd8e629174517157876d9928ecbe6486937ca73fc41d9e74639c228edb0abbeb22f8600ddbbe9846c9b267c20a1c1960bf62f6d57c6e69278a66d431e61a8f358adcb4a9aa4b73f786a4c8287751be67a194199761620af9b6507cb2b3d2882c351c3639d52ff7666b42af71592ac6dba49aa7321036cd8c1b9f473c93bed87a700ee195f885ba55cfc73985409227966948d8ea8033dfeaa541448f415c2b8a1126a236a4360aa391dc288a4c26fcb807227e5cb51d8b3725adefcb24ee8c75c5b9a2e9e4a3eadacabe13dd4a5693ae19537ae1111b4f1433f41775dd7819201899168c85f6ea2a9330a09b4a26ae9c32ae7a3eeb0b12d77b56a821cd311190651969d941224a48490fcfe59344386a264692125683f1026581676c4a18681999b78b60966ded0b9ac41030515fe0206a99e9ed1320af3c6e51100cb105208098323b8f70a12b854032c8f5bb2746edbfb388678ad14df37b87262b264374fb7e13302e2cdb526ed85c7976d17375f877fa916ee4ca512e51cc1407c7a142f21e6201cd13932a7f02a6e90f8485940069a55434f42c3b3464efea58548d64d19a930a5118edd946409261a40a50350caa05069b85841cd6dd5f8fea2a7cfa83c76322398f75c7513dc71eb00a22531434232c731bcd31088fc0ca5176feee66d3bee2bbd1a9fdb61fc2df265f84fb95463b5c7988a563941bd9470a635cbf4b857c39717bbb388fbe34b4f11f2db1b5d9af0b81d7d4d4e8451f9e6dd659bd6a2b62cc3ad15e5486f8f2bd49ee4dfe226013444975e90013dad15898515a78ee7202d8bce1ae12a93c9b46c2ff07c0bd69137dadab5f4cbaf27e88bc3298ed9a7e8a447bc6ff8ac841fe083d9aa69a05834c3c05de90b917d179c99c753ef8baa5fd8fc1ae50c1fbfe902190940f0ff37a20ed1d1c7dd14ffc236ec1a533828762f13722d69b8b1e2e8ab101ac17366165cd6d9a496bcfccf76c174c2759fb63c5a4beb5b50d41f047a4791338f60c63769b9c2e70b761d1ef40e87cb236145c5448fab71ec5f85cb74f3deffcf1533b266d6f55dfb75563549147ba0602739bc0157faa4d9a4bdb8d40ba4abce8cddf64a65894c325908e273a29235a29209a1138d14abaa1698d5db0fff123402fc728e57b1457800000036e290cf657c26b70c000000f8010000d80300000c000000050000000000000095956bb003f0866d5c5c96f355d8c411db36e9248fa389c4a19a085a6454283a088b5b2980353f5d3372e9cddbe43cdfac346520d865d38c82cfb7832a214a9d7182eee7cf4f2779c64f37701843da2ff811220ebfca3840791214b26710237c922bcb4c2ac0cc2fb487d9d391ea6e243ec7c24ba89e1e0bd82a025765c016893bdbd45a5d261f322e212a1e3e7f5258b6c53ef0d7c3e0ff7b158d84227ca8824364bd56727a3368bfe7d6f48ec327757060562dd1fe5a6e83c38ba6983f973495f3fff2e61acbdead26729ba251026923764fcab43902c8d11d21fc176d0caff2ab023e4cbb5d66e82d9506c5db95dcb5a99fee8f3fafbe7673d8126ddf8e13103a045f969f107b04fe6c050fc4e737674e5ef0030c44e7f1b48179df57f533900ccfdb687e6b50f9525c5c1f31f21011cc89c91f0e3bf59c623554273a4ca19d3e488f2be82f87e3dd36e55a911c78a9c029da856cc4a20d8b6997c9a050eaac9831b43d20e40cd9d28e21ad47ec45f7be381cdfe6f33c5f0036de729f85e786ed868d1e4b8829f1280b358ae7ed064078d208be502960a9ec852a0f875cdfbb5102b5595867787c38fdf18b355a0da7cbb1a72540c99fa7d9e0df7846795a17fc0bae95c146dcf037d45a40d66c6385f95832ba00abe2e4f5ff94f1b95a3fa80b52e2b1fcaf9fbcac191739b95e0696ef6a3ae4a0688ea948c692c0bf1287c35a14ee8867284c1f5e80f20ba06c7211244469aea3662eaafc7a66694de478bceb5e48b262a8b0c34e329db897c2f65b2ab7c5f2cb65b8eba2cb105475a513ace66a36ff6a843fe9b792daedcc3d704973036e7d2ce18f5a9644c1b4b2072202db82bdc50b9ae8b1582bdeeadda8330963fbaa4fc08366c152b6dc97a488c37f48eea1115870f9c82acdd7b493c1afd18085308d6011436158d7e7f081df27b74e18d721b6fdeaa3b89d7df8931a92dbae738612f9b0b143fdaca9ced030b2a93353f2cb24be7776e4fc05215dfd2c0c0690afd6e2cce9d099354497cdc99cf256f9d8e381a20329e44c6b88a1f7e2701715afe71f54350fdc4bafe6c03b1fa4b6132841cef63921032064fde0f9b22b1ef4bfaf6e2de476247a52bbd009b8aa142ca6af1d3ddee0204be5763c0275190fe3835ab367abe9df7db5346c045db8f90b24d9f4d13690eedbd386892ab9fdffa1b881422007c2b11fb1670f8f5285b41bcc25595e268618970d7a6e70f7080a627cfb2ae0775e6ef7b5ac02854ce0968cfe2fa933a4add047155292009d1887ffa3405136db04a4282fd75ede37123094a07691801b0d1cdeca745f421a9741b409756cbc9385dbe10b1108f5d61f04aabecad992bef268229d14bb5665f218718e1c2508c77c34575c2f331123bdfcbc83dbeff93fd263e3248e2e1aa6826bb9e9820816dd2f9921c28bfbb693aa7985e2dc03b3d0e42f6b0f8c090eb5e5cbb8777efbbb15ce77f3e51f1e3547efcc3d9c2c02eaf9a6f6551eb4e878000000fac5eb038815060d0c000000f8010000d80300000c0000000500000000000000b5e642b781edf064ca18242b10b995223c329948f3358f092f28c6cd4d387b484d9caf067895373c246f0c5e421baec288bfb61b13bd948e3bf4232d38955ee4bd6dacffb9093be1cb8519ae3a2d711a568b30823a4514c1d2ada553face0079932e0758b76e58a4a15f1ec17a78c1c09745b2a6b20ef4d04ee0b07c526b8ce00e0614336d75d4d48321a7bd2d70122788aa8047951db41834d3930fb7794c5b03e6191779c8ddb9f65d4af8fe325eddef48ee35a8f3d6cd79860a5d291a43b899115327a5a25908fed77f6572ed7aa5b7b2c838b51b0a4470850d353251224735f8583a5ba815b640259356f4c34ae9a768e87b26ebb59a278bb83eba0c6754fa370e30b67943f9010bdab8bc04c4976ad95a8b76452d602b0cc45b2bd0d74486c15508653f1394a118744fb2c4f170dccaf88450c484e907c0fba635f5c1185bc2209bf88eaaa0848e56861601bbefb57010d2b4382fd55ac5c4044749ba8a6437a0d747e3431b66458c66135e27421d6abf546f174a61684f652e08164db787ef4bf390bbc3c979f860c36b6b7ce99cb5f76d88d6afdc29ce322c244ef6d564a196aed760d1697fe8faabe22fcb02a7b37d79c6c9cf19dd1b42a4a6a74d07d242fa82d29130f7516be833b7f0bdbf781963b30a412d6889179120f3a692218f6b968e32fe01ebff685b4ed01512225e0b53b6db66e9af673e76390e4da83bf0f57d4996edb310bbebd61fc9a22a46101d600bfb150d49ac4a2521a6f62db99fec2a0b1c90c3cc28e5a29633add7e9320076a850acfd63edd5d2c511ede337a8e631129b5bed7d6d4b0e33da986a450d4fc5400ca39cd8fb3886f80538029c2854516389332f1da4f577d81f68c91a114343d398c7aeeba625bcc296cfdf020715cb6d6958df19e5a75e2fddb35eaf0f99e10ed0e785e8ae9e6e34673e99e4af14aece042d08dac892f0c08c02bf87d5483e256a77dd8c722694a9f2922b6b91cff5513a81a17de3f0646299b7713b133bb852365db1aead093ad24c14121b92b986c47a2aa7ab69c337aa9905ec3ff69f4f3d6c6933a95e8183a9640723dfb1c7740728b8e0715015d36c1cc8d7270ba9b5f5d93a6968254910624a98c178f94e8631ed2091f0a9084503ef8dd3f4037d08747455e361157261b9dbb82ecca7ff2b1a7f382c5266d797742795d9688960cc3f9441bb90850c53cce1836335941e3ec92ef94bb12d516287659e5e208e5f1003e5243e8e52aef80f72410a8c64e5fda152f4812da002607252a109b7147db91279b6516a336f6bfc4f0db74d696bb92099dc7b21216f61e7053f5484ee70e192926fd12926e5df133a9dd8421f04d06a00b995324f0bed6c9efef8044db802214c368068a860251bb42eb783d60dd1c59561c2a0035ca4afcb83427b343ef399951711c380a8b9e1358c2d79f06dad976e6de4b751a690d2e2402a941fb4f7a897918a6ae8ce748da98c7fcbdbea2aa2bef20f28a5b9a66b2c7178000000fec501d343aed66d0c000000f8010000d80300000c0000000500000000000000b26edc050a62a67ef59dfdb53897a3802f38714257ac1bd36578eda2916cbf1bf45c6976737cb7d0da6346a6227e2a168caa0b6503b7b3c293048762de327dee72eda24880c425b1812457cf281ba318340fb021a379f3f725aa9d2cf88c1ea9a20e385969ceadb58641a4490a4834c22e97f7cbc0aa9e29806ffd7b93a32150dc4a0e678a76a17b241a173cc68d86b6a1942f9fbfb22aae2ae493c4fb5633eb7978f5c14d57b28d3c0d27938978a6dfd94ec6a21826302718eae561d42389bf81ad7a7f3e6d5d62c89fc180ee7daeee7a51d31ca4b3bd9104adf96376b5a50e0549f489dc8f5175f19fdc94906c4b58a0c1855a2d3e9546f7bdccdf29278b8dd156c29e2d84ed6cb100a6cd28241299dc276195a29d71a3986aee95a685d7c8a6c8c243f849da24fc6955f83adca8b2d1cc923d1df5785ba71c6d7bbd65088302d6d86e1493f2002a20b185690a385e81764f0753246126b9c36bd0e92b76cf515fc81c6db565e36fe0e44c4ec282e8016464fd238c029924bc3dd94b4db52791a037bb7e4cefe4bbe434720e987b4883c13f8621fc088009731f89996b6529042f309d8cc1e36cf2bde0b7cb467c528f212f81d3526b19e2d83132cad0bf20f1fa7e64499efb584bb2cd9b1b4f2618b3528f2fa5f85733ae0733df2b8193298be52a5ef6554c0cd536761829f2d566e7e0d345749693ef132027290f3f3f7b21816186f40fddb19f4b91df59b54c611737686df5a9e55f2edf3d7f2d2b30983dd4ffb627f3cb2a984e45e7127ffc14b793689b1469ce6ffed2d21f37ed6da3b43490c2db0eadf693c6f3017baaf87c92ba293d9feaef6ba8ed62b6f3e8866a9f5d4a2b58dd88c5b0c0864352afe8bc1346a4c640056a2ab3a67733f2e06ab5eb27933bcba0ce834069386884ed9c7cbadc5365d5e4aabd5aa2da6cc2e436a1a06cecd9682cd3cac81e9a000650ac5ad95a54d274378edd9695e12f0c2037d6dc59f5e5832d44f55306de81466c273915ddeb4a3e8e87635bab4b845c682d2c29f01160575532f10ebeac2ed4da499b1eabc0f5cf70a3a9ca037387fa193891ab13a5173cdb7125843b2af816050b81883956e16c37435fac16d6105e9f88bf30f1f995127b0dd1887032a86123ecf203dba61ceb5b71123fbc9791b72521a3e2f14a2446bdfa4cf7b688f547efe55b36bf9d1d811fbc0235924674db0555e0820c539db6d3844036a88838ffcf7f9385a599c5a939f8fdbb00132fb8e26fdc656febec58f1ac1cb92e04984782db9108ad0059ca489baae37d89b4eb0c51d267242605087165552029b89ee0583db0f4e11918fa63db1904da0b588954948c1f0403dd77ed3616cc481d0457d80141265a4c96cb89980f0cb81b45583796d117babcad3571f2409542f826ce9ac5036692c64d93bf4e1e9e6f1ec7772b5146625a0a17cd7196a247fbd0a612a8d8b4fc3e7bcb1021e3d14a02044510e36f0b84bb3d7640e6bec0584b312ddfb3780000005c5a507c0ec139050c000000f8010000d80300000c0000000500000000000000a39a0249ff528e7ba838266d5e01256a75f02833687b0364af7f0ef6a3b37fd91c14983417ced55d73ef4d7e6b1586edb03c50830906c1291115eb880179f1efaa2777bbe06f9281beee8aee5f9cad8bdf7d14733de8990d78a561b3da7bcc1296ba2e6a44f0bf6d02cf8798b8a4bdc6817287f334244ba6446d5bbbb6a30d567cfa7ab09e20297217b685d79df493ebac7a52529a34856f9bff1ec35ed80dcfebb2cec4e9dc4a478a02233c1d0d6da5136480c4eff18478f6e0a965d3bdd75c91fe78daac10e59f863387144e6cad4eab8dfb48ec1cec90ea4effd0cb52100a96329345233916d235cde29da7255fdaa7fee86beae90ab3af5eac354408f720a31cc5c0a321f8904fd87715a812d662930e9f41319eadafd21c94e256aac88392311ce2514ed1586eb076980b842196e0bbbcce03545bce1ecf1c5eb08f100c6231e9aae4d84dba26fa3ba67545caf1ae3ba1c2ab4d428754b42935af657ff30c6303ab0f69dbb85610858fc7baf500f06813afa9194a5218851fd668586b6c8c878b25a74eb190b6a8566c224e581933b91087b0ac60f42253d2e23ac986f3fd8601f7f3c3a8fa1bc24a542cf53b3095abba44c747d5cef0bd1c593b23d26dd21dda5aaaab45048575b071d1c69889ecb810c30803572c1c6756b6a14f6742b87606ad427b294e4e6a3f734de9760ca0ba8690cbc668d0a20c279496582726a10e3811aa431854d68adc4348257aa1040ea8f225feca8a3bf0e5bd667421adf343ea29c9da33175f8550db7b7c837e4e844449e2f82105e61c84be8a64477ea89b142f27bcb26643761b3bf5221fec71e9ff5b0cdb93f3cb5b2ed38030bcd40882c91b9dfb5da9519b52c2a0bbddd003535e5150e76e818f5aa219eadda710179cad304403aec745a8c3709fa082e503dff29a303d48d7a9d441b4a622e628a4a2ca55ccca1c59ae86ac594e56c1a78c8066b59681a565bff99fddd9e275382481314a01e21b43b3ed53a94968aa9d0aecba415103d32c9fe3921c7aa30d1c465a78baad3b4573d203501b60f9ae6e274570788fadb5ebfd8b1131ae47f3bfb1d00fc38d5a54f1b66918bc44a77024f4f18451410d33a790e4f015fc9dc19692c69346b5280ce465e98b745884d6df1034fd2a8bd921c50bebff1dc16452c3b30e40232482cac148b97d4d9b371846cc14e76347fb1f2f0a2580d803ce56dd8e1acfe634b7df3c32e99952f5f31773f1bba1510cb56987443329b03828fc00a77724a36afa3058edc2d778348d18410cc30d9db3d30a4b15fd8d4135eda18ed6247968530dad7abb68711a5ce0fa2a0f168ab0fc8fe051e88969f9790d9f8b1f04")
+	blockRaw, err := hex.DecodeString("cfb301f43e01adaa077cf48df0160b768f2a6b78c58b8aeba71e90e7e5f04b41b2d8f91272bd037b64d5b5293120e219d1dd23b92adb03593206f667ab8cbd6fa78ed9f3d0711ecf1458ee9e3d9a2938b7f7c86001904915dd35c9448ba6292275b84a625bce903b90ae9d66f35f533440d52b0dcfb5e5b4137c4753510b79369fcf2f3ecfa606f01ec1912b85890a70b9f12bf6fa0a2e6a127b723a97488ec57945d2b99204fd28f7d1f8a6cee5ebff33868e1829e7ef8dc5ec2aef1e9fd6549e513653f1ebeb6db4580ba8f26af7b8f431fcbe2473aa26f4ae4cb6e060535dade76c5f275404a2e09ce03dafee52a494137d893cbc635b05b420d7417a5dcf841e167b0d2b892df99d914fa1ffb334ca0e078353626b24f107595ecea828ee513c4976d9539758d429e000d6a671744d6921101903000000000000000000000000000000c20a00f0f56a81ebb9ab471efbbab3fc2440108d12c421421bdc61739165a156917dc5a505c937828fc80c720de49f3503f40b3893350c366aeeba2003223d4f53d21b2aaa6bfbdb6668b47125eed29e3ae25113d76618c8f6423836a34087b2b53ee52b977f942a35c40200008d160000051800002120000025260000e5290000c1390000f13a000021440000cf590000cf590000cf590000ae09507041b2ccb9e3b3f9cda71ffae3dc8b2c83f331ebdc98cc4269c56bd4db05706bf317c8877608bc751b36d9af380c5fea6bc804d2080940b3910acc8f222fc4b59166630d8a3b31eba539325c2c60aaaa0408e986241cb462fad8652bdcae09fe6000766000f0951400000005040000f6070000e70b0000d80f00009525b42b5d2a565683ab4f733e051077418f017d31230847eee84a165704b8c2a4ace8bf5714a614820de92e7bd3700b62ae304bda7684e5b6114b52abf835af34cfd6c8b0e2968392145044537481f82422dfe04934047248edd9bce4d0efca04cafc1a49a4068ded875fbe0db1be42e76cba630b374e9777bda6fcf631bc48ae09fef40076f40000e4c27a02fe0100fe0100fe0100fe0100fe0100fe0100fe0100fe0100fe0100fe0100fe0100760100f0b6049ba44d6dec0841d87cf096d73b9d5481150a1213bad856773c7d120403df9894b8dec4f62e4b946f6c90218a6a88079d47baa39f01ed19e24724aaa28e898c0aa55002b2cae431432d9ab9efeaa7a56f3ca11fd356af8907c82f9ca67726b0edcc402a3a5f0971ea13d0166fa342fc384073187c6eeafcf5777b778aa85543d9ae09507041b2ccb9e3b3f9cda71ffae3dc8b2c83f331ebdc98cc4269c56bd4db05706bf317c8877608bc751b36d9af380c5fea6bc804a64505fef103fef103fef103fef103fef103fef103fef103fef103fef103fef103fef103fef10342f103f0816230f6ca936682786f305798a86bafdec6713cbc181137ca3816b23ec5ad4b1d3b7eefa943f3f507d62a9fa84e573e4f1ce88b14ba26f7f87ddf8e4d73619e25376183f9169e862a06dee8ad8bb4201f14f3bc3005addd3937e0a968513cbc48e257fe614d17c78c38074d018457010b36102867f6724ff2297073bbdb74d835ae09fee207fee207fee207fee207fee207fee207fee207fee207fee207fee207fee207fee207fee207bae207f0815270c1aefe76cfb027cd03651f259bf5edfa5d06adb5ab2c99f6000d297aa946c77168dc70d6016983ba389a01c1ea53ec1184761fafbbd403e23912095139eafcd37e7fd2eea7655ce4c6fa3289d370aedd946878134e4646e9782ede200aaa3f316c03fd00f7e8461e6d56a11a6ef4558e2fb1e178f693d78b0639d50a5527ae09fef103fef103fef103fef103fef103fef103fef103fef103fef103fef103fef103fef103fef103baf103f081e86b194858a17f0d5559f64ecd814f3172e75bb123337a11bec85be9c35c91d0f2718ae5a30095d853b6ab35f1ee2f3fbff94824c2a67570030792ab5601c34c27f8c0c3e12a4dec7f16da93f07083f85873b45e6bd3c33cd1fed9005b734a0d812051ed55ad4cc4fff3933f96e322cf29a4b3f575b934349254dd8e2135d0f2ae09fef103fef103fef103fef103fef103fef103fef103fef103fef103fef103fef103fef103fef103baf103f4830dbe2e96edd44518b15d956bb9540806f3a031855faa8aba7173d0c8881f42819c5899a579bb3429b3359b7922727d3aa17935d5fe5065e8130017ad464547614380ad9ea28cf97e70754840137185cb2684a373f72b42651fa07bab35b94c617074bf36bbbc91104da8c193d3b9976b8c6f73c60f2070cd524e0210409146491046cecda649af344aed303a990623bf6a62b853310099dbbaf3aef0bcbb5bad81d96818c42e85bb72d46d9f4745557acd22ec1aae0feeb438a3316775eab62b47cbb544d4380001f699a6ad4662857789905b924e0e23de47f2bc2ac6b5dc3c1d2c8207633097db26c0a6365aca2cae2197ce7be713044a3219cf89d3983fac23fd09f8accab1a6ca539a1628b424e828fc83d155fde04ea381bdf7b8575f25e8d00b48e62458c3afdfb938b06c7be915a53fa9baf12be275b2892cca1991e384ad04804ece745a6506b7abdf7dcd553acfbf125ea758e8a6dc2e32286b7866a6d0c7eed23a40f00c68827099736da5e35b7174bfa1bddf4b08000000120400005ac7efd2c24a18202dc094ce93315b48f9d082b07fbc49597c29b001bb08affc1e2619bb98ef888b7e022246300000000c000000f8010000d80300000c0000000500000000000000a889879436f347bb3b82d4d51d644262a0d4b3390aff76d7d535f4c122358ca43f6af9d41712168a39395898871c7be499065f9f458ab4bd6af233646e5d63f6a266059a49743a97bacdc66ae07ef687067e36c0641303846d27779efa7133ce973fe2b3d6cc48a97b31d2215953a731dc930f062077d61f5c8f52206261d3f7462b869babbb131f613a45be85e2e76489865a5107f9a60c6d862c2055b21bd1eff79e00219105a6846a0b929f796fabafc88901d0bf01902407eadf2945bc43a19b27621edf310af1e2f99aa9e9bda45a1fac592d9badc9220af25047012593fa1ae1ac0f4622af26bd35367cc173229632f21e6567d50102b350f812415b13731c9ad4fb1f38565b69881f642b602b1ab0b2cb76d6af0c18dec0232dc3e0a68ff0b8d3a42bf8ceeed48f6ddd7c2f3290aae4549d523301d6cccf6776bdee1bb18b6b66a884696a6d2ffb5b038f79bf8f90f15664577767fba5b16024637848ddc7ebf1950e5f62a6145b89ac9a9c7085cb3977f6306ee8c5cb886d2b48e28480c1d0f2b81d34a072637d1e0b065839502d65c274ff9c061fea4a17b6ebb92a83832f66edb088daf0d24e9828f6dc3ab2f385cf47f73f7d99280d5c457c41874d5446a587f0162d16231195d59299df9bd6fdb249bf9872d964d9146f1a9d7bb9a24dd09e2266d3b82f0fc55ab486f1e938db47f959ba6834ceb01548454708311cc4a372b20dc25deb49286eeff2d5035f8f2f9d48c813152b273d90aa09a3b8644b5b020d798fef2e452ab10afc7fb33f57e5fabc6bf37ed17cc03e9b286aa2b4e825c7e195ba72c592d00287ee9b48fab007886fea56568d003efc5936f7af4e3930a93ecfca68f2c590c531b06214927d9a87f38af7267c17037a46863b744d78883b07ee72aa4bd155aaed326830018a0b4c0ab56e41e58646ed02f5dba412bd2ba4a3dd318fdc0ec64fbb017fef43728b17617343c9056a5bb9cef283ff310bfd9ac7cb96b74503bc32c4e3e51820147e6cb3e277593a8acfb581308a6f2ec74fdf18f2c6665ac5385c3bdf69a8d28974a3a704fbd17859ee9783680d9567117816f616fcb869d915136aff23d60ff0487ed468823342434dbdcef788e15242f1ec279a35a178e98eea69a8f0194027a89fe98bd343267a7cc414a55592cf5fa819d03a65c79122f5a492de56b4ab73e41a193a6e04307286657329ff89d06e84d847d206d96405ad2cba3e28738fbe4697450b0fff40bbbb4233bc056a04d0ee8ac4abfaa8af81873de81633007941544c9626370d13780e9298c64978aeaabe1591d1043063728e235ba3053a884d0a18086858f5a73211bb7d353d1f04af429e6cd05634ff94afdf6ccc77dc082671e98ead3b86fff07440918244c662d26176323edaa4276aaa6d16300000000c000000f8010000d80300000c000000050000000000000092a19131d439d13f266e8a14ed840a57f7078f8af7524c935d669640a15a7275e2ce1ef8416f37ef5112fa3c4d0e343fb8365b266fd05471be31b740dd2b4bfb71041115b3f04cef09bbb6b2373a5070f4a8d2ce01e42da54550b4408abaf6d0b73fcd5a3d35a55d8e23252144b929dec3fb3ffcd5a68db665e3faadff2843c46499e556063524a0c295ed6080e543bfb854c55e9fc99e0ab45f4d50ba46792550acc74f89199cbb960a1b4fc92f0578a7b717831341502184e2c7679920b8a1922e88c46dc110815e3b2384d12eb8d60a73162ddbb2dac8c4917dcfe5472029c49d315ae5c49aae21aa458180db280f84539bd1799d41f511bd2171a52a9cda2ae70b4cc672572a57a079210b4da8cf69811522ddd936132f68e5b42721ca788de9a016bf7a840a706984ff32f07c5243757e69ec2d76c11cfef609fb8fe16a599e60c49d9e7029c8e631656ebd8da093a99c5a8b6c885928670fc8843b6985207fdf9a83347465b950aeb8663d73ef4fc4b4235b48fd5fd820292b738515e5ae9f23c661067e45fdf4e932bf092dbffaf134bb77109e2ebc131096357ba0cc2d83fdf8e5f91975cac393b3fb647919b28bcf17bd2d9cca54ad409a65c7093b2f96ac256504b902944f341e1f6bf84ed252aaef8cf5fb62f0a145faf8cbbbd89280f7ade58685f8c0f4c3ad93f40048d6a1de7da24dbcf8ae6ff82d35f900f6eb01055aec6794c1e7a28addb670d68403d305e5d7faf68b8cdbe0d26b48869e2561f23a1b9aa13fee64ca9f307d6ec02d03b670f294a098fc7683caf8e5e19f93a034011542d4a609de023cf3612d19a07cd474a5f057e0ef8e4f4272a320dac58e4c33e75e05c8d8a33e761295f82115b34dd8656e16570461581184fa9e8fe50d095809c7ea01939ac9218f94b8e4e1064b51dcffbfd366cbce763bc5bff98e6f5c193a489f741267ccf47546914d585d08941d86577f45381285174c363f61dea72c590322c5f36200200747561d0dbca19af70ec002c50cfd660022c31f820e50427cbcfa3943547a16f6f10170b087da4e2f53874c4da8bfa7fcb406bfb39c48240363afe6dc57e9d3040ef441a2d2c4aa5923daaf9818524ceeca99242541974f4c4b09a7fdd44e220505cdfe10f43d11aa241b42c00fe134c3c27850a43ccced25e5cd1f06312d274a6872880f306170aec9e6b322a28f1f55abcaec8a8cbb8053789e365b4dc96382244617d1d574bcc763b6d296b5954754b81dce1f064727f0f2d7421af3760778560e1b167f983e7e9ecde0124775c93dadcec629201d299f506dcf35986815b0d71de2440e92961d0399f7885e9634e924795a1f04a98e2ce661a695cbf6c36a9a56f0abda6a37a5874529a4de809ec009c57c351cb65d65cb6c2ecb2f0e816404e610d7d6ae09507041b2ccb9e3b3f9cda71ffae3dc8b2c83f331ebdc98cc4269c56bd4db05706bf317c8877608bc751b36d9af380c5fea6bc804d2080940b3910acc8f222fc4b59166630d8a3b31eba539325c2c60aaaa0408e986241cb462fad8652bdcb7876c43a4a4c38d7fe6633f22d27c25d345572ff3b079e5533cf824b7b0268c39eb9edf1a358e0cba6679429482ef92ae09507041b2ccb9e3b3f9cda71ffae3dc8b2c83f331ebdc98cc4269c56bd4db05706bf317c8877608bc751b36d9af380c5fea6bc804d2080940b3910acc8f222fc4b59166630d8a3b31eba539325c2c60aaaa0408e986241cb462fad8652bdc76a8d4aa2998dfa82aae05dfcd167c72a58ed9e2850a62a0b3064bf53456845535f10417986f634990bfcd36eca0d7505335105d5d075144edf91624bcd57e5faa1d687aae09507041b2ccb9e3b3f9cda71ffae3dc8b2c83f331ebdc98cc4269c56bd4db05706bf317c8877608bc751b36d9af380c5fea6bc804d2080940b3910acc8f222fc4b59166630d8a3b31eba539325c2c60aaaa0408e986241cb462fad8652bdcb75e896810fde906022b199a0cb1a7c10759e0293b9bfdb3d4ef599813a4a3f42f4065d9d210c04f992b9ced3ffd28aeae09507041b2ccb9e3b3f9cda71ffae3dc8b2c83f331ebdc98cc4269c56bd4db05706bf317c8877608bc751b36d9af380c5fea6bc804d2080940b3910acc8f222fc4b59166630d8a3b31eba539325c2c60aaaa0408e986241cb462fad8652bdc7719dc22de45442b68751bcfb5d9250cb052e6638b2780bcda1f318bd6ec2455e8df3639410cacb039544d0c2326df079cd5b786a5a78eb80ae52c5b25915f66685bb990ae09507041b2ccb9e3b3f9cda71ffae3dc8b2c83f331ebdc98cc4269c56bd4db05706bf317c8877608bc751b36d9af380c5fea6bc804d2080940b3910acc8f222fc4b59166630d8a3b31eba539325c2c60aaaa0408e986241cb462fad8652bdc8334985abbc8a14b512654727c0323b5c07f5119b1d925cb6d6e7c7b4d7f84ddeacc9402ef55db444f6e44cde15ce352ae09507041b2ccb9e3b3f9cda71ffae3dc8b2c83f331ebdc98cc4269c56bd4db05706bf317c8877608bc751b36d9af380c5fea6bc804d2080940b3910acc8f222fc4b59166630d8a3b31eba539325c2c60aaaa0408e986241cb462fad8652bdc55de0ec164a66553fcbc73d61278025cf6780e0ab50c3395ed93f57a06fa22be0b3a49b0195f52846b8fcda9c2d2a39e71bccce882bf10bf48633bd0fc4a515f81ad1178ae09507041b2ccb9e3b3f9cda71ffae3dc8b2c83f331ebdc98cc4269c56bd4db05706bf3eea41cc0b6de2313d6cb644eeaf45e1d4029cd42c5146354eedc7e9a23746da2bad9cd359e9e781a70cdbb71b975df5fd22b3e55aefe341d7a341df049316566aad07129a82d566136a8d69e5f9898e121a59be9d6a8b5dafd477f95dae3f102cde6e1f2428e5f766778a91151d93ef79e7b54cd66e34cfad1834122f7fefc7dfcae09507041b2fed81d66d81dc0a816684d2215d7219ce836f5eaa659427ffc775a778088e60513f35e910fbdbe4872d8ee1faadfc0a0c5c91124154f6baefe34017a3401f0431acaa43e2a56cbe46b6499d1ece1fe69e33bf9fdb70ab30c742514116b33639635f1c9d5c9062b0136468967d6cba6fc7fa8e5b29c8495875f696b5355877b4cb1531479be3000fe64017e6401c091c0b0716f54765c737d34ff6af94c24f16a818c8cbb9b51c5e6743409bd27838c0efc81b37974af494433729005209d91ba3000fec0007ec000c08697f0fc2f4a8f623e9932f1660b80e42b0f4b501a07452e701eeacdca1f8e4d6e0ed08452d5406317e675095728702186ba3000fec0007ec000c0941530211ece7e73744b9b846485ea8c5a5578e921642bd7c1f973acef0f0cbe5201c93c1d75e1830e7f8730487d212c94ba3000fec0007ec000c08b46385ffe7179ceb69caac9e4fe242e5f0f3952ea0a4897c835016e9304c064c0b647752102384e911ccb932c8645f48bba3000fec0007ec0001c08000000f20700000108f086f9030000a93e1f31e9dc83bfab10ffda7add8cd135b146862fe650a4fcec9943e309f7d819f441c53b11a3068601100d1e7108c94b1c59a844e2caf8bd4fb605525acec8626998f63cdc01ba2b511af46bffa7196bdc396e56afafba3bedfde1279c4b5579263e46223e1e38cfc919e79e21821f60d81485e4afb6fef2816525b4e1a51dae0950fe5405725405fe2c23fe2c23fe2c23fe2c23fe2c23fe2c23fe2c23fe2c23fe2c23fe2c23fe2c23fe2c23422c23f0812d568d3ccc991ac68112d13ef70f8d65b14cc3f8aae9b487b49e304fa73fe12d7dbfad3dc72455ee3e669573ecc333b499d41034eb5e414d4c8352297a10605dad472d64a147a3b052d79c9387a42a4308404161e524820220c35f972e2a25e3c6847dff7bac51819724c9aef772fe773866606f59f3acadf76b12707fc42935ae09fe4a1bfe4a1bfe4a1bfe4a1bfe4a1bfe4a1bfe4a1bfe4a1bfe4a1bfe4a1bfe4a1bfe4a1bfe4a1bba4a1bf1eaf081ecefa6363812fe62f271ea37754a6f124c389fbf6644de38b66b1c024d1fd2987dd9153a629f1ca9698ea90b3c430379d54deb6b38befc8dd0a57504a4811af64ea2b2240e7a73c19dc58a60479ad221665295b6c3a791ecab3d5c1615e78843c4377c0d9eb65fe03e03f4e31052de2963e10d45859e2c4527e6176145e67856ae09fef903fef903fef903fef903fef903fef903fef903fef903fef903fef903fef903fef903fef903baf903f0818e6d799d55f2c19a76a4ec0c66d641c0d1555bae1ab1a3382a878e0511f18e8a2c82ceca3568f4055a5c0458d93f1af7f8e1be76e1cae8389a48b9cde762d157add946dae4c26d3c1b55252838ea020fcecb53c425ac33957abec6dd556136acfecc2988184d6e752769c451ead380c1e2e74d63c4da92b609bc70128f8c9d8fae09fef103fef103fef103fef103fef103fef103fef103fef103fef103fef103fef103fef103fef103baf103fe3c107e3c10e01d385cd6de69bab58c66c2a7df44e52dc66595991f62e5b5aa875b2c07ed7d3dcb55c355b2641a1c6397b11cd601c587a96abc9a70a7cb6aaefea8147aa814f46901ffa667cdaa9cf796ae74736163c64eb83f0c2e6fcc51dec9cd6e34d30caba8d83a1170f147ac16ca17a3dd4e231b8dec2a4aebc6e27d7ca0e7f2809aff02212ac64962186ad36670314903b2c157db946a08d6669bd5f7524aa326bd67b42a9525a08eb2a647e07de9f011b2072bef1bda155f490d29fca46f784602eec4e9090972424e15b10e5bc9aa191ce69d181646c8061cd8f885220257aa50bdec2f87bd706a775553308d4e8791ddb99dad646f0d3c58a24fba3584393ef266683dd54215816939cfbb051c62f5903478c3a377f39b16c2fecd227ca1cb113aae0afbf94d28678de9be86a26b14276cdd666f4984f302102f9c8e046ae096fa37f797e925f754e8b6951b9082bfceea1b04aa861a3efc2c83fd47cd8f22ce9caa2529d3f898b76007058807aaf23f08d1d67ff9751f52932cbd643ff9f470abf6000781e9dc2422d154c28d8bc271b3aadc7037700e8994bd2e220f81c7c02ba64537a54967babe390f190000f63938f49b012bc646bca2fd941f168bca3c4f448c5d7a1e4311a43eb1559290c1efc2459d7009959f6821b63060a6621210de35a8b9aac57e37c92b27c5470d049276f4763299c8842107cf39c483ed949dea2529d5a0415c9c7d5794b344cf198cceae4c92958909a2efa57dd09bd4e972e0eba0430e51ec65118b89a22b700be3a735642ba57eb37c4dbca752c75c98cd978ecd1eabd18c895da8dc867cb35edfb8fb910840415f13c07e3b8d0fced5ace4fe7e1071634811d2505bb726cdf7784a90b5e9e79fd081e93bc16584496102d6b4290da22da9a0d10764821875f4763677e4c45df3202d9e7126efb4c461ab74406f337edb720470f67a197fe5bd8cb1a2b065827149849437b388246569339ffe3ebac8bd6433dd330130f3dbcbc6c13e12a568d2d4d8c36873b9372838a874c1e5e3edbe6e3684ce5c0e60a126acc9cabf3df7bf0ba06e36d7aafc2d46b8009d4d5f98c4dc4d16b69c9a9a84a68760cb35effced4bbccbb4c7bc041c10bf765058e84eee1f6063147f0fb90cbc0eaeb46c7bdf43a0f68ac005ad2f74a0c354b04eefd4709f0c0000000000000000deda01f0659fbfc2f87a06bb715c7096e7c6f0e47b9d57853f1ffb6ffbe2889af81af8225fb33212a23684df3d563f6e87c10c3ed5bf168c93c545575c7b571cb646ab09b7d97e23e8521b3a9f18e93fcc9e9c2f1953a851c24e65ab32f49caf7d713b5e402fd9c7aeae09fe4908764908fe38057e3805f469018d041c6130c54e36a075a42137e171631a53484e402da8315dd77cd2689efada9db28492ca97a35000e80279924612d068080359eea4571440b83de6ac57d83913a88df9d2347eed53a177a4029d074ebd39b0abd266654d1eba119fdd40ec3776d004baa58b486930bc6409ecb16409daafe6ba592130825e67ce17ba63c322a47d16063f4e95d43ff85b13cb0d4ea3332edd4394f74e13d0ed2fe4dc43072681ba5b5e4f04456f2b829c6d84b898b6b4b7bf37a503975ecb1cee0413e0db37460ccad62471fee9fbccff9f52e29268ed8c1975c8855b175dcb740670b29fd77d69dd8e36839a38be681ec4ae45ec7f09b0421dd646a00c3a026230661007af72492b1b904b93bde4c087cc4740b0f13b911f2dd93d1b044231beac6ab1426b804def2ff8baa014be311a41096d4c3f0169cecc4b4ea9c593fca64740745e3720d2e0e85ad31659cfb1614022d1871339b0d571652f57d50211f235d75206d300000000000000000000d6c602f49b01286e9b8887af1706e40298baeb2cc16889dad9a788db536797c9edf5ffbe3fc1bcfc3e1265ecb0bb8f6c38f8866cde2aad4b3487581f4f0483709cd39e7d60c61be9dc8d7db2f66c8097665e43d2bf3c09852846776371d8ab94d3c25b834e516dce0018152a9acfaf11fdf8ceebc2ef2f2fea6b9d197ffa111b7d57126512ad27bbe8e164c71feffb01199b6fe656fd9e1801f95b2240955ac57a02f4c6b15cb9f8627a926a130fd97602164286e9aeb9d64869a5594fbd28ae662ef6be84e51580763bba2ff59ac4e2690d21af10d9a93fa154f78653edcdeb0fe5633672d727e301a712481b2633943bf0063b2ba13a179bc277f2f30aeacb325bce8b0008e451583be4dfe14945ffe8b2296bef4074773b720558e198b71bbf8c189de4f2feab0a1fc3c57ce18490349df7dce4b4467358d40336fb3fa862e37dbfb776e51013e051b6aca05c9493e74cd1b0b785cf35ef6018c4b25b025d29f601e07c518660462379d5fb44339fad515d98dbc332500d794f565884d32b7790fcfd247ec0c33be8d17edd7cbcea6bad033bef60dc80daa40000000000000000d6d201f065000019aa5c2345f3f26913e2cfb823aeee5deec409af910731b3b44f305e14e5aac644d8650cc3eaa92bdace53546d5a68e43c014c6c8452cad191f607a6f11a39eb49e0fb60ea53417f4dc135cacb3a21384c1383a736f9c1214198588caac1c50c317459c9fe38047e3804fe60007e6000f0c2b1ec881b2608f575142e285db98c59e039e100c5ee94b7670d778e893ff99e8130d34a88fa949b6ca36a0e8347dc78f81400000066040000b80800000a0d00005c1100003e7790f385fce163049b9e71db3be069f62a67171aaed782ca4ec4018b305f7824dc04fab3b252180efe29dcef531c1f51b45fa1e02276495cd31da88970a0e24e7f2333bb31c17967e3fd04aa464c5419c58b927fdea173657174ef105c0a77fec18e7218bad2c6dd1fc70f78000000f7fe7d9d272777060c000000f8010042702cf409159182d171cdff011a5c1b4e142046d1e1741f5a6cff5507d72209d820a0dcb8e8187115420a8e3ea99ce320780ed3dcf293b038dcf885ef250959e4261588255c91d756dec059bedc7fdb958a342c2aac95fd7e3df44b681d43e88a3314f4ac6aa692ff59660005917fbea71591f06d02bde5edbc07fbfcae7c91307557cd412ad0823e379ead9a74bd56d01dbd4aa5c4965fbbe7c5a0b451aa555beb119cdb70b62defe55a8b023d3688d1ef900a2536ffa0b250ed8257f4dfa53c0602b6c083b3153ab6bf8753c5d9358716992aaf9bee1fe82b8762e58cb5953444bbcea9071478cc82dbf8dd88121ae1064badd47bb135fca09ab5fcae8f2dd8e629174517157876d9928ecbe6486937ca73fc41d9e74639c228edb0abbeb22f8600ddbbe9846c9b267c20a1c1960bf62f6d57c6e69278a66d431e61a8f358adcb4a9aa4b73f786a4c8287751be67a194199761620af9b6507cb2b3d2882c351c3639d52ff7666b42af71592ac6dba49aa7321036cd8c1b9f473c93bed87a700ee195f885ba55cfc73985409227966948d8ea8033dfeaa541448f415c2b8a1126a236a4360aa391dc288a4c26fcb807227e5cb51d8b3725adefcb24ee8c75c5b9a2e9e4a3eadacabe13dd4a5693ae19537ae1111b4f1433f41775dd7819201899168c85f6ea2a9330a09b4a26ae9c32ae7a3eeb0b12d77b56a821cd311190651969d941224a48490fcfe59344386a264692125683f1026581676c4a18681999b78b60966ded0b9ac41030515fe0206a99e9ed1320af3c6e51100cb105208098323b8f70a12b854032c8f5bb2746edbfb388678ad14df37b87262b264374fb7e13302e2cdb526ed85c7976d17375f877fa916ee4ca512e51cc1407c7a142f21e6201cd13932a7f02a6e90f8485940069a55434f42c3b3464efea58548d64d19a930a5118edd946409261a40a50350caa05069b85841cd6dd5f8fea2a7cfa83c76322398f75c7513dc71eb00a22531434232c731bcd31088fc0ca5176feee66d3bee2bbd1a9fdb61fc2df265f84fb95463b5c7988a563941bd9470a635cbf4b857c39717bbb388fbe34b4f11f2db1b5d9af0b81d7d4d4e8451f9e6dd659bd6a2b62cc3ad15e5486f8f2bd49ee4dfe226013444975e90013dad15898515a78ee7202d8bce1ae12a93c9b46c2ff07c0bd69137dadab5f4cbaf27e88bc3298ed9a7e8a447bc6ff8ac841fe083d9aa69a05834c3c05de90b917d179c99c753ef8baa5fd8fc1ae50c1fbfe902190940f0ff37a20ed1d1c7dd14ffc236ec1a533828762f13722d69b8b1e2e8ab101ac17366165cd6d9a496bcfccf76c174c2759fb63c5a4beb5b50d41f047a4791338f60c63769b9c2e70b761d1ef40e87cb236145c5448fab71ec5f85cb74f3deffcf1533b266d6f55dfb75563549147ba0602739bc0157faa4d9a4bdb8d40ba4abce8cddf64a65894c325908e273a29235a29209a1138d14abaa1698d5db0fff123402fc728e57b1457800000036e290cf657c26b70c000000f8010000d80300000c000000050000000000000095956bb003f0866d5c5c96f355d8c411db36e9248fa389c4a19a085a6454283a088b5b2980353f5d3372e9cddbe43cdfac346520d865d38c82cfb7832a214a9d7182eee7cf4f2779c64f37701843da2ff811220ebfca3840791214b26710237c922bcb4c2ac0cc2fb487d9d391ea6e243ec7c24ba89e1e0bd82a025765c016893bdbd45a5d261f322e212a1e3e7f5258b6c53ef0d7c3e0ff7b158d84227ca8824364bd56727a3368bfe7d6f48ec327757060562dd1fe5a6e83c38ba6983f973495f3fff2e61acbdead26729ba251026923764fcab43902c8d11d21fc176d0caff2ab023e4cbb5d66e82d9506c5db95dcb5a99fee8f3fafbe7673d8126ddf8e13103a045f969f107b04fe6c050fc4e737674e5ef0030c44e7f1b48179df57f533900ccfdb687e6b50f9525c5c1f31f21011cc89c91f0e3bf59c623554273a4ca19d3e488f2be82f87e3dd36e55a911c78a9c029da856cc4a20d8b6997c9a050eaac9831b43d20e40cd9d28e21ad47ec45f7be381cdfe6f33c5f0036de729f85e786ed868d1e4b8829f1280b358ae7ed064078d208be502960a9ec852a0f875cdfbb5102b5595867787c38fdf18b355a0da7cbb1a72540c99fa7d9e0df7846795a17fc0bae95c146dcf037d45a40d66c6385f95832ba00abe2e4f5ff94f1b95a3fa80b52e2b1fcaf9fbcac191739b95e0696ef6a3ae4a0688ea948c692c0bf1287c35a14ee8867284c1f5e80f20ba06c7211244469aea3662eaafc7a66694de478bceb5e48b262a8b0c34e329db897c2f65b2ab7c5f2cb65b8eba2cb105475a513ace66a36ff6a843fe9b792daedcc3d704973036e7d2ce18f5a9644c1b4b2072202db82bdc50b9ae8b1582bdeeadda8330963fbaa4fc08366c152b6dc97a488c37f48eea1115870f9c82acdd7b493c1afd18085308d6011436158d7e7f081df27b74e18d721b6fdeaa3b89d7df8931a92dbae738612f9b0b143fdaca9ced030b2a93353f2cb24be7776e4fc05215dfd2c0c0690afd6e2cce9d099354497cdc99cf256f9d8e381a20329e44c6b88a1f7e2701715afe71f54350fdc4bafe6c03b1fa4b6132841cef63921032064fde0f9b22b1ef4bfaf6e2de476247a52bbd009b8aa142ca6af1d3ddee0204be5763c0275190fe3835ab367abe9df7db5346c045db8f90b24d9f4d13690eedbd386892ab9fdffa1b881422007c2b11fb1670f8f5285b41bcc25595e268618970d7a6e70f7080a627cfb2ae0775e6ef7b5ac02854ce0968cfe2fa933a4add047155292009d1887ffa3405136db04a4282fd75ede37123094a07691801b0d1cdeca745f421a9741b409756cbc9385dbe10b1108f5d61f04aabecad992bef268229d14bb5665f218718e1c2508c77c34575c2f331123bdfcbc83dbeff93fd263e3248e2e1aa6826bb9e9820816dd2f9921c28bfbb693aa7985e2dc03b3d0e42f6b0f8c090eb5e5cbb8777efbbb15ce77f3e51f1e3547efcc3d9c2c02eaf9a6f6551eb4e878000000fac5eb038815060d0c000000f8010000d80300000c0000000500000000000000b5e642b781edf064ca18242b10b995223c329948f3358f092f28c6cd4d387b484d9caf067895373c246f0c5e421baec288bfb61b13bd948e3bf4232d38955ee4bd6dacffb9093be1cb8519ae3a2d711a568b30823a4514c1d2ada553face0079932e0758b76e58a4a15f1ec17a78c1c09745b2a6b20ef4d04ee0b07c526b8ce00e0614336d75d4d48321a7bd2d70122788aa8047951db41834d3930fb7794c5b03e6191779c8ddb9f65d4af8fe325eddef48ee35a8f3d6cd79860a5d291a43b899115327a5a25908fed77f6572ed7aa5b7b2c838b51b0a4470850d353251224735f8583a5ba815b640259356f4c34ae9a768e87b26ebb59a278bb83eba0c6754fa370e30b67943f9010bdab8bc04c4976ad95a8b76452d602b0cc45b2bd0d74486c15508653f1394a118744fb2c4f170dccaf88450c484e907c0fba635f5c1185bc2209bf88eaaa0848e56861601bbefb57010d2b4382fd55ac5c4044749ba8a6437a0d747e3431b66458c66135e27421d6abf546f174a61684f652e08164db787ef4bf390bbc3c979f860c36b6b7ce99cb5f76d88d6afdc29ce322c244ef6d564a196aed760d1697fe8faabe22fcb02a7b37d79c6c9cf19dd1b42a4a6a74d07d242fa82d29130f7516be833b7f0bdbf781963b30a412d6889179120f3a692218f6b968e32fe01ebff685b4ed01512225e0b53b6db66e9af673e76390e4da83bf0f57d4996edb310bbebd61fc9a22a46101d600bfb150d49ac4a2521a6f62db99fec2a0b1c90c3cc28e5a29633add7e9320076a850acfd63edd5d2c511ede337a8e631129b5bed7d6d4b0e33da986a450d4fc5400ca39cd8fb3886f80538029c2854516389332f1da4f577d81f68c91a114343d398c7aeeba625bcc296cfdf020715cb6d6958df19e5a75e2fddb35eaf0f99e10ed0e785e8ae9e6e34673e99e4af14aece042d08dac892f0c08c02bf87d5483e256a77dd8c722694a9f2922b6b91cff5513a81a17de3f0646299b7713b133bb852365db1aead093ad24c14121b92b986c47a2aa7ab69c337aa9905ec3ff69f4f3d6c6933a95e8183a9640723dfb1c7740728b8e0715015d36c1cc8d7270ba9b5f5d93a6968254910624a98c178f94e8631ed2091f0a9084503ef8dd3f4037d08747455e361157261b9dbb82ecca7ff2b1a7f382c5266d797742795d9688960cc3f9441bb90850c53cce1836335941e3ec92ef94bb12d516287659e5e208e5f1003e5243e8e52aef80f72410a8c64e5fda152f4812da002607252a109b7147db91279b6516a336f6bfc4f0db74d696bb92099dc7b21216f61e7053f5484ee70e192926fd12926e5df133a9dd8421f04d06a00b995324f0bed6c9efef8044db802214c368068a860251bb42eb783d60dd1c59561c2a0035ca4afcb83427b343ef399951711c380a8b9e1358c2d79f06dad976e6de4b751a690d2e2402a941fb4f7a897918a6ae8ce748da98c7fcbdbea2aa2bef20f28a5b9a66b2c7178000000fec501d343aed66d0c000000f8010000d80300000c0000000500000000000000b26edc050a62a67ef59dfdb53897a3802f38714257ac1bd36578eda2916cbf1bf45c6976737cb7d0da6346a6227e2a168caa0b6503b7b3c293048762de327dee72eda24880c425b1812457cf281ba318340fb021a379f3f725aa9d2cf88c1ea9a20e385969ceadb58641a4490a4834c22e97f7cbc0aa9e29806ffd7b93a32150dc4a0e678a76a17b241a173cc68d86b6a1942f9fbfb22aae2ae493c4fb5633eb7978f5c14d57b28d3c0d27938978a6dfd94ec6a21826302718eae561d42389bf81ad7a7f3e6d5d62c89fc180ee7daeee7a51d31ca4b3bd9104adf96376b5a50e0549f489dc8f5175f19fdc94906c4b58a0c1855a2d3e9546f7bdccdf29278b8dd156c29e2d84ed6cb100a6cd28241299dc276195a29d71a3986aee95a685d7c8a6c8c243f849da24fc6955f83adca8b2d1cc923d1df5785ba71c6d7bbd65088302d6d86e1493f2002a20b185690a385e81764f0753246126b9c36bd0e92b76cf515fc81c6db565e36fe0e44c4ec282e8016464fd238c029924bc3dd94b4db52791a037bb7e4cefe4bbe434720e987b4883c13f8621fc088009731f89996b6529042f309d8cc1e36cf2bde0b7cb467c528f212f81d3526b19e2d83132cad0bf20f1fa7e64499efb584bb2cd9b1b4f2618b3528f2fa5f85733ae0733df2b8193298be52a5ef6554c0cd536761829f2d566e7e0d345749693ef132027290f3f3f7b21816186f40fddb19f4b91df59b54c611737686df5a9e55f2edf3d7f2d2b30983dd4ffb627f3cb2a984e45e7127ffc14b793689b1469ce6ffed2d21f37ed6da3b43490c2db0eadf693c6f3017baaf87c92ba293d9feaef6ba8ed62b6f3e8866a9f5d4a2b58dd88c5b0c0864352afe8bc1346a4c640056a2ab3a67733f2e06ab5eb27933bcba0ce834069386884ed9c7cbadc5365d5e4aabd5aa2da6cc2e436a1a06cecd9682cd3cac81e9a000650ac5ad95a54d274378edd9695e12f0c2037d6dc59f5e5832d44f55306de81466c273915ddeb4a3e8e87635bab4b845c682d2c29f01160575532f10ebeac2ed4da499b1eabc0f5cf70a3a9ca037387fa193891ab13a5173cdb7125843b2af816050b81883956e16c37435fac16d6105e9f88bf30f1f995127b0dd1887032a86123ecf203dba61ceb5b71123fbc9791b72521a3e2f14a2446bdfa4cf7b688f547efe55b36bf9d1d811fbc0235924674db0555e0820c539db6d3844036a88838ffcf7f9385a599c5a939f8fdbb00132fb8e26fdc656febec58f1ac1cb92e04984782db9108ad0059ca489baae37d89b4eb0c51d267242605087165552029b89ee0583db0f4e11918fa63db1904da0b588954948c1f0403dd77ed3616cc481d0457d80141265a4c96cb89980f0cb81b45583796d117babcad3571f2409542f826ce9ac5036692c64d93bf4e1e9e6f1ec7772b5146625a0a17cd7196a247fbd0a612a8d8b4fc3e7bcb1021e3d14a02044510e36f0b84bb3d7640e6bec0584b312ddfb3780000005c5a507c0ec139050c000000f8010000d80300000c0000000500000000000000a39a0249ff528e7ba838266d5e01256a75f02833687b0364af7f0ef6a3b37fd91c14983417ced55d73ef4d7e6b1586edb03c50830906c1291115eb880179f1efaa2777bbe06f9281beee8aee5f9cad8bdf7d14733de8990d78a561b3da7bcc1296ba2e6a44f0bf6d02cf8798b8a4bdc6817287f334244ba6446d5bbbb6a30d567cfa7ab09e20297217b685d79df493ebac7a52529a34856f9bff1ec35ed80dcfebb2cec4e9dc4a478a02233c1d0d6da5136480c4eff18478f6e0a965d3bdd75c91fe78daac10e59f863387144e6cad4eab8dfb48ec1cec90ea4effd0cb52100a96329345233916d235cde29da7255fdaa7fee86beae90ab3af5eac354408f720a31cc5c0a321f8904fd87715a812d662930e9f41319eadafd21c94e256aac88392311ce2514ed1586eb076980b842196e0bbbcce03545bce1ecf1c5eb08f100c6231e9aae4d84dba26fa3ba67545caf1ae3ba1c2ab4d428754b42935af657ff30c6303ab0f69dbb85610858fc7baf500f06813afa9194a5218851fd668586b6c8c878b25a74eb190b6a8566c224e581933b91087b0ac60f42253d2e23ac986f3fd8601f7f3c3a8fa1bc24a542cf53b3095abba44c747d5cef0bd1c593b23d26dd21dda5aaaab45048575b071d1c69889ecb810c30803572c1c6756b6a14f6742b87606ad427b294e4e6a3f734de9760ca0ba8690cbc668d0a20c279496582726a10e3811aa431854d68adc4348257aa1040ea8f225feca8a3bf0e5bd667421adf343ea29c9da33175f8550db7b7c837e4e844449e2f82105e61c84be8a64477ea89b142f27bcb26643761b3bf5221fec71e9ff5b0cdb93f3cb5b2ed38030bcd40882c91b9dfb5da9519b52c2a0bbddd003535e5150e76e818f5aa219eadda710179cad304403aec745a8c3709fa082e503dff29a303d48d7a9d441b4a622e628a4a2ca55ccca1c59ae86ac594e56c1a78c8066b59681a565bff99fddd9e275382481314a01e21b43b3ed53a94968aa9d0aecba415103d32c9fe3921c7aa30d1c465a78baad3b4573d203501b60f9ae6e274570788fadb5ebfd8b1131ae47f3bfb1d00fc38d5a54f1b66918bc44a77024f4f18451410d33a790e4f015fc9dc19692c69346b5280ce465e98b745884d6df1034fd2a8bd921c50bebff1dc16452c3b30e40232482cac148b97d4d9b371846cc14e76347fb1f2f0a2580d803ce56dd8e1acfe634b7df3c32e99952f5f31773f1bba1510cb56987443329b03828fc00a77724a36afa3058edc2d778348d18410cc30d9db3d30a4b15fd8d4135eda18ed6247968530dad7abb68711a5ce0fa2a0f168ab0fc8fe051e88969f9790d9f8b1f04")
 
 	assert.NoError(t, err)
 
@@ -64,8 +64,10 @@ func TestBlocksMerkle(t *testing.T) {
 	assert.Equal(t, "524360b77083f0b15d01d48e8cc7cb6f1f81d26b55aff0199dec9afa351ae4d1", b.ExitMerkleRoot().String())
 	assert.Equal(t, "d428abda52d80abf7f36d77eabb57b6a8d0d2f013e86cf9bc8ef2374bd7999f7", b.VoteSlashingRoot().String())
 	assert.Equal(t, "a55dce6b41da70a336de9095f495a67beebb4ebd854c0d5a07d93c22023eb54d", b.RANDAOSlashingsRoot().String())
-	assert.Equal(t, "c93de79808db4ec8cc3bc9600a93256f00677ce97191b5ca0e28fe2c702f7b22", b.ProposerSlashingsRoot().String())
+	assert.Equal(t, "403ff2934d239a8042b2fd5cd619055bea37f13bd11f868de9821fe4d147dc99", b.ProposerSlashingsRoot().String())
 	assert.Equal(t, "4537ac637f1825cfb73cbaba73436bf7cc29f5975180042d21a65d173133ef5e", b.GovernanceVoteMerkleRoot().String())
+	assert.Equal(t, "0000000000000000000000000000000000000000000000000000000000000000", b.ContractMerkleRoot().String())
+	assert.Equal(t, "0000000000000000000000000000000000000000000000000000000000000000", b.AssetTxMerkleRoot().String())
 
 	expectedTx := []string{"802b1fe9de2637c4968cea3b6b773788835172dc7b5d607b5d85ab01c381efed", "b513f2f3fb5e1d85f3df536cfb4196c8350de70caa04e5266af9e16209f65e7f"}
 	txs := b.GetTxs()
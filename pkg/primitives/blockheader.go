@@ -10,7 +10,7 @@ import (
 var ErrorBlockHeaderSize = errors.New("blockheader size is too big")
 
 // MaxBlockHeaderBytes is the maximum amount of bytes a header can contain.
-const MaxBlockHeaderBytes = 372
+const MaxBlockHeaderBytes = 436
 
 // BlockHeader is the container of merkle roots for the blockchain
 type BlockHeader struct {
@@ -25,6 +25,8 @@ type BlockHeader struct {
 	RANDAOSlashingMerkleRoot   [32]byte `ssz-size:"32"`
 	ProposerSlashingMerkleRoot [32]byte `ssz-size:"32"`
 	GovernanceVotesMerkleRoot  [32]byte `ssz-size:"32"`
+	ContractMerkleRoot         [32]byte `ssz-size:"32"`
+	AssetTxMerkleRoot          [32]byte `ssz-size:"32"`
 	PrevBlockHash              [32]byte `ssz-size:"32"`
 	Timestamp                  uint64
 	Slot                       uint64
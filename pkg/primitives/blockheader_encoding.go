@@ -47,19 +47,25 @@ func (b *BlockHeader) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	// Field (10) 'GovernanceVotesMerkleRoot'
 	dst = append(dst, b.GovernanceVotesMerkleRoot[:]...)
 
-	// Field (11) 'PrevBlockHash'
+	// Field (11) 'ContractMerkleRoot'
+	dst = append(dst, b.ContractMerkleRoot[:]...)
+
+	// Field (12) 'AssetTxMerkleRoot'
+	dst = append(dst, b.AssetTxMerkleRoot[:]...)
+
+	// Field (13) 'PrevBlockHash'
 	dst = append(dst, b.PrevBlockHash[:]...)
 
-	// Field (12) 'Timestamp'
+	// Field (14) 'Timestamp'
 	dst = ssz.MarshalUint64(dst, b.Timestamp)
 
-	// Field (13) 'Slot'
+	// Field (15) 'Slot'
 	dst = ssz.MarshalUint64(dst, b.Slot)
 
-	// Field (14) 'StateRoot'
+	// Field (16) 'StateRoot'
 	dst = append(dst, b.StateRoot[:]...)
 
-	// Field (15) 'FeeAddress'
+	// Field (17) 'FeeAddress'
 	dst = append(dst, b.FeeAddress[:]...)
 
 	return
@@ -69,7 +75,7 @@ func (b *BlockHeader) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 func (b *BlockHeader) UnmarshalSSZ(buf []byte) error {
 	var err error
 	size := uint64(len(buf))
-	if size != 404 {
+	if size != 468 {
 		return ssz.ErrSize
 	}
 
@@ -106,27 +112,33 @@ func (b *BlockHeader) UnmarshalSSZ(buf []byte) error {
 	// Field (10) 'GovernanceVotesMerkleRoot'
 	copy(b.GovernanceVotesMerkleRoot[:], buf[272:304])
 
-	// Field (11) 'PrevBlockHash'
-	copy(b.PrevBlockHash[:], buf[304:336])
+	// Field (11) 'ContractMerkleRoot'
+	copy(b.ContractMerkleRoot[:], buf[304:336])
+
+	// Field (12) 'AssetTxMerkleRoot'
+	copy(b.AssetTxMerkleRoot[:], buf[336:368])
 
-	// Field (12) 'Timestamp'
-	b.Timestamp = ssz.UnmarshallUint64(buf[336:344])
+	// Field (13) 'PrevBlockHash'
+	copy(b.PrevBlockHash[:], buf[368:400])
 
-	// Field (13) 'Slot'
-	b.Slot = ssz.UnmarshallUint64(buf[344:352])
+	// Field (14) 'Timestamp'
+	b.Timestamp = ssz.UnmarshallUint64(buf[400:408])
 
-	// Field (14) 'StateRoot'
-	copy(b.StateRoot[:], buf[352:384])
+	// Field (15) 'Slot'
+	b.Slot = ssz.UnmarshallUint64(buf[408:416])
 
-	// Field (15) 'FeeAddress'
-	copy(b.FeeAddress[:], buf[384:404])
+	// Field (16) 'StateRoot'
+	copy(b.StateRoot[:], buf[416:448])
+
+	// Field (17) 'FeeAddress'
+	copy(b.FeeAddress[:], buf[448:468])
 
 	return err
 }
 
 // SizeSSZ returns the ssz encoded size in bytes for the BlockHeader object
 func (b *BlockHeader) SizeSSZ() (size int) {
-	size = 404
+	size = 468
 	return
 }
 
@@ -172,19 +184,25 @@ func (b *BlockHeader) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	// Field (10) 'GovernanceVotesMerkleRoot'
 	hh.PutBytes(b.GovernanceVotesMerkleRoot[:])
 
-	// Field (11) 'PrevBlockHash'
+	// Field (11) 'ContractMerkleRoot'
+	hh.PutBytes(b.ContractMerkleRoot[:])
+
+	// Field (12) 'AssetTxMerkleRoot'
+	hh.PutBytes(b.AssetTxMerkleRoot[:])
+
+	// Field (13) 'PrevBlockHash'
 	hh.PutBytes(b.PrevBlockHash[:])
 
-	// Field (12) 'Timestamp'
+	// Field (14) 'Timestamp'
 	hh.PutUint64(b.Timestamp)
 
-	// Field (13) 'Slot'
+	// Field (15) 'Slot'
 	hh.PutUint64(b.Slot)
 
-	// Field (14) 'StateRoot'
+	// Field (16) 'StateRoot'
 	hh.PutBytes(b.StateRoot[:])
 
-	// Field (15) 'FeeAddress'
+	// Field (17) 'FeeAddress'
 	hh.PutBytes(b.FeeAddress[:])
 
 	hh.Merkleize(indx)
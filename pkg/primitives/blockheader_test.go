@@ -32,6 +32,8 @@ func TestBlockHeader(t *testing.T) {
 		RANDAOSlashingMerkleRoot:   [32]byte{1, 2, 3},
 		ProposerSlashingMerkleRoot: [32]byte{1, 2, 3},
 		GovernanceVotesMerkleRoot:  [32]byte{1, 2, 3},
+		ContractMerkleRoot:         [32]byte{1, 2, 3},
+		AssetTxMerkleRoot:          [32]byte{1, 2, 3},
 		PrevBlockHash:              [32]byte{1, 2, 3},
 		Timestamp:                  500,
 		Slot:                       14,
@@ -39,5 +41,5 @@ func TestBlockHeader(t *testing.T) {
 		FeeAddress:                 [20]byte{1, 2, 3},
 	}
 
-	assert.Equal(t, "f485d6bb7dd7a305ec6de59e237c47b6c9e6d0ddd39c70e8cd3a770591620800", d.Hash().String())
+	assert.Equal(t, "33caeae31c92de2552b04ede035e36f8a1f4d7b9426040eec398f59a31145f3c", d.Hash().String())
 }
@@ -10,11 +10,20 @@ type AccountInfo struct {
 	Info    uint64
 }
 
+// AssetSerializable is an asset with its balances on a serializable struct.
+type AssetSerializable struct {
+	ID       [20]byte
+	Issuer   [20]byte
+	Supply   uint64
+	Balances []*AccountInfo `ssz-max:"2097152"`
+}
+
 // CoinsStateSerializable is a struct to properly serialize the coinstate efficiently
 type CoinsStateSerializable struct {
-	Balances []*AccountInfo `ssz-max:"2097152"`
-	Nonces   []*AccountInfo `ssz-max:"2097152"`
-	Proofs   [][32]byte     `ssz-max:"2097152"`
+	Balances []*AccountInfo       `ssz-max:"2097152"`
+	Nonces   []*AccountInfo       `ssz-max:"2097152"`
+	Proofs   [][32]byte           `ssz-max:"2097152"`
+	Assets   []*AssetSerializable `ssz-max:"1048576"`
 }
 
 func (c *CoinsStateSerializable) Marshal() ([]byte, error) {
@@ -33,11 +42,41 @@ func (c *CoinsStateSerializable) Unmarshal(b []byte) error {
 	return c.UnmarshalSSZ(des)
 }
 
+// Asset is a token issued by an account. Only the issuer can mint more units of it.
+type Asset struct {
+	Issuer   [20]byte
+	Supply   uint64
+	Balances map[[20]byte]uint64
+}
+
+// Copy copies the asset and returns a new one.
+func (a *Asset) Copy() *Asset {
+	a2 := &Asset{
+		Issuer:   a.Issuer,
+		Supply:   a.Supply,
+		Balances: make(map[[20]byte]uint64, len(a.Balances)),
+	}
+	for k, v := range a.Balances {
+		a2.Balances[k] = v
+	}
+	return a2
+}
+
 // CoinsState is the state that we use to store accounts balances and Nonces
 type CoinsState struct {
 	Balances       map[[20]byte]uint64
 	Nonces         map[[20]byte]uint64
 	ProofsVerified map[[32]byte]struct{}
+	Assets         map[[20]byte]*Asset
+}
+
+// AssetBalance returns the balance of an account for an asset.
+func (u *CoinsState) AssetBalance(asset [20]byte, account [20]byte) uint64 {
+	a, ok := u.Assets[asset]
+	if !ok {
+		return 0
+	}
+	return a.Balances[account]
 }
 
 // Marshal serialize to bytes the struct
@@ -76,6 +115,7 @@ func (u *CoinsState) Copy() CoinsState {
 	u2.Balances = make(map[[20]byte]uint64)
 	u2.Nonces = make(map[[20]byte]uint64)
 	u2.ProofsVerified = make(map[[32]byte]struct{})
+	u2.Assets = make(map[[20]byte]*Asset, len(u.Assets))
 	for i, c := range u.Balances {
 		u2.Balances[i] = c
 	}
@@ -85,6 +125,9 @@ func (u *CoinsState) Copy() CoinsState {
 	for k := range u.ProofsVerified {
 		u2.ProofsVerified[k] = struct{}{}
 	}
+	for k, a := range u.Assets {
+		u2.Assets[k] = a.Copy()
+	}
 	return u2
 }
 
@@ -92,6 +135,7 @@ func (u *CoinsState) Copy() CoinsState {
 func (u *CoinsState) FromSerializable(ser *CoinsStateSerializable) {
 	u.Balances = map[[20]byte]uint64{}
 	u.Nonces = map[[20]byte]uint64{}
+	u.Assets = map[[20]byte]*Asset{}
 	for _, b := range ser.Balances {
 		u.Balances[b.Account] = b.Info
 	}
@@ -101,6 +145,17 @@ func (u *CoinsState) FromSerializable(ser *CoinsStateSerializable) {
 	for _, n := range ser.Proofs {
 		u.ProofsVerified[n] = struct{}{}
 	}
+	for _, a := range ser.Assets {
+		asset := &Asset{
+			Issuer:   a.Issuer,
+			Supply:   a.Supply,
+			Balances: make(map[[20]byte]uint64, len(a.Balances)),
+		}
+		for _, b := range a.Balances {
+			asset.Balances[b.Account] = b.Info
+		}
+		u.Assets[a.ID] = asset
+	}
 	return
 }
 
@@ -118,5 +173,13 @@ func (u *CoinsState) ToSerializable() CoinsStateSerializable {
 	for k := range u.ProofsVerified {
 		proofs = append(proofs, k)
 	}
-	return CoinsStateSerializable{Balances: balances, Nonces: nonces, Proofs: proofs}
+	var assets []*AssetSerializable
+	for id, a := range u.Assets {
+		var assetBalances []*AccountInfo
+		for k, v := range a.Balances {
+			assetBalances = append(assetBalances, &AccountInfo{Account: k, Info: v})
+		}
+		assets = append(assets, &AssetSerializable{ID: id, Issuer: a.Issuer, Supply: a.Supply, Balances: assetBalances})
+	}
+	return CoinsStateSerializable{Balances: balances, Nonces: nonces, Proofs: proofs, Assets: assets}
 }
@@ -65,6 +65,136 @@ func (a *AccountInfo) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	return
 }
 
+// MarshalSSZ ssz marshals the AssetSerializable object
+func (a *AssetSerializable) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(a)
+}
+
+// MarshalSSZTo ssz marshals the AssetSerializable object to a target array
+func (a *AssetSerializable) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(52)
+
+	// Field (0) 'ID'
+	dst = append(dst, a.ID[:]...)
+
+	// Field (1) 'Issuer'
+	dst = append(dst, a.Issuer[:]...)
+
+	// Field (2) 'Supply'
+	dst = ssz.MarshalUint64(dst, a.Supply)
+
+	// Offset (3) 'Balances'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(a.Balances) * 28
+
+	// Field (3) 'Balances'
+	if len(a.Balances) > 2097152 {
+		err = ssz.ErrListTooBig
+		return
+	}
+	for ii := 0; ii < len(a.Balances); ii++ {
+		if dst, err = a.Balances[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the AssetSerializable object
+func (a *AssetSerializable) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 52 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o3 uint64
+
+	// Field (0) 'ID'
+	copy(a.ID[:], buf[0:20])
+
+	// Field (1) 'Issuer'
+	copy(a.Issuer[:], buf[20:40])
+
+	// Field (2) 'Supply'
+	a.Supply = ssz.UnmarshallUint64(buf[40:48])
+
+	// Offset (3) 'Balances'
+	if o3 = ssz.ReadOffset(buf[48:52]); o3 > size {
+		return ssz.ErrOffset
+	}
+
+	// Field (3) 'Balances'
+	{
+		buf = tail[o3:]
+		num, err := ssz.DivideInt2(len(buf), 28, 2097152)
+		if err != nil {
+			return err
+		}
+		a.Balances = make([]*AccountInfo, num)
+		for ii := 0; ii < num; ii++ {
+			if a.Balances[ii] == nil {
+				a.Balances[ii] = new(AccountInfo)
+			}
+			if err = a.Balances[ii].UnmarshalSSZ(buf[ii*28 : (ii+1)*28]); err != nil {
+				return err
+			}
+		}
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the AssetSerializable object
+func (a *AssetSerializable) SizeSSZ() (size int) {
+	size = 52
+
+	// Field (3) 'Balances'
+	size += len(a.Balances) * 28
+
+	return
+}
+
+// HashTreeRoot ssz hashes the AssetSerializable object
+func (a *AssetSerializable) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(a)
+}
+
+// HashTreeRootWith ssz hashes the AssetSerializable object with a hasher
+func (a *AssetSerializable) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'ID'
+	hh.PutBytes(a.ID[:])
+
+	// Field (1) 'Issuer'
+	hh.PutBytes(a.Issuer[:])
+
+	// Field (2) 'Supply'
+	hh.PutUint64(a.Supply)
+
+	// Field (3) 'Balances'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(a.Balances))
+		if num > 2097152 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for i := uint64(0); i < num; i++ {
+			if err = a.Balances[i].HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 2097152)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
 // MarshalSSZ ssz marshals the CoinsStateSerializable object
 func (c *CoinsStateSerializable) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(c)
@@ -73,7 +203,7 @@ func (c *CoinsStateSerializable) MarshalSSZ() ([]byte, error) {
 // MarshalSSZTo ssz marshals the CoinsStateSerializable object to a target array
 func (c *CoinsStateSerializable) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = buf
-	offset := int(12)
+	offset := int(16)
 
 	// Offset (0) 'Balances'
 	dst = ssz.WriteOffset(dst, offset)
@@ -87,6 +217,13 @@ func (c *CoinsStateSerializable) MarshalSSZTo(buf []byte) (dst []byte, err error
 	dst = ssz.WriteOffset(dst, offset)
 	offset += len(c.Proofs) * 32
 
+	// Offset (3) 'Assets'
+	dst = ssz.WriteOffset(dst, offset)
+	for ii := 0; ii < len(c.Assets); ii++ {
+		offset += 4
+		offset += c.Assets[ii].SizeSSZ()
+	}
+
 	// Field (0) 'Balances'
 	if len(c.Balances) > 2097152 {
 		err = ssz.ErrListTooBig
@@ -118,6 +255,24 @@ func (c *CoinsStateSerializable) MarshalSSZTo(buf []byte) (dst []byte, err error
 		dst = append(dst, c.Proofs[ii][:]...)
 	}
 
+	// Field (3) 'Assets'
+	if len(c.Assets) > 1048576 {
+		err = ssz.ErrListTooBig
+		return
+	}
+	{
+		offset = 4 * len(c.Assets)
+		for ii := 0; ii < len(c.Assets); ii++ {
+			dst = ssz.WriteOffset(dst, offset)
+			offset += c.Assets[ii].SizeSSZ()
+		}
+	}
+	for ii := 0; ii < len(c.Assets); ii++ {
+		if dst, err = c.Assets[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
 	return
 }
 
@@ -125,12 +280,12 @@ func (c *CoinsStateSerializable) MarshalSSZTo(buf []byte) (dst []byte, err error
 func (c *CoinsStateSerializable) UnmarshalSSZ(buf []byte) error {
 	var err error
 	size := uint64(len(buf))
-	if size < 12 {
+	if size < 16 {
 		return ssz.ErrSize
 	}
 
 	tail := buf
-	var o0, o1, o2 uint64
+	var o0, o1, o2, o3 uint64
 
 	// Offset (0) 'Balances'
 	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
@@ -147,6 +302,11 @@ func (c *CoinsStateSerializable) UnmarshalSSZ(buf []byte) error {
 		return ssz.ErrOffset
 	}
 
+	// Offset (3) 'Assets'
+	if o3 = ssz.ReadOffset(buf[12:16]); o3 > size || o2 > o3 {
+		return ssz.ErrOffset
+	}
+
 	// Field (0) 'Balances'
 	{
 		buf = tail[o0:o1]
@@ -185,7 +345,7 @@ func (c *CoinsStateSerializable) UnmarshalSSZ(buf []byte) error {
 
 	// Field (2) 'Proofs'
 	{
-		buf = tail[o2:]
+		buf = tail[o2:o3]
 		num, err := ssz.DivideInt2(len(buf), 32, 2097152)
 		if err != nil {
 			return err
@@ -195,12 +355,34 @@ func (c *CoinsStateSerializable) UnmarshalSSZ(buf []byte) error {
 			copy(c.Proofs[ii][:], buf[ii*32:(ii+1)*32])
 		}
 	}
+
+	// Field (3) 'Assets'
+	{
+		buf = tail[o3:]
+		num, err := ssz.DecodeDynamicLength(buf, 1048576)
+		if err != nil {
+			return err
+		}
+		c.Assets = make([]*AssetSerializable, num)
+		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+			if c.Assets[indx] == nil {
+				c.Assets[indx] = new(AssetSerializable)
+			}
+			if err = c.Assets[indx].UnmarshalSSZ(buf); err != nil {
+				return err
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
 	return err
 }
 
 // SizeSSZ returns the ssz encoded size in bytes for the CoinsStateSerializable object
 func (c *CoinsStateSerializable) SizeSSZ() (size int) {
-	size = 12
+	size = 16
 
 	// Field (0) 'Balances'
 	size += len(c.Balances) * 28
@@ -211,6 +393,12 @@ func (c *CoinsStateSerializable) SizeSSZ() (size int) {
 	// Field (2) 'Proofs'
 	size += len(c.Proofs) * 32
 
+	// Field (3) 'Assets'
+	for ii := 0; ii < len(c.Assets); ii++ {
+		size += 4
+		size += c.Assets[ii].SizeSSZ()
+	}
+
 	return
 }
 
@@ -269,6 +457,22 @@ func (c *CoinsStateSerializable) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 		hh.MerkleizeWithMixin(subIndx, numItems, ssz.CalculateLimit(2097152, numItems, 32))
 	}
 
+	// Field (3) 'Assets'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(c.Assets))
+		if num > 1048576 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for i := uint64(0); i < num; i++ {
+			if err = c.Assets[i].HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 1048576)
+	}
+
 	hh.Merkleize(indx)
 	return
 }
@@ -0,0 +1,101 @@
+package primitives
+
+import (
+	"github.com/olympus-protocol/ogen/pkg/chainhash"
+)
+
+const (
+	// MaxContractStorageKeys is the maximum amount of keys on the storage of a contract, the size limit of the
+	// serialized storage.
+	MaxContractStorageKeys = 1048576
+	// MaxContracts is the maximum amount of deployed contracts, the size limit of the serialized contracts state.
+	MaxContracts = 1048576
+)
+
+// ContractStorageEntry is a key and its value on the storage of a contract.
+type ContractStorageEntry struct {
+	Key   [32]byte `ssz-size:"32"`
+	Value [32]byte `ssz-size:"32"`
+}
+
+// ContractSerializable is a deployed contract on a serializable struct.
+type ContractSerializable struct {
+	Address [20]byte                `ssz-size:"20"`
+	Code    []byte                  `ssz-max:"8192"`
+	Storage []*ContractStorageEntry `ssz-max:"1048576"`
+}
+
+// ContractsStateSerializable is a struct to properly serialize the contracts state.
+type ContractsStateSerializable struct {
+	Contracts []*ContractSerializable `ssz-max:"1048576"`
+}
+
+// Contract is the code and storage of a deployed contract. The contract balance is kept on the CoinsState.
+type Contract struct {
+	Code    []byte
+	Storage map[chainhash.Hash]chainhash.Hash
+}
+
+// CodeHash returns the hash of the contract code.
+func (c *Contract) CodeHash() chainhash.Hash {
+	return chainhash.HashH(c.Code)
+}
+
+// Copy copies the contract and returns a new one.
+func (c *Contract) Copy() *Contract {
+	c2 := &Contract{
+		Code:    c.Code,
+		Storage: make(map[chainhash.Hash]chainhash.Hash, len(c.Storage)),
+	}
+	for k, v := range c.Storage {
+		c2.Storage[k] = v
+	}
+	return c2
+}
+
+// ContractsState is the state that we use to store the deployed contracts.
+type ContractsState struct {
+	Contracts map[[20]byte]*Contract
+}
+
+// Copy copies ContractsState and returns a new one.
+func (cs *ContractsState) Copy() ContractsState {
+	cs2 := ContractsState{
+		Contracts: make(map[[20]byte]*Contract, len(cs.Contracts)),
+	}
+	for addr, c := range cs.Contracts {
+		cs2.Contracts[addr] = c.Copy()
+	}
+	return cs2
+}
+
+// ToSerializable converts the struct from maps to slices.
+func (cs *ContractsState) ToSerializable() ContractsStateSerializable {
+	var contracts []*ContractSerializable
+	for addr, c := range cs.Contracts {
+		var storage []*ContractStorageEntry
+		for k, v := range c.Storage {
+			storage = append(storage, &ContractStorageEntry{Key: k, Value: v})
+		}
+		contracts = append(contracts, &ContractSerializable{Address: addr, Code: c.Code, Storage: storage})
+	}
+	return ContractsStateSerializable{Contracts: contracts}
+}
+
+// FromSerializable creates a ContractsState
+func (cs *ContractsState) FromSerializable(ser *ContractsStateSerializable) {
+	cs.Contracts = map[[20]byte]*Contract{}
+	if ser == nil {
+		return
+	}
+	for _, c := range ser.Contracts {
+		contract := &Contract{
+			Code:    c.Code,
+			Storage: make(map[chainhash.Hash]chainhash.Hash, len(c.Storage)),
+		}
+		for _, e := range c.Storage {
+			contract.Storage[e.Key] = e.Value
+		}
+		cs.Contracts[c.Address] = contract
+	}
+}
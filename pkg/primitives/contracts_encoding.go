@@ -0,0 +1,336 @@
+// Code generated by fastssz. DO NOT EDIT.
+package primitives
+
+import (
+	ssz "github.com/ferranbt/fastssz"
+)
+
+// MarshalSSZ ssz marshals the ContractStorageEntry object
+func (c *ContractStorageEntry) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(c)
+}
+
+// MarshalSSZTo ssz marshals the ContractStorageEntry object to a target array
+func (c *ContractStorageEntry) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Key'
+	dst = append(dst, c.Key[:]...)
+
+	// Field (1) 'Value'
+	dst = append(dst, c.Value[:]...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the ContractStorageEntry object
+func (c *ContractStorageEntry) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 64 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'Key'
+	copy(c.Key[:], buf[0:32])
+
+	// Field (1) 'Value'
+	copy(c.Value[:], buf[32:64])
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the ContractStorageEntry object
+func (c *ContractStorageEntry) SizeSSZ() (size int) {
+	size = 64
+	return
+}
+
+// HashTreeRoot ssz hashes the ContractStorageEntry object
+func (c *ContractStorageEntry) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(c)
+}
+
+// HashTreeRootWith ssz hashes the ContractStorageEntry object with a hasher
+func (c *ContractStorageEntry) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Key'
+	hh.PutBytes(c.Key[:])
+
+	// Field (1) 'Value'
+	hh.PutBytes(c.Value[:])
+
+	hh.Merkleize(indx)
+	return
+}
+
+// MarshalSSZ ssz marshals the ContractSerializable object
+func (c *ContractSerializable) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(c)
+}
+
+// MarshalSSZTo ssz marshals the ContractSerializable object to a target array
+func (c *ContractSerializable) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(28)
+
+	// Field (0) 'Address'
+	dst = append(dst, c.Address[:]...)
+
+	// Offset (1) 'Code'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(c.Code)
+
+	// Offset (2) 'Storage'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(c.Storage) * 64
+
+	// Field (1) 'Code'
+	if len(c.Code) > 8192 {
+		err = ssz.ErrBytesLength
+		return
+	}
+	dst = append(dst, c.Code...)
+
+	// Field (2) 'Storage'
+	if len(c.Storage) > 1048576 {
+		err = ssz.ErrListTooBig
+		return
+	}
+	for ii := 0; ii < len(c.Storage); ii++ {
+		if dst, err = c.Storage[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the ContractSerializable object
+func (c *ContractSerializable) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 28 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o1, o2 uint64
+
+	// Field (0) 'Address'
+	copy(c.Address[:], buf[0:20])
+
+	// Offset (1) 'Code'
+	if o1 = ssz.ReadOffset(buf[20:24]); o1 > size {
+		return ssz.ErrOffset
+	}
+
+	// Offset (2) 'Storage'
+	if o2 = ssz.ReadOffset(buf[24:28]); o2 > size || o1 > o2 {
+		return ssz.ErrOffset
+	}
+
+	// Field (1) 'Code'
+	{
+		buf = tail[o1:o2]
+		if len(buf) > 8192 {
+			return ssz.ErrBytesLength
+		}
+		if cap(c.Code) == 0 {
+			c.Code = make([]byte, 0, len(buf))
+		}
+		c.Code = append(c.Code, buf...)
+	}
+
+	// Field (2) 'Storage'
+	{
+		buf = tail[o2:]
+		num, err := ssz.DivideInt2(len(buf), 64, 1048576)
+		if err != nil {
+			return err
+		}
+		c.Storage = make([]*ContractStorageEntry, num)
+		for ii := 0; ii < num; ii++ {
+			if c.Storage[ii] == nil {
+				c.Storage[ii] = new(ContractStorageEntry)
+			}
+			if err = c.Storage[ii].UnmarshalSSZ(buf[ii*64 : (ii+1)*64]); err != nil {
+				return err
+			}
+		}
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the ContractSerializable object
+func (c *ContractSerializable) SizeSSZ() (size int) {
+	size = 28
+
+	// Field (1) 'Code'
+	size += len(c.Code)
+
+	// Field (2) 'Storage'
+	size += len(c.Storage) * 64
+
+	return
+}
+
+// HashTreeRoot ssz hashes the ContractSerializable object
+func (c *ContractSerializable) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(c)
+}
+
+// HashTreeRootWith ssz hashes the ContractSerializable object with a hasher
+func (c *ContractSerializable) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Address'
+	hh.PutBytes(c.Address[:])
+
+	// Field (1) 'Code'
+	if len(c.Code) > 8192 {
+		err = ssz.ErrBytesLength
+		return
+	}
+	hh.PutBytes(c.Code)
+
+	// Field (2) 'Storage'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(c.Storage))
+		if num > 1048576 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for i := uint64(0); i < num; i++ {
+			if err = c.Storage[i].HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 1048576)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// MarshalSSZ ssz marshals the ContractsStateSerializable object
+func (c *ContractsStateSerializable) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(c)
+}
+
+// MarshalSSZTo ssz marshals the ContractsStateSerializable object to a target array
+func (c *ContractsStateSerializable) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(4)
+
+	// Offset (0) 'Contracts'
+	dst = ssz.WriteOffset(dst, offset)
+	for ii := 0; ii < len(c.Contracts); ii++ {
+		offset += 4
+		offset += c.Contracts[ii].SizeSSZ()
+	}
+
+	// Field (0) 'Contracts'
+	if len(c.Contracts) > 1048576 {
+		err = ssz.ErrListTooBig
+		return
+	}
+	{
+		offset = 4 * len(c.Contracts)
+		for ii := 0; ii < len(c.Contracts); ii++ {
+			dst = ssz.WriteOffset(dst, offset)
+			offset += c.Contracts[ii].SizeSSZ()
+		}
+	}
+	for ii := 0; ii < len(c.Contracts); ii++ {
+		if dst, err = c.Contracts[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the ContractsStateSerializable object
+func (c *ContractsStateSerializable) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 4 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o0 uint64
+
+	// Offset (0) 'Contracts'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
+	}
+
+	// Field (0) 'Contracts'
+	{
+		buf = tail[o0:]
+		num, err := ssz.DecodeDynamicLength(buf, 1048576)
+		if err != nil {
+			return err
+		}
+		c.Contracts = make([]*ContractSerializable, num)
+		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+			if c.Contracts[indx] == nil {
+				c.Contracts[indx] = new(ContractSerializable)
+			}
+			if err = c.Contracts[indx].UnmarshalSSZ(buf); err != nil {
+				return err
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the ContractsStateSerializable object
+func (c *ContractsStateSerializable) SizeSSZ() (size int) {
+	size = 4
+
+	// Field (0) 'Contracts'
+	for ii := 0; ii < len(c.Contracts); ii++ {
+		size += 4
+		size += c.Contracts[ii].SizeSSZ()
+	}
+
+	return
+}
+
+// HashTreeRoot ssz hashes the ContractsStateSerializable object
+func (c *ContractsStateSerializable) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(c)
+}
+
+// HashTreeRootWith ssz hashes the ContractsStateSerializable object with a hasher
+func (c *ContractsStateSerializable) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Contracts'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(c.Contracts))
+		if num > 1048576 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for i := uint64(0); i < num; i++ {
+			if err = c.Contracts[i].HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 1048576)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
@@ -0,0 +1,51 @@
+package primitives_test
+
+import (
+	"github.com/olympus-protocol/ogen/pkg/chainhash"
+	"github.com/olympus-protocol/ogen/pkg/primitives"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestContractsState_Serializable(t *testing.T) {
+	cs := primitives.ContractsState{
+		Contracts: map[[20]byte]*primitives.Contract{
+			{1}: {Code: []byte{0x60, 0x01, 0xf3}, Storage: map[chainhash.Hash]chainhash.Hash{{1}: {2}, {3}: {4}}},
+			{2}: {Code: []byte{0x00}, Storage: map[chainhash.Hash]chainhash.Hash{}},
+		},
+	}
+
+	ser := cs.ToSerializable()
+	assert.Equal(t, 2, len(ser.Contracts))
+
+	b, err := ser.MarshalSSZ()
+	assert.NoError(t, err)
+
+	desc := new(primitives.ContractsStateSerializable)
+	assert.NoError(t, desc.UnmarshalSSZ(b))
+
+	cs2 := new(primitives.ContractsState)
+	cs2.FromSerializable(desc)
+
+	assert.Equal(t, cs, *cs2)
+
+	cs3 := new(primitives.ContractsState)
+	cs3.FromSerializable(nil)
+	assert.NotNil(t, cs3.Contracts)
+}
+
+func TestContractsState_Copy(t *testing.T) {
+	cs := primitives.ContractsState{
+		Contracts: map[[20]byte]*primitives.Contract{
+			{1}: {Code: []byte{0x00}, Storage: map[chainhash.Hash]chainhash.Hash{{1}: {2}}},
+		},
+	}
+
+	cs2 := cs.Copy()
+	cs2.Contracts[[20]byte{1}].Storage[chainhash.Hash{1}] = chainhash.Hash{5}
+	cs2.Contracts[[20]byte{2}] = &primitives.Contract{}
+
+	assert.Equal(t, chainhash.Hash{2}, cs.Contracts[[20]byte{1}].Storage[chainhash.Hash{1}])
+	assert.Equal(t, 1, len(cs.Contracts))
+	assert.Equal(t, cs.Contracts[[20]byte{1}].CodeHash(), cs2.Contracts[[20]byte{1}].CodeHash())
+}
@@ -1,6 +1,8 @@
 package primitives
 
 import (
+	"errors"
+
 	"github.com/olympus-protocol/ogen/pkg/bls"
 	"github.com/olympus-protocol/ogen/pkg/chainhash"
 )
@@ -46,6 +48,46 @@ func (d *Deposit) Hash() chainhash.Hash {
 	return chainhash.HashH(b)
 }
 
+// VerifySig checks the signature of the deposit data by the depositing address and the proof of possession of the
+// validator key.
+func (d *Deposit) VerifySig() error {
+	if d.Data == nil {
+		return errors.New("deposit without data")
+	}
+
+	pub, err := d.GetPublicKey()
+	if err != nil {
+		return err
+	}
+	sig, err := d.GetSignature()
+	if err != nil {
+		return err
+	}
+	buf, err := d.Data.Marshal()
+	if err != nil {
+		return err
+	}
+	msg := chainhash.HashH(buf)
+	if !sig.Verify(pub, msg[:]) {
+		return errors.New("deposit signature is not valid")
+	}
+
+	dataPub, err := d.Data.GetPublicKey()
+	if err != nil {
+		return err
+	}
+	dataSig, err := d.Data.GetSignature()
+	if err != nil {
+		return err
+	}
+	pubHash := chainhash.HashH(d.Data.PublicKey[:])
+	if !dataSig.Verify(dataPub, pubHash[:]) {
+		return errors.New("proof-of-possession is not valid")
+	}
+
+	return nil
+}
+
 // MaxDepositDataSize is the maximum amount of bytes the deposit data can contain.
 const MaxDepositDataSize = 164
 
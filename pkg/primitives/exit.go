@@ -1,6 +1,8 @@
 package primitives
 
 import (
+	"errors"
+
 	"github.com/olympus-protocol/ogen/pkg/bls"
 	"github.com/olympus-protocol/ogen/pkg/chainhash"
 )
@@ -45,3 +47,20 @@ func (e *Exit) Hash() chainhash.Hash {
 	b, _ := e.Marshal()
 	return chainhash.HashH(b)
 }
+
+// VerifySig checks the signature of the validator public key by the withdraw key.
+func (e *Exit) VerifySig() error {
+	pub, err := e.GetWithdrawPubKey()
+	if err != nil {
+		return err
+	}
+	sig, err := e.GetSignature()
+	if err != nil {
+		return err
+	}
+	msg := chainhash.HashH(e.ValidatorPubkey[:])
+	if !sig.Verify(pub, msg[:]) {
+		return errors.New("exit signature is not valid")
+	}
+	return nil
+}
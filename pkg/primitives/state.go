@@ -88,6 +88,9 @@ type SerializableState struct {
 	VotingState        uint64
 
 	LastPaidSlot uint64
+
+	// Contracts keeps the code and storage of the deployed contracts.
+	Contracts *ContractsStateSerializable
 }
 
 func (s *SerializableState) Marshal() ([]byte, error) {
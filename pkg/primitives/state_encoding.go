@@ -13,7 +13,7 @@ func (s *SerializableState) MarshalSSZ() ([]byte, error) {
 // MarshalSSZTo ssz marshals the SerializableState object to a target array
 func (s *SerializableState) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = buf
-	offset := int(264)
+	offset := int(268)
 
 	// Offset (0) 'CoinsState'
 	dst = ssz.WriteOffset(dst, offset)
@@ -120,6 +120,13 @@ func (s *SerializableState) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	// Field (26) 'LastPaidSlot'
 	dst = ssz.MarshalUint64(dst, s.LastPaidSlot)
 
+	// Offset (27) 'Contracts'
+	dst = ssz.WriteOffset(dst, offset)
+	if s.Contracts == nil {
+		s.Contracts = new(ContractsStateSerializable)
+	}
+	offset += s.Contracts.SizeSSZ()
+
 	// Field (0) 'CoinsState'
 	if dst, err = s.CoinsState.MarshalSSZTo(dst); err != nil {
 		return
@@ -238,6 +245,11 @@ func (s *SerializableState) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 		return
 	}
 
+	// Field (27) 'Contracts'
+	if dst, err = s.Contracts.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
 	return
 }
 
@@ -245,12 +257,12 @@ func (s *SerializableState) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 func (s *SerializableState) UnmarshalSSZ(buf []byte) error {
 	var err error
 	size := uint64(len(buf))
-	if size < 264 {
+	if size < 268 {
 		return ssz.ErrSize
 	}
 
 	tail := buf
-	var o0, o1, o7, o8, o9, o10, o13, o16, o19, o20, o21, o22 uint64
+	var o0, o1, o7, o8, o9, o10, o13, o16, o19, o20, o21, o22, o27 uint64
 
 	// Offset (0) 'CoinsState'
 	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
@@ -357,6 +369,11 @@ func (s *SerializableState) UnmarshalSSZ(buf []byte) error {
 	// Field (26) 'LastPaidSlot'
 	s.LastPaidSlot = ssz.UnmarshallUint64(buf[256:264])
 
+	// Offset (27) 'Contracts'
+	if o27 = ssz.ReadOffset(buf[264:268]); o27 > size || o22 > o27 {
+		return ssz.ErrOffset
+	}
+
 	// Field (0) 'CoinsState'
 	{
 		buf = tail[o0:o1]
@@ -522,7 +539,7 @@ func (s *SerializableState) UnmarshalSSZ(buf []byte) error {
 
 	// Field (22) 'Governance'
 	{
-		buf = tail[o22:]
+		buf = tail[o22:o27]
 		if s.Governance == nil {
 			s.Governance = new(GovernanceSerializable)
 		}
@@ -530,12 +547,23 @@ func (s *SerializableState) UnmarshalSSZ(buf []byte) error {
 			return err
 		}
 	}
+
+	// Field (27) 'Contracts'
+	{
+		buf = tail[o27:]
+		if s.Contracts == nil {
+			s.Contracts = new(ContractsStateSerializable)
+		}
+		if err = s.Contracts.UnmarshalSSZ(buf); err != nil {
+			return err
+		}
+	}
 	return err
 }
 
 // SizeSSZ returns the ssz encoded size in bytes for the SerializableState object
 func (s *SerializableState) SizeSSZ() (size int) {
-	size = 264
+	size = 268
 
 	// Field (0) 'CoinsState'
 	if s.CoinsState == nil {
@@ -585,6 +613,12 @@ func (s *SerializableState) SizeSSZ() (size int) {
 	}
 	size += s.Governance.SizeSSZ()
 
+	// Field (27) 'Contracts'
+	if s.Contracts == nil {
+		s.Contracts = new(ContractsStateSerializable)
+	}
+	size += s.Contracts.SizeSSZ()
+
 	return
 }
 
@@ -795,6 +829,11 @@ func (s *SerializableState) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	// Field (26) 'LastPaidSlot'
 	hh.PutUint64(s.LastPaidSlot)
 
+	// Field (27) 'Contracts'
+	if err = s.Contracts.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
 	hh.Merkleize(indx)
 	return
 }
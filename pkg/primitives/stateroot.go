@@ -0,0 +1,89 @@
+package primitives
+
+import (
+	"encoding/binary"
+
+	"github.com/olympus-protocol/ogen/pkg/chainhash"
+)
+
+const (
+	// StateKeyBalance is the prefix of the account balance keys on the state tree.
+	StateKeyBalance = "balance"
+
+	// StateKeyNonce is the prefix of the account nonce keys on the state tree.
+	StateKeyNonce = "nonce"
+
+	// StateKeyValidator is the prefix of the validator registry keys on the state tree.
+	StateKeyValidator = "validator"
+
+	// StateKeyContractCode is the prefix of the contract code hash keys on the state tree.
+	StateKeyContractCode = "code"
+
+	// StateKeyContractStorage is the prefix of the contract storage keys on the state tree.
+	StateKeyContractStorage = "storage"
+
+	// StateKeyAsset is the prefix of the asset issuer and supply keys on the state tree.
+	StateKeyAsset = "asset"
+
+	// StateKeyAssetBalance is the prefix of the account asset balance keys on the state tree.
+	StateKeyAssetBalance = "assetbalance"
+)
+
+// BalanceKey returns the state tree key for the balance of an account.
+func BalanceKey(account [20]byte) chainhash.Hash {
+	return chainhash.HashH(append([]byte(StateKeyBalance), account[:]...))
+}
+
+// NonceKey returns the state tree key for the nonce of an account.
+func NonceKey(account [20]byte) chainhash.Hash {
+	return chainhash.HashH(append([]byte(StateKeyNonce), account[:]...))
+}
+
+// ValidatorKey returns the state tree key for a validator of the registry.
+func ValidatorKey(index uint64) chainhash.Hash {
+	var b [8]byte
+	binary.LittleEndian.PutUint64(b[:], index)
+	return chainhash.HashH(append([]byte(StateKeyValidator), b[:]...))
+}
+
+// ContractCodeKey returns the state tree key for the code hash of a contract.
+func ContractCodeKey(contract [20]byte) chainhash.Hash {
+	return chainhash.HashH(append([]byte(StateKeyContractCode), contract[:]...))
+}
+
+// ContractStorageKey returns the state tree key for a key of a contract storage.
+func ContractStorageKey(contract [20]byte, key chainhash.Hash) chainhash.Hash {
+	b := append([]byte(StateKeyContractStorage), contract[:]...)
+	return chainhash.HashH(append(b, key[:]...))
+}
+
+// AssetKey returns the state tree key for the issuer and supply of an asset.
+func AssetKey(asset [20]byte) chainhash.Hash {
+	return chainhash.HashH(append([]byte(StateKeyAsset), asset[:]...))
+}
+
+// AssetBalanceKey returns the state tree key for the balance of an account for an asset.
+func AssetBalanceKey(asset [20]byte, account [20]byte) chainhash.Hash {
+	b := append([]byte(StateKeyAssetBalance), asset[:]...)
+	return chainhash.HashH(append(b, account[:]...))
+}
+
+// AssetValue encodes the issuer and supply of an asset as a state tree value.
+func AssetValue(a *Asset) chainhash.Hash {
+	var b [28]byte
+	copy(b[:], a.Issuer[:])
+	binary.LittleEndian.PutUint64(b[20:], a.Supply)
+	return chainhash.HashH(b[:])
+}
+
+// Uint64Value encodes a number as a state tree value.
+func Uint64Value(n uint64) chainhash.Hash {
+	var h chainhash.Hash
+	binary.LittleEndian.PutUint64(h[:], n)
+	return h
+}
+
+// ValueUint64 decodes a number from a state tree value.
+func ValueUint64(h chainhash.Hash) uint64 {
+	return binary.LittleEndian.Uint64(h[:])
+}
@@ -0,0 +1,21 @@
+package primitives_test
+
+import (
+	"testing"
+
+	"github.com/olympus-protocol/ogen/pkg/primitives"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStateKeys(t *testing.T) {
+	acc := [20]byte{1, 2, 3}
+
+	assert.NotEqual(t, primitives.BalanceKey(acc), primitives.NonceKey(acc))
+	assert.NotEqual(t, primitives.BalanceKey(acc), primitives.BalanceKey([20]byte{1, 2, 4}))
+	assert.NotEqual(t, primitives.ValidatorKey(0), primitives.ValidatorKey(1))
+}
+
+func TestUint64Value(t *testing.T) {
+	assert.Equal(t, uint64(0), primitives.ValueUint64(primitives.Uint64Value(0)))
+	assert.Equal(t, uint64(123456789), primitives.ValueUint64(primitives.Uint64Value(123456789)))
+}
@@ -1,6 +1,8 @@
 package primitives
 
 import (
+	"errors"
+
 	"github.com/olympus-protocol/ogen/pkg/chainhash"
 )
 
@@ -24,6 +26,59 @@ func init() {
 	EmptyTree = EmptyTrees[255]
 }
 
+// isRight checks if the key is in the left or right subtree at a certain level. Level 255 is the root level.
+func isRight(key chainhash.Hash, level uint8) bool {
+	return key[level/8]&(1<<uint(level%8)) != 0
+}
+
+// CalculateSubtreeHashWithOneLeaf calculates the hash of a subtree with only a single leaf at a certain height.
+// atLevel is the height to calculate at.
+func CalculateSubtreeHashWithOneLeaf(key *chainhash.Hash, value *chainhash.Hash, atLevel uint8) chainhash.Hash {
+	h := *value
+
+	for i := uint8(0); i < atLevel; i++ {
+		right := isRight(*key, i+1)
+
+		// the key is in the right subtree
+		if right {
+			h = CombineHashes(&EmptyTrees[i], &h)
+		} else {
+			h = CombineHashes(&h, &EmptyTrees[i])
+		}
+	}
+
+	return h
+}
+
+// CalculateRoot calculates the root of the tree with the given witness information.
+func CalculateRoot(key chainhash.Hash, value chainhash.Hash, witnessBitfield chainhash.Hash, witnesses []chainhash.Hash, lastLevel uint8) (*chainhash.Hash, error) {
+	hk := chainhash.HashH(key[:])
+	h := CalculateSubtreeHashWithOneLeaf(&hk, &value, lastLevel)
+
+	currentWitness := 0
+
+	for i := uint16(lastLevel) + 1; i <= 255; i++ {
+		right := isRight(hk, uint8(i))
+
+		hashToAdd := EmptyTrees[i-1]
+		if witnessBitfield[i/8]&(1<<uint8(i%8)) != 0 {
+			if currentWitness >= len(witnesses) {
+				return nil, errors.New("not enough witnesses")
+			}
+			hashToAdd = witnesses[currentWitness]
+			currentWitness++
+		}
+
+		if right {
+			h = CombineHashes(&hashToAdd, &h)
+		} else {
+			h = CombineHashes(&h, &hashToAdd)
+		}
+	}
+
+	return &h, nil
+}
+
 // UpdateWitness allows an executor to securely update the tree root so that only a single key is changed.
 type UpdateWitness struct {
 	Key             chainhash.Hash
@@ -55,6 +110,15 @@ type VerificationWitness struct {
 	LastLevel       uint8
 }
 
+// Check ensures the witness proves its key and value against the state root.
+func (vw *VerificationWitness) Check(stateRoot chainhash.Hash) bool {
+	root, err := CalculateRoot(vw.Key, vw.Value, vw.WitnessBitfield, vw.Witnesses, vw.LastLevel)
+	if err != nil {
+		return false
+	}
+	return root.IsEqual(&stateRoot)
+}
+
 // Copy returns a copy of the update witness.
 func (vw *VerificationWitness) Copy() VerificationWitness {
 	newVw := *vw
@@ -0,0 +1,108 @@
+package primitives
+
+import (
+	"encoding/binary"
+
+	"github.com/olympus-protocol/ogen/pkg/bls"
+	"github.com/olympus-protocol/ogen/pkg/chainhash"
+)
+
+const (
+	// AssetIssue creates a new asset or mints more units of an asset issued by the sender.
+	AssetIssue uint64 = iota
+	// AssetTransfer sends asset units from the sender to another account.
+	AssetTransfer
+	// AssetBurn destroys asset units of the sender and reduces the asset supply.
+	AssetBurn
+)
+
+const (
+	// MaxAssetTxSize is the maximum size of an asset transaction.
+	MaxAssetTxSize = 216
+)
+
+// AssetID calculates the identifier of an asset created by an account with the issue transaction nonce.
+func AssetID(issuer [20]byte, nonce uint64) [20]byte {
+	buf := make([]byte, 0, 33)
+	buf = append(buf, "asset"...)
+	buf = append(buf, issuer[:]...)
+	var n [8]byte
+	binary.LittleEndian.PutUint64(n[:], nonce)
+	buf = append(buf, n[:]...)
+	h := chainhash.HashH(buf)
+
+	var id [20]byte
+	copy(id[:], h[:20])
+	return id
+}
+
+// AssetTx is a transaction that issues, transfers or burns units of an asset. The fee and the nonce are shared with
+// the native coin transactions of the sender.
+type AssetTx struct {
+	Type          uint64
+	Asset         [20]byte
+	To            [20]byte
+	FromPublicKey [48]byte
+	Amount        uint64
+	Nonce         uint64
+	Fee           uint64
+	Signature     [96]byte
+}
+
+// Marshal encodes the data.
+func (t *AssetTx) Marshal() ([]byte, error) {
+	return t.MarshalSSZ()
+}
+
+// Unmarshal decodes the data.
+func (t *AssetTx) Unmarshal(b []byte) error {
+	return t.UnmarshalSSZ(b)
+}
+
+// Hash calculates the transaction hash.
+func (t *AssetTx) Hash() chainhash.Hash {
+	b, _ := t.Marshal()
+	return chainhash.DoubleHashH(b)
+}
+
+// Valid returns true if the transaction type is known.
+func (t *AssetTx) Valid() bool {
+	return t.Type <= AssetBurn
+}
+
+// FromPubkeyHash calculates the hash of the from public key.
+func (t *AssetTx) FromPubkeyHash() ([20]byte, error) {
+	pub, err := bls.PublicKeyFromBytes(t.FromPublicKey[:])
+	if err != nil {
+		return [20]byte{}, err
+	}
+	return pub.Hash()
+}
+
+// SignatureMessage gets the message the needs to be signed.
+func (t AssetTx) SignatureMessage() chainhash.Hash {
+	cp := t
+	cp.Signature = [96]byte{}
+	b, _ := cp.Marshal()
+	return chainhash.HashH(b)
+}
+
+// VerifySig verifies the signatures is valid.
+func (t *AssetTx) VerifySig() error {
+	sigMsg := t.SignatureMessage()
+
+	sig, err := bls.SignatureFromBytes(t.Signature[:])
+	if err != nil {
+		return err
+	}
+
+	pub, err := bls.PublicKeyFromBytes(t.FromPublicKey[:])
+	if err != nil {
+		return err
+	}
+
+	if !sig.Verify(pub, sigMsg[:]) {
+		return ErrorInvalidSignature
+	}
+	return nil
+}
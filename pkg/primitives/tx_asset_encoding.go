@@ -0,0 +1,120 @@
+// Code generated by fastssz. DO NOT EDIT.
+package primitives
+
+import (
+	ssz "github.com/ferranbt/fastssz"
+)
+
+// MarshalSSZ ssz marshals the AssetTx object
+func (a *AssetTx) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(a)
+}
+
+// MarshalSSZTo ssz marshals the AssetTx object to a target array
+func (a *AssetTx) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Type'
+	dst = ssz.MarshalUint64(dst, a.Type)
+
+	// Field (1) 'Asset'
+	dst = append(dst, a.Asset[:]...)
+
+	// Field (2) 'To'
+	dst = append(dst, a.To[:]...)
+
+	// Field (3) 'FromPublicKey'
+	dst = append(dst, a.FromPublicKey[:]...)
+
+	// Field (4) 'Amount'
+	dst = ssz.MarshalUint64(dst, a.Amount)
+
+	// Field (5) 'Nonce'
+	dst = ssz.MarshalUint64(dst, a.Nonce)
+
+	// Field (6) 'Fee'
+	dst = ssz.MarshalUint64(dst, a.Fee)
+
+	// Field (7) 'Signature'
+	dst = append(dst, a.Signature[:]...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the AssetTx object
+func (a *AssetTx) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 216 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'Type'
+	a.Type = ssz.UnmarshallUint64(buf[0:8])
+
+	// Field (1) 'Asset'
+	copy(a.Asset[:], buf[8:28])
+
+	// Field (2) 'To'
+	copy(a.To[:], buf[28:48])
+
+	// Field (3) 'FromPublicKey'
+	copy(a.FromPublicKey[:], buf[48:96])
+
+	// Field (4) 'Amount'
+	a.Amount = ssz.UnmarshallUint64(buf[96:104])
+
+	// Field (5) 'Nonce'
+	a.Nonce = ssz.UnmarshallUint64(buf[104:112])
+
+	// Field (6) 'Fee'
+	a.Fee = ssz.UnmarshallUint64(buf[112:120])
+
+	// Field (7) 'Signature'
+	copy(a.Signature[:], buf[120:216])
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the AssetTx object
+func (a *AssetTx) SizeSSZ() (size int) {
+	size = 216
+	return
+}
+
+// HashTreeRoot ssz hashes the AssetTx object
+func (a *AssetTx) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(a)
+}
+
+// HashTreeRootWith ssz hashes the AssetTx object with a hasher
+func (a *AssetTx) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Type'
+	hh.PutUint64(a.Type)
+
+	// Field (1) 'Asset'
+	hh.PutBytes(a.Asset[:])
+
+	// Field (2) 'To'
+	hh.PutBytes(a.To[:])
+
+	// Field (3) 'FromPublicKey'
+	hh.PutBytes(a.FromPublicKey[:])
+
+	// Field (4) 'Amount'
+	hh.PutUint64(a.Amount)
+
+	// Field (5) 'Nonce'
+	hh.PutUint64(a.Nonce)
+
+	// Field (6) 'Fee'
+	hh.PutUint64(a.Fee)
+
+	// Field (7) 'Signature'
+	hh.PutBytes(a.Signature[:])
+
+	hh.Merkleize(indx)
+	return
+}
@@ -0,0 +1,188 @@
+package primitives
+
+import (
+	"encoding/binary"
+	"errors"
+	"math/bits"
+
+	"github.com/olympus-protocol/ogen/pkg/bls"
+	"github.com/olympus-protocol/ogen/pkg/chainhash"
+)
+
+var (
+	// ErrorContractCostOverflow returned when the amount plus the maximum gas fee of a contract transaction overflows.
+	ErrorContractCostOverflow = errors.New("contract transaction cost overflows")
+)
+
+const (
+	// MaxContractCodeSize is the maximum size of the code of a contract.
+	MaxContractCodeSize = 8192
+
+	// MaxContractInputSize is the maximum size of the input of a contract deploy or call.
+	MaxContractInputSize = 1024
+
+	// MaxContractDeploySize is the maximum size of a contract deploy transaction.
+	MaxContractDeploySize = 48 + 4 + MaxContractCodeSize + 4 + MaxContractInputSize + 8 + 8 + 8 + 8 + 96
+
+	// MaxContractCallSize is the maximum size of a contract call transaction.
+	MaxContractCallSize = 20 + 48 + 4 + MaxContractInputSize + 8 + 8 + 8 + 8 + 96
+)
+
+// ContractAddress calculates the address of a contract deployed by an account with the deploy transaction nonce.
+func ContractAddress(deployer [20]byte, nonce uint64) [20]byte {
+	buf := make([]byte, 28)
+	copy(buf, deployer[:])
+	binary.LittleEndian.PutUint64(buf[20:], nonce)
+	h := chainhash.HashH(buf)
+
+	var addr [20]byte
+	copy(addr[:], h[:20])
+	return addr
+}
+
+func contractCost(amount, gasLimit, gasPrice uint64) (uint64, error) {
+	hi, fee := bits.Mul64(gasLimit, gasPrice)
+	if hi != 0 {
+		return 0, ErrorContractCostOverflow
+	}
+	cost, carry := bits.Add64(fee, amount, 0)
+	if carry != 0 {
+		return 0, ErrorContractCostOverflow
+	}
+	return cost, nil
+}
+
+// ContractDeploy is a transaction that deploys a contract. The code is executed once with the input after being stored,
+// the deploy is reverted if that execution fails.
+type ContractDeploy struct {
+	FromPublicKey [48]byte
+	Code          []byte `ssz-max:"8192"`
+	Input         []byte `ssz-max:"1024"`
+	Amount        uint64
+	Nonce         uint64
+	GasLimit      uint64
+	GasPrice      uint64
+	Signature     [96]byte
+}
+
+// Marshal encodes the data.
+func (c *ContractDeploy) Marshal() ([]byte, error) {
+	return c.MarshalSSZ()
+}
+
+// Unmarshal decodes the data.
+func (c *ContractDeploy) Unmarshal(b []byte) error {
+	return c.UnmarshalSSZ(b)
+}
+
+// Hash calculates the transaction hash.
+func (c *ContractDeploy) Hash() chainhash.Hash {
+	b, _ := c.Marshal()
+	return chainhash.DoubleHashH(b)
+}
+
+// FromPubkeyHash calculates the hash of the from public key.
+func (c *ContractDeploy) FromPubkeyHash() ([20]byte, error) {
+	pub, err := bls.PublicKeyFromBytes(c.FromPublicKey[:])
+	if err != nil {
+		return [20]byte{}, err
+	}
+	return pub.Hash()
+}
+
+// ContractAddress returns the address of the deployed contract.
+func (c *ContractDeploy) ContractAddress() ([20]byte, error) {
+	pkh, err := c.FromPubkeyHash()
+	if err != nil {
+		return [20]byte{}, err
+	}
+	return ContractAddress(pkh, c.Nonce), nil
+}
+
+// MaxCost returns the amount plus the fee paid if the whole gas limit is used.
+func (c *ContractDeploy) MaxCost() (uint64, error) {
+	return contractCost(c.Amount, c.GasLimit, c.GasPrice)
+}
+
+// SignatureMessage gets the message the needs to be signed.
+func (c ContractDeploy) SignatureMessage() chainhash.Hash {
+	cp := c
+	cp.Signature = [96]byte{}
+	b, _ := cp.Marshal()
+	return chainhash.HashH(b)
+}
+
+// VerifySig verifies the signatures is valid.
+func (c *ContractDeploy) VerifySig() error {
+	return verifyContractSig(c.FromPublicKey, c.Signature, c.SignatureMessage())
+}
+
+// ContractCall is a transaction that executes the code of a deployed contract with an input.
+type ContractCall struct {
+	To            [20]byte
+	FromPublicKey [48]byte
+	Input         []byte `ssz-max:"1024"`
+	Amount        uint64
+	Nonce         uint64
+	GasLimit      uint64
+	GasPrice      uint64
+	Signature     [96]byte
+}
+
+// Marshal encodes the data.
+func (c *ContractCall) Marshal() ([]byte, error) {
+	return c.MarshalSSZ()
+}
+
+// Unmarshal decodes the data.
+func (c *ContractCall) Unmarshal(b []byte) error {
+	return c.UnmarshalSSZ(b)
+}
+
+// Hash calculates the transaction hash.
+func (c *ContractCall) Hash() chainhash.Hash {
+	b, _ := c.Marshal()
+	return chainhash.DoubleHashH(b)
+}
+
+// FromPubkeyHash calculates the hash of the from public key.
+func (c *ContractCall) FromPubkeyHash() ([20]byte, error) {
+	pub, err := bls.PublicKeyFromBytes(c.FromPublicKey[:])
+	if err != nil {
+		return [20]byte{}, err
+	}
+	return pub.Hash()
+}
+
+// MaxCost returns the amount plus the fee paid if the whole gas limit is used.
+func (c *ContractCall) MaxCost() (uint64, error) {
+	return contractCost(c.Amount, c.GasLimit, c.GasPrice)
+}
+
+// SignatureMessage gets the message the needs to be signed.
+func (c ContractCall) SignatureMessage() chainhash.Hash {
+	cp := c
+	cp.Signature = [96]byte{}
+	b, _ := cp.Marshal()
+	return chainhash.HashH(b)
+}
+
+// VerifySig verifies the signatures is valid.
+func (c *ContractCall) VerifySig() error {
+	return verifyContractSig(c.FromPublicKey, c.Signature, c.SignatureMessage())
+}
+
+func verifyContractSig(pubBytes [48]byte, sigBytes [96]byte, msg chainhash.Hash) error {
+	sig, err := bls.SignatureFromBytes(sigBytes[:])
+	if err != nil {
+		return err
+	}
+	pub, err := bls.PublicKeyFromBytes(pubBytes[:])
+	if err != nil {
+		return err
+	}
+	if !sig.Verify(pub, msg[:]) {
+		return ErrorInvalidSignature
+	}
+	return nil
+}
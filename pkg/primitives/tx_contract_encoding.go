@@ -0,0 +1,329 @@
+// Code generated by fastssz. DO NOT EDIT.
+package primitives
+
+import (
+	ssz "github.com/ferranbt/fastssz"
+)
+
+// MarshalSSZ ssz marshals the ContractDeploy object
+func (c *ContractDeploy) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(c)
+}
+
+// MarshalSSZTo ssz marshals the ContractDeploy object to a target array
+func (c *ContractDeploy) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(184)
+
+	// Field (0) 'FromPublicKey'
+	dst = append(dst, c.FromPublicKey[:]...)
+
+	// Offset (1) 'Code'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(c.Code)
+
+	// Offset (2) 'Input'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(c.Input)
+
+	// Field (3) 'Amount'
+	dst = ssz.MarshalUint64(dst, c.Amount)
+
+	// Field (4) 'Nonce'
+	dst = ssz.MarshalUint64(dst, c.Nonce)
+
+	// Field (5) 'GasLimit'
+	dst = ssz.MarshalUint64(dst, c.GasLimit)
+
+	// Field (6) 'GasPrice'
+	dst = ssz.MarshalUint64(dst, c.GasPrice)
+
+	// Field (7) 'Signature'
+	dst = append(dst, c.Signature[:]...)
+
+	// Field (1) 'Code'
+	if len(c.Code) > 8192 {
+		err = ssz.ErrBytesLength
+		return
+	}
+	dst = append(dst, c.Code...)
+
+	// Field (2) 'Input'
+	if len(c.Input) > 1024 {
+		err = ssz.ErrBytesLength
+		return
+	}
+	dst = append(dst, c.Input...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the ContractDeploy object
+func (c *ContractDeploy) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 184 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o1, o2 uint64
+
+	// Field (0) 'FromPublicKey'
+	copy(c.FromPublicKey[:], buf[0:48])
+
+	// Offset (1) 'Code'
+	if o1 = ssz.ReadOffset(buf[48:52]); o1 > size {
+		return ssz.ErrOffset
+	}
+
+	// Offset (2) 'Input'
+	if o2 = ssz.ReadOffset(buf[52:56]); o2 > size || o1 > o2 {
+		return ssz.ErrOffset
+	}
+
+	// Field (3) 'Amount'
+	c.Amount = ssz.UnmarshallUint64(buf[56:64])
+
+	// Field (4) 'Nonce'
+	c.Nonce = ssz.UnmarshallUint64(buf[64:72])
+
+	// Field (5) 'GasLimit'
+	c.GasLimit = ssz.UnmarshallUint64(buf[72:80])
+
+	// Field (6) 'GasPrice'
+	c.GasPrice = ssz.UnmarshallUint64(buf[80:88])
+
+	// Field (7) 'Signature'
+	copy(c.Signature[:], buf[88:184])
+
+	// Field (1) 'Code'
+	{
+		buf = tail[o1:o2]
+		if len(buf) > 8192 {
+			return ssz.ErrBytesLength
+		}
+		if cap(c.Code) == 0 {
+			c.Code = make([]byte, 0, len(buf))
+		}
+		c.Code = append(c.Code, buf...)
+	}
+
+	// Field (2) 'Input'
+	{
+		buf = tail[o2:]
+		if len(buf) > 1024 {
+			return ssz.ErrBytesLength
+		}
+		if cap(c.Input) == 0 {
+			c.Input = make([]byte, 0, len(buf))
+		}
+		c.Input = append(c.Input, buf...)
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the ContractDeploy object
+func (c *ContractDeploy) SizeSSZ() (size int) {
+	size = 184
+
+	// Field (1) 'Code'
+	size += len(c.Code)
+
+	// Field (2) 'Input'
+	size += len(c.Input)
+
+	return
+}
+
+// HashTreeRoot ssz hashes the ContractDeploy object
+func (c *ContractDeploy) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(c)
+}
+
+// HashTreeRootWith ssz hashes the ContractDeploy object with a hasher
+func (c *ContractDeploy) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'FromPublicKey'
+	hh.PutBytes(c.FromPublicKey[:])
+
+	// Field (1) 'Code'
+	if len(c.Code) > 8192 {
+		err = ssz.ErrBytesLength
+		return
+	}
+	hh.PutBytes(c.Code)
+
+	// Field (2) 'Input'
+	if len(c.Input) > 1024 {
+		err = ssz.ErrBytesLength
+		return
+	}
+	hh.PutBytes(c.Input)
+
+	// Field (3) 'Amount'
+	hh.PutUint64(c.Amount)
+
+	// Field (4) 'Nonce'
+	hh.PutUint64(c.Nonce)
+
+	// Field (5) 'GasLimit'
+	hh.PutUint64(c.GasLimit)
+
+	// Field (6) 'GasPrice'
+	hh.PutUint64(c.GasPrice)
+
+	// Field (7) 'Signature'
+	hh.PutBytes(c.Signature[:])
+
+	hh.Merkleize(indx)
+	return
+}
+
+// MarshalSSZ ssz marshals the ContractCall object
+func (c *ContractCall) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(c)
+}
+
+// MarshalSSZTo ssz marshals the ContractCall object to a target array
+func (c *ContractCall) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(200)
+
+	// Field (0) 'To'
+	dst = append(dst, c.To[:]...)
+
+	// Field (1) 'FromPublicKey'
+	dst = append(dst, c.FromPublicKey[:]...)
+
+	// Offset (2) 'Input'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(c.Input)
+
+	// Field (3) 'Amount'
+	dst = ssz.MarshalUint64(dst, c.Amount)
+
+	// Field (4) 'Nonce'
+	dst = ssz.MarshalUint64(dst, c.Nonce)
+
+	// Field (5) 'GasLimit'
+	dst = ssz.MarshalUint64(dst, c.GasLimit)
+
+	// Field (6) 'GasPrice'
+	dst = ssz.MarshalUint64(dst, c.GasPrice)
+
+	// Field (7) 'Signature'
+	dst = append(dst, c.Signature[:]...)
+
+	// Field (2) 'Input'
+	if len(c.Input) > 1024 {
+		err = ssz.ErrBytesLength
+		return
+	}
+	dst = append(dst, c.Input...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the ContractCall object
+func (c *ContractCall) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 200 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o2 uint64
+
+	// Field (0) 'To'
+	copy(c.To[:], buf[0:20])
+
+	// Field (1) 'FromPublicKey'
+	copy(c.FromPublicKey[:], buf[20:68])
+
+	// Offset (2) 'Input'
+	if o2 = ssz.ReadOffset(buf[68:72]); o2 > size {
+		return ssz.ErrOffset
+	}
+
+	// Field (3) 'Amount'
+	c.Amount = ssz.UnmarshallUint64(buf[72:80])
+
+	// Field (4) 'Nonce'
+	c.Nonce = ssz.UnmarshallUint64(buf[80:88])
+
+	// Field (5) 'GasLimit'
+	c.GasLimit = ssz.UnmarshallUint64(buf[88:96])
+
+	// Field (6) 'GasPrice'
+	c.GasPrice = ssz.UnmarshallUint64(buf[96:104])
+
+	// Field (7) 'Signature'
+	copy(c.Signature[:], buf[104:200])
+
+	// Field (2) 'Input'
+	{
+		buf = tail[o2:]
+		if len(buf) > 1024 {
+			return ssz.ErrBytesLength
+		}
+		if cap(c.Input) == 0 {
+			c.Input = make([]byte, 0, len(buf))
+		}
+		c.Input = append(c.Input, buf...)
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the ContractCall object
+func (c *ContractCall) SizeSSZ() (size int) {
+	size = 200
+
+	// Field (2) 'Input'
+	size += len(c.Input)
+
+	return
+}
+
+// HashTreeRoot ssz hashes the ContractCall object
+func (c *ContractCall) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(c)
+}
+
+// HashTreeRootWith ssz hashes the ContractCall object with a hasher
+func (c *ContractCall) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'To'
+	hh.PutBytes(c.To[:])
+
+	// Field (1) 'FromPublicKey'
+	hh.PutBytes(c.FromPublicKey[:])
+
+	// Field (2) 'Input'
+	if len(c.Input) > 1024 {
+		err = ssz.ErrBytesLength
+		return
+	}
+	hh.PutBytes(c.Input)
+
+	// Field (3) 'Amount'
+	hh.PutUint64(c.Amount)
+
+	// Field (4) 'Nonce'
+	hh.PutUint64(c.Nonce)
+
+	// Field (5) 'GasLimit'
+	hh.PutUint64(c.GasLimit)
+
+	// Field (6) 'GasPrice'
+	hh.PutUint64(c.GasPrice)
+
+	// Field (7) 'Signature'
+	hh.PutBytes(c.Signature[:])
+
+	hh.Merkleize(indx)
+	return
+}
@@ -87,3 +87,77 @@ func TestTxMulti(t *testing.T) {
 
 	assert.Equal(t, hashExp, hash)
 }
+
+func TestContractDeploy(t *testing.T) {
+	v := testdata.FuzzContractDeploy(10)
+	for _, c := range v {
+		ser, err := c.Marshal()
+		assert.NoError(t, err)
+
+		desc := new(primitives.ContractDeploy)
+		err = desc.Unmarshal(ser)
+		assert.NoError(t, err)
+
+		assert.Equal(t, c, desc)
+
+		assert.NoError(t, c.VerifySig())
+
+		from, err := c.FromPubkeyHash()
+		assert.NoError(t, err)
+
+		addr, err := c.ContractAddress()
+		assert.NoError(t, err)
+		assert.Equal(t, primitives.ContractAddress(from, c.Nonce), addr)
+	}
+
+	tx := primitives.ContractDeploy{Amount: 10, GasLimit: 100, GasPrice: 2}
+	cost, err := tx.MaxCost()
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(210), cost)
+
+	tx.GasPrice = ^uint64(0)
+	_, err = tx.MaxCost()
+	assert.Equal(t, primitives.ErrorContractCostOverflow, err)
+}
+
+func TestContractCall(t *testing.T) {
+	v := testdata.FuzzContractCall(10)
+	for _, c := range v {
+		ser, err := c.Marshal()
+		assert.NoError(t, err)
+
+		desc := new(primitives.ContractCall)
+		err = desc.Unmarshal(ser)
+		assert.NoError(t, err)
+
+		assert.Equal(t, c, desc)
+
+		assert.NoError(t, c.VerifySig())
+
+		c.Amount++
+		assert.Error(t, c.VerifySig())
+	}
+}
+
+func TestAssetTx(t *testing.T) {
+	v := testdata.FuzzAssetTx(10)
+	for _, c := range v {
+		ser, err := c.Marshal()
+		assert.NoError(t, err)
+
+		desc := new(primitives.AssetTx)
+		err = desc.Unmarshal(ser)
+		assert.NoError(t, err)
+
+		assert.Equal(t, c, desc)
+
+		assert.True(t, c.Valid())
+		assert.NoError(t, c.VerifySig())
+
+		c.Amount++
+		assert.Error(t, c.VerifySig())
+	}
+
+	var issuer [20]byte
+	assert.NotEqual(t, primitives.AssetID(issuer, 1), primitives.AssetID(issuer, 2))
+}
@@ -0,0 +1,84 @@
+package primitives
+
+import (
+	"encoding/binary"
+
+	"github.com/olympus-protocol/ogen/pkg/bls"
+	"github.com/olympus-protocol/ogen/pkg/chainhash"
+)
+
+// AggregatorSelectionMessage returns the message a validator signs to prove it was selected to aggregate the votes
+// of a slot.
+func AggregatorSelectionMessage(slot uint64) chainhash.Hash {
+	buf := make([]byte, 0, 18)
+	buf = append(buf, "aggregator"...)
+	var s [8]byte
+	binary.LittleEndian.PutUint64(s[:], slot)
+	buf = append(buf, s[:]...)
+	return chainhash.HashH(buf)
+}
+
+// IsAggregator returns true if the selection proof selects a validator as aggregator of a committee. On average,
+// target validators of each committee are selected.
+func IsAggregator(committeeSize uint64, target uint64, selectionProof [96]byte) bool {
+	modulo := uint64(1)
+	if target > 0 && committeeSize/target > 1 {
+		modulo = committeeSize / target
+	}
+	h := chainhash.HashH(selectionProof[:])
+	return binary.LittleEndian.Uint64(h[:8])%modulo == 0
+}
+
+// AggregateVote is a vote aggregated by a validator selected to collect the individual votes of a slot.
+type AggregateVote struct {
+	// Vote is the aggregated vote.
+	Vote *MultiValidatorVote
+	// AggregatorIndex is the registry index of the aggregator.
+	AggregatorIndex uint64
+	// SelectionProof is the aggregator signature of the slot selection message.
+	SelectionProof [96]byte
+	// Signature is the aggregator signature of the aggregate.
+	Signature [96]byte
+}
+
+// Marshal encodes the data.
+func (a *AggregateVote) Marshal() ([]byte, error) {
+	return a.MarshalSSZ()
+}
+
+// Unmarshal decodes the data.
+func (a *AggregateVote) Unmarshal(b []byte) error {
+	return a.UnmarshalSSZ(b)
+}
+
+// SignatureMessage returns the message the aggregator signs.
+func (a *AggregateVote) SignatureMessage() chainhash.Hash {
+	cp := *a
+	cp.Signature = [96]byte{}
+	b, _ := cp.Marshal()
+	return chainhash.HashH(b)
+}
+
+// Hash calculates the hash of the aggregate.
+func (a *AggregateVote) Hash() chainhash.Hash {
+	b, _ := a.Marshal()
+	return chainhash.HashH(b)
+}
+
+// VerifySelection checks the selection proof and the aggregator signature with the aggregator public key.
+func (a *AggregateVote) VerifySelection(pub *bls.PublicKey) bool {
+	proof, err := bls.SignatureFromBytes(a.SelectionProof[:])
+	if err != nil {
+		return false
+	}
+	selectionMsg := AggregatorSelectionMessage(a.Vote.Data.Slot)
+	if !proof.Verify(pub, selectionMsg[:]) {
+		return false
+	}
+	sig, err := bls.SignatureFromBytes(a.Signature[:])
+	if err != nil {
+		return false
+	}
+	msg := a.SignatureMessage()
+	return sig.Verify(pub, msg[:])
+}
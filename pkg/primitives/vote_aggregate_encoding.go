@@ -0,0 +1,118 @@
+// Code generated by fastssz. DO NOT EDIT.
+package primitives
+
+import (
+	ssz "github.com/ferranbt/fastssz"
+)
+
+// MarshalSSZ ssz marshals the AggregateVote object
+func (a *AggregateVote) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(a)
+}
+
+// MarshalSSZTo ssz marshals the AggregateVote object to a target array
+func (a *AggregateVote) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(204)
+
+	// Offset (0) 'Vote'
+	dst = ssz.WriteOffset(dst, offset)
+	if a.Vote == nil {
+		a.Vote = new(MultiValidatorVote)
+	}
+	offset += a.Vote.SizeSSZ()
+
+	// Field (1) 'AggregatorIndex'
+	dst = ssz.MarshalUint64(dst, a.AggregatorIndex)
+
+	// Field (2) 'SelectionProof'
+	dst = append(dst, a.SelectionProof[:]...)
+
+	// Field (3) 'Signature'
+	dst = append(dst, a.Signature[:]...)
+
+	// Field (0) 'Vote'
+	if dst, err = a.Vote.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the AggregateVote object
+func (a *AggregateVote) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 204 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o0 uint64
+
+	// Offset (0) 'Vote'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
+	}
+
+	// Field (1) 'AggregatorIndex'
+	a.AggregatorIndex = ssz.UnmarshallUint64(buf[4:12])
+
+	// Field (2) 'SelectionProof'
+	copy(a.SelectionProof[:], buf[12:108])
+
+	// Field (3) 'Signature'
+	copy(a.Signature[:], buf[108:204])
+
+	// Field (0) 'Vote'
+	{
+		buf = tail[o0:]
+		if a.Vote == nil {
+			a.Vote = new(MultiValidatorVote)
+		}
+		if err = a.Vote.UnmarshalSSZ(buf); err != nil {
+			return err
+		}
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the AggregateVote object
+func (a *AggregateVote) SizeSSZ() (size int) {
+	size = 204
+
+	// Field (0) 'Vote'
+	if a.Vote == nil {
+		a.Vote = new(MultiValidatorVote)
+	}
+	size += a.Vote.SizeSSZ()
+
+	return
+}
+
+// HashTreeRoot ssz hashes the AggregateVote object
+func (a *AggregateVote) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(a)
+}
+
+// HashTreeRootWith ssz hashes the AggregateVote object with a hasher
+func (a *AggregateVote) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Vote'
+	if err = a.Vote.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (1) 'AggregatorIndex'
+	hh.PutUint64(a.AggregatorIndex)
+
+	// Field (2) 'SelectionProof'
+	hh.PutBytes(a.SelectionProof[:])
+
+	// Field (3) 'Signature'
+	hh.PutBytes(a.Signature[:])
+
+	hh.Merkleize(indx)
+	return
+}
@@ -0,0 +1,58 @@
+package primitives_test
+
+import (
+	"testing"
+
+	"github.com/olympus-protocol/ogen/pkg/bls"
+	"github.com/olympus-protocol/ogen/pkg/primitives"
+	testdata "github.com/olympus-protocol/ogen/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAggregateVote(t *testing.T) {
+	v := testdata.FuzzAggregateVote(10)
+	for _, c := range v {
+		ser, err := c.Marshal()
+		assert.NoError(t, err)
+
+		desc := new(primitives.AggregateVote)
+		err = desc.Unmarshal(ser)
+		assert.NoError(t, err)
+
+		assert.Equal(t, c, desc)
+	}
+
+	priv, err := bls.RandKey()
+	assert.NoError(t, err)
+
+	agg := v[0]
+	selectionMsg := primitives.AggregatorSelectionMessage(agg.Vote.Data.Slot)
+	copy(agg.SelectionProof[:], priv.Sign(selectionMsg[:]).Marshal())
+	msg := agg.SignatureMessage()
+	copy(agg.Signature[:], priv.Sign(msg[:]).Marshal())
+
+	assert.Equal(t, msg, agg.SignatureMessage())
+	assert.True(t, agg.VerifySelection(priv.PublicKey()))
+
+	other, err := bls.RandKey()
+	assert.NoError(t, err)
+	assert.False(t, agg.VerifySelection(other.PublicKey()))
+
+	agg.AggregatorIndex++
+	assert.False(t, agg.VerifySelection(priv.PublicKey()))
+}
+
+func TestIsAggregator(t *testing.T) {
+	proof := [96]byte{1, 2, 3}
+	assert.True(t, primitives.IsAggregator(10, 16, proof))
+	assert.True(t, primitives.IsAggregator(100, 0, proof))
+
+	selected := 0
+	for i := 0; i < 1000; i++ {
+		p := [96]byte{byte(i), byte(i >> 8)}
+		if primitives.IsAggregator(1600, 16, p) {
+			selected++
+		}
+	}
+	assert.True(t, selected > 0 && selected < 100)
+}
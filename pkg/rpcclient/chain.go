@@ -2,12 +2,16 @@ package rpcclient
 
 import (
 	"context"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/olympus-protocol/ogen/api/proto"
+	"github.com/olympus-protocol/ogen/pkg/chainhash"
+	"github.com/olympus-protocol/ogen/pkg/stateproof"
 )
 
 func (c *Client) GetChainInfo() (string, error) {
@@ -84,11 +88,14 @@ func (c *Client) GetAccountInfo(args []string) (string, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
 	defer cancel()
 	if len(args) < 1 {
-		return "", errors.New("Usage: getaccountinfo <account>")
+		return "", errors.New("Usage: getaccountinfo <account> [slot|blockhash]")
 	}
 	req := &proto.Account{
 		Account: args[0],
 	}
+	if len(args) > 1 {
+		req.Slot, req.BlockHash = parseStateQuery(args[1])
+	}
 	res, err := c.chain.GetAccountInfo(ctx, req)
 	if err != nil {
 		return "", err
@@ -99,3 +106,190 @@ func (c *Client) GetAccountInfo(args []string) (string, error) {
 	}
 	return string(b), nil
 }
+
+// GetContractStorage returns the value of a key on the storage of a contract.
+func (c *Client) GetContractStorage(args []string) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if len(args) < 2 {
+		return "", errors.New("Usage: getcontractstorage <contract> <key>")
+	}
+	res, err := c.chain.GetContractStorage(ctx, &proto.ContractStorageKey{Contract: args[0], Key: args[1]})
+	if err != nil {
+		return "", err
+	}
+	b, err := json.MarshalIndent(res, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// GetAsset returns the issuer, supply and holders count of an asset.
+func (c *Client) GetAsset(args []string) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if len(args) < 1 {
+		return "", errors.New("Usage: getasset <asset>")
+	}
+	res, err := c.chain.GetAsset(ctx, &proto.AssetID{Id: args[0]})
+	if err != nil {
+		return "", err
+	}
+	b, err := json.MarshalIndent(res, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// GetAccountProof returns the account balance and nonce proof. The proof is verified against the state root of the
+// block header before returning it.
+func (c *Client) GetAccountProof(args []string) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+	if len(args) < 1 {
+		return "", errors.New("Usage: getaccountproof <account> [slot|blockhash]")
+	}
+	req := &proto.Account{
+		Account: args[0],
+	}
+	if len(args) > 1 {
+		req.Slot, req.BlockHash = parseStateQuery(args[1])
+	}
+	res, err := c.chain.GetAccountProof(ctx, req)
+	if err != nil {
+		return "", err
+	}
+	proof, err := stateproof.FromProto(res)
+	if err != nil {
+		return "", err
+	}
+	block, err := c.chain.GetBlock(ctx, &proto.Hash{Hash: res.BlockHash})
+	if err != nil {
+		return "", err
+	}
+	rootBytes, err := hex.DecodeString(block.Header.StateRoot)
+	if err != nil {
+		return "", err
+	}
+	stateRoot, err := chainhash.NewHash(rootBytes)
+	if err != nil {
+		return "", err
+	}
+	if err := proof.VerifyAgainst(*stateRoot); err != nil {
+		return "", err
+	}
+	b, err := json.MarshalIndent(res, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// GetBlockReceipts returns the epoch receipts, manager payouts and validator balances of the epoch transitions processed
+// before a block.
+func (c *Client) GetBlockReceipts(args []string) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if len(args) < 1 {
+		return "", errors.New("Usage: getblockreceipts <hash>")
+	}
+	res, err := c.chain.GetBlockReceipts(ctx, &proto.Hash{Hash: args[0]})
+	if err != nil {
+		return "", err
+	}
+	b, err := json.MarshalIndent(res, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// GetContractReceipts returns the receipts of the contract deploys and calls included in a block.
+func (c *Client) GetContractReceipts(args []string) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if len(args) < 1 {
+		return "", errors.New("Usage: getcontractreceipts <hash>")
+	}
+	res, err := c.chain.GetContractReceipts(ctx, &proto.Hash{Hash: args[0]})
+	if err != nil {
+		return "", err
+	}
+	b, err := json.MarshalIndent(res, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// Sync streams the serialized blocks after a block hash up to the chain tip.
+func (c *Client) Sync(ctx context.Context, args []string, handler StreamHandler) error {
+	if len(args) < 1 {
+		return errors.New("Usage: sync <hash>")
+	}
+	stream, err := c.chain.Sync(ctx, &proto.Hash{Hash: args[0]})
+	if err != nil {
+		return err
+	}
+	return receive(ctx, func() (interface{}, error) { return stream.Recv() }, handler)
+}
+
+// SubscribeBlocks streams the serialized blocks added to the chain until the context is cancelled.
+func (c *Client) SubscribeBlocks(ctx context.Context, handler StreamHandler) error {
+	stream, err := c.chain.SubscribeBlocks(ctx, &proto.Empty{})
+	if err != nil {
+		return err
+	}
+	return receive(ctx, func() (interface{}, error) { return stream.Recv() }, handler)
+}
+
+// SubscribeTransactions streams the transaction events of a comma separated list of accounts, optionally resuming
+// from the cursor of a previous event.
+func (c *Client) SubscribeTransactions(ctx context.Context, args []string, handler StreamHandler) error {
+	if len(args) < 1 {
+		return errors.New("Usage: subscribetransactions <account,...> [cursor]")
+	}
+	stream, err := c.chain.SubscribeTransactions(ctx, parseSubscribeRequest(args))
+	if err != nil {
+		return err
+	}
+	return receive(ctx, func() (interface{}, error) { return stream.Recv() }, handler)
+}
+
+// SubscribeValidatorTransactions streams the deposit, exit and slashing events of a comma separated list of validator
+// public keys, optionally resuming from the cursor of a previous event.
+func (c *Client) SubscribeValidatorTransactions(ctx context.Context, args []string, handler StreamHandler) error {
+	if len(args) < 1 {
+		return errors.New("Usage: subscribevalidatortransactions <pub_key,...> [cursor]")
+	}
+	stream, err := c.chain.SubscribeValidatorTransactions(ctx, parseSubscribeRequest(args))
+	if err != nil {
+		return err
+	}
+	return receive(ctx, func() (interface{}, error) { return stream.Recv() }, handler)
+}
+
+// parseSubscribeRequest parses the comma separated keys and the optional cursor of a subscription.
+func parseSubscribeRequest(args []string) *proto.SubscribeRequest {
+	req := &proto.SubscribeRequest{}
+	for _, k := range strings.Split(args[0], ",") {
+		if k = strings.TrimSpace(k); k != "" {
+			req.Keys = append(req.Keys, k)
+		}
+	}
+	if len(args) > 1 {
+		req.Cursor = args[1]
+	}
+	return req
+}
+
+// parseStateQuery parses an optional argument that can be either a slot number or a block hash.
+func parseStateQuery(arg string) (*uint64, string) {
+	slot, err := strconv.ParseUint(arg, 10, 64)
+	if err != nil {
+		return nil, arg
+	}
+	return &slot, ""
+}
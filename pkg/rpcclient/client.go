@@ -1,8 +1,11 @@
 package rpcclient
 
 import (
+	"context"
 	"crypto/tls"
+	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 
 	"github.com/olympus-protocol/ogen/api/proto"
@@ -79,3 +82,27 @@ func NewRPCClient(addr string, insecure bool) *Client {
 	}
 	return client
 }
+
+// StreamHandler is called with every message received from a streaming method.
+type StreamHandler func(msg string) error
+
+// receive reads the messages of a stream until it ends or the context is cancelled. Every message is passed to the
+// handler with the same format used by the other methods.
+func receive(ctx context.Context, recv func() (interface{}, error), handler StreamHandler) error {
+	for {
+		msg, err := recv()
+		if err == io.EOF || ctx.Err() != nil {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		b, err := json.MarshalIndent(msg, "", "  ")
+		if err != nil {
+			return err
+		}
+		if err := handler(string(b)); err != nil {
+			return err
+		}
+	}
+}
@@ -136,3 +136,69 @@ func (c *Client) DecodeRawBlock(args []string) (string, error) {
 	}
 	return string(b), nil
 }
+
+// GetParticipationStatus returns the voting and proposing status of the validators owned by the node.
+func (c *Client) GetParticipationStatus() (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	res, err := c.utils.GetParticipationStatus(ctx, &proto.Empty{})
+	if err != nil {
+		return "", err
+	}
+	b, err := json.MarshalIndent(res, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// GetLogLevels returns the log level of every module of the node.
+func (c *Client) GetLogLevels() (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	res, err := c.utils.GetLogLevels(ctx, &proto.Empty{})
+	if err != nil {
+		return "", err
+	}
+	b, err := json.MarshalIndent(res, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// SetLogLevel changes the log level of a module of the node.
+func (c *Client) SetLogLevel(args []string) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if len(args) < 2 {
+		return "", errors.New("Usage: setloglevel <module> <level>")
+	}
+	res, err := c.utils.SetLogLevel(ctx, &proto.LogLevel{Module: args[0], Level: args[1]})
+	if err != nil {
+		return "", err
+	}
+	b, err := json.MarshalIndent(res, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// SyncMempool streams the transactions currently on the coins mempool.
+func (c *Client) SyncMempool(ctx context.Context, handler StreamHandler) error {
+	stream, err := c.utils.SyncMempool(ctx, &proto.Empty{})
+	if err != nil {
+		return err
+	}
+	return receive(ctx, func() (interface{}, error) { return stream.Recv() }, handler)
+}
+
+// SubscribeMempool streams the transactions added to the coins mempool until the context is cancelled.
+func (c *Client) SubscribeMempool(ctx context.Context, handler StreamHandler) error {
+	stream, err := c.utils.SubscribeMempool(ctx, &proto.Empty{})
+	if err != nil {
+		return err
+	}
+	return receive(ctx, func() (interface{}, error) { return stream.Recv() }, handler)
+}
@@ -9,10 +9,18 @@ import (
 	"github.com/olympus-protocol/ogen/api/proto"
 )
 
-func (c *Client) GetValidatorsList() (string, error) {
+func (c *Client) GetValidatorsList(args []string) (string, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
 	defer cancel()
-	res, err := c.validators.GetValidatorsList(ctx, &proto.Empty{})
+	var res *proto.ValidatorsRegistry
+	var err error
+	if len(args) > 0 {
+		req := new(proto.StateQuery)
+		req.Slot, req.BlockHash = parseStateQuery(args[0])
+		res, err = c.validators.GetValidatorsListAt(ctx, req)
+	} else {
+		res, err = c.validators.GetValidatorsList(ctx, &proto.Empty{})
+	}
 	if err != nil {
 		return "", err
 	}
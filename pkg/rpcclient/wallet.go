@@ -2,12 +2,16 @@ package rpcclient
 
 import (
 	"context"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
+	"io/ioutil"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/olympus-protocol/ogen/api/proto"
+	"github.com/olympus-protocol/ogen/internal/execution"
 )
 
 func (c *Client) ListWallets() (string, error) {
@@ -214,6 +218,179 @@ func (c *Client) SendTransaction(args []string) (string, error) {
 	return string(b), nil
 }
 
+// DeployContract deploys a contract from the open wallet. The code is either hex encoded or the path of a file ending
+// with .asm, which is assembled before deploying it.
+func (c *Client) DeployContract(args []string) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if len(args) < 2 {
+		return "", errors.New("Usage: deploycontract <code|file.asm> <gas_limit> [gas_price] [amount] [input]")
+	}
+	code, err := contractCode(args[0])
+	if err != nil {
+		return "", err
+	}
+	req := &proto.DeployContractInfo{Code: code}
+	req.GasLimit, req.GasPrice, req.Amount, req.Input, err = parseContractArgs(args[1:])
+	if err != nil {
+		return "", err
+	}
+	res, err := c.wallet.DeployContract(ctx, req)
+	if err != nil {
+		return "", err
+	}
+	b, err := json.MarshalIndent(res, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// CallContract calls a contract from the open wallet.
+func (c *Client) CallContract(args []string) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if len(args) < 2 {
+		return "", errors.New("Usage: callcontract <contract> <gas_limit> [gas_price] [amount] [input]")
+	}
+	req := &proto.CallContractInfo{Contract: args[0]}
+	var err error
+	req.GasLimit, req.GasPrice, req.Amount, req.Input, err = parseContractArgs(args[1:])
+	if err != nil {
+		return "", err
+	}
+	res, err := c.wallet.CallContract(ctx, req)
+	if err != nil {
+		return "", err
+	}
+	b, err := json.MarshalIndent(res, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+func (c *Client) IssueAsset(args []string) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if len(args) < 1 {
+		return "", errors.New("Usage: issueasset <amount> [asset] [account]")
+	}
+	amount, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		return "", err
+	}
+	req := &proto.AssetTxInfo{Amount: amount}
+	if len(args) > 1 {
+		req.Asset = args[1]
+	}
+	if len(args) > 2 {
+		req.Account = args[2]
+	}
+	res, err := c.wallet.IssueAsset(ctx, req)
+	if err != nil {
+		return "", err
+	}
+	b, err := json.MarshalIndent(res, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+func (c *Client) TransferAsset(args []string) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if len(args) < 3 {
+		return "", errors.New("Usage: transferasset <asset> <account> <amount>")
+	}
+	amount, err := strconv.ParseUint(args[2], 10, 64)
+	if err != nil {
+		return "", err
+	}
+	res, err := c.wallet.TransferAsset(ctx, &proto.AssetTxInfo{Asset: args[0], Account: args[1], Amount: amount})
+	if err != nil {
+		return "", err
+	}
+	b, err := json.MarshalIndent(res, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+func (c *Client) BurnAsset(args []string) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if len(args) < 2 {
+		return "", errors.New("Usage: burnasset <asset> <amount>")
+	}
+	amount, err := strconv.ParseUint(args[1], 10, 64)
+	if err != nil {
+		return "", err
+	}
+	res, err := c.wallet.BurnAsset(ctx, &proto.AssetTxInfo{Asset: args[0], Amount: amount})
+	if err != nil {
+		return "", err
+	}
+	b, err := json.MarshalIndent(res, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+func (c *Client) GetAssetBalances() (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	res, err := c.wallet.GetAssetBalances(ctx, &proto.Empty{})
+	if err != nil {
+		return "", err
+	}
+	b, err := json.MarshalIndent(res, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+func contractCode(arg string) (string, error) {
+	if !strings.HasSuffix(arg, ".asm") {
+		return arg, nil
+	}
+	src, err := ioutil.ReadFile(arg)
+	if err != nil {
+		return "", err
+	}
+	code, err := execution.Assemble(string(src))
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(code), nil
+}
+
+// parseContractArgs parses the gas limit followed by the optional gas price, amount and hex input.
+func parseContractArgs(args []string) (gasLimit uint64, gasPrice uint64, amount string, input string, err error) {
+	gasPrice = 1
+	gasLimit, err = strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		return
+	}
+	if len(args) > 1 {
+		gasPrice, err = strconv.ParseUint(args[1], 10, 64)
+		if err != nil {
+			return
+		}
+	}
+	if len(args) > 2 {
+		amount = args[2]
+	}
+	if len(args) > 3 {
+		input = args[3]
+	}
+	return
+}
+
 func (c *Client) ExitValidator(args []string) (string, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
 	defer cancel()
@@ -247,3 +424,39 @@ func (c *Client) StartValidator(args []string) (string, error) {
 	}
 	return string(b), nil
 }
+
+// StartValidatorBulk starts multiple validators using the current open wallet as the deposit holder.
+func (c *Client) StartValidatorBulk(args []string) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+	if len(args) < 1 {
+		return "", errors.New("Usage: startvalidatorbulk <priv_key> [priv_key...]")
+	}
+	res, err := c.wallet.StartValidatorBulk(ctx, &proto.KeyPairs{Keys: args})
+	if err != nil {
+		return "", err
+	}
+	b, err := json.MarshalIndent(res, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// ExitValidatorBulk exits multiple validators from the current open wallet.
+func (c *Client) ExitValidatorBulk(args []string) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+	if len(args) < 1 {
+		return "", errors.New("Usage: exitvalidatorbulk <pub_key> [pub_key...]")
+	}
+	res, err := c.wallet.ExitValidatorBulk(ctx, &proto.KeyPairs{Keys: args})
+	if err != nil {
+		return "", err
+	}
+	b, err := json.MarshalIndent(res, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
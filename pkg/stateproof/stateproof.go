@@ -0,0 +1,181 @@
+package stateproof
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/olympus-protocol/ogen/api/proto"
+	"github.com/olympus-protocol/ogen/pkg/bech32"
+	"github.com/olympus-protocol/ogen/pkg/chainhash"
+	"github.com/olympus-protocol/ogen/pkg/primitives"
+)
+
+var (
+	// ErrStateRootMismatch is returned when the proof is for a different state root than the trusted one.
+	ErrStateRootMismatch = errors.New("proof state root doesn't match the trusted state root")
+
+	// ErrInvalidWitness is returned when a witness doesn't prove its value against the state root.
+	ErrInvalidWitness = errors.New("witness doesn't match the state root")
+)
+
+// AccountProof is the balance and nonce of an account proven against the state root of a block.
+type AccountProof struct {
+	Account        [20]byte
+	Balance        uint64
+	Nonce          uint64
+	BlockHash      chainhash.Hash
+	Slot           uint64
+	StateRoot      chainhash.Hash
+	BalanceWitness *primitives.VerificationWitness
+	NonceWitness   *primitives.VerificationWitness
+}
+
+// Verify checks that the balance and nonce witnesses prove the account values against the proof state root.
+func (p *AccountProof) Verify() error {
+	if err := verifyValue(p.BalanceWitness, primitives.BalanceKey(p.Account), p.Balance, p.StateRoot); err != nil {
+		return fmt.Errorf("invalid balance proof: %s", err)
+	}
+	if err := verifyValue(p.NonceWitness, primitives.NonceKey(p.Account), p.Nonce, p.StateRoot); err != nil {
+		return fmt.Errorf("invalid nonce proof: %s", err)
+	}
+	return nil
+}
+
+// VerifyAgainst checks the proof against a trusted state root, usually taken from a block header.
+func (p *AccountProof) VerifyAgainst(stateRoot chainhash.Hash) error {
+	if !p.StateRoot.IsEqual(&stateRoot) {
+		return ErrStateRootMismatch
+	}
+	return p.Verify()
+}
+
+func verifyValue(w *primitives.VerificationWitness, key chainhash.Hash, value uint64, stateRoot chainhash.Hash) error {
+	if w == nil {
+		return errors.New("missing witness")
+	}
+	if !w.Key.IsEqual(&key) {
+		return errors.New("witness key doesn't match the account")
+	}
+	if primitives.ValueUint64(w.Value) != value {
+		return errors.New("witness value doesn't match the account")
+	}
+	if !w.Check(stateRoot) {
+		return ErrInvalidWitness
+	}
+	return nil
+}
+
+// FromProto converts an account proof RPC response.
+func FromProto(p *proto.AccountProof) (*AccountProof, error) {
+	_, acc, err := bech32.Decode(p.Account)
+	if err != nil {
+		return nil, err
+	}
+	if len(acc) != 20 {
+		return nil, errors.New("invalid account length")
+	}
+
+	blockHash, err := chainhash.NewHashFromStr(p.BlockHash)
+	if err != nil {
+		return nil, err
+	}
+	stateRoot, err := decodeHash(p.StateRoot)
+	if err != nil {
+		return nil, err
+	}
+	balanceWitness, err := WitnessFromProto(p.BalanceProof)
+	if err != nil {
+		return nil, err
+	}
+	nonceWitness, err := WitnessFromProto(p.NonceProof)
+	if err != nil {
+		return nil, err
+	}
+
+	proof := &AccountProof{
+		Balance:        p.Balance,
+		Nonce:          p.Nonce,
+		BlockHash:      blockHash,
+		Slot:           p.Slot,
+		StateRoot:      stateRoot,
+		BalanceWitness: balanceWitness,
+		NonceWitness:   nonceWitness,
+	}
+	copy(proof.Account[:], acc)
+
+	return proof, nil
+}
+
+// ToProto converts the proof to its RPC representation, encoding the account with the provided prefix.
+func (p *AccountProof) ToProto(accountPrefix string) *proto.AccountProof {
+	return &proto.AccountProof{
+		Account:      bech32.Encode(accountPrefix, p.Account[:]),
+		Balance:      p.Balance,
+		Nonce:        p.Nonce,
+		BlockHash:    p.BlockHash.String(),
+		Slot:         p.Slot,
+		StateRoot:    hex.EncodeToString(p.StateRoot[:]),
+		BalanceProof: WitnessToProto(p.BalanceWitness),
+		NonceProof:   WitnessToProto(p.NonceWitness),
+	}
+}
+
+// WitnessToProto converts a verification witness to its RPC representation.
+func WitnessToProto(w *primitives.VerificationWitness) *proto.StateProof {
+	witnesses := make([]string, len(w.Witnesses))
+	for i := range w.Witnesses {
+		witnesses[i] = hex.EncodeToString(w.Witnesses[i][:])
+	}
+	return &proto.StateProof{
+		Key:             hex.EncodeToString(w.Key[:]),
+		Value:           hex.EncodeToString(w.Value[:]),
+		WitnessBitfield: hex.EncodeToString(w.WitnessBitfield[:]),
+		Witnesses:       witnesses,
+		LastLevel:       uint32(w.LastLevel),
+	}
+}
+
+// WitnessFromProto converts a verification witness from its RPC representation.
+func WitnessFromProto(p *proto.StateProof) (*primitives.VerificationWitness, error) {
+	if p == nil {
+		return nil, errors.New("missing witness")
+	}
+	if p.LastLevel > 255 {
+		return nil, errors.New("invalid witness last level")
+	}
+
+	var err error
+	w := &primitives.VerificationWitness{
+		Witnesses: make([]chainhash.Hash, len(p.Witnesses)),
+		LastLevel: uint8(p.LastLevel),
+	}
+	if w.Key, err = decodeHash(p.Key); err != nil {
+		return nil, err
+	}
+	if w.Value, err = decodeHash(p.Value); err != nil {
+		return nil, err
+	}
+	if w.WitnessBitfield, err = decodeHash(p.WitnessBitfield); err != nil {
+		return nil, err
+	}
+	for i := range p.Witnesses {
+		if w.Witnesses[i], err = decodeHash(p.Witnesses[i]); err != nil {
+			return nil, err
+		}
+	}
+	return w, nil
+}
+
+func decodeHash(s string) (chainhash.Hash, error) {
+	var h chainhash.Hash
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		return h, err
+	}
+	if len(b) != chainhash.HashSize {
+		return h, fmt.Errorf("invalid hash length %d", len(b))
+	}
+	copy(h[:], b)
+	return h, nil
+}
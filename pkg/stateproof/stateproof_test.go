@@ -0,0 +1,109 @@
+package stateproof_test
+
+import (
+	"testing"
+
+	"github.com/olympus-protocol/ogen/internal/csmt"
+	"github.com/olympus-protocol/ogen/pkg/chainhash"
+	"github.com/olympus-protocol/ogen/pkg/primitives"
+	"github.com/olympus-protocol/ogen/pkg/stateproof"
+	"github.com/stretchr/testify/assert"
+)
+
+func buildProof(t *testing.T, acc [20]byte) *stateproof.AccountProof {
+	tree := csmt.NewTree(csmt.NewInMemoryTreeDB())
+
+	proof := &stateproof.AccountProof{
+		Account: acc,
+		Balance: 1000,
+		Nonce:   3,
+	}
+
+	err := tree.Update(func(tx csmt.TreeTransactionAccess) error {
+		for i := byte(0); i < 20; i++ {
+			if err := tx.Set(primitives.BalanceKey([20]byte{i}), primitives.Uint64Value(uint64(i)+1)); err != nil {
+				return err
+			}
+		}
+		if err := tx.Set(primitives.BalanceKey(acc), primitives.Uint64Value(proof.Balance)); err != nil {
+			return err
+		}
+		return tx.Set(primitives.NonceKey(acc), primitives.Uint64Value(proof.Nonce))
+	})
+	assert.NoError(t, err)
+
+	err = tree.View(func(tx csmt.TreeTransactionAccess) error {
+		root, err := tx.Hash()
+		if err != nil {
+			return err
+		}
+		proof.StateRoot = *root
+
+		if proof.BalanceWitness, err = tx.Prove(primitives.BalanceKey(acc)); err != nil {
+			return err
+		}
+		proof.NonceWitness, err = tx.Prove(primitives.NonceKey(acc))
+		return err
+	})
+	assert.NoError(t, err)
+
+	return proof
+}
+
+func TestAccountProof_Verify(t *testing.T) {
+	proof := buildProof(t, [20]byte{100, 1})
+
+	assert.NoError(t, proof.Verify())
+	assert.NoError(t, proof.VerifyAgainst(proof.StateRoot))
+	assert.Equal(t, stateproof.ErrStateRootMismatch, proof.VerifyAgainst(chainhash.Hash{1}))
+
+	proof.Balance++
+	assert.Error(t, proof.Verify())
+	proof.Balance--
+
+	proof.Account[0]++
+	assert.Error(t, proof.Verify())
+	proof.Account[0]--
+
+	proof.BalanceWitness.Value = primitives.Uint64Value(proof.Balance + 1)
+	proof.Balance++
+	assert.Error(t, proof.Verify())
+}
+
+func TestAccountProof_Missing(t *testing.T) {
+	tree := csmt.NewTree(csmt.NewInMemoryTreeDB())
+	assert.NoError(t, tree.Update(func(tx csmt.TreeTransactionAccess) error {
+		return tx.Set(primitives.BalanceKey([20]byte{1}), primitives.Uint64Value(10))
+	}))
+
+	// an account missing from the tree is proven with zero balance and nonce
+	proof := &stateproof.AccountProof{Account: [20]byte{2}}
+	assert.NoError(t, tree.View(func(tx csmt.TreeTransactionAccess) error {
+		root, err := tx.Hash()
+		if err != nil {
+			return err
+		}
+		proof.StateRoot = *root
+		if proof.BalanceWitness, err = tx.Prove(primitives.BalanceKey(proof.Account)); err != nil {
+			return err
+		}
+		proof.NonceWitness, err = tx.Prove(primitives.NonceKey(proof.Account))
+		return err
+	}))
+
+	assert.NoError(t, proof.Verify())
+
+	proof.Balance = 10
+	assert.Error(t, proof.Verify())
+}
+
+func TestFromProto(t *testing.T) {
+	proof := buildProof(t, [20]byte{100, 1})
+
+	res := proof.ToProto("olpub")
+
+	decoded, err := stateproof.FromProto(res)
+	assert.NoError(t, err)
+	assert.Equal(t, proof, decoded)
+	assert.NoError(t, decoded.Verify())
+}
@@ -78,6 +78,19 @@ func FuzzMultiValidatorVote(n int, correct bool, complete bool) []*primitives.Mu
 	return v
 }
 
+// FuzzAggregateVote creates a slice of AggregateVote
+func FuzzAggregateVote(n int) []*primitives.AggregateVote {
+	var v []*primitives.AggregateVote
+	f := fuzz.New().NilChance(0)
+	for i := 0; i < n; i++ {
+		d := new(primitives.AggregateVote)
+		f.Fuzz(&d)
+		d.Vote = FuzzMultiValidatorVote(1, true, true)[0]
+		v = append(v, d)
+	}
+	return v
+}
+
 // FuzzValidator creates a slice of Validator
 func FuzzValidator(n int) []*primitives.Validator {
 	var v []*primitives.Validator
@@ -158,9 +171,21 @@ func FuzzCoinState(n int) *primitives.CoinsState {
 	f.Fuzz(&balances)
 	f.Fuzz(&nonces)
 
+	assets := map[[20]byte]*primitives.Asset{}
+	for i := 0; i < n; i++ {
+		a := &primitives.Asset{Balances: map[[20]byte]uint64{}}
+		f.Fuzz(&a.Issuer)
+		f.Fuzz(&a.Supply)
+		f.Fuzz(&a.Balances)
+		var id [20]byte
+		f.Fuzz(&id)
+		assets[id] = a
+	}
+
 	v := &primitives.CoinsState{
 		Balances: balances,
 		Nonces:   nonces,
+		Assets:   assets,
 	}
 	return v
 }
@@ -238,6 +263,9 @@ func FuzzBlock(n int, correct bool, complete bool) []*primitives.Block {
 			RANDAOSlashings:   FuzzRANDAOSlashing(2),
 			ProposerSlashings: FuzzProposerSlashing(2, true),
 			GovernanceVotes:   FuzzGovernanceVote(5),
+			ContractDeploys:   FuzzContractDeploy(2),
+			ContractCalls:     FuzzContractCall(2),
+			AssetTxs:          FuzzAssetTx(2),
 		}
 
 		var sig [96]byte
@@ -407,6 +435,55 @@ func FuzzTx(n int) []*primitives.Tx {
 	return v
 }
 
+// FuzzContractDeploy returns a slice of n signed ContractDeploy
+func FuzzContractDeploy(n int) []*primitives.ContractDeploy {
+	f := fuzz.New().NilChance(0)
+	var v []*primitives.ContractDeploy
+	for i := 0; i < n; i++ {
+		d := new(primitives.ContractDeploy)
+		f.Fuzz(d)
+		k, _ := bls.RandKey()
+		copy(d.FromPublicKey[:], k.PublicKey().Marshal())
+		msg := d.SignatureMessage()
+		copy(d.Signature[:], k.Sign(msg[:]).Marshal())
+		v = append(v, d)
+	}
+	return v
+}
+
+// FuzzContractCall returns a slice of n signed ContractCall
+func FuzzContractCall(n int) []*primitives.ContractCall {
+	f := fuzz.New().NilChance(0)
+	var v []*primitives.ContractCall
+	for i := 0; i < n; i++ {
+		d := new(primitives.ContractCall)
+		f.Fuzz(d)
+		k, _ := bls.RandKey()
+		copy(d.FromPublicKey[:], k.PublicKey().Marshal())
+		msg := d.SignatureMessage()
+		copy(d.Signature[:], k.Sign(msg[:]).Marshal())
+		v = append(v, d)
+	}
+	return v
+}
+
+// FuzzAssetTx returns a slice of n signed AssetTx
+func FuzzAssetTx(n int) []*primitives.AssetTx {
+	f := fuzz.New().NilChance(0)
+	var v []*primitives.AssetTx
+	for i := 0; i < n; i++ {
+		d := new(primitives.AssetTx)
+		f.Fuzz(d)
+		d.Type = d.Type % (primitives.AssetBurn + 1)
+		k, _ := bls.RandKey()
+		copy(d.FromPublicKey[:], k.PublicKey().Marshal())
+		msg := d.SignatureMessage()
+		copy(d.Signature[:], k.Sign(msg[:]).Marshal())
+		v = append(v, d)
+	}
+	return v
+}
+
 // FuzzTx returns a slice of n Tx
 func FuzzTxMulti(n int) []*primitives.TxMulti {
 	f := fuzz.New().NilChance(0)
@@ -40,12 +40,18 @@ var TestParams = params.ChainParams{
 	InactivityPenaltyQuotient:    17179869184,
 	SlotDuration:                 6,
 	MaxVotesPerBlock:             32,
+	TargetAggregatorsPerSlot:     16,
 	MaxTxsPerBlock:               5000,
 	MaxDepositsPerBlock:          128,
 	MaxExitsPerBlock:             128,
 	MaxRANDAOSlashingsPerBlock:   20,
 	MaxProposerSlashingsPerBlock: 2,
 	MaxVoteSlashingsPerBlock:     10,
+	MaxContractDeploysPerBlock:   16,
+	MaxContractCallsPerBlock:     128,
+	MaxContractGasPerBlock:       10000000,
+	MinContractGasPrice:          1,
+	MaxAssetTxsPerBlock:          1024,
 	WhistleblowerRewardQuotient:  2,
 	GovernancePercentages: []uint8{
 		30, // tech
@@ -57,6 +63,7 @@ var TestParams = params.ChainParams{
 	MinVotingBalance:          100,
 	CommunityOverrideQuotient: 3,
 	VotingPeriodSlots:         20160, // minutes in a week
+	StateRootActivationSlot:   0,
 	InitialManagers: [][20]byte{
 		{252, 94, 117, 132, 63, 93, 202, 26, 36, 23, 195, 26, 169, 95, 74, 147, 72, 184, 66, 20},        // tlpub1l308tpplth9p5fqhcvd2jh62jdytsss54nt6d4
 		{192, 13, 158, 167, 115, 190, 56, 51, 43, 11, 156, 43, 27, 145, 143, 61, 40, 209, 114, 238},     // tlpub1cqxeafmnhcurx2ctns43hyv0855dzuhwnllx6w
@@ -0,0 +1,263 @@
+// Package testchain builds chains of valid blocks and votes signed by known genesis validators for tests.
+package testchain
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/olympus-protocol/ogen/cmd/ogen/config"
+	"github.com/olympus-protocol/ogen/cmd/ogen/initialization"
+	"github.com/olympus-protocol/ogen/internal/state"
+	"github.com/olympus-protocol/ogen/pkg/bitfield"
+	"github.com/olympus-protocol/ogen/pkg/bls"
+	"github.com/olympus-protocol/ogen/pkg/chainhash"
+	"github.com/olympus-protocol/ogen/pkg/logger"
+	"github.com/olympus-protocol/ogen/pkg/primitives"
+	testdata "github.com/olympus-protocol/ogen/test"
+)
+
+// Keys are the keys of the genesis validators and the premine account of a test chain.
+type Keys struct {
+	InitParams *initialization.InitializationParameters
+	Premine    *bls.SecretKey
+
+	validators map[[48]byte]*bls.SecretKey
+}
+
+// Setup sets the global test params and flags for a genesis with numValidators validators. The genesis time is set
+// far enough in the past for the blocks of the test to be accepted.
+func Setup(numValidators int) (*Keys, error) {
+	bls.Initialize(&testdata.TestParams)
+
+	premine, err := bls.RandKey()
+	if err != nil {
+		return nil, err
+	}
+	k := &Keys{
+		InitParams: &initialization.InitializationParameters{
+			PremineAddress: premine.PublicKey().ToAccount(),
+			GenesisTime:    time.Now().Add(-24 * time.Hour),
+		},
+		Premine:    premine,
+		validators: make(map[[48]byte]*bls.SecretKey),
+	}
+
+	for i := 0; i < numValidators; i++ {
+		key, err := bls.RandKey()
+		if err != nil {
+			return nil, err
+		}
+		var pub [48]byte
+		copy(pub[:], key.PublicKey().Marshal())
+		k.validators[pub] = key
+		k.InitParams.InitialValidators = append(k.InitParams.InitialValidators, initialization.ValidatorInitialization{
+			PubKey:       fmt.Sprintf("%x", pub),
+			PayeeAddress: k.InitParams.PremineAddress,
+		})
+	}
+
+	config.GlobalParams = &config.Params{
+		Logger:     logger.New(os.Stdout).Quiet(),
+		NetParams:  &testdata.TestParams,
+		InitParams: k.InitParams,
+		Context:    context.Background(),
+	}
+	config.GlobalFlags = &config.Flags{
+		DBBackend: "memory",
+	}
+
+	return k, nil
+}
+
+// GenesisState returns the state of the genesis block.
+func (k *Keys) GenesisState() (state.State, error) {
+	genesis := primitives.GetGenesisBlock()
+	return state.GetGenesisStateWithInitializationParameters(genesis.Hash(), k.InitParams, config.GlobalParams.NetParams)
+}
+
+// Key returns the secret key of a genesis validator.
+func (k *Keys) Key(pub [48]byte) (*bls.SecretKey, bool) {
+	key, ok := k.validators[pub]
+	return key, ok
+}
+
+// Vote returns the vote of the whole committee of the state slot for the beacon block. The state must be updated to
+// the slot of the vote.
+func (k *Keys) Vote(st state.State, beacon chainhash.Hash) (*primitives.MultiValidatorVote, error) {
+	netParams := config.GlobalParams.NetParams
+	slot := st.GetSlot()
+
+	committee, err := st.GetVoteCommittee(slot)
+	if err != nil {
+		return nil, err
+	}
+
+	toEpoch := (slot - 1) / netParams.EpochLength
+	data := &primitives.VoteData{
+		Slot:            slot,
+		FromEpoch:       st.GetJustifiedEpoch(),
+		FromHash:        st.GetJustifiedEpochHash(),
+		ToEpoch:         toEpoch,
+		ToHash:          st.GetRecentBlockHash(toEpoch*netParams.EpochLength - 1),
+		BeaconBlockHash: beacon,
+	}
+	dataHash := data.Hash()
+
+	registry := st.GetValidatorRegistry()
+	participation := bitfield.NewBitlist(uint64(len(committee)))
+	sigs := make([]*bls.Signature, 0, len(committee))
+	for i, index := range committee {
+		key, ok := k.validators[registry[index].PubKey]
+		if !ok {
+			return nil, fmt.Errorf("missing key for validator %d", index)
+		}
+		sigs = append(sigs, key.Sign(dataHash[:]))
+		participation.Set(uint(i))
+	}
+
+	vote := &primitives.MultiValidatorVote{
+		Data:                  data,
+		ParticipationBitfield: participation,
+	}
+	copy(vote.Sig[:], bls.AggregateSignatures(sigs).Marshal())
+	return vote, nil
+}
+
+// Block returns a block on top of the parent for the slot of the state, with its merkle roots and state root filled
+// and signed by the slot proposer. The fill function can add items to the block before the roots are calculated. The
+// state must be updated to the slot of the block and is not modified.
+func (k *Keys) Block(st state.State, parent chainhash.Hash, fill func(b *primitives.Block)) (*primitives.Block, error) {
+	slot := st.GetSlot()
+
+	proposer, key, err := k.proposer(st)
+	if err != nil {
+		return nil, err
+	}
+
+	b := &primitives.Block{
+		Header: &primitives.BlockHeader{
+			PrevBlockHash: parent,
+			Timestamp:     uint64(time.Now().Unix()),
+			Slot:          slot,
+			FeeAddress:    proposer.PayeeAddress,
+		},
+	}
+	if fill != nil {
+		fill(b)
+	}
+
+	b.Header.VoteMerkleRoot = b.VotesMerkleRoot()
+	b.Header.TxMerkleRoot = b.TransactionMerkleRoot()
+	b.Header.TxMultiMerkleRoot = b.TransactionMultiMerkleRoot()
+	b.Header.DepositMerkleRoot = b.DepositMerkleRoot()
+	b.Header.ExitMerkleRoot = b.ExitMerkleRoot()
+	b.Header.ProposerSlashingMerkleRoot = b.ProposerSlashingsRoot()
+	b.Header.RANDAOSlashingMerkleRoot = b.RANDAOSlashingsRoot()
+	b.Header.VoteSlashingMerkleRoot = b.VoteSlashingRoot()
+	b.Header.GovernanceVotesMerkleRoot = b.GovernanceVoteMerkleRoot()
+	b.Header.ContractMerkleRoot = b.ContractMerkleRoot()
+	b.Header.AssetTxMerkleRoot = b.AssetTxMerkleRoot()
+
+	randaoHash := chainhash.HashH([]byte(fmt.Sprintf("%d", slot)))
+	copy(b.RandaoSignature[:], key.Sign(randaoHash[:]).Marshal())
+
+	post := st.Copy()
+	if err := post.ApplyBlock(b); err != nil {
+		return nil, err
+	}
+	root, err := post.StateRoot()
+	if err != nil {
+		return nil, err
+	}
+	b.Header.StateRoot = root
+
+	blockHash := b.Hash()
+	copy(b.Signature[:], key.Sign(blockHash[:]).Marshal())
+
+	return b, nil
+}
+
+// Sign signs the block again with the key of the slot proposer, after its header was changed. The state must be
+// updated to the slot of the block.
+func (k *Keys) Sign(st state.State, b *primitives.Block) error {
+	_, key, err := k.proposer(st)
+	if err != nil {
+		return err
+	}
+	blockHash := b.Hash()
+	copy(b.Signature[:], key.Sign(blockHash[:]).Marshal())
+	return nil
+}
+
+// proposer returns the proposer of the state slot and its key.
+func (k *Keys) proposer(st state.State) (*primitives.Validator, *bls.SecretKey, error) {
+	netParams := config.GlobalParams.NetParams
+	slot := st.GetSlot()
+
+	slotIndex := (slot + netParams.EpochLength - 1) % netParams.EpochLength
+	proposer := st.GetValidatorRegistry()[st.GetProposerQueue()[slotIndex]]
+	key, ok := k.validators[proposer.PubKey]
+	if !ok {
+		return nil, nil, fmt.Errorf("missing key for proposer of slot %d", slot)
+	}
+	return proposer, key, nil
+}
+
+// View is a block view of a chain without forks for processing states outside of a blockchain.
+type View struct {
+	slots  []uint64
+	hashes []chainhash.Hash
+	roots  []chainhash.Hash
+
+	tipSlot uint64
+}
+
+var _ state.BlockView = &View{}
+
+// NewView returns a view with the genesis block as tip.
+func NewView() *View {
+	genesis := primitives.GetGenesisBlock()
+	return &View{
+		slots:  []uint64{0},
+		hashes: []chainhash.Hash{genesis.Hash()},
+		roots:  []chainhash.Hash{{}},
+	}
+}
+
+// Add adds a block as the new tip of the view.
+func (v *View) Add(b *primitives.Block) {
+	v.slots = append(v.slots, b.Header.Slot)
+	v.hashes = append(v.hashes, b.Hash())
+	v.roots = append(v.roots, b.Header.StateRoot)
+	v.tipSlot = b.Header.Slot
+}
+
+// GetHashBySlot returns the hash of the last block at or before the slot.
+func (v *View) GetHashBySlot(slot uint64) (chainhash.Hash, error) {
+	if slot > v.tipSlot && slot > v.slots[len(v.slots)-1] {
+		return chainhash.Hash{}, fmt.Errorf("could not get block past tip")
+	}
+	for i := len(v.slots) - 1; i >= 0; i-- {
+		if v.slots[i] <= slot {
+			return v.hashes[i], nil
+		}
+	}
+	return v.hashes[0], nil
+}
+
+// Tip returns the hash of the last block.
+func (v *View) Tip() (chainhash.Hash, error) {
+	return v.hashes[len(v.hashes)-1], nil
+}
+
+// SetTipSlot sets the slot the state was updated to.
+func (v *View) SetTipSlot(slot uint64) {
+	v.tipSlot = slot
+}
+
+// GetLastStateRoot returns the state root of the last block.
+func (v *View) GetLastStateRoot() (chainhash.Hash, error) {
+	return v.roots[len(v.roots)-1], nil
+}